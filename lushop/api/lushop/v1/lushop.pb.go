@@ -12,6 +12,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,6 +25,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 短信验证码场景
+type SmsScene int32
+
+const (
+	SmsScene_SMS_SCENE_UNSPECIFIED    SmsScene = 0
+	SmsScene_SMS_SCENE_LOGIN          SmsScene = 1 // 登录
+	SmsScene_SMS_SCENE_REGISTER       SmsScene = 2 // 注册
+	SmsScene_SMS_SCENE_RESET_PASSWORD SmsScene = 3 // 重置密码
+)
+
+// Enum value maps for SmsScene.
+var (
+	SmsScene_name = map[int32]string{
+		0: "SMS_SCENE_UNSPECIFIED",
+		1: "SMS_SCENE_LOGIN",
+		2: "SMS_SCENE_REGISTER",
+		3: "SMS_SCENE_RESET_PASSWORD",
+	}
+	SmsScene_value = map[string]int32{
+		"SMS_SCENE_UNSPECIFIED":    0,
+		"SMS_SCENE_LOGIN":          1,
+		"SMS_SCENE_REGISTER":       2,
+		"SMS_SCENE_RESET_PASSWORD": 3,
+	}
+)
+
+func (x SmsScene) Enum() *SmsScene {
+	p := new(SmsScene)
+	*p = x
+	return p
+}
+
+func (x SmsScene) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SmsScene) Descriptor() protoreflect.EnumDescriptor {
+	return file_lushop_v1_lushop_proto_enumTypes[0].Descriptor()
+}
+
+func (SmsScene) Type() protoreflect.EnumType {
+	return &file_lushop_v1_lushop_proto_enumTypes[0]
+}
+
+func (x SmsScene) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SmsScene.Descriptor instead.
+func (SmsScene) EnumDescriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{0}
+}
+
 type CreateUserInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NickName      string                 `protobuf:"bytes,1,opt,name=nickName,proto3" json:"nickName,omitempty"`
@@ -90,6 +144,7 @@ type RegisterReq struct {
 	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SmsCode       string                 `protobuf:"bytes,4,opt,name=smsCode,proto3" json:"smsCode,omitempty"` // 注册场景的短信验证码
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -145,16 +200,25 @@ func (x *RegisterReq) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterReq) GetSmsCode() string {
+	if x != nil {
+		return x.SmsCode
+	}
+	return ""
+}
+
 // 用户注册响应
 type RegisterReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Mobile        string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	Username      string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	Token         string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	ExpiredAt     int64                  `protobuf:"varint,6,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mobile           string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Username         string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Token            string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiredAt        int64                  `protobuf:"varint,6,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"`
+	RefreshToken     string                 `protobuf:"bytes,7,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	RefreshExpiredAt int64                  `protobuf:"varint,8,opt,name=refreshExpiredAt,proto3" json:"refreshExpiredAt,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *RegisterReply) Reset() {
@@ -222,31 +286,42 @@ func (x *RegisterReply) GetExpiredAt() int64 {
 	return 0
 }
 
-// 用户登录请求
-type LoginReq struct {
+func (x *RegisterReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RegisterReply) GetRefreshExpiredAt() int64 {
+	if x != nil {
+		return x.RefreshExpiredAt
+	}
+	return 0
+}
+
+type SmsLoginReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Captcha       string                 `protobuf:"bytes,3,opt,name=captcha,proto3" json:"captcha,omitempty"`
-	CaptchaId     string                 `protobuf:"bytes,4,opt,name=captchaId,proto3" json:"captchaId,omitempty"`
+	SmsCode       string                 `protobuf:"bytes,2,opt,name=smsCode,proto3" json:"smsCode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginReq) Reset() {
-	*x = LoginReq{}
+func (x *SmsLoginReq) Reset() {
+	*x = SmsLoginReq{}
 	mi := &file_lushop_v1_lushop_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginReq) String() string {
+func (x *SmsLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginReq) ProtoMessage() {}
+func (*SmsLoginReq) ProtoMessage() {}
 
-func (x *LoginReq) ProtoReflect() protoreflect.Message {
+func (x *SmsLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_lushop_v1_lushop_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -258,67 +333,100 @@ func (x *LoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
-func (*LoginReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SmsLoginReq.ProtoReflect.Descriptor instead.
+func (*SmsLoginReq) Descriptor() ([]byte, []int) {
 	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LoginReq) GetMobile() string {
+func (x *SmsLoginReq) GetMobile() string {
 	if x != nil {
 		return x.Mobile
 	}
 	return ""
 }
 
-func (x *LoginReq) GetPassword() string {
+func (x *SmsLoginReq) GetSmsCode() string {
 	if x != nil {
-		return x.Password
+		return x.SmsCode
 	}
 	return ""
 }
 
-func (x *LoginReq) GetCaptcha() string {
+type SendSmsCodeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Scene         SmsScene               `protobuf:"varint,2,opt,name=scene,proto3,enum=lushop.lushop.v1.SmsScene" json:"scene,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSmsCodeReq) Reset() {
+	*x = SendSmsCodeReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSmsCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSmsCodeReq) ProtoMessage() {}
+
+func (x *SendSmsCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[4]
 	if x != nil {
-		return x.Captcha
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LoginReq) GetCaptchaId() string {
+// Deprecated: Use SendSmsCodeReq.ProtoReflect.Descriptor instead.
+func (*SendSmsCodeReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SendSmsCodeReq) GetMobile() string {
 	if x != nil {
-		return x.CaptchaId
+		return x.Mobile
 	}
 	return ""
 }
 
-// user Detail returned
-type UserDetailResponse struct {
+func (x *SendSmsCodeReq) GetScene() SmsScene {
+	if x != nil {
+		return x.Scene
+	}
+	return SmsScene_SMS_SCENE_UNSPECIFIED
+}
+
+type SendSmsCodeReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Mobile        string                 `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty"`
-	NickName      string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	Birthday      int64                  `protobuf:"varint,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
-	Gender        string                 `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`
-	Role          int32                  `protobuf:"varint,6,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiredAt     int64                  `protobuf:"varint,1,opt,name=expiredAt,proto3" json:"expiredAt,omitempty"` // 验证码过期时间
+	ResendAt      int64                  `protobuf:"varint,2,opt,name=resendAt,proto3" json:"resendAt,omitempty"`   // 可以再次发送的时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserDetailResponse) Reset() {
-	*x = UserDetailResponse{}
-	mi := &file_lushop_v1_lushop_proto_msgTypes[4]
+func (x *SendSmsCodeReply) Reset() {
+	*x = SendSmsCodeReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserDetailResponse) String() string {
+func (x *SendSmsCodeReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserDetailResponse) ProtoMessage() {}
+func (*SendSmsCodeReply) ProtoMessage() {}
 
-func (x *UserDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lushop_v1_lushop_proto_msgTypes[4]
+func (x *SendSmsCodeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,77 +437,161 @@ func (x *UserDetailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserDetailResponse.ProtoReflect.Descriptor instead.
-func (*UserDetailResponse) Descriptor() ([]byte, []int) {
-	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SendSmsCodeReply.ProtoReflect.Descriptor instead.
+func (*SendSmsCodeReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UserDetailResponse) GetId() int64 {
+func (x *SendSmsCodeReply) GetExpiredAt() int64 {
 	if x != nil {
-		return x.Id
+		return x.ExpiredAt
 	}
 	return 0
 }
 
-func (x *UserDetailResponse) GetMobile() string {
+func (x *SendSmsCodeReply) GetResendAt() int64 {
+	if x != nil {
+		return x.ResendAt
+	}
+	return 0
+}
+
+type VerifySmsCodeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Scene         SmsScene               `protobuf:"varint,2,opt,name=scene,proto3,enum=lushop.lushop.v1.SmsScene" json:"scene,omitempty"`
+	SmsCode       string                 `protobuf:"bytes,3,opt,name=smsCode,proto3" json:"smsCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySmsCodeReq) Reset() {
+	*x = VerifySmsCodeReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySmsCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySmsCodeReq) ProtoMessage() {}
+
+func (x *VerifySmsCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySmsCodeReq.ProtoReflect.Descriptor instead.
+func (*VerifySmsCodeReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VerifySmsCodeReq) GetMobile() string {
 	if x != nil {
 		return x.Mobile
 	}
 	return ""
 }
 
-func (x *UserDetailResponse) GetNickName() string {
+func (x *VerifySmsCodeReq) GetScene() SmsScene {
 	if x != nil {
-		return x.NickName
+		return x.Scene
+	}
+	return SmsScene_SMS_SCENE_UNSPECIFIED
+}
+
+func (x *VerifySmsCodeReq) GetSmsCode() string {
+	if x != nil {
+		return x.SmsCode
 	}
 	return ""
 }
 
-func (x *UserDetailResponse) GetBirthday() int64 {
+type ChangePasswordReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordReq) ProtoMessage() {}
+
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[7]
 	if x != nil {
-		return x.Birthday
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserDetailResponse) GetGender() string {
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangePasswordReq) GetOldPassword() string {
 	if x != nil {
-		return x.Gender
+		return x.OldPassword
 	}
 	return ""
 }
 
-func (x *UserDetailResponse) GetRole() int32 {
+func (x *ChangePasswordReq) GetNewPassword() string {
 	if x != nil {
-		return x.Role
+		return x.NewPassword
 	}
-	return 0
+	return ""
 }
 
-type CaptchaReply struct {
+type ResetPasswordReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captchaId,proto3" json:"captchaId,omitempty"`
-	PicPath       string                 `protobuf:"bytes,2,opt,name=picPath,proto3" json:"picPath,omitempty"`
-	Ans           string                 `protobuf:"bytes,3,opt,name=ans,proto3" json:"ans,omitempty"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	SmsCode       string                 `protobuf:"bytes,2,opt,name=smsCode,proto3" json:"smsCode,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CaptchaReply) Reset() {
-	*x = CaptchaReply{}
-	mi := &file_lushop_v1_lushop_proto_msgTypes[5]
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaptchaReply) String() string {
+func (x *ResetPasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaptchaReply) ProtoMessage() {}
+func (*ResetPasswordReq) ProtoMessage() {}
 
-func (x *CaptchaReply) ProtoReflect() protoreflect.Message {
-	mi := &file_lushop_v1_lushop_proto_msgTypes[5]
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,72 +602,4349 @@ func (x *CaptchaReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaptchaReply.ProtoReflect.Descriptor instead.
-func (*CaptchaReply) Descriptor() ([]byte, []int) {
-	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CaptchaReply) GetCaptchaId() string {
+func (x *ResetPasswordReq) GetMobile() string {
 	if x != nil {
-		return x.CaptchaId
+		return x.Mobile
 	}
 	return ""
 }
 
-func (x *CaptchaReply) GetPicPath() string {
+func (x *ResetPasswordReq) GetSmsCode() string {
 	if x != nil {
-		return x.PicPath
+		return x.SmsCode
 	}
 	return ""
 }
 
-func (x *CaptchaReply) GetAns() string {
+func (x *ResetPasswordReq) GetNewPassword() string {
 	if x != nil {
-		return x.Ans
+		return x.NewPassword
 	}
 	return ""
 }
 
-var File_lushop_v1_lushop_proto protoreflect.FileDescriptor
+type RefreshReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_lushop_v1_lushop_proto_rawDesc = "" +
-	"\n" +
-	"\x16lushop/v1/lushop.proto\x12\x10lushop.lushop.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"`\n" +
-	"\x0eCreateUserInfo\x12\x1a\n" +
-	"\bnickName\x18\x01 \x01(\tR\bnickName\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
-	"\x06mobile\x18\x03 \x01(\tR\x06mobile\"{\n" +
-	"\vRegisterReq\x12 \n" +
-	"\x06mobile\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01\vR\x06mobile\x12%\n" +
-	"\busername\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x03\x18\x0fR\busername\x12#\n" +
-	"\bpassword\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\bpassword\"\x87\x01\n" +
-	"\rRegisterReply\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06mobile\x18\x03 \x01(\tR\x06mobile\x12\x1a\n" +
-	"\busername\x18\x04 \x01(\tR\busername\x12\x14\n" +
-	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1c\n" +
-	"\texpiredAt\x18\x06 \x01(\x03R\texpiredAt\"\x9d\x01\n" +
-	"\bLoginReq\x12 \n" +
-	"\x06mobile\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01\vR\x06mobile\x12#\n" +
-	"\bpassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\bpassword\x12#\n" +
-	"\acaptcha\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x04\x18\x06R\acaptcha\x12%\n" +
-	"\tcaptchaId\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tcaptchaId\"\xa0\x01\n" +
-	"\x12UserDetailResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06mobile\x18\x02 \x01(\tR\x06mobile\x12\x1a\n" +
-	"\bnickName\x18\x03 \x01(\tR\bnickName\x12\x1a\n" +
-	"\bbirthday\x18\x04 \x01(\x03R\bbirthday\x12\x16\n" +
-	"\x06gender\x18\x05 \x01(\tR\x06gender\x12\x12\n" +
-	"\x04role\x18\x06 \x01(\x05R\x04role\"X\n" +
-	"\fCaptchaReply\x12\x1c\n" +
-	"\tcaptchaId\x18\x01 \x01(\tR\tcaptchaId\x12\x18\n" +
-	"\apicPath\x18\x02 \x01(\tR\apicPath\x12\x10\n" +
-	"\x03ans\x18\x03 \x01(\tR\x03ans2\x98\x03\n" +
-	"\x06Lushop\x12i\n" +
-	"\bRegister\x12\x1d.lushop.lushop.v1.RegisterReq\x1a\x1f.lushop.lushop.v1.RegisterReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/user/register\x12`\n" +
-	"\x05Login\x12\x1a.lushop.lushop.v1.LoginReq\x1a\x1f.lushop.lushop.v1.RegisterReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/user/login\x12_\n" +
-	"\aCaptcha\x12\x16.google.protobuf.Empty\x1a\x1e.lushop.lushop.v1.CaptchaReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/user/captcha\x12`\n" +
-	"\x06Detail\x12\x16.google.protobuf.Empty\x1a$.lushop.lushop.v1.UserDetailResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/user/detailB\x19Z\x17lushop/api/lushop/v1;v1b\x06proto3"
+func (x *RefreshReq) Reset() {
+	*x = RefreshReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshReq) ProtoMessage() {}
+
+func (x *RefreshReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshReq.ProtoReflect.Descriptor instead.
+func (*RefreshReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefreshReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutReq) Reset() {
+	*x = LogoutReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutReq) ProtoMessage() {}
+
+func (x *LogoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
+func (*LogoutReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LogoutReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// 用户登录请求
+type LoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Captcha       string                 `protobuf:"bytes,3,opt,name=captcha,proto3" json:"captcha,omitempty"`
+	CaptchaId     string                 `protobuf:"bytes,4,opt,name=captchaId,proto3" json:"captchaId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReq) ProtoMessage() {}
+
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LoginReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *LoginReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LoginReq) GetCaptcha() string {
+	if x != nil {
+		return x.Captcha
+	}
+	return ""
+}
+
+func (x *LoginReq) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+// user Detail returned
+type UserDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mobile        string                 `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	NickName      string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	Birthday      *int64                 `protobuf:"varint,4,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"` // unix 秒，没有设置生日时不返回
+	Gender        string                 `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`
+	Role          int32                  `protobuf:"varint,6,opt,name=role,proto3" json:"role,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // 注册时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDetailResponse) Reset() {
+	*x = UserDetailResponse{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDetailResponse) ProtoMessage() {}
+
+func (x *UserDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDetailResponse.ProtoReflect.Descriptor instead.
+func (*UserDetailResponse) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserDetailResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserDetailResponse) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *UserDetailResponse) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *UserDetailResponse) GetBirthday() int64 {
+	if x != nil && x.Birthday != nil {
+		return *x.Birthday
+	}
+	return 0
+}
+
+func (x *UserDetailResponse) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UserDetailResponse) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *UserDetailResponse) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *UserDetailResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type DeleteAccountReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountReq) Reset() {
+	*x = DeleteAccountReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountReq) ProtoMessage() {}
+
+func (x *DeleteAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountReq.ProtoReflect.Descriptor instead.
+func (*DeleteAccountReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteAccountReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// 个人数据导出
+type UserDataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportedAt    int64                  `protobuf:"varint,1,opt,name=exportedAt,proto3" json:"exportedAt,omitempty"`
+	Profile       *UserDetailResponse    `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Addresses     []*AddressItem         `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Cart          []*CartItem            `protobuf:"bytes,4,rep,name=cart,proto3" json:"cart,omitempty"`
+	Saved         []*CartItem            `protobuf:"bytes,5,rep,name=saved,proto3" json:"saved,omitempty"`       // 稍后购买
+	Wishlist      []*WishlistExportItem  `protobuf:"bytes,6,rep,name=wishlist,proto3" json:"wishlist,omitempty"` // 收藏夹
+	Orders        []*OrderExportItem     `protobuf:"bytes,7,rep,name=orders,proto3" json:"orders,omitempty"`     // 订单，还没有订单服务，目前始终为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserDataExport) GetExportedAt() int64 {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return 0
+}
+
+func (x *UserDataExport) GetProfile() *UserDetailResponse {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetAddresses() []*AddressItem {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetCart() []*CartItem {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetSaved() []*CartItem {
+	if x != nil {
+		return x.Saved
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetWishlist() []*WishlistExportItem {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+func (x *UserDataExport) GetOrders() []*OrderExportItem {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type WishlistExportItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoodsId       int64                  `protobuf:"varint,1,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	GoodsName     string                 `protobuf:"bytes,2,opt,name=goodsName,proto3" json:"goodsName,omitempty"` // 商品已删除时为空
+	GoodsSn       string                 `protobuf:"bytes,3,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // 收藏时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistExportItem) Reset() {
+	*x = WishlistExportItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistExportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistExportItem) ProtoMessage() {}
+
+func (x *WishlistExportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistExportItem.ProtoReflect.Descriptor instead.
+func (*WishlistExportItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WishlistExportItem) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *WishlistExportItem) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *WishlistExportItem) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *WishlistExportItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 导出的订单，接入订单服务后补充字段
+type OrderExportItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderSn       string                 `protobuf:"bytes,2,opt,name=orderSn,proto3" json:"orderSn,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderExportItem) Reset() {
+	*x = OrderExportItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderExportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderExportItem) ProtoMessage() {}
+
+func (x *OrderExportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderExportItem.ProtoReflect.Descriptor instead.
+func (*OrderExportItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrderExportItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderExportItem) GetOrderSn() string {
+	if x != nil {
+		return x.OrderSn
+	}
+	return ""
+}
+
+func (x *OrderExportItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// updateMask 为空时只更新出现的字段，不为空时只更新 updateMask 中的字段，
+// updateMask 中有 birthday 但没有设置 birthday 时清空生日
+type UpdateProfileReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NickName      *string                `protobuf:"bytes,1,opt,name=nickName,proto3,oneof" json:"nickName,omitempty"`
+	Gender        *string                `protobuf:"bytes,2,opt,name=gender,proto3,oneof" json:"gender,omitempty"`
+	Birthday      *int64                 `protobuf:"varint,3,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"` // unix 秒，可以早于 1970 年
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=updateMask,proto3" json:"updateMask,omitempty"`    // nickName、gender、birthday
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileReq) Reset() {
+	*x = UpdateProfileReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileReq) ProtoMessage() {}
+
+func (x *UpdateProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileReq.ProtoReflect.Descriptor instead.
+func (*UpdateProfileReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateProfileReq) GetNickName() string {
+	if x != nil && x.NickName != nil {
+		return *x.NickName
+	}
+	return ""
+}
+
+func (x *UpdateProfileReq) GetGender() string {
+	if x != nil && x.Gender != nil {
+		return *x.Gender
+	}
+	return ""
+}
+
+func (x *UpdateProfileReq) GetBirthday() int64 {
+	if x != nil && x.Birthday != nil {
+		return *x.Birthday
+	}
+	return 0
+}
+
+func (x *UpdateProfileReq) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type CaptchaReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captchaId,proto3" json:"captchaId,omitempty"`
+	PicPath       string                 `protobuf:"bytes,2,opt,name=picPath,proto3" json:"picPath,omitempty"`
+	Ans           string                 `protobuf:"bytes,3,opt,name=ans,proto3" json:"ans,omitempty"` // 验证码答案，只在开发测试环境返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptchaReply) Reset() {
+	*x = CaptchaReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptchaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptchaReply) ProtoMessage() {}
+
+func (x *CaptchaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptchaReply.ProtoReflect.Descriptor instead.
+func (*CaptchaReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CaptchaReply) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *CaptchaReply) GetPicPath() string {
+	if x != nil {
+		return x.PicPath
+	}
+	return ""
+}
+
+func (x *CaptchaReply) GetAns() string {
+	if x != nil {
+		return x.Ans
+	}
+	return ""
+}
+
+type GuestTokenReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestTokenReply) Reset() {
+	*x = GuestTokenReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestTokenReply) ProtoMessage() {}
+
+func (x *GuestTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestTokenReply.ProtoReflect.Descriptor instead.
+func (*GuestTokenReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GuestTokenReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 购物车商品
+type CartItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoodsId       int64                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	GoodsSn       string                 `protobuf:"bytes,3,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	GoodsName     string                 `protobuf:"bytes,4,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	SkuId         int64                  `protobuf:"varint,5,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	SkuName       string                 `protobuf:"bytes,6,opt,name=skuName,proto3" json:"skuName,omitempty"`
+	GoodsPrice    int64                  `protobuf:"varint,7,opt,name=goodsPrice,proto3" json:"goodsPrice,omitempty"`     // 加入购物车时的价格
+	CurrentPrice  int64                  `protobuf:"varint,8,opt,name=currentPrice,proto3" json:"currentPrice,omitempty"` // 商品当前价格
+	GoodsNum      int32                  `protobuf:"varint,9,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	IsSelect      bool                   `protobuf:"varint,10,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	OnSale        bool                   `protobuf:"varint,11,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	Stock         int64                  `protobuf:"varint,12,opt,name=stock,proto3" json:"stock,omitempty"`
+	PriceChanged  bool                   `protobuf:"varint,13,opt,name=priceChanged,proto3" json:"priceChanged,omitempty"`
+	OutOfStock    bool                   `protobuf:"varint,14,opt,name=outOfStock,proto3" json:"outOfStock,omitempty"`
+	OverLimit     bool                   `protobuf:"varint,15,opt,name=overLimit,proto3" json:"overLimit,omitempty"` // 超过限购数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CartItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartItem) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *CartItem) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *CartItem) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *CartItem) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CartItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *CartItem) GetGoodsPrice() int64 {
+	if x != nil {
+		return x.GoodsPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetCurrentPrice() int64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetGoodsNum() int32 {
+	if x != nil {
+		return x.GoodsNum
+	}
+	return 0
+}
+
+func (x *CartItem) GetIsSelect() bool {
+	if x != nil {
+		return x.IsSelect
+	}
+	return false
+}
+
+func (x *CartItem) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *CartItem) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CartItem) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *CartItem) GetOutOfStock() bool {
+	if x != nil {
+		return x.OutOfStock
+	}
+	return false
+}
+
+func (x *CartItem) GetOverLimit() bool {
+	if x != nil {
+		return x.OverLimit
+	}
+	return false
+}
+
+type CartListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*CartItem            `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartListReply) Reset() {
+	*x = CartListReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartListReply) ProtoMessage() {}
+
+func (x *CartListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartListReply.ProtoReflect.Descriptor instead.
+func (*CartListReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CartListReply) GetList() []*CartItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GuestCartReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	GoodsNum      int32                  `protobuf:"varint,2,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestCartReq) Reset() {
+	*x = GuestCartReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestCartReq) ProtoMessage() {}
+
+func (x *GuestCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestCartReq.ProtoReflect.Descriptor instead.
+func (*GuestCartReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GuestCartReq) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GuestCartReq) GetGoodsNum() int32 {
+	if x != nil {
+		return x.GoodsNum
+	}
+	return 0
+}
+
+type DeleteCartReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuIds        []int64                `protobuf:"varint,1,rep,packed,name=skuIds,proto3" json:"skuIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCartReq) Reset() {
+	*x = DeleteCartReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCartReq) ProtoMessage() {}
+
+func (x *DeleteCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCartReq.ProtoReflect.Descriptor instead.
+func (*DeleteCartReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteCartReq) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type CartReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	GoodsNum      int32                  `protobuf:"varint,2,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartReq) Reset() {
+	*x = CartReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartReq) ProtoMessage() {}
+
+func (x *CartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartReq.ProtoReflect.Descriptor instead.
+func (*CartReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CartReq) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CartReq) GetGoodsNum() int32 {
+	if x != nil {
+		return x.GoodsNum
+	}
+	return 0
+}
+
+type SelectCartReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuIds        []int64                `protobuf:"varint,1,rep,packed,name=skuIds,proto3" json:"skuIds,omitempty"` // 为空时选中或取消选中所有商品
+	IsSelect      bool                   `protobuf:"varint,2,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCartReq) Reset() {
+	*x = SelectCartReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCartReq) ProtoMessage() {}
+
+func (x *SelectCartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCartReq.ProtoReflect.Descriptor instead.
+func (*SelectCartReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SelectCartReq) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+func (x *SelectCartReq) GetIsSelect() bool {
+	if x != nil {
+		return x.IsSelect
+	}
+	return false
+}
+
+// 收货地址，地区使用 6 位行政区划代码
+type AddressItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProvinceCode  string                 `protobuf:"bytes,2,opt,name=provinceCode,proto3" json:"provinceCode,omitempty"`
+	CityCode      string                 `protobuf:"bytes,3,opt,name=cityCode,proto3" json:"cityCode,omitempty"`
+	DistrictCode  string                 `protobuf:"bytes,4,opt,name=districtCode,proto3" json:"districtCode,omitempty"`
+	Recipient     string                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Mobile        string                 `protobuf:"bytes,6,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Detail        string                 `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,8,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressItem) Reset() {
+	*x = AddressItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressItem) ProtoMessage() {}
+
+func (x *AddressItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressItem.ProtoReflect.Descriptor instead.
+func (*AddressItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddressItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddressItem) GetProvinceCode() string {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return ""
+}
+
+func (x *AddressItem) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *AddressItem) GetDistrictCode() string {
+	if x != nil {
+		return x.DistrictCode
+	}
+	return ""
+}
+
+func (x *AddressItem) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AddressItem) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *AddressItem) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AddressItem) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type AddressListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*AddressItem         `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressListReply) Reset() {
+	*x = AddressListReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressListReply) ProtoMessage() {}
+
+func (x *AddressListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressListReply.ProtoReflect.Descriptor instead.
+func (*AddressListReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddressListReply) GetList() []*AddressItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AddressReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 修改时从路径中获取
+	ProvinceCode  string                 `protobuf:"bytes,2,opt,name=provinceCode,proto3" json:"provinceCode,omitempty"`
+	CityCode      string                 `protobuf:"bytes,3,opt,name=cityCode,proto3" json:"cityCode,omitempty"`
+	DistrictCode  string                 `protobuf:"bytes,4,opt,name=districtCode,proto3" json:"districtCode,omitempty"`
+	Recipient     string                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Mobile        string                 `protobuf:"bytes,6,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Detail        string                 `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,8,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressReq) Reset() {
+	*x = AddressReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressReq) ProtoMessage() {}
+
+func (x *AddressReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressReq.ProtoReflect.Descriptor instead.
+func (*AddressReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddressReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddressReq) GetProvinceCode() string {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return ""
+}
+
+func (x *AddressReq) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *AddressReq) GetDistrictCode() string {
+	if x != nil {
+		return x.DistrictCode
+	}
+	return ""
+}
+
+func (x *AddressReq) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AddressReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *AddressReq) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AddressReq) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type AddressIdReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressIdReq) Reset() {
+	*x = AddressIdReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressIdReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressIdReq) ProtoMessage() {}
+
+func (x *AddressIdReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressIdReq.ProtoReflect.Descriptor instead.
+func (*AddressIdReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddressIdReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 商品分类
+type CategoryItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int32                  `protobuf:"varint,3,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	IsTab         bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	SubCategory   []*CategoryItem        `protobuf:"bytes,7,rep,name=subCategory,proto3" json:"subCategory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryItem) Reset() {
+	*x = CategoryItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryItem) ProtoMessage() {}
+
+func (x *CategoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryItem.ProtoReflect.Descriptor instead.
+func (*CategoryItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CategoryItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryItem) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CategoryItem) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CategoryItem) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *CategoryItem) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *CategoryItem) GetSubCategory() []*CategoryItem {
+	if x != nil {
+		return x.SubCategory
+	}
+	return nil
+}
+
+type CategoryTreeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*CategoryItem        `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTreeReply) Reset() {
+	*x = CategoryTreeReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeReply) ProtoMessage() {}
+
+func (x *CategoryTreeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeReply.ProtoReflect.Descriptor instead.
+func (*CategoryTreeReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CategoryTreeReply) GetList() []*CategoryItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type SubCategoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubCategoryReq) Reset() {
+	*x = SubCategoryReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubCategoryReq) ProtoMessage() {}
+
+func (x *SubCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubCategoryReq.ProtoReflect.Descriptor instead.
+func (*SubCategoryReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SubCategoryReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SubCategoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *CategoryItem          `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	SubCategory   []*CategoryItem        `protobuf:"bytes,2,rep,name=subCategory,proto3" json:"subCategory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubCategoryReply) Reset() {
+	*x = SubCategoryReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubCategoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubCategoryReply) ProtoMessage() {}
+
+func (x *SubCategoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubCategoryReply.ProtoReflect.Descriptor instead.
+func (*SubCategoryReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubCategoryReply) GetInfo() *CategoryItem {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *SubCategoryReply) GetSubCategory() []*CategoryItem {
+	if x != nil {
+		return x.SubCategory
+	}
+	return nil
+}
+
+type BrandListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         int32                  `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int32                  `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandListReq) Reset() {
+	*x = BrandListReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandListReq) ProtoMessage() {}
+
+func (x *BrandListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandListReq.ProtoReflect.Descriptor instead.
+func (*BrandListReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BrandListReq) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *BrandListReq) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type BrandItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandItem) Reset() {
+	*x = BrandItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandItem) ProtoMessage() {}
+
+func (x *BrandItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandItem.ProtoReflect.Descriptor instead.
+func (*BrandItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BrandItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrandItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BrandItem) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BrandItem) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type BrandListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List          []*BrandItem           `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandListReply) Reset() {
+	*x = BrandListReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandListReply) ProtoMessage() {}
+
+func (x *BrandListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandListReply.ProtoReflect.Descriptor instead.
+func (*BrandListReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BrandListReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BrandListReply) GetList() []*BrandItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 商品搜索，条件都为空时返回所有商品
+type GoodsSearchReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keywords      string                 `protobuf:"bytes,1,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId       int32                  `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	MinPrice      int64                  `protobuf:"varint,4,opt,name=minPrice,proto3" json:"minPrice,omitempty"`
+	MaxPrice      int64                  `protobuf:"varint,5,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	IsHot         bool                   `protobuf:"varint,6,opt,name=isHot,proto3" json:"isHot,omitempty"`
+	IsNew         bool                   `protobuf:"varint,7,opt,name=isNew,proto3" json:"isNew,omitempty"`
+	Pages         int64                  `protobuf:"varint,8,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int64                  `protobuf:"varint,9,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsSearchReq) Reset() {
+	*x = GoodsSearchReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsSearchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsSearchReq) ProtoMessage() {}
+
+func (x *GoodsSearchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsSearchReq.ProtoReflect.Descriptor instead.
+func (*GoodsSearchReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GoodsSearchReq) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+func (x *GoodsSearchReq) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GoodsSearchReq) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *GoodsSearchReq) GetMinPrice() int64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *GoodsSearchReq) GetMaxPrice() int64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GoodsSearchReq) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *GoodsSearchReq) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *GoodsSearchReq) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *GoodsSearchReq) GetPagePerNums() int64 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type GoodsItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId       int32                  `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	GoodsSn       string                 `protobuf:"bytes,5,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	ClickNum      int64                  `protobuf:"varint,6,opt,name=clickNum,proto3" json:"clickNum,omitempty"`
+	SoldNum       int64                  `protobuf:"varint,7,opt,name=soldNum,proto3" json:"soldNum,omitempty"`
+	FavNum        int64                  `protobuf:"varint,8,opt,name=favNum,proto3" json:"favNum,omitempty"`
+	MarketPrice   int64                  `protobuf:"varint,9,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`
+	GoodsBrief    string                 `protobuf:"bytes,10,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`
+	ShipFree      bool                   `protobuf:"varint,11,opt,name=shipFree,proto3" json:"shipFree,omitempty"`
+	Image         string                 `protobuf:"bytes,12,opt,name=image,proto3" json:"image,omitempty"`
+	Images        []string               `protobuf:"bytes,13,rep,name=images,proto3" json:"images,omitempty"`
+	IsNew         bool                   `protobuf:"varint,14,opt,name=isNew,proto3" json:"isNew,omitempty"`
+	IsHot         bool                   `protobuf:"varint,15,opt,name=isHot,proto3" json:"isHot,omitempty"`
+	OnSale        bool                   `protobuf:"varint,16,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsItem) Reset() {
+	*x = GoodsItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsItem) ProtoMessage() {}
+
+func (x *GoodsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsItem.ProtoReflect.Descriptor instead.
+func (*GoodsItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GoodsItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsItem) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GoodsItem) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *GoodsItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsItem) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *GoodsItem) GetClickNum() int64 {
+	if x != nil {
+		return x.ClickNum
+	}
+	return 0
+}
+
+func (x *GoodsItem) GetSoldNum() int64 {
+	if x != nil {
+		return x.SoldNum
+	}
+	return 0
+}
+
+func (x *GoodsItem) GetFavNum() int64 {
+	if x != nil {
+		return x.FavNum
+	}
+	return 0
+}
+
+func (x *GoodsItem) GetMarketPrice() int64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *GoodsItem) GetGoodsBrief() string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return ""
+}
+
+func (x *GoodsItem) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *GoodsItem) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *GoodsItem) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *GoodsItem) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *GoodsItem) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *GoodsItem) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+type GoodsListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List          []*GoodsItem           `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsListReply) Reset() {
+	*x = GoodsListReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsListReply) ProtoMessage() {}
+
+func (x *GoodsListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsListReply.ProtoReflect.Descriptor instead.
+func (*GoodsListReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GoodsListReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GoodsListReply) GetList() []*GoodsItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GoodsDetailReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsDetailReq) Reset() {
+	*x = GoodsDetailReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsDetailReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsDetailReq) ProtoMessage() {}
+
+func (x *GoodsDetailReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsDetailReq.ProtoReflect.Descriptor instead.
+func (*GoodsDetailReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GoodsDetailReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SkuItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoodsId        int64                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	SkuName        string                 `protobuf:"bytes,3,opt,name=skuName,proto3" json:"skuName,omitempty"`
+	SkuCode        string                 `protobuf:"bytes,4,opt,name=skuCode,proto3" json:"skuCode,omitempty"`
+	Price          int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice int64                  `protobuf:"varint,6,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	Points         int64                  `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`
+	Pic            string                 `protobuf:"bytes,8,opt,name=pic,proto3" json:"pic,omitempty"`
+	Stock          int64                  `protobuf:"varint,9,opt,name=stock,proto3" json:"stock,omitempty"`
+	OnSale         bool                   `protobuf:"varint,10,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	PurchaseLimit  int32                  `protobuf:"varint,11,opt,name=purchaseLimit,proto3" json:"purchaseLimit,omitempty"` // 每个用户限购数量，0 为不限购
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SkuItem) Reset() {
+	*x = SkuItem{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuItem) ProtoMessage() {}
+
+func (x *SkuItem) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuItem.ProtoReflect.Descriptor instead.
+func (*SkuItem) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SkuItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SkuItem) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *SkuItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *SkuItem) GetSkuCode() string {
+	if x != nil {
+		return x.SkuCode
+	}
+	return ""
+}
+
+func (x *SkuItem) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuItem) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *SkuItem) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *SkuItem) GetPic() string {
+	if x != nil {
+		return x.Pic
+	}
+	return ""
+}
+
+func (x *SkuItem) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *SkuItem) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *SkuItem) GetPurchaseLimit() int32 {
+	if x != nil {
+		return x.PurchaseLimit
+	}
+	return 0
+}
+
+type SkuListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*SkuItem             `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuListReply) Reset() {
+	*x = SkuListReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuListReply) ProtoMessage() {}
+
+func (x *SkuListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuListReply.ProtoReflect.Descriptor instead.
+func (*SkuListReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SkuListReply) GetList() []*SkuItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AdminIdReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminIdReq) Reset() {
+	*x = AdminIdReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminIdReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminIdReq) ProtoMessage() {}
+
+func (x *AdminIdReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminIdReq.ProtoReflect.Descriptor instead.
+func (*AdminIdReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AdminIdReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminIdReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminIdReply) Reset() {
+	*x = AdminIdReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminIdReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminIdReply) ProtoMessage() {}
+
+func (x *AdminIdReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminIdReply.ProtoReflect.Descriptor instead.
+func (*AdminIdReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AdminIdReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminCategoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      int32                  `protobuf:"varint,3,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	Level         int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	IsTab         bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCategoryReq) Reset() {
+	*x = AdminCategoryReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCategoryReq) ProtoMessage() {}
+
+func (x *AdminCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCategoryReq.ProtoReflect.Descriptor instead.
+func (*AdminCategoryReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AdminCategoryReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminCategoryReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminCategoryReq) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *AdminCategoryReq) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminCategoryReq) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *AdminCategoryReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type AdminBrandReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	IsTab         bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminBrandReq) Reset() {
+	*x = AdminBrandReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminBrandReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminBrandReq) ProtoMessage() {}
+
+func (x *AdminBrandReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminBrandReq.ProtoReflect.Descriptor instead.
+func (*AdminBrandReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AdminBrandReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminBrandReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminBrandReq) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *AdminBrandReq) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *AdminBrandReq) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *AdminBrandReq) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 后台新增商品，sku 的规格和属性值来自商品类型模板
+type AdminGoodsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int32                  `protobuf:"varint,1,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId       int32                  `protobuf:"varint,2,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	TypeId        int64                  `protobuf:"varint,3,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	NameAlias     string                 `protobuf:"bytes,5,opt,name=nameAlias,proto3" json:"nameAlias,omitempty"`
+	GoodsTags     string                 `protobuf:"bytes,6,opt,name=goodsTags,proto3" json:"goodsTags,omitempty"`
+	GoodsSn       string                 `protobuf:"bytes,7,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	ShopPrice     int64                  `protobuf:"varint,8,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`
+	MarketPrice   int64                  `protobuf:"varint,9,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`
+	Inventory     int64                  `protobuf:"varint,10,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	GoodsBrief    string                 `protobuf:"bytes,11,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`
+	Image         string                 `protobuf:"bytes,12,opt,name=image,proto3" json:"image,omitempty"`
+	Images        []string               `protobuf:"bytes,13,rep,name=images,proto3" json:"images,omitempty"`
+	ShipFree      bool                   `protobuf:"varint,14,opt,name=shipFree,proto3" json:"shipFree,omitempty"`
+	ShipId        int32                  `protobuf:"varint,15,opt,name=shipId,proto3" json:"shipId,omitempty"`
+	IsNew         bool                   `protobuf:"varint,16,opt,name=isNew,proto3" json:"isNew,omitempty"`
+	IsHot         bool                   `protobuf:"varint,17,opt,name=isHot,proto3" json:"isHot,omitempty"`
+	OnSale        bool                   `protobuf:"varint,18,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	Skus          []*AdminSku            `protobuf:"bytes,19,rep,name=skus,proto3" json:"skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGoodsReq) Reset() {
+	*x = AdminGoodsReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGoodsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGoodsReq) ProtoMessage() {}
+
+func (x *AdminGoodsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGoodsReq.ProtoReflect.Descriptor instead.
+func (*AdminGoodsReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AdminGoodsReq) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *AdminGoodsReq) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *AdminGoodsReq) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *AdminGoodsReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminGoodsReq) GetNameAlias() string {
+	if x != nil {
+		return x.NameAlias
+	}
+	return ""
+}
+
+func (x *AdminGoodsReq) GetGoodsTags() string {
+	if x != nil {
+		return x.GoodsTags
+	}
+	return ""
+}
+
+func (x *AdminGoodsReq) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *AdminGoodsReq) GetShopPrice() int64 {
+	if x != nil {
+		return x.ShopPrice
+	}
+	return 0
+}
+
+func (x *AdminGoodsReq) GetMarketPrice() int64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *AdminGoodsReq) GetInventory() int64 {
+	if x != nil {
+		return x.Inventory
+	}
+	return 0
+}
+
+func (x *AdminGoodsReq) GetGoodsBrief() string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return ""
+}
+
+func (x *AdminGoodsReq) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *AdminGoodsReq) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *AdminGoodsReq) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *AdminGoodsReq) GetShipId() int32 {
+	if x != nil {
+		return x.ShipId
+	}
+	return 0
+}
+
+func (x *AdminGoodsReq) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *AdminGoodsReq) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *AdminGoodsReq) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *AdminGoodsReq) GetSkus() []*AdminSku {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+type AdminSku struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkuName        string                 `protobuf:"bytes,2,opt,name=skuName,proto3" json:"skuName,omitempty"`
+	Code           string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	BarCode        string                 `protobuf:"bytes,4,opt,name=barCode,proto3" json:"barCode,omitempty"`
+	Price          int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice int64                  `protobuf:"varint,6,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	Points         int64                  `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`
+	Image          string                 `protobuf:"bytes,8,opt,name=image,proto3" json:"image,omitempty"`
+	Sort           int32                  `protobuf:"varint,9,opt,name=sort,proto3" json:"sort,omitempty"`
+	Inventory      int64                  `protobuf:"varint,10,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	PurchaseLimit  int32                  `protobuf:"varint,11,opt,name=purchaseLimit,proto3" json:"purchaseLimit,omitempty"`
+	Specs          []*AdminSku_Spec       `protobuf:"bytes,12,rep,name=specs,proto3" json:"specs,omitempty"`
+	AttrGroups     []*AdminSku_AttrGroup  `protobuf:"bytes,13,rep,name=attrGroups,proto3" json:"attrGroups,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminSku) Reset() {
+	*x = AdminSku{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSku) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSku) ProtoMessage() {}
+
+func (x *AdminSku) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSku.ProtoReflect.Descriptor instead.
+func (*AdminSku) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AdminSku) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminSku) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *AdminSku) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AdminSku) GetBarCode() string {
+	if x != nil {
+		return x.BarCode
+	}
+	return ""
+}
+
+func (x *AdminSku) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AdminSku) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *AdminSku) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *AdminSku) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *AdminSku) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *AdminSku) GetInventory() int64 {
+	if x != nil {
+		return x.Inventory
+	}
+	return 0
+}
+
+func (x *AdminSku) GetPurchaseLimit() int32 {
+	if x != nil {
+		return x.PurchaseLimit
+	}
+	return 0
+}
+
+func (x *AdminSku) GetSpecs() []*AdminSku_Spec {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *AdminSku) GetAttrGroups() []*AdminSku_AttrGroup {
+	if x != nil {
+		return x.AttrGroups
+	}
+	return nil
+}
+
+type AdminSkuPriceReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkuId          int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	Price          int64                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice int64                  `protobuf:"varint,3,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	EffectiveFrom  int64                  `protobuf:"varint,4,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`   // 开始生效的时间戳，为 0 或早于当前时间时立即生效
+	EffectiveUntil int64                  `protobuf:"varint,5,opt,name=effectiveUntil,proto3" json:"effectiveUntil,omitempty"` // 结束生效的时间戳，为 0 时一直有效
+	Remark         string                 `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminSkuPriceReq) Reset() {
+	*x = AdminSkuPriceReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSkuPriceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSkuPriceReq) ProtoMessage() {}
+
+func (x *AdminSkuPriceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSkuPriceReq.ProtoReflect.Descriptor instead.
+func (*AdminSkuPriceReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AdminSkuPriceReq) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReq) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReq) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReq) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReq) GetEffectiveUntil() int64 {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type AdminSkuPriceReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkuId          int64                  `protobuf:"varint,2,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	Price          int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice int64                  `protobuf:"varint,4,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	EffectiveFrom  int64                  `protobuf:"varint,5,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`
+	EffectiveUntil int64                  `protobuf:"varint,6,opt,name=effectiveUntil,proto3" json:"effectiveUntil,omitempty"`
+	Status         int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"` // 0 等待生效 1 已生效 2 已结束
+	Remark         string                 `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminSkuPriceReply) Reset() {
+	*x = AdminSkuPriceReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSkuPriceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSkuPriceReply) ProtoMessage() {}
+
+func (x *AdminSkuPriceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSkuPriceReply.ProtoReflect.Descriptor instead.
+func (*AdminSkuPriceReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AdminSkuPriceReply) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReply) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReply) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReply) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReply) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReply) GetEffectiveUntil() int64 {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReply) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminSkuPriceReply) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type AdminSkuLimitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	PurchaseLimit int32                  `protobuf:"varint,2,opt,name=purchaseLimit,proto3" json:"purchaseLimit,omitempty"` // 0 为不限购
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSkuLimitReq) Reset() {
+	*x = AdminSkuLimitReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSkuLimitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSkuLimitReq) ProtoMessage() {}
+
+func (x *AdminSkuLimitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSkuLimitReq.ProtoReflect.Descriptor instead.
+func (*AdminSkuLimitReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AdminSkuLimitReq) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *AdminSkuLimitReq) GetPurchaseLimit() int32 {
+	if x != nil {
+		return x.PurchaseLimit
+	}
+	return 0
+}
+
+// 条件为空时不过滤
+type AdminUserListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         uint32                 `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   uint32                 `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	Mobile        string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile,omitempty"`     // 手机号前缀
+	NickName      string                 `protobuf:"bytes,4,opt,name=nickName,proto3" json:"nickName,omitempty"` // 昵称包含
+	Role          int32                  `protobuf:"varint,5,opt,name=role,proto3" json:"role,omitempty"`
+	Gender        string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`
+	StartTime     int64                  `protobuf:"varint,7,opt,name=startTime,proto3" json:"startTime,omitempty"` // 注册时间范围，unix 秒，包含开始时间，不包含结束时间
+	EndTime       int64                  `protobuf:"varint,8,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUserListReq) Reset() {
+	*x = AdminUserListReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUserListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReq) ProtoMessage() {}
+
+func (x *AdminUserListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReq.ProtoReflect.Descriptor instead.
+func (*AdminUserListReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AdminUserListReq) GetPages() uint32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *AdminUserListReq) GetPagePerNums() uint32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+func (x *AdminUserListReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *AdminUserListReq) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *AdminUserListReq) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *AdminUserListReq) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *AdminUserListReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AdminUserListReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type AdminUserDisabledReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Disabled      bool                   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUserDisabledReq) Reset() {
+	*x = AdminUserDisabledReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUserDisabledReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserDisabledReq) ProtoMessage() {}
+
+func (x *AdminUserDisabledReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserDisabledReq.ProtoReflect.Descriptor instead.
+func (*AdminUserDisabledReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AdminUserDisabledReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserDisabledReq) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type AdminUserRoleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUserRoleReq) Reset() {
+	*x = AdminUserRoleReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUserRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserRoleReq) ProtoMessage() {}
+
+func (x *AdminUserRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserRoleReq.ProtoReflect.Descriptor instead.
+func (*AdminUserRoleReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AdminUserRoleReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUserRoleReq) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+type AdminUserListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List          []*UserDetailResponse  `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUserListReply) Reset() {
+	*x = AdminUserListReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUserListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUserListReply) ProtoMessage() {}
+
+func (x *AdminUserListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUserListReply.ProtoReflect.Descriptor instead.
+func (*AdminUserListReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AdminUserListReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AdminUserListReply) GetList() []*UserDetailResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 手机号和 IP 至少填一个
+type AdminUnlockLoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUnlockLoginReq) Reset() {
+	*x = AdminUnlockLoginReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUnlockLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUnlockLoginReq) ProtoMessage() {}
+
+func (x *AdminUnlockLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUnlockLoginReq.ProtoReflect.Descriptor instead.
+func (*AdminUnlockLoginReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AdminUnlockLoginReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *AdminUnlockLoginReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type AdminLockEventListReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Pages         uint32                 `protobuf:"varint,3,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   uint32                 `protobuf:"varint,4,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLockEventListReq) Reset() {
+	*x = AdminLockEventListReq{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLockEventListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLockEventListReq) ProtoMessage() {}
+
+func (x *AdminLockEventListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLockEventListReq.ProtoReflect.Descriptor instead.
+func (*AdminLockEventListReq) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AdminLockEventListReq) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *AdminLockEventListReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AdminLockEventListReq) GetPages() uint32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *AdminLockEventListReq) GetPagePerNums() uint32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type LockEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mobile        string                 `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // lock 锁定 unlock 解锁
+	Scope         string                 `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`   // mobile 按手机号 ip 按 IP
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Failures      int64                  `protobuf:"varint,7,opt,name=failures,proto3" json:"failures,omitempty"`
+	Until         int64                  `protobuf:"varint,8,opt,name=until,proto3" json:"until,omitempty"`       // 锁定到期时间
+	Operator      int64                  `protobuf:"varint,9,opt,name=operator,proto3" json:"operator,omitempty"` // 手动解锁的管理员ID
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockEvent) Reset() {
+	*x = LockEvent{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockEvent) ProtoMessage() {}
+
+func (x *LockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockEvent.ProtoReflect.Descriptor instead.
+func (*LockEvent) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LockEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LockEvent) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *LockEvent) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LockEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LockEvent) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *LockEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LockEvent) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *LockEvent) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *LockEvent) GetOperator() int64 {
+	if x != nil {
+		return x.Operator
+	}
+	return 0
+}
+
+func (x *LockEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AdminLockEventListReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List          []*LockEvent           `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminLockEventListReply) Reset() {
+	*x = AdminLockEventListReply{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminLockEventListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminLockEventListReply) ProtoMessage() {}
+
+func (x *AdminLockEventListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminLockEventListReply.ProtoReflect.Descriptor instead.
+func (*AdminLockEventListReply) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AdminLockEventListReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AdminLockEventListReply) GetList() []*LockEvent {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type AdminSku_Spec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpecId        int64                  `protobuf:"varint,1,opt,name=specId,proto3" json:"specId,omitempty"`
+	ValueId       int64                  `protobuf:"varint,2,opt,name=valueId,proto3" json:"valueId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSku_Spec) Reset() {
+	*x = AdminSku_Spec{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSku_Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSku_Spec) ProtoMessage() {}
+
+func (x *AdminSku_Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSku_Spec.ProtoReflect.Descriptor instead.
+func (*AdminSku_Spec) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{48, 0}
+}
+
+func (x *AdminSku_Spec) GetSpecId() int64 {
+	if x != nil {
+		return x.SpecId
+	}
+	return 0
+}
+
+func (x *AdminSku_Spec) GetValueId() int64 {
+	if x != nil {
+		return x.ValueId
+	}
+	return 0
+}
+
+type AdminSku_Attr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttrId        int64                  `protobuf:"varint,1,opt,name=attrId,proto3" json:"attrId,omitempty"`
+	AttrName      string                 `protobuf:"bytes,2,opt,name=attrName,proto3" json:"attrName,omitempty"`
+	ValueId       int64                  `protobuf:"varint,3,opt,name=valueId,proto3" json:"valueId,omitempty"`
+	ValueName     string                 `protobuf:"bytes,4,opt,name=valueName,proto3" json:"valueName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSku_Attr) Reset() {
+	*x = AdminSku_Attr{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSku_Attr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSku_Attr) ProtoMessage() {}
+
+func (x *AdminSku_Attr) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSku_Attr.ProtoReflect.Descriptor instead.
+func (*AdminSku_Attr) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{48, 1}
+}
+
+func (x *AdminSku_Attr) GetAttrId() int64 {
+	if x != nil {
+		return x.AttrId
+	}
+	return 0
+}
+
+func (x *AdminSku_Attr) GetAttrName() string {
+	if x != nil {
+		return x.AttrName
+	}
+	return ""
+}
+
+func (x *AdminSku_Attr) GetValueId() int64 {
+	if x != nil {
+		return x.ValueId
+	}
+	return 0
+}
+
+func (x *AdminSku_Attr) GetValueName() string {
+	if x != nil {
+		return x.ValueName
+	}
+	return ""
+}
+
+type AdminSku_AttrGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Attrs         []*AdminSku_Attr       `protobuf:"bytes,3,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSku_AttrGroup) Reset() {
+	*x = AdminSku_AttrGroup{}
+	mi := &file_lushop_v1_lushop_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSku_AttrGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSku_AttrGroup) ProtoMessage() {}
+
+func (x *AdminSku_AttrGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_lushop_v1_lushop_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSku_AttrGroup.ProtoReflect.Descriptor instead.
+func (*AdminSku_AttrGroup) Descriptor() ([]byte, []int) {
+	return file_lushop_v1_lushop_proto_rawDescGZIP(), []int{48, 2}
+}
+
+func (x *AdminSku_AttrGroup) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AdminSku_AttrGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AdminSku_AttrGroup) GetAttrs() []*AdminSku_Attr {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+var File_lushop_v1_lushop_proto protoreflect.FileDescriptor
+
+const file_lushop_v1_lushop_proto_rawDesc = "" +
+	"\n" +
+	"\x16lushop/v1/lushop.proto\x12\x10lushop.lushop.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"`\n" +
+	"\x0eCreateUserInfo\x12\x1a\n" +
+	"\bnickName\x18\x01 \x01(\tR\bnickName\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06mobile\x18\x03 \x01(\tR\x06mobile\"\xae\x01\n" +
+	"\vRegisterReq\x12/\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x17\xfaB\x14r\x122\x10^1[3-9][0-9]{9}$R\x06mobile\x12%\n" +
+	"\busername\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x03\x18\x0fR\busername\x12#\n" +
+	"\bpassword\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\bpassword\x12\"\n" +
+	"\asmsCode\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x06R\asmsCode\"\xd7\x01\n" +
+	"\rRegisterReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06mobile\x18\x03 \x01(\tR\x06mobile\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1c\n" +
+	"\texpiredAt\x18\x06 \x01(\x03R\texpiredAt\x12\"\n" +
+	"\frefreshToken\x18\a \x01(\tR\frefreshToken\x12*\n" +
+	"\x10refreshExpiredAt\x18\b \x01(\x03R\x10refreshExpiredAt\"b\n" +
+	"\vSmsLoginReq\x12/\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x17\xfaB\x14r\x122\x10^1[3-9][0-9]{9}$R\x06mobile\x12\"\n" +
+	"\asmsCode\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x06R\asmsCode\"\x7f\n" +
+	"\x0eSendSmsCodeReq\x12/\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x17\xfaB\x14r\x122\x10^1[3-9][0-9]{9}$R\x06mobile\x12<\n" +
+	"\x05scene\x18\x02 \x01(\x0e2\x1a.lushop.lushop.v1.SmsSceneB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x05scene\"L\n" +
+	"\x10SendSmsCodeReply\x12\x1c\n" +
+	"\texpiredAt\x18\x01 \x01(\x03R\texpiredAt\x12\x1a\n" +
+	"\bresendAt\x18\x02 \x01(\x03R\bresendAt\"\xa5\x01\n" +
+	"\x10VerifySmsCodeReq\x12/\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x17\xfaB\x14r\x122\x10^1[3-9][0-9]{9}$R\x06mobile\x12<\n" +
+	"\x05scene\x18\x02 \x01(\x0e2\x1a.lushop.lushop.v1.SmsSceneB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x05scene\x12\"\n" +
+	"\asmsCode\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x06R\asmsCode\"i\n" +
+	"\x11ChangePasswordReq\x12)\n" +
+	"\voldPassword\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\voldPassword\x12)\n" +
+	"\vnewPassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\vnewPassword\"\x92\x01\n" +
+	"\x10ResetPasswordReq\x12/\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x17\xfaB\x14r\x122\x10^1[3-9][0-9]{9}$R\x06mobile\x12\"\n" +
+	"\asmsCode\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x06R\asmsCode\x12)\n" +
+	"\vnewPassword\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\vnewPassword\":\n" +
+	"\n" +
+	"RefreshReq\x12,\n" +
+	"\frefreshToken\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x98\x01@R\frefreshToken\"/\n" +
+	"\tLogoutReq\x12\"\n" +
+	"\frefreshToken\x18\x01 \x01(\tR\frefreshToken\"\xac\x01\n" +
+	"\bLoginReq\x12/\n" +
+	"\x06mobile\x18\x01 \x01(\tB\x17\xfaB\x14r\x122\x10^1[3-9][0-9]{9}$R\x06mobile\x12#\n" +
+	"\bpassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x05R\bpassword\x12#\n" +
+	"\acaptcha\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x04\x18\x06R\acaptcha\x12%\n" +
+	"\tcaptchaId\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tcaptchaId\"\xec\x01\n" +
+	"\x12UserDetailResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06mobile\x18\x02 \x01(\tR\x06mobile\x12\x1a\n" +
+	"\bnickName\x18\x03 \x01(\tR\bnickName\x12\x1f\n" +
+	"\bbirthday\x18\x04 \x01(\x03H\x00R\bbirthday\x88\x01\x01\x12\x16\n" +
+	"\x06gender\x18\x05 \x01(\tR\x06gender\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\x05R\x04role\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\x03R\tcreatedAtB\v\n" +
+	"\t_birthday\"9\n" +
+	"\x10DeleteAccountReq\x12%\n" +
+	"\bpassword\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\bpassword\"\x8c\x03\n" +
+	"\x0eUserDataExport\x12\x1e\n" +
+	"\n" +
+	"exportedAt\x18\x01 \x01(\x03R\n" +
+	"exportedAt\x12>\n" +
+	"\aprofile\x18\x02 \x01(\v2$.lushop.lushop.v1.UserDetailResponseR\aprofile\x12;\n" +
+	"\taddresses\x18\x03 \x03(\v2\x1d.lushop.lushop.v1.AddressItemR\taddresses\x12.\n" +
+	"\x04cart\x18\x04 \x03(\v2\x1a.lushop.lushop.v1.CartItemR\x04cart\x120\n" +
+	"\x05saved\x18\x05 \x03(\v2\x1a.lushop.lushop.v1.CartItemR\x05saved\x12@\n" +
+	"\bwishlist\x18\x06 \x03(\v2$.lushop.lushop.v1.WishlistExportItemR\bwishlist\x129\n" +
+	"\x06orders\x18\a \x03(\v2!.lushop.lushop.v1.OrderExportItemR\x06orders\"\x84\x01\n" +
+	"\x12WishlistExportItem\x12\x18\n" +
+	"\agoodsId\x18\x01 \x01(\x03R\agoodsId\x12\x1c\n" +
+	"\tgoodsName\x18\x02 \x01(\tR\tgoodsName\x12\x18\n" +
+	"\agoodsSn\x18\x03 \x01(\tR\agoodsSn\x12\x1c\n" +
+	"\tcreatedAt\x18\x04 \x01(\x03R\tcreatedAt\"Y\n" +
+	"\x0fOrderExportItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aorderSn\x18\x02 \x01(\tR\aorderSn\x12\x1c\n" +
+	"\tcreatedAt\x18\x03 \x01(\x03R\tcreatedAt\"\xf2\x01\n" +
+	"\x10UpdateProfileReq\x12*\n" +
+	"\bnickName\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x19H\x00R\bnickName\x88\x01\x01\x120\n" +
+	"\x06gender\x18\x02 \x01(\tB\x13\xfaB\x10r\x0eR\x04maleR\x06femaleH\x01R\x06gender\x88\x01\x01\x12\x1f\n" +
+	"\bbirthday\x18\x03 \x01(\x03H\x02R\bbirthday\x88\x01\x01\x12:\n" +
+	"\n" +
+	"updateMask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\v\n" +
+	"\t_nickNameB\t\n" +
+	"\a_genderB\v\n" +
+	"\t_birthday\"X\n" +
+	"\fCaptchaReply\x12\x1c\n" +
+	"\tcaptchaId\x18\x01 \x01(\tR\tcaptchaId\x12\x18\n" +
+	"\apicPath\x18\x02 \x01(\tR\apicPath\x12\x10\n" +
+	"\x03ans\x18\x03 \x01(\tR\x03ans\"'\n" +
+	"\x0fGuestTokenReply\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xa8\x03\n" +
+	"\bCartItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x03R\agoodsId\x12\x18\n" +
+	"\agoodsSn\x18\x03 \x01(\tR\agoodsSn\x12\x1c\n" +
+	"\tgoodsName\x18\x04 \x01(\tR\tgoodsName\x12\x14\n" +
+	"\x05skuId\x18\x05 \x01(\x03R\x05skuId\x12\x18\n" +
+	"\askuName\x18\x06 \x01(\tR\askuName\x12\x1e\n" +
+	"\n" +
+	"goodsPrice\x18\a \x01(\x03R\n" +
+	"goodsPrice\x12\"\n" +
+	"\fcurrentPrice\x18\b \x01(\x03R\fcurrentPrice\x12\x1a\n" +
+	"\bgoodsNum\x18\t \x01(\x05R\bgoodsNum\x12\x1a\n" +
+	"\bisSelect\x18\n" +
+	" \x01(\bR\bisSelect\x12\x16\n" +
+	"\x06onSale\x18\v \x01(\bR\x06onSale\x12\x14\n" +
+	"\x05stock\x18\f \x01(\x03R\x05stock\x12\"\n" +
+	"\fpriceChanged\x18\r \x01(\bR\fpriceChanged\x12\x1e\n" +
+	"\n" +
+	"outOfStock\x18\x0e \x01(\bR\n" +
+	"outOfStock\x12\x1c\n" +
+	"\toverLimit\x18\x0f \x01(\bR\toverLimit\"?\n" +
+	"\rCartListReply\x12.\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.lushop.lushop.v1.CartItemR\x04list\"R\n" +
+	"\fGuestCartReq\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12#\n" +
+	"\bgoodsNum\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bgoodsNum\"1\n" +
+	"\rDeleteCartReq\x12 \n" +
+	"\x06skuIds\x18\x01 \x03(\x03B\b\xfaB\x05\x92\x01\x02\b\x01R\x06skuIds\"M\n" +
+	"\aCartReq\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12#\n" +
+	"\bgoodsNum\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bgoodsNum\"C\n" +
+	"\rSelectCartReq\x12\x16\n" +
+	"\x06skuIds\x18\x01 \x03(\x03R\x06skuIds\x12\x1a\n" +
+	"\bisSelect\x18\x02 \x01(\bR\bisSelect\"\xed\x01\n" +
+	"\vAddressItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
+	"\fprovinceCode\x18\x02 \x01(\tR\fprovinceCode\x12\x1a\n" +
+	"\bcityCode\x18\x03 \x01(\tR\bcityCode\x12\"\n" +
+	"\fdistrictCode\x18\x04 \x01(\tR\fdistrictCode\x12\x1c\n" +
+	"\trecipient\x18\x05 \x01(\tR\trecipient\x12\x16\n" +
+	"\x06mobile\x18\x06 \x01(\tR\x06mobile\x12\x16\n" +
+	"\x06detail\x18\a \x01(\tR\x06detail\x12\x1c\n" +
+	"\tisDefault\x18\b \x01(\bR\tisDefault\"E\n" +
+	"\x10AddressListReply\x121\n" +
+	"\x04list\x18\x01 \x03(\v2\x1d.lushop.lushop.v1.AddressItemR\x04list\"\xd5\x02\n" +
+	"\n" +
+	"AddressReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x125\n" +
+	"\fprovinceCode\x18\x02 \x01(\tB\x11\xfaB\x0er\f2\n" +
+	"^[0-9]{6}$R\fprovinceCode\x12-\n" +
+	"\bcityCode\x18\x03 \x01(\tB\x11\xfaB\x0er\f2\n" +
+	"^[0-9]{6}$R\bcityCode\x125\n" +
+	"\fdistrictCode\x18\x04 \x01(\tB\x11\xfaB\x0er\f2\n" +
+	"^[0-9]{6}$R\fdistrictCode\x12'\n" +
+	"\trecipient\x18\x05 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x19R\trecipient\x12/\n" +
+	"\x06mobile\x18\x06 \x01(\tB\x17\xfaB\x14r\x122\x10^1[3-9][0-9]{9}$R\x06mobile\x12\"\n" +
+	"\x06detail\x18\a \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x06detail\x12\x1c\n" +
+	"\tisDefault\x18\b \x01(\bR\tisDefault\"'\n" +
+	"\fAddressIdReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xd0\x01\n" +
+	"\fCategoryItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bparentId\x18\x03 \x01(\x05R\bparentId\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x14\n" +
+	"\x05isTab\x18\x05 \x01(\bR\x05isTab\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\x12@\n" +
+	"\vsubCategory\x18\a \x03(\v2\x1e.lushop.lushop.v1.CategoryItemR\vsubCategory\"G\n" +
+	"\x11CategoryTreeReply\x122\n" +
+	"\x04list\x18\x01 \x03(\v2\x1e.lushop.lushop.v1.CategoryItemR\x04list\")\n" +
+	"\x0eSubCategoryReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x02id\"\x88\x01\n" +
+	"\x10SubCategoryReply\x122\n" +
+	"\x04info\x18\x01 \x01(\v2\x1e.lushop.lushop.v1.CategoryItemR\x04info\x12@\n" +
+	"\vsubCategory\x18\x02 \x03(\v2\x1e.lushop.lushop.v1.CategoryItemR\vsubCategory\"Z\n" +
+	"\fBrandListReq\x12\x1d\n" +
+	"\x05pages\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05pages\x12+\n" +
+	"\vpagePerNums\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\vpagePerNums\"W\n" +
+	"\tBrandItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04logo\x18\x03 \x01(\tR\x04logo\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\"W\n" +
+	"\x0eBrandListReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12/\n" +
+	"\x04list\x18\x02 \x03(\v2\x1b.lushop.lushop.v1.BrandItemR\x04list\"\xb1\x02\n" +
+	"\x0eGoodsSearchReq\x12#\n" +
+	"\bkeywords\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x182R\bkeywords\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x18\n" +
+	"\abrandId\x18\x03 \x01(\x05R\abrandId\x12#\n" +
+	"\bminPrice\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bminPrice\x12#\n" +
+	"\bmaxPrice\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\bmaxPrice\x12\x14\n" +
+	"\x05isHot\x18\x06 \x01(\bR\x05isHot\x12\x14\n" +
+	"\x05isNew\x18\a \x01(\bR\x05isNew\x12\x1d\n" +
+	"\x05pages\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x05pages\x12+\n" +
+	"\vpagePerNums\x18\t \x01(\x03B\t\xfaB\x06\"\x04\x18d(\x00R\vpagePerNums\"\xa1\x03\n" +
+	"\tGoodsItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x18\n" +
+	"\abrandId\x18\x03 \x01(\x05R\abrandId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\agoodsSn\x18\x05 \x01(\tR\agoodsSn\x12\x1a\n" +
+	"\bclickNum\x18\x06 \x01(\x03R\bclickNum\x12\x18\n" +
+	"\asoldNum\x18\a \x01(\x03R\asoldNum\x12\x16\n" +
+	"\x06favNum\x18\b \x01(\x03R\x06favNum\x12 \n" +
+	"\vmarketPrice\x18\t \x01(\x03R\vmarketPrice\x12\x1e\n" +
+	"\n" +
+	"goodsBrief\x18\n" +
+	" \x01(\tR\n" +
+	"goodsBrief\x12\x1a\n" +
+	"\bshipFree\x18\v \x01(\bR\bshipFree\x12\x14\n" +
+	"\x05image\x18\f \x01(\tR\x05image\x12\x16\n" +
+	"\x06images\x18\r \x03(\tR\x06images\x12\x14\n" +
+	"\x05isNew\x18\x0e \x01(\bR\x05isNew\x12\x14\n" +
+	"\x05isHot\x18\x0f \x01(\bR\x05isHot\x12\x16\n" +
+	"\x06onSale\x18\x10 \x01(\bR\x06onSale\"W\n" +
+	"\x0eGoodsListReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12/\n" +
+	"\x04list\x18\x02 \x03(\v2\x1b.lushop.lushop.v1.GoodsItemR\x04list\")\n" +
+	"\x0eGoodsDetailReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\xa3\x02\n" +
+	"\aSkuItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x03R\agoodsId\x12\x18\n" +
+	"\askuName\x18\x03 \x01(\tR\askuName\x12\x18\n" +
+	"\askuCode\x18\x04 \x01(\tR\askuCode\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12&\n" +
+	"\x0epromotionPrice\x18\x06 \x01(\x03R\x0epromotionPrice\x12\x16\n" +
+	"\x06points\x18\a \x01(\x03R\x06points\x12\x10\n" +
+	"\x03pic\x18\b \x01(\tR\x03pic\x12\x14\n" +
+	"\x05stock\x18\t \x01(\x03R\x05stock\x12\x16\n" +
+	"\x06onSale\x18\n" +
+	" \x01(\bR\x06onSale\x12$\n" +
+	"\rpurchaseLimit\x18\v \x01(\x05R\rpurchaseLimit\"=\n" +
+	"\fSkuListReply\x12-\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.lushop.lushop.v1.SkuItemR\x04list\"%\n" +
+	"\n" +
+	"AdminIdReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x1e\n" +
+	"\fAdminIdReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb3\x01\n" +
+	"\x10AdminCategoryReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\x12#\n" +
+	"\bparentId\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bparentId\x12!\n" +
+	"\x05level\x18\x04 \x01(\x05B\v\xfaB\b\x1a\x060\x010\x020\x03R\x05level\x12\x14\n" +
+	"\x05isTab\x18\x05 \x01(\bR\x05isTab\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"\x90\x01\n" +
+	"\rAdminBrandReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x182R\x04name\x12\x12\n" +
+	"\x04logo\x18\x03 \x01(\tR\x04logo\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x14\n" +
+	"\x05isTab\x18\x05 \x01(\bR\x05isTab\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"\xe9\x04\n" +
+	"\rAdminGoodsReq\x12'\n" +
+	"\n" +
+	"categoryId\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\n" +
+	"categoryId\x12!\n" +
+	"\abrandId\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\abrandId\x12\x1f\n" +
+	"\x06typeId\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06typeId\x12\x1d\n" +
+	"\x04name\x18\x04 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12\x1c\n" +
+	"\tnameAlias\x18\x05 \x01(\tR\tnameAlias\x12\x1c\n" +
+	"\tgoodsTags\x18\x06 \x01(\tR\tgoodsTags\x12!\n" +
+	"\agoodsSn\x18\a \x01(\tB\a\xfaB\x04r\x02\x10\x01R\agoodsSn\x12%\n" +
+	"\tshopPrice\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tshopPrice\x12)\n" +
+	"\vmarketPrice\x18\t \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vmarketPrice\x12%\n" +
+	"\tinventory\x18\n" +
+	" \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tinventory\x12\x1e\n" +
+	"\n" +
+	"goodsBrief\x18\v \x01(\tR\n" +
+	"goodsBrief\x12\x14\n" +
+	"\x05image\x18\f \x01(\tR\x05image\x12\x16\n" +
+	"\x06images\x18\r \x03(\tR\x06images\x12\x1a\n" +
+	"\bshipFree\x18\x0e \x01(\bR\bshipFree\x12\x16\n" +
+	"\x06shipId\x18\x0f \x01(\x05R\x06shipId\x12\x14\n" +
+	"\x05isNew\x18\x10 \x01(\bR\x05isNew\x12\x14\n" +
+	"\x05isHot\x18\x11 \x01(\bR\x05isHot\x12\x16\n" +
+	"\x06onSale\x18\x12 \x01(\bR\x06onSale\x12.\n" +
+	"\x04skus\x18\x13 \x03(\v2\x1a.lushop.lushop.v1.AdminSkuR\x04skus\"\xc3\x06\n" +
+	"\bAdminSku\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\askuName\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\askuName\x12\x1b\n" +
+	"\x04code\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\x12!\n" +
+	"\abarCode\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\abarCode\x12\x1d\n" +
+	"\x05price\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x05price\x12/\n" +
+	"\x0epromotionPrice\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0epromotionPrice\x12\x16\n" +
+	"\x06points\x18\a \x01(\x03R\x06points\x12\x14\n" +
+	"\x05image\x18\b \x01(\tR\x05image\x12\x12\n" +
+	"\x04sort\x18\t \x01(\x05R\x04sort\x12%\n" +
+	"\tinventory\x18\n" +
+	" \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tinventory\x12-\n" +
+	"\rpurchaseLimit\x18\v \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rpurchaseLimit\x125\n" +
+	"\x05specs\x18\f \x03(\v2\x1f.lushop.lushop.v1.AdminSku.SpecR\x05specs\x12D\n" +
+	"\n" +
+	"attrGroups\x18\r \x03(\v2$.lushop.lushop.v1.AdminSku.AttrGroupR\n" +
+	"attrGroups\x1aJ\n" +
+	"\x04Spec\x12\x1f\n" +
+	"\x06specId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06specId\x12!\n" +
+	"\avalueId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\avalueId\x1a\x96\x01\n" +
+	"\x04Attr\x12\x1f\n" +
+	"\x06attrId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06attrId\x12#\n" +
+	"\battrName\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\battrName\x12!\n" +
+	"\avalueId\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\avalueId\x12%\n" +
+	"\tvalueName\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tvalueName\x1az\n" +
+	"\tAttrGroup\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x125\n" +
+	"\x05attrs\x18\x03 \x03(\v2\x1f.lushop.lushop.v1.AdminSku.AttrR\x05attrs\"\xe7\x01\n" +
+	"\x10AdminSkuPriceReq\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12\x1d\n" +
+	"\x05price\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05price\x12/\n" +
+	"\x0epromotionPrice\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0epromotionPrice\x12$\n" +
+	"\reffectiveFrom\x18\x04 \x01(\x03R\reffectiveFrom\x12&\n" +
+	"\x0eeffectiveUntil\x18\x05 \x01(\x03R\x0eeffectiveUntil\x12\x16\n" +
+	"\x06remark\x18\x06 \x01(\tR\x06remark\"\xf6\x01\n" +
+	"\x12AdminSkuPriceReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05skuId\x18\x02 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12&\n" +
+	"\x0epromotionPrice\x18\x04 \x01(\x03R\x0epromotionPrice\x12$\n" +
+	"\reffectiveFrom\x18\x05 \x01(\x03R\reffectiveFrom\x12&\n" +
+	"\x0eeffectiveUntil\x18\x06 \x01(\x03R\x0eeffectiveUntil\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x16\n" +
+	"\x06remark\x18\b \x01(\tR\x06remark\"`\n" +
+	"\x10AdminSkuLimitReq\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12-\n" +
+	"\rpurchaseLimit\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rpurchaseLimit\"\xb4\x02\n" +
+	"\x10AdminUserListReq\x12\x14\n" +
+	"\x05pages\x18\x01 \x01(\rR\x05pages\x12)\n" +
+	"\vpagePerNums\x18\x02 \x01(\rB\a\xfaB\x04*\x02\x18dR\vpagePerNums\x12\x1f\n" +
+	"\x06mobile\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18\vR\x06mobile\x12#\n" +
+	"\bnickName\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x18\x19R\bnickName\x12\x1f\n" +
+	"\x04role\x18\x05 \x01(\x05B\v\xfaB\b\x1a\x060\x000\x010\x02R\x04role\x12.\n" +
+	"\x06gender\x18\x06 \x01(\tB\x16\xfaB\x13r\x11R\x04maleR\x06female\xd0\x01\x01R\x06gender\x12%\n" +
+	"\tstartTime\x18\a \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tstartTime\x12!\n" +
+	"\aendTime\x18\b \x01(\x03B\a\xfaB\x04\"\x02(\x00R\aendTime\"K\n" +
+	"\x14AdminUserDisabledReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\"J\n" +
+	"\x10AdminUserRoleReq\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12\x1d\n" +
+	"\x04role\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x040\x010\x02R\x04role\"d\n" +
+	"\x12AdminUserListReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x128\n" +
+	"\x04list\x18\x02 \x03(\v2$.lushop.lushop.v1.UserDetailResponseR\x04list\"V\n" +
+	"\x13AdminUnlockLoginReq\x12#\n" +
+	"\x06mobile\x18\x01 \x01(\tB\v\xfaB\br\x06\x98\x01\v\xd0\x01\x01R\x06mobile\x12\x1a\n" +
+	"\x02ip\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x18@\xd0\x01\x01R\x02ip\"\x80\x01\n" +
+	"\x15AdminLockEventListReq\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x14\n" +
+	"\x05pages\x18\x03 \x01(\rR\x05pages\x12)\n" +
+	"\vpagePerNums\x18\x04 \x01(\rB\a\xfaB\x04*\x02\x18dR\vpagePerNums\"\xf5\x01\n" +
+	"\tLockEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06mobile\x18\x02 \x01(\tR\x06mobile\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x14\n" +
+	"\x05scope\x18\x05 \x01(\tR\x05scope\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bfailures\x18\a \x01(\x03R\bfailures\x12\x14\n" +
+	"\x05until\x18\b \x01(\x03R\x05until\x12\x1a\n" +
+	"\boperator\x18\t \x01(\x03R\boperator\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\x03R\tcreatedAt\"`\n" +
+	"\x17AdminLockEventListReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12/\n" +
+	"\x04list\x18\x02 \x03(\v2\x1b.lushop.lushop.v1.LockEventR\x04list*p\n" +
+	"\bSmsScene\x12\x19\n" +
+	"\x15SMS_SCENE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fSMS_SCENE_LOGIN\x10\x01\x12\x16\n" +
+	"\x12SMS_SCENE_REGISTER\x10\x02\x12\x1c\n" +
+	"\x18SMS_SCENE_RESET_PASSWORD\x10\x032\xac*\n" +
+	"\x06Lushop\x12i\n" +
+	"\bRegister\x12\x1d.lushop.lushop.v1.RegisterReq\x1a\x1f.lushop.lushop.v1.RegisterReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/user/register\x12`\n" +
+	"\x05Login\x12\x1a.lushop.lushop.v1.LoginReq\x1a\x1f.lushop.lushop.v1.RegisterReply\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/user/login\x12j\n" +
+	"\bSmsLogin\x12\x1d.lushop.lushop.v1.SmsLoginReq\x1a\x1f.lushop.lushop.v1.RegisterReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/user/sms-login\x12m\n" +
+	"\vSendSmsCode\x12 .lushop.lushop.v1.SendSmsCodeReq\x1a\".lushop.lushop.v1.SendSmsCodeReply\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/sms/send\x12g\n" +
+	"\rVerifySmsCode\x12\".lushop.lushop.v1.VerifySmsCodeReq\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/sms/verify\x12u\n" +
+	"\x0eChangePassword\x12#.lushop.lushop.v1.ChangePasswordReq\x1a\x1f.lushop.lushop.v1.RegisterReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/user/password\x12p\n" +
+	"\rResetPassword\x12\".lushop.lushop.v1.ResetPasswordReq\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/user/password/reset\x12f\n" +
+	"\aRefresh\x12\x1c.lushop.lushop.v1.RefreshReq\x1a\x1f.lushop.lushop.v1.RegisterReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/user/refresh\x12Z\n" +
+	"\x06Logout\x12\x1b.lushop.lushop.v1.LogoutReq\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/user/logout\x12_\n" +
+	"\aCaptcha\x12\x16.google.protobuf.Empty\x1a\x1e.lushop.lushop.v1.CaptchaReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/user/captcha\x12`\n" +
+	"\x06Detail\x12\x16.google.protobuf.Empty\x1a$.lushop.lushop.v1.UserDetailResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/user/detail\x12p\n" +
+	"\rDeleteAccount\x12\".lushop.lushop.v1.DeleteAccountReq\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/user/account/delete\x12`\n" +
+	"\n" +
+	"ExportData\x12\x16.google.protobuf.Empty\x1a .lushop.lushop.v1.UserDataExport\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/api/user/export\x12w\n" +
+	"\rUpdateProfile\x12\".lushop.lushop.v1.UpdateProfileReq\x1a$.lushop.lushop.v1.UserDetailResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/user/profile\x12b\n" +
+	"\fCategoryTree\x12\x16.google.protobuf.Empty\x1a#.lushop.lushop.v1.CategoryTreeReply\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/category\x12o\n" +
+	"\vSubCategory\x12 .lushop.lushop.v1.SubCategoryReq\x1a\".lushop.lushop.v1.SubCategoryReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/category/{id}\x12a\n" +
+	"\tBrandList\x12\x1e.lushop.lushop.v1.BrandListReq\x1a .lushop.lushop.v1.BrandListReply\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/api/brand\x12e\n" +
+	"\vGoodsSearch\x12 .lushop.lushop.v1.GoodsSearchReq\x1a .lushop.lushop.v1.GoodsListReply\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/api/goods\x12e\n" +
+	"\vGoodsDetail\x12 .lushop.lushop.v1.GoodsDetailReq\x1a\x1b.lushop.lushop.v1.GoodsItem\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/goods/{id}\x12m\n" +
+	"\fGoodsSkuList\x12 .lushop.lushop.v1.GoodsDetailReq\x1a\x1e.lushop.lushop.v1.SkuListReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/goods/{id}/sku\x12y\n" +
+	"\x13AdminCreateCategory\x12\".lushop.lushop.v1.AdminCategoryReq\x1a\x1e.lushop.lushop.v1.CategoryItem\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/admin/category\x12v\n" +
+	"\x13AdminUpdateCategory\x12\".lushop.lushop.v1.AdminCategoryReq\x1a\x16.google.protobuf.Empty\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/api/admin/category/{id}\x12m\n" +
+	"\x13AdminDeleteCategory\x12\x1c.lushop.lushop.v1.AdminIdReq\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/admin/category/{id}\x12m\n" +
+	"\x10AdminCreateBrand\x12\x1f.lushop.lushop.v1.AdminBrandReq\x1a\x1b.lushop.lushop.v1.BrandItem\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/admin/brand\x12m\n" +
+	"\x10AdminUpdateBrand\x12\x1f.lushop.lushop.v1.AdminBrandReq\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/api/admin/brand/{id}\x12g\n" +
+	"\x10AdminDeleteBrand\x12\x1c.lushop.lushop.v1.AdminIdReq\x1a\x16.google.protobuf.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/api/admin/brand/{id}\x12p\n" +
+	"\x10AdminCreateGoods\x12\x1f.lushop.lushop.v1.AdminGoodsReq\x1a\x1e.lushop.lushop.v1.AdminIdReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/admin/goods\x12\x88\x01\n" +
+	"\x13AdminChangeSkuPrice\x12\".lushop.lushop.v1.AdminSkuPriceReq\x1a$.lushop.lushop.v1.AdminSkuPriceReply\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/admin/sku/{skuId}/price\x12|\n" +
+	"\x15AdminSkuPurchaseLimit\x12\".lushop.lushop.v1.AdminSkuLimitReq\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/admin/sku/{skuId}/limit\x12r\n" +
+	"\rAdminUserList\x12\".lushop.lushop.v1.AdminUserListReq\x1a$.lushop.lushop.v1.AdminUserListReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/admin/user\x12s\n" +
+	"\x0fAdminUserDetail\x12\x1c.lushop.lushop.v1.AdminIdReq\x1a$.lushop.lushop.v1.UserDetailResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/admin/user/{id}\x12\x80\x01\n" +
+	"\x14AdminSetUserDisabled\x12&.lushop.lushop.v1.AdminUserDisabledReq\x1a\x16.google.protobuf.Empty\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/admin/user/{id}/disabled\x12w\n" +
+	"\x13AdminChangeUserRole\x12\".lushop.lushop.v1.AdminUserRoleReq\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/admin/user/{id}/role\x12u\n" +
+	"\x10AdminUnlockLogin\x12%.lushop.lushop.v1.AdminUnlockLoginReq\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/admin/login/unlock\x12\x8d\x01\n" +
+	"\x12AdminLockEventList\x12'.lushop.lushop.v1.AdminLockEventListReq\x1a).lushop.lushop.v1.AdminLockEventListReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/admin/login/lock-event\x12_\n" +
+	"\vListAddress\x12\x16.google.protobuf.Empty\x1a\".lushop.lushop.v1.AddressListReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/address\x12e\n" +
+	"\rCreateAddress\x12\x1c.lushop.lushop.v1.AddressReq\x1a\x1d.lushop.lushop.v1.AddressItem\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/address\x12c\n" +
+	"\rUpdateAddress\x12\x1c.lushop.lushop.v1.AddressReq\x1a\x16.google.protobuf.Empty\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/address/{id}\x12b\n" +
+	"\rDeleteAddress\x12\x1e.lushop.lushop.v1.AddressIdReq\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/api/address/{id}\x12q\n" +
+	"\x11SetDefaultAddress\x12\x1e.lushop.lushop.v1.AddressIdReq\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/api/address/{id}/default\x12V\n" +
+	"\bListCart\x12\x16.google.protobuf.Empty\x1a\x1f.lushop.lushop.v1.CartListReply\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/api/cart\x12Y\n" +
+	"\n" +
+	"CreateCart\x12\x19.lushop.lushop.v1.CartReq\x1a\x1a.lushop.lushop.v1.CartItem\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/api/cart\x12U\n" +
+	"\n" +
+	"UpdateCart\x12\x19.lushop.lushop.v1.CartReq\x1a\x16.google.protobuf.Empty\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\x1a\t/api/cart\x12X\n" +
+	"\n" +
+	"DeleteCart\x12\x1f.lushop.lushop.v1.DeleteCartReq\x1a\x16.google.protobuf.Empty\"\x11\x82\xd3\xe4\x93\x02\v*\t/api/cart\x12b\n" +
+	"\n" +
+	"SelectCart\x12\x1f.lushop.lushop.v1.SelectCartReq\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/api/cart/select\x12i\n" +
+	"\n" +
+	"GuestToken\x12\x16.google.protobuf.Empty\x1a!.lushop.lushop.v1.GuestTokenReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/cart/guest/token\x12a\n" +
+	"\rListGuestCart\x12\x16.google.protobuf.Empty\x1a\x1f.lushop.lushop.v1.CartListReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/cart/guest\x12i\n" +
+	"\x0fCreateGuestCart\x12\x1e.lushop.lushop.v1.GuestCartReq\x1a\x1a.lushop.lushop.v1.CartItem\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/cart/guest\x12e\n" +
+	"\x0fUpdateGuestCart\x12\x1e.lushop.lushop.v1.GuestCartReq\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/api/cart/guest\x12c\n" +
+	"\x0fDeleteGuestCart\x12\x1f.lushop.lushop.v1.DeleteCartReq\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/api/cart/guestB\x19Z\x17lushop/api/lushop/v1;v1b\x06proto3"
 
 var (
 	file_lushop_v1_lushop_proto_rawDescOnce sync.Once
@@ -489,30 +4958,206 @@ func file_lushop_v1_lushop_proto_rawDescGZIP() []byte {
 	return file_lushop_v1_lushop_proto_rawDescData
 }
 
-var file_lushop_v1_lushop_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_lushop_v1_lushop_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lushop_v1_lushop_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_lushop_v1_lushop_proto_goTypes = []any{
-	(*CreateUserInfo)(nil),     // 0: lushop.lushop.v1.CreateUserInfo
-	(*RegisterReq)(nil),        // 1: lushop.lushop.v1.RegisterReq
-	(*RegisterReply)(nil),      // 2: lushop.lushop.v1.RegisterReply
-	(*LoginReq)(nil),           // 3: lushop.lushop.v1.LoginReq
-	(*UserDetailResponse)(nil), // 4: lushop.lushop.v1.UserDetailResponse
-	(*CaptchaReply)(nil),       // 5: lushop.lushop.v1.CaptchaReply
-	(*emptypb.Empty)(nil),      // 6: google.protobuf.Empty
+	(SmsScene)(0),                   // 0: lushop.lushop.v1.SmsScene
+	(*CreateUserInfo)(nil),          // 1: lushop.lushop.v1.CreateUserInfo
+	(*RegisterReq)(nil),             // 2: lushop.lushop.v1.RegisterReq
+	(*RegisterReply)(nil),           // 3: lushop.lushop.v1.RegisterReply
+	(*SmsLoginReq)(nil),             // 4: lushop.lushop.v1.SmsLoginReq
+	(*SendSmsCodeReq)(nil),          // 5: lushop.lushop.v1.SendSmsCodeReq
+	(*SendSmsCodeReply)(nil),        // 6: lushop.lushop.v1.SendSmsCodeReply
+	(*VerifySmsCodeReq)(nil),        // 7: lushop.lushop.v1.VerifySmsCodeReq
+	(*ChangePasswordReq)(nil),       // 8: lushop.lushop.v1.ChangePasswordReq
+	(*ResetPasswordReq)(nil),        // 9: lushop.lushop.v1.ResetPasswordReq
+	(*RefreshReq)(nil),              // 10: lushop.lushop.v1.RefreshReq
+	(*LogoutReq)(nil),               // 11: lushop.lushop.v1.LogoutReq
+	(*LoginReq)(nil),                // 12: lushop.lushop.v1.LoginReq
+	(*UserDetailResponse)(nil),      // 13: lushop.lushop.v1.UserDetailResponse
+	(*DeleteAccountReq)(nil),        // 14: lushop.lushop.v1.DeleteAccountReq
+	(*UserDataExport)(nil),          // 15: lushop.lushop.v1.UserDataExport
+	(*WishlistExportItem)(nil),      // 16: lushop.lushop.v1.WishlistExportItem
+	(*OrderExportItem)(nil),         // 17: lushop.lushop.v1.OrderExportItem
+	(*UpdateProfileReq)(nil),        // 18: lushop.lushop.v1.UpdateProfileReq
+	(*CaptchaReply)(nil),            // 19: lushop.lushop.v1.CaptchaReply
+	(*GuestTokenReply)(nil),         // 20: lushop.lushop.v1.GuestTokenReply
+	(*CartItem)(nil),                // 21: lushop.lushop.v1.CartItem
+	(*CartListReply)(nil),           // 22: lushop.lushop.v1.CartListReply
+	(*GuestCartReq)(nil),            // 23: lushop.lushop.v1.GuestCartReq
+	(*DeleteCartReq)(nil),           // 24: lushop.lushop.v1.DeleteCartReq
+	(*CartReq)(nil),                 // 25: lushop.lushop.v1.CartReq
+	(*SelectCartReq)(nil),           // 26: lushop.lushop.v1.SelectCartReq
+	(*AddressItem)(nil),             // 27: lushop.lushop.v1.AddressItem
+	(*AddressListReply)(nil),        // 28: lushop.lushop.v1.AddressListReply
+	(*AddressReq)(nil),              // 29: lushop.lushop.v1.AddressReq
+	(*AddressIdReq)(nil),            // 30: lushop.lushop.v1.AddressIdReq
+	(*CategoryItem)(nil),            // 31: lushop.lushop.v1.CategoryItem
+	(*CategoryTreeReply)(nil),       // 32: lushop.lushop.v1.CategoryTreeReply
+	(*SubCategoryReq)(nil),          // 33: lushop.lushop.v1.SubCategoryReq
+	(*SubCategoryReply)(nil),        // 34: lushop.lushop.v1.SubCategoryReply
+	(*BrandListReq)(nil),            // 35: lushop.lushop.v1.BrandListReq
+	(*BrandItem)(nil),               // 36: lushop.lushop.v1.BrandItem
+	(*BrandListReply)(nil),          // 37: lushop.lushop.v1.BrandListReply
+	(*GoodsSearchReq)(nil),          // 38: lushop.lushop.v1.GoodsSearchReq
+	(*GoodsItem)(nil),               // 39: lushop.lushop.v1.GoodsItem
+	(*GoodsListReply)(nil),          // 40: lushop.lushop.v1.GoodsListReply
+	(*GoodsDetailReq)(nil),          // 41: lushop.lushop.v1.GoodsDetailReq
+	(*SkuItem)(nil),                 // 42: lushop.lushop.v1.SkuItem
+	(*SkuListReply)(nil),            // 43: lushop.lushop.v1.SkuListReply
+	(*AdminIdReq)(nil),              // 44: lushop.lushop.v1.AdminIdReq
+	(*AdminIdReply)(nil),            // 45: lushop.lushop.v1.AdminIdReply
+	(*AdminCategoryReq)(nil),        // 46: lushop.lushop.v1.AdminCategoryReq
+	(*AdminBrandReq)(nil),           // 47: lushop.lushop.v1.AdminBrandReq
+	(*AdminGoodsReq)(nil),           // 48: lushop.lushop.v1.AdminGoodsReq
+	(*AdminSku)(nil),                // 49: lushop.lushop.v1.AdminSku
+	(*AdminSkuPriceReq)(nil),        // 50: lushop.lushop.v1.AdminSkuPriceReq
+	(*AdminSkuPriceReply)(nil),      // 51: lushop.lushop.v1.AdminSkuPriceReply
+	(*AdminSkuLimitReq)(nil),        // 52: lushop.lushop.v1.AdminSkuLimitReq
+	(*AdminUserListReq)(nil),        // 53: lushop.lushop.v1.AdminUserListReq
+	(*AdminUserDisabledReq)(nil),    // 54: lushop.lushop.v1.AdminUserDisabledReq
+	(*AdminUserRoleReq)(nil),        // 55: lushop.lushop.v1.AdminUserRoleReq
+	(*AdminUserListReply)(nil),      // 56: lushop.lushop.v1.AdminUserListReply
+	(*AdminUnlockLoginReq)(nil),     // 57: lushop.lushop.v1.AdminUnlockLoginReq
+	(*AdminLockEventListReq)(nil),   // 58: lushop.lushop.v1.AdminLockEventListReq
+	(*LockEvent)(nil),               // 59: lushop.lushop.v1.LockEvent
+	(*AdminLockEventListReply)(nil), // 60: lushop.lushop.v1.AdminLockEventListReply
+	(*AdminSku_Spec)(nil),           // 61: lushop.lushop.v1.AdminSku.Spec
+	(*AdminSku_Attr)(nil),           // 62: lushop.lushop.v1.AdminSku.Attr
+	(*AdminSku_AttrGroup)(nil),      // 63: lushop.lushop.v1.AdminSku.AttrGroup
+	(*fieldmaskpb.FieldMask)(nil),   // 64: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),           // 65: google.protobuf.Empty
 }
 var file_lushop_v1_lushop_proto_depIdxs = []int32{
-	1, // 0: lushop.lushop.v1.Lushop.Register:input_type -> lushop.lushop.v1.RegisterReq
-	3, // 1: lushop.lushop.v1.Lushop.Login:input_type -> lushop.lushop.v1.LoginReq
-	6, // 2: lushop.lushop.v1.Lushop.Captcha:input_type -> google.protobuf.Empty
-	6, // 3: lushop.lushop.v1.Lushop.Detail:input_type -> google.protobuf.Empty
-	2, // 4: lushop.lushop.v1.Lushop.Register:output_type -> lushop.lushop.v1.RegisterReply
-	2, // 5: lushop.lushop.v1.Lushop.Login:output_type -> lushop.lushop.v1.RegisterReply
-	5, // 6: lushop.lushop.v1.Lushop.Captcha:output_type -> lushop.lushop.v1.CaptchaReply
-	4, // 7: lushop.lushop.v1.Lushop.Detail:output_type -> lushop.lushop.v1.UserDetailResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: lushop.lushop.v1.SendSmsCodeReq.scene:type_name -> lushop.lushop.v1.SmsScene
+	0,  // 1: lushop.lushop.v1.VerifySmsCodeReq.scene:type_name -> lushop.lushop.v1.SmsScene
+	13, // 2: lushop.lushop.v1.UserDataExport.profile:type_name -> lushop.lushop.v1.UserDetailResponse
+	27, // 3: lushop.lushop.v1.UserDataExport.addresses:type_name -> lushop.lushop.v1.AddressItem
+	21, // 4: lushop.lushop.v1.UserDataExport.cart:type_name -> lushop.lushop.v1.CartItem
+	21, // 5: lushop.lushop.v1.UserDataExport.saved:type_name -> lushop.lushop.v1.CartItem
+	16, // 6: lushop.lushop.v1.UserDataExport.wishlist:type_name -> lushop.lushop.v1.WishlistExportItem
+	17, // 7: lushop.lushop.v1.UserDataExport.orders:type_name -> lushop.lushop.v1.OrderExportItem
+	64, // 8: lushop.lushop.v1.UpdateProfileReq.updateMask:type_name -> google.protobuf.FieldMask
+	21, // 9: lushop.lushop.v1.CartListReply.list:type_name -> lushop.lushop.v1.CartItem
+	27, // 10: lushop.lushop.v1.AddressListReply.list:type_name -> lushop.lushop.v1.AddressItem
+	31, // 11: lushop.lushop.v1.CategoryItem.subCategory:type_name -> lushop.lushop.v1.CategoryItem
+	31, // 12: lushop.lushop.v1.CategoryTreeReply.list:type_name -> lushop.lushop.v1.CategoryItem
+	31, // 13: lushop.lushop.v1.SubCategoryReply.info:type_name -> lushop.lushop.v1.CategoryItem
+	31, // 14: lushop.lushop.v1.SubCategoryReply.subCategory:type_name -> lushop.lushop.v1.CategoryItem
+	36, // 15: lushop.lushop.v1.BrandListReply.list:type_name -> lushop.lushop.v1.BrandItem
+	39, // 16: lushop.lushop.v1.GoodsListReply.list:type_name -> lushop.lushop.v1.GoodsItem
+	42, // 17: lushop.lushop.v1.SkuListReply.list:type_name -> lushop.lushop.v1.SkuItem
+	49, // 18: lushop.lushop.v1.AdminGoodsReq.skus:type_name -> lushop.lushop.v1.AdminSku
+	61, // 19: lushop.lushop.v1.AdminSku.specs:type_name -> lushop.lushop.v1.AdminSku.Spec
+	63, // 20: lushop.lushop.v1.AdminSku.attrGroups:type_name -> lushop.lushop.v1.AdminSku.AttrGroup
+	13, // 21: lushop.lushop.v1.AdminUserListReply.list:type_name -> lushop.lushop.v1.UserDetailResponse
+	59, // 22: lushop.lushop.v1.AdminLockEventListReply.list:type_name -> lushop.lushop.v1.LockEvent
+	62, // 23: lushop.lushop.v1.AdminSku.AttrGroup.attrs:type_name -> lushop.lushop.v1.AdminSku.Attr
+	2,  // 24: lushop.lushop.v1.Lushop.Register:input_type -> lushop.lushop.v1.RegisterReq
+	12, // 25: lushop.lushop.v1.Lushop.Login:input_type -> lushop.lushop.v1.LoginReq
+	4,  // 26: lushop.lushop.v1.Lushop.SmsLogin:input_type -> lushop.lushop.v1.SmsLoginReq
+	5,  // 27: lushop.lushop.v1.Lushop.SendSmsCode:input_type -> lushop.lushop.v1.SendSmsCodeReq
+	7,  // 28: lushop.lushop.v1.Lushop.VerifySmsCode:input_type -> lushop.lushop.v1.VerifySmsCodeReq
+	8,  // 29: lushop.lushop.v1.Lushop.ChangePassword:input_type -> lushop.lushop.v1.ChangePasswordReq
+	9,  // 30: lushop.lushop.v1.Lushop.ResetPassword:input_type -> lushop.lushop.v1.ResetPasswordReq
+	10, // 31: lushop.lushop.v1.Lushop.Refresh:input_type -> lushop.lushop.v1.RefreshReq
+	11, // 32: lushop.lushop.v1.Lushop.Logout:input_type -> lushop.lushop.v1.LogoutReq
+	65, // 33: lushop.lushop.v1.Lushop.Captcha:input_type -> google.protobuf.Empty
+	65, // 34: lushop.lushop.v1.Lushop.Detail:input_type -> google.protobuf.Empty
+	14, // 35: lushop.lushop.v1.Lushop.DeleteAccount:input_type -> lushop.lushop.v1.DeleteAccountReq
+	65, // 36: lushop.lushop.v1.Lushop.ExportData:input_type -> google.protobuf.Empty
+	18, // 37: lushop.lushop.v1.Lushop.UpdateProfile:input_type -> lushop.lushop.v1.UpdateProfileReq
+	65, // 38: lushop.lushop.v1.Lushop.CategoryTree:input_type -> google.protobuf.Empty
+	33, // 39: lushop.lushop.v1.Lushop.SubCategory:input_type -> lushop.lushop.v1.SubCategoryReq
+	35, // 40: lushop.lushop.v1.Lushop.BrandList:input_type -> lushop.lushop.v1.BrandListReq
+	38, // 41: lushop.lushop.v1.Lushop.GoodsSearch:input_type -> lushop.lushop.v1.GoodsSearchReq
+	41, // 42: lushop.lushop.v1.Lushop.GoodsDetail:input_type -> lushop.lushop.v1.GoodsDetailReq
+	41, // 43: lushop.lushop.v1.Lushop.GoodsSkuList:input_type -> lushop.lushop.v1.GoodsDetailReq
+	46, // 44: lushop.lushop.v1.Lushop.AdminCreateCategory:input_type -> lushop.lushop.v1.AdminCategoryReq
+	46, // 45: lushop.lushop.v1.Lushop.AdminUpdateCategory:input_type -> lushop.lushop.v1.AdminCategoryReq
+	44, // 46: lushop.lushop.v1.Lushop.AdminDeleteCategory:input_type -> lushop.lushop.v1.AdminIdReq
+	47, // 47: lushop.lushop.v1.Lushop.AdminCreateBrand:input_type -> lushop.lushop.v1.AdminBrandReq
+	47, // 48: lushop.lushop.v1.Lushop.AdminUpdateBrand:input_type -> lushop.lushop.v1.AdminBrandReq
+	44, // 49: lushop.lushop.v1.Lushop.AdminDeleteBrand:input_type -> lushop.lushop.v1.AdminIdReq
+	48, // 50: lushop.lushop.v1.Lushop.AdminCreateGoods:input_type -> lushop.lushop.v1.AdminGoodsReq
+	50, // 51: lushop.lushop.v1.Lushop.AdminChangeSkuPrice:input_type -> lushop.lushop.v1.AdminSkuPriceReq
+	52, // 52: lushop.lushop.v1.Lushop.AdminSkuPurchaseLimit:input_type -> lushop.lushop.v1.AdminSkuLimitReq
+	53, // 53: lushop.lushop.v1.Lushop.AdminUserList:input_type -> lushop.lushop.v1.AdminUserListReq
+	44, // 54: lushop.lushop.v1.Lushop.AdminUserDetail:input_type -> lushop.lushop.v1.AdminIdReq
+	54, // 55: lushop.lushop.v1.Lushop.AdminSetUserDisabled:input_type -> lushop.lushop.v1.AdminUserDisabledReq
+	55, // 56: lushop.lushop.v1.Lushop.AdminChangeUserRole:input_type -> lushop.lushop.v1.AdminUserRoleReq
+	57, // 57: lushop.lushop.v1.Lushop.AdminUnlockLogin:input_type -> lushop.lushop.v1.AdminUnlockLoginReq
+	58, // 58: lushop.lushop.v1.Lushop.AdminLockEventList:input_type -> lushop.lushop.v1.AdminLockEventListReq
+	65, // 59: lushop.lushop.v1.Lushop.ListAddress:input_type -> google.protobuf.Empty
+	29, // 60: lushop.lushop.v1.Lushop.CreateAddress:input_type -> lushop.lushop.v1.AddressReq
+	29, // 61: lushop.lushop.v1.Lushop.UpdateAddress:input_type -> lushop.lushop.v1.AddressReq
+	30, // 62: lushop.lushop.v1.Lushop.DeleteAddress:input_type -> lushop.lushop.v1.AddressIdReq
+	30, // 63: lushop.lushop.v1.Lushop.SetDefaultAddress:input_type -> lushop.lushop.v1.AddressIdReq
+	65, // 64: lushop.lushop.v1.Lushop.ListCart:input_type -> google.protobuf.Empty
+	25, // 65: lushop.lushop.v1.Lushop.CreateCart:input_type -> lushop.lushop.v1.CartReq
+	25, // 66: lushop.lushop.v1.Lushop.UpdateCart:input_type -> lushop.lushop.v1.CartReq
+	24, // 67: lushop.lushop.v1.Lushop.DeleteCart:input_type -> lushop.lushop.v1.DeleteCartReq
+	26, // 68: lushop.lushop.v1.Lushop.SelectCart:input_type -> lushop.lushop.v1.SelectCartReq
+	65, // 69: lushop.lushop.v1.Lushop.GuestToken:input_type -> google.protobuf.Empty
+	65, // 70: lushop.lushop.v1.Lushop.ListGuestCart:input_type -> google.protobuf.Empty
+	23, // 71: lushop.lushop.v1.Lushop.CreateGuestCart:input_type -> lushop.lushop.v1.GuestCartReq
+	23, // 72: lushop.lushop.v1.Lushop.UpdateGuestCart:input_type -> lushop.lushop.v1.GuestCartReq
+	24, // 73: lushop.lushop.v1.Lushop.DeleteGuestCart:input_type -> lushop.lushop.v1.DeleteCartReq
+	3,  // 74: lushop.lushop.v1.Lushop.Register:output_type -> lushop.lushop.v1.RegisterReply
+	3,  // 75: lushop.lushop.v1.Lushop.Login:output_type -> lushop.lushop.v1.RegisterReply
+	3,  // 76: lushop.lushop.v1.Lushop.SmsLogin:output_type -> lushop.lushop.v1.RegisterReply
+	6,  // 77: lushop.lushop.v1.Lushop.SendSmsCode:output_type -> lushop.lushop.v1.SendSmsCodeReply
+	65, // 78: lushop.lushop.v1.Lushop.VerifySmsCode:output_type -> google.protobuf.Empty
+	3,  // 79: lushop.lushop.v1.Lushop.ChangePassword:output_type -> lushop.lushop.v1.RegisterReply
+	65, // 80: lushop.lushop.v1.Lushop.ResetPassword:output_type -> google.protobuf.Empty
+	3,  // 81: lushop.lushop.v1.Lushop.Refresh:output_type -> lushop.lushop.v1.RegisterReply
+	65, // 82: lushop.lushop.v1.Lushop.Logout:output_type -> google.protobuf.Empty
+	19, // 83: lushop.lushop.v1.Lushop.Captcha:output_type -> lushop.lushop.v1.CaptchaReply
+	13, // 84: lushop.lushop.v1.Lushop.Detail:output_type -> lushop.lushop.v1.UserDetailResponse
+	65, // 85: lushop.lushop.v1.Lushop.DeleteAccount:output_type -> google.protobuf.Empty
+	15, // 86: lushop.lushop.v1.Lushop.ExportData:output_type -> lushop.lushop.v1.UserDataExport
+	13, // 87: lushop.lushop.v1.Lushop.UpdateProfile:output_type -> lushop.lushop.v1.UserDetailResponse
+	32, // 88: lushop.lushop.v1.Lushop.CategoryTree:output_type -> lushop.lushop.v1.CategoryTreeReply
+	34, // 89: lushop.lushop.v1.Lushop.SubCategory:output_type -> lushop.lushop.v1.SubCategoryReply
+	37, // 90: lushop.lushop.v1.Lushop.BrandList:output_type -> lushop.lushop.v1.BrandListReply
+	40, // 91: lushop.lushop.v1.Lushop.GoodsSearch:output_type -> lushop.lushop.v1.GoodsListReply
+	39, // 92: lushop.lushop.v1.Lushop.GoodsDetail:output_type -> lushop.lushop.v1.GoodsItem
+	43, // 93: lushop.lushop.v1.Lushop.GoodsSkuList:output_type -> lushop.lushop.v1.SkuListReply
+	31, // 94: lushop.lushop.v1.Lushop.AdminCreateCategory:output_type -> lushop.lushop.v1.CategoryItem
+	65, // 95: lushop.lushop.v1.Lushop.AdminUpdateCategory:output_type -> google.protobuf.Empty
+	65, // 96: lushop.lushop.v1.Lushop.AdminDeleteCategory:output_type -> google.protobuf.Empty
+	36, // 97: lushop.lushop.v1.Lushop.AdminCreateBrand:output_type -> lushop.lushop.v1.BrandItem
+	65, // 98: lushop.lushop.v1.Lushop.AdminUpdateBrand:output_type -> google.protobuf.Empty
+	65, // 99: lushop.lushop.v1.Lushop.AdminDeleteBrand:output_type -> google.protobuf.Empty
+	45, // 100: lushop.lushop.v1.Lushop.AdminCreateGoods:output_type -> lushop.lushop.v1.AdminIdReply
+	51, // 101: lushop.lushop.v1.Lushop.AdminChangeSkuPrice:output_type -> lushop.lushop.v1.AdminSkuPriceReply
+	65, // 102: lushop.lushop.v1.Lushop.AdminSkuPurchaseLimit:output_type -> google.protobuf.Empty
+	56, // 103: lushop.lushop.v1.Lushop.AdminUserList:output_type -> lushop.lushop.v1.AdminUserListReply
+	13, // 104: lushop.lushop.v1.Lushop.AdminUserDetail:output_type -> lushop.lushop.v1.UserDetailResponse
+	65, // 105: lushop.lushop.v1.Lushop.AdminSetUserDisabled:output_type -> google.protobuf.Empty
+	65, // 106: lushop.lushop.v1.Lushop.AdminChangeUserRole:output_type -> google.protobuf.Empty
+	65, // 107: lushop.lushop.v1.Lushop.AdminUnlockLogin:output_type -> google.protobuf.Empty
+	60, // 108: lushop.lushop.v1.Lushop.AdminLockEventList:output_type -> lushop.lushop.v1.AdminLockEventListReply
+	28, // 109: lushop.lushop.v1.Lushop.ListAddress:output_type -> lushop.lushop.v1.AddressListReply
+	27, // 110: lushop.lushop.v1.Lushop.CreateAddress:output_type -> lushop.lushop.v1.AddressItem
+	65, // 111: lushop.lushop.v1.Lushop.UpdateAddress:output_type -> google.protobuf.Empty
+	65, // 112: lushop.lushop.v1.Lushop.DeleteAddress:output_type -> google.protobuf.Empty
+	65, // 113: lushop.lushop.v1.Lushop.SetDefaultAddress:output_type -> google.protobuf.Empty
+	22, // 114: lushop.lushop.v1.Lushop.ListCart:output_type -> lushop.lushop.v1.CartListReply
+	21, // 115: lushop.lushop.v1.Lushop.CreateCart:output_type -> lushop.lushop.v1.CartItem
+	65, // 116: lushop.lushop.v1.Lushop.UpdateCart:output_type -> google.protobuf.Empty
+	65, // 117: lushop.lushop.v1.Lushop.DeleteCart:output_type -> google.protobuf.Empty
+	65, // 118: lushop.lushop.v1.Lushop.SelectCart:output_type -> google.protobuf.Empty
+	20, // 119: lushop.lushop.v1.Lushop.GuestToken:output_type -> lushop.lushop.v1.GuestTokenReply
+	22, // 120: lushop.lushop.v1.Lushop.ListGuestCart:output_type -> lushop.lushop.v1.CartListReply
+	21, // 121: lushop.lushop.v1.Lushop.CreateGuestCart:output_type -> lushop.lushop.v1.CartItem
+	65, // 122: lushop.lushop.v1.Lushop.UpdateGuestCart:output_type -> google.protobuf.Empty
+	65, // 123: lushop.lushop.v1.Lushop.DeleteGuestCart:output_type -> google.protobuf.Empty
+	74, // [74:124] is the sub-list for method output_type
+	24, // [24:74] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_lushop_v1_lushop_proto_init() }
@@ -520,18 +5165,21 @@ func file_lushop_v1_lushop_proto_init() {
 	if File_lushop_v1_lushop_proto != nil {
 		return
 	}
+	file_lushop_v1_lushop_proto_msgTypes[12].OneofWrappers = []any{}
+	file_lushop_v1_lushop_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lushop_v1_lushop_proto_rawDesc), len(file_lushop_v1_lushop_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_lushop_v1_lushop_proto_goTypes,
 		DependencyIndexes: file_lushop_v1_lushop_proto_depIdxs,
+		EnumInfos:         file_lushop_v1_lushop_proto_enumTypes,
 		MessageInfos:      file_lushop_v1_lushop_proto_msgTypes,
 	}.Build()
 	File_lushop_v1_lushop_proto = out.File
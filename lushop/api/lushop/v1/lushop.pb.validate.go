@@ -163,16 +163,15 @@ func (m *RegisterReq) validate(all bool) error {
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetMobile()) != 11 {
+	if !_RegisterReq_Mobile_Pattern.MatchString(m.GetMobile()) {
 		err := RegisterReqValidationError{
 			field:  "Mobile",
-			reason: "value length must be 11 runes",
+			reason: "value does not match regex pattern \"^1[3-9][0-9]{9}$\"",
 		}
 		if !all {
 			return err
 		}
 		errors = append(errors, err)
-
 	}
 
 	if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 15 {
@@ -197,6 +196,18 @@ func (m *RegisterReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetSmsCode()) != 6 {
+		err := RegisterReqValidationError{
+			field:  "SmsCode",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
 	if len(errors) > 0 {
 		return RegisterReqMultiError(errors)
 	}
@@ -274,6 +285,8 @@ var _ interface {
 	ErrorName() string
 } = RegisterReqValidationError{}
 
+var _RegisterReq_Mobile_Pattern = regexp.MustCompile("^1[3-9][0-9]{9}$")
+
 // Validate checks the field values on RegisterReply with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -306,6 +319,10 @@ func (m *RegisterReply) validate(all bool) error {
 
 	// no validation rules for ExpiredAt
 
+	// no validation rules for RefreshToken
+
+	// no validation rules for RefreshExpiredAt
+
 	if len(errors) > 0 {
 		return RegisterReplyMultiError(errors)
 	}
@@ -384,32 +401,43 @@ var _ interface {
 	ErrorName() string
 } = RegisterReplyValidationError{}
 
-// Validate checks the field values on LoginReq with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on SmsLoginReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *LoginReq) Validate() error {
+func (m *SmsLoginReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LoginReq with the rules defined in
+// ValidateAll checks the field values on SmsLoginReq with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LoginReqMultiError, or nil
-// if none found.
-func (m *LoginReq) ValidateAll() error {
+// result is a list of violation errors wrapped in SmsLoginReqMultiError, or
+// nil if none found.
+func (m *SmsLoginReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LoginReq) validate(all bool) error {
+func (m *SmsLoginReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetMobile()) != 11 {
-		err := LoginReqValidationError{
+	if !_SmsLoginReq_Mobile_Pattern.MatchString(m.GetMobile()) {
+		err := SmsLoginReqValidationError{
 			field:  "Mobile",
-			reason: "value length must be 11 runes",
+			reason: "value does not match regex pattern \"^1[3-9][0-9]{9}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSmsCode()) != 6 {
+		err := SmsLoginReqValidationError{
+			field:  "SmsCode",
+			reason: "value length must be 6 runes",
 		}
 		if !all {
 			return err
@@ -418,10 +446,111 @@ func (m *LoginReq) validate(all bool) error {
 
 	}
 
-	if utf8.RuneCountInString(m.GetPassword()) < 5 {
-		err := LoginReqValidationError{
-			field:  "Password",
-			reason: "value length must be at least 5 runes",
+	if len(errors) > 0 {
+		return SmsLoginReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SmsLoginReqMultiError is an error wrapping multiple validation errors
+// returned by SmsLoginReq.ValidateAll() if the designated constraints aren't met.
+type SmsLoginReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SmsLoginReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SmsLoginReqMultiError) AllErrors() []error { return m }
+
+// SmsLoginReqValidationError is the validation error returned by
+// SmsLoginReq.Validate if the designated constraints aren't met.
+type SmsLoginReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SmsLoginReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SmsLoginReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SmsLoginReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SmsLoginReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SmsLoginReqValidationError) ErrorName() string { return "SmsLoginReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SmsLoginReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSmsLoginReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SmsLoginReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SmsLoginReqValidationError{}
+
+var _SmsLoginReq_Mobile_Pattern = regexp.MustCompile("^1[3-9][0-9]{9}$")
+
+// Validate checks the field values on SendSmsCodeReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendSmsCodeReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendSmsCodeReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendSmsCodeReqMultiError,
+// or nil if none found.
+func (m *SendSmsCodeReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendSmsCodeReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_SendSmsCodeReq_Mobile_Pattern.MatchString(m.GetMobile()) {
+		err := SendSmsCodeReqValidationError{
+			field:  "Mobile",
+			reason: "value does not match regex pattern \"^1[3-9][0-9]{9}$\"",
 		}
 		if !all {
 			return err
@@ -429,10 +558,10 @@ func (m *LoginReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetCaptcha()); l < 4 || l > 6 {
-		err := LoginReqValidationError{
-			field:  "Captcha",
-			reason: "value length must be between 4 and 6 runes, inclusive",
+	if _, ok := _SendSmsCodeReq_Scene_NotInLookup[m.GetScene()]; ok {
+		err := SendSmsCodeReqValidationError{
+			field:  "Scene",
+			reason: "value must not be in list [SMS_SCENE_UNSPECIFIED]",
 		}
 		if !all {
 			return err
@@ -440,10 +569,10 @@ func (m *LoginReq) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetCaptchaId()) < 1 {
-		err := LoginReqValidationError{
-			field:  "CaptchaId",
-			reason: "value length must be at least 1 runes",
+	if _, ok := SmsScene_name[int32(m.GetScene())]; !ok {
+		err := SendSmsCodeReqValidationError{
+			field:  "Scene",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -452,18 +581,19 @@ func (m *LoginReq) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return LoginReqMultiError(errors)
+		return SendSmsCodeReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// LoginReqMultiError is an error wrapping multiple validation errors returned
-// by LoginReq.ValidateAll() if the designated constraints aren't met.
-type LoginReqMultiError []error
+// SendSmsCodeReqMultiError is an error wrapping multiple validation errors
+// returned by SendSmsCodeReq.ValidateAll() if the designated constraints
+// aren't met.
+type SendSmsCodeReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LoginReqMultiError) Error() string {
+func (m SendSmsCodeReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -472,11 +602,11 @@ func (m LoginReqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LoginReqMultiError) AllErrors() []error { return m }
+func (m SendSmsCodeReqMultiError) AllErrors() []error { return m }
 
-// LoginReqValidationError is the validation error returned by
-// LoginReq.Validate if the designated constraints aren't met.
-type LoginReqValidationError struct {
+// SendSmsCodeReqValidationError is the validation error returned by
+// SendSmsCodeReq.Validate if the designated constraints aren't met.
+type SendSmsCodeReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -484,22 +614,22 @@ type LoginReqValidationError struct {
 }
 
 // Field function returns field value.
-func (e LoginReqValidationError) Field() string { return e.field }
+func (e SendSmsCodeReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LoginReqValidationError) Reason() string { return e.reason }
+func (e SendSmsCodeReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LoginReqValidationError) Cause() error { return e.cause }
+func (e SendSmsCodeReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LoginReqValidationError) Key() bool { return e.key }
+func (e SendSmsCodeReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LoginReqValidationError) ErrorName() string { return "LoginReqValidationError" }
+func (e SendSmsCodeReqValidationError) ErrorName() string { return "SendSmsCodeReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LoginReqValidationError) Error() string {
+func (e SendSmsCodeReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -511,14 +641,14 @@ func (e LoginReqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLoginReq.%s: %s%s",
+		"invalid %sSendSmsCodeReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LoginReqValidationError{}
+var _ error = SendSmsCodeReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -526,56 +656,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LoginReqValidationError{}
+} = SendSmsCodeReqValidationError{}
 
-// Validate checks the field values on UserDetailResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserDetailResponse) Validate() error {
+var _SendSmsCodeReq_Mobile_Pattern = regexp.MustCompile("^1[3-9][0-9]{9}$")
+
+var _SendSmsCodeReq_Scene_NotInLookup = map[SmsScene]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SendSmsCodeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SendSmsCodeReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserDetailResponse with the rules
+// ValidateAll checks the field values on SendSmsCodeReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UserDetailResponseMultiError, or nil if none found.
-func (m *UserDetailResponse) ValidateAll() error {
+// SendSmsCodeReplyMultiError, or nil if none found.
+func (m *SendSmsCodeReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserDetailResponse) validate(all bool) error {
+func (m *SendSmsCodeReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for Mobile
-
-	// no validation rules for NickName
-
-	// no validation rules for Birthday
-
-	// no validation rules for Gender
+	// no validation rules for ExpiredAt
 
-	// no validation rules for Role
+	// no validation rules for ResendAt
 
 	if len(errors) > 0 {
-		return UserDetailResponseMultiError(errors)
+		return SendSmsCodeReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserDetailResponseMultiError is an error wrapping multiple validation errors
-// returned by UserDetailResponse.ValidateAll() if the designated constraints
+// SendSmsCodeReplyMultiError is an error wrapping multiple validation errors
+// returned by SendSmsCodeReply.ValidateAll() if the designated constraints
 // aren't met.
-type UserDetailResponseMultiError []error
+type SendSmsCodeReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserDetailResponseMultiError) Error() string {
+func (m SendSmsCodeReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -584,11 +712,11 @@ func (m UserDetailResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserDetailResponseMultiError) AllErrors() []error { return m }
+func (m SendSmsCodeReplyMultiError) AllErrors() []error { return m }
 
-// UserDetailResponseValidationError is the validation error returned by
-// UserDetailResponse.Validate if the designated constraints aren't met.
-type UserDetailResponseValidationError struct {
+// SendSmsCodeReplyValidationError is the validation error returned by
+// SendSmsCodeReply.Validate if the designated constraints aren't met.
+type SendSmsCodeReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -596,24 +724,22 @@ type UserDetailResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserDetailResponseValidationError) Field() string { return e.field }
+func (e SendSmsCodeReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserDetailResponseValidationError) Reason() string { return e.reason }
+func (e SendSmsCodeReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserDetailResponseValidationError) Cause() error { return e.cause }
+func (e SendSmsCodeReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserDetailResponseValidationError) Key() bool { return e.key }
+func (e SendSmsCodeReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserDetailResponseValidationError) ErrorName() string {
-	return "UserDetailResponseValidationError"
-}
+func (e SendSmsCodeReplyValidationError) ErrorName() string { return "SendSmsCodeReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UserDetailResponseValidationError) Error() string {
+func (e SendSmsCodeReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -625,14 +751,14 @@ func (e UserDetailResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserDetailResponse.%s: %s%s",
+		"invalid %sSendSmsCodeReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserDetailResponseValidationError{}
+var _ error = SendSmsCodeReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -640,49 +766,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserDetailResponseValidationError{}
+} = SendSmsCodeReplyValidationError{}
 
-// Validate checks the field values on CaptchaReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CaptchaReply) Validate() error {
+// Validate checks the field values on VerifySmsCodeReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifySmsCodeReq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CaptchaReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CaptchaReplyMultiError, or
-// nil if none found.
-func (m *CaptchaReply) ValidateAll() error {
+// ValidateAll checks the field values on VerifySmsCodeReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifySmsCodeReqMultiError, or nil if none found.
+func (m *VerifySmsCodeReq) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CaptchaReply) validate(all bool) error {
+func (m *VerifySmsCodeReq) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CaptchaId
+	if !_VerifySmsCodeReq_Mobile_Pattern.MatchString(m.GetMobile()) {
+		err := VerifySmsCodeReqValidationError{
+			field:  "Mobile",
+			reason: "value does not match regex pattern \"^1[3-9][0-9]{9}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for PicPath
+	if _, ok := _VerifySmsCodeReq_Scene_NotInLookup[m.GetScene()]; ok {
+		err := VerifySmsCodeReqValidationError{
+			field:  "Scene",
+			reason: "value must not be in list [SMS_SCENE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Ans
+	if _, ok := SmsScene_name[int32(m.GetScene())]; !ok {
+		err := VerifySmsCodeReqValidationError{
+			field:  "Scene",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSmsCode()) != 6 {
+		err := VerifySmsCodeReqValidationError{
+			field:  "SmsCode",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
 
 	if len(errors) > 0 {
-		return CaptchaReplyMultiError(errors)
+		return VerifySmsCodeReqMultiError(errors)
 	}
 
 	return nil
 }
 
-// CaptchaReplyMultiError is an error wrapping multiple validation errors
-// returned by CaptchaReply.ValidateAll() if the designated constraints aren't met.
-type CaptchaReplyMultiError []error
+// VerifySmsCodeReqMultiError is an error wrapping multiple validation errors
+// returned by VerifySmsCodeReq.ValidateAll() if the designated constraints
+// aren't met.
+type VerifySmsCodeReqMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CaptchaReplyMultiError) Error() string {
+func (m VerifySmsCodeReqMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -691,11 +857,11 @@ func (m CaptchaReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CaptchaReplyMultiError) AllErrors() []error { return m }
+func (m VerifySmsCodeReqMultiError) AllErrors() []error { return m }
 
-// CaptchaReplyValidationError is the validation error returned by
-// CaptchaReply.Validate if the designated constraints aren't met.
-type CaptchaReplyValidationError struct {
+// VerifySmsCodeReqValidationError is the validation error returned by
+// VerifySmsCodeReq.Validate if the designated constraints aren't met.
+type VerifySmsCodeReqValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -703,22 +869,22 @@ type CaptchaReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CaptchaReplyValidationError) Field() string { return e.field }
+func (e VerifySmsCodeReqValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CaptchaReplyValidationError) Reason() string { return e.reason }
+func (e VerifySmsCodeReqValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CaptchaReplyValidationError) Cause() error { return e.cause }
+func (e VerifySmsCodeReqValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CaptchaReplyValidationError) Key() bool { return e.key }
+func (e VerifySmsCodeReqValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CaptchaReplyValidationError) ErrorName() string { return "CaptchaReplyValidationError" }
+func (e VerifySmsCodeReqValidationError) ErrorName() string { return "VerifySmsCodeReqValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CaptchaReplyValidationError) Error() string {
+func (e VerifySmsCodeReqValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -730,14 +896,14 @@ func (e CaptchaReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCaptchaReply.%s: %s%s",
+		"invalid %sVerifySmsCodeReq.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CaptchaReplyValidationError{}
+var _ error = VerifySmsCodeReqValidationError{}
 
 var _ interface {
 	Field() string
@@ -745,4 +911,7551 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CaptchaReplyValidationError{}
+} = VerifySmsCodeReqValidationError{}
+
+var _VerifySmsCodeReq_Mobile_Pattern = regexp.MustCompile("^1[3-9][0-9]{9}$")
+
+var _VerifySmsCodeReq_Scene_NotInLookup = map[SmsScene]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ChangePasswordReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordReqMultiError, or nil if none found.
+func (m *ChangePasswordReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOldPassword()) < 1 {
+		err := ChangePasswordReqValidationError{
+			field:  "OldPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNewPassword()) < 5 {
+		err := ChangePasswordReqValidationError{
+			field:  "NewPassword",
+			reason: "value length must be at least 5 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangePasswordReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordReqMultiError is an error wrapping multiple validation errors
+// returned by ChangePasswordReq.ValidateAll() if the designated constraints
+// aren't met.
+type ChangePasswordReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordReqMultiError) AllErrors() []error { return m }
+
+// ChangePasswordReqValidationError is the validation error returned by
+// ChangePasswordReq.Validate if the designated constraints aren't met.
+type ChangePasswordReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordReqValidationError) ErrorName() string {
+	return "ChangePasswordReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordReqValidationError{}
+
+// Validate checks the field values on ResetPasswordReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordReqMultiError, or nil if none found.
+func (m *ResetPasswordReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ResetPasswordReq_Mobile_Pattern.MatchString(m.GetMobile()) {
+		err := ResetPasswordReqValidationError{
+			field:  "Mobile",
+			reason: "value does not match regex pattern \"^1[3-9][0-9]{9}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSmsCode()) != 6 {
+		err := ResetPasswordReqValidationError{
+			field:  "SmsCode",
+			reason: "value length must be 6 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if utf8.RuneCountInString(m.GetNewPassword()) < 5 {
+		err := ResetPasswordReqValidationError{
+			field:  "NewPassword",
+			reason: "value length must be at least 5 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetPasswordReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordReqMultiError is an error wrapping multiple validation errors
+// returned by ResetPasswordReq.ValidateAll() if the designated constraints
+// aren't met.
+type ResetPasswordReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordReqMultiError) AllErrors() []error { return m }
+
+// ResetPasswordReqValidationError is the validation error returned by
+// ResetPasswordReq.Validate if the designated constraints aren't met.
+type ResetPasswordReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordReqValidationError) ErrorName() string { return "ResetPasswordReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordReqValidationError{}
+
+var _ResetPasswordReq_Mobile_Pattern = regexp.MustCompile("^1[3-9][0-9]{9}$")
+
+// Validate checks the field values on RefreshReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefreshReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefreshReqMultiError, or
+// nil if none found.
+func (m *RefreshReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRefreshToken()) != 64 {
+		err := RefreshReqValidationError{
+			field:  "RefreshToken",
+			reason: "value length must be 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return RefreshReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshReqMultiError is an error wrapping multiple validation errors
+// returned by RefreshReq.ValidateAll() if the designated constraints aren't met.
+type RefreshReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshReqMultiError) AllErrors() []error { return m }
+
+// RefreshReqValidationError is the validation error returned by
+// RefreshReq.Validate if the designated constraints aren't met.
+type RefreshReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshReqValidationError) ErrorName() string { return "RefreshReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefreshReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshReqValidationError{}
+
+// Validate checks the field values on LogoutReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogoutReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogoutReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogoutReqMultiError, or nil
+// if none found.
+func (m *LogoutReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogoutReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return LogoutReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogoutReqMultiError is an error wrapping multiple validation errors returned
+// by LogoutReq.ValidateAll() if the designated constraints aren't met.
+type LogoutReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogoutReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogoutReqMultiError) AllErrors() []error { return m }
+
+// LogoutReqValidationError is the validation error returned by
+// LogoutReq.Validate if the designated constraints aren't met.
+type LogoutReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogoutReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogoutReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogoutReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogoutReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogoutReqValidationError) ErrorName() string { return "LogoutReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogoutReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogoutReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogoutReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogoutReqValidationError{}
+
+// Validate checks the field values on LoginReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoginReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoginReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoginReqMultiError, or nil
+// if none found.
+func (m *LoginReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoginReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_LoginReq_Mobile_Pattern.MatchString(m.GetMobile()) {
+		err := LoginReqValidationError{
+			field:  "Mobile",
+			reason: "value does not match regex pattern \"^1[3-9][0-9]{9}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) < 5 {
+		err := LoginReqValidationError{
+			field:  "Password",
+			reason: "value length must be at least 5 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCaptcha()); l < 4 || l > 6 {
+		err := LoginReqValidationError{
+			field:  "Captcha",
+			reason: "value length must be between 4 and 6 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCaptchaId()) < 1 {
+		err := LoginReqValidationError{
+			field:  "CaptchaId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LoginReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoginReqMultiError is an error wrapping multiple validation errors returned
+// by LoginReq.ValidateAll() if the designated constraints aren't met.
+type LoginReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoginReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoginReqMultiError) AllErrors() []error { return m }
+
+// LoginReqValidationError is the validation error returned by
+// LoginReq.Validate if the designated constraints aren't met.
+type LoginReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoginReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoginReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoginReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoginReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoginReqValidationError) ErrorName() string { return "LoginReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoginReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoginReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoginReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoginReqValidationError{}
+
+var _LoginReq_Mobile_Pattern = regexp.MustCompile("^1[3-9][0-9]{9}$")
+
+// Validate checks the field values on UserDetailResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserDetailResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDetailResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDetailResponseMultiError, or nil if none found.
+func (m *UserDetailResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDetailResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Mobile
+
+	// no validation rules for NickName
+
+	// no validation rules for Gender
+
+	// no validation rules for Role
+
+	// no validation rules for Disabled
+
+	// no validation rules for CreatedAt
+
+	if m.Birthday != nil {
+		// no validation rules for Birthday
+	}
+
+	if len(errors) > 0 {
+		return UserDetailResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDetailResponseMultiError is an error wrapping multiple validation errors
+// returned by UserDetailResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UserDetailResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDetailResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDetailResponseMultiError) AllErrors() []error { return m }
+
+// UserDetailResponseValidationError is the validation error returned by
+// UserDetailResponse.Validate if the designated constraints aren't met.
+type UserDetailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDetailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDetailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDetailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDetailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDetailResponseValidationError) ErrorName() string {
+	return "UserDetailResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserDetailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDetailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDetailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDetailResponseValidationError{}
+
+// Validate checks the field values on DeleteAccountReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAccountReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAccountReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAccountReqMultiError, or nil if none found.
+func (m *DeleteAccountReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAccountReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 64 {
+		err := DeleteAccountReqValidationError{
+			field:  "Password",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteAccountReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAccountReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteAccountReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteAccountReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAccountReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAccountReqMultiError) AllErrors() []error { return m }
+
+// DeleteAccountReqValidationError is the validation error returned by
+// DeleteAccountReq.Validate if the designated constraints aren't met.
+type DeleteAccountReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAccountReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAccountReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAccountReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAccountReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAccountReqValidationError) ErrorName() string { return "DeleteAccountReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteAccountReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAccountReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAccountReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAccountReqValidationError{}
+
+// Validate checks the field values on UserDataExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDataExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDataExportMultiError,
+// or nil if none found.
+func (m *UserDataExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ExportedAt
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserDataExportValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserDataExportValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserDataExportValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAddresses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Addresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Addresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataExportValidationError{
+					field:  fmt.Sprintf("Addresses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCart() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Cart[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Cart[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataExportValidationError{
+					field:  fmt.Sprintf("Cart[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSaved() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Saved[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Saved[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataExportValidationError{
+					field:  fmt.Sprintf("Saved[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetWishlist() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Wishlist[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Wishlist[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataExportValidationError{
+					field:  fmt.Sprintf("Wishlist[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserDataExportValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserDataExportValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserDataExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataExportMultiError is an error wrapping multiple validation errors
+// returned by UserDataExport.ValidateAll() if the designated constraints
+// aren't met.
+type UserDataExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataExportMultiError) AllErrors() []error { return m }
+
+// UserDataExportValidationError is the validation error returned by
+// UserDataExport.Validate if the designated constraints aren't met.
+type UserDataExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataExportValidationError) ErrorName() string { return "UserDataExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataExportValidationError{}
+
+// Validate checks the field values on WishlistExportItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WishlistExportItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WishlistExportItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WishlistExportItemMultiError, or nil if none found.
+func (m *WishlistExportItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WishlistExportItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GoodsId
+
+	// no validation rules for GoodsName
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return WishlistExportItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// WishlistExportItemMultiError is an error wrapping multiple validation errors
+// returned by WishlistExportItem.ValidateAll() if the designated constraints
+// aren't met.
+type WishlistExportItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WishlistExportItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WishlistExportItemMultiError) AllErrors() []error { return m }
+
+// WishlistExportItemValidationError is the validation error returned by
+// WishlistExportItem.Validate if the designated constraints aren't met.
+type WishlistExportItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WishlistExportItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WishlistExportItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WishlistExportItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WishlistExportItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WishlistExportItemValidationError) ErrorName() string {
+	return "WishlistExportItemValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WishlistExportItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWishlistExportItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WishlistExportItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WishlistExportItemValidationError{}
+
+// Validate checks the field values on OrderExportItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderExportItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderExportItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderExportItemMultiError, or nil if none found.
+func (m *OrderExportItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderExportItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrderSn
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return OrderExportItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderExportItemMultiError is an error wrapping multiple validation errors
+// returned by OrderExportItem.ValidateAll() if the designated constraints
+// aren't met.
+type OrderExportItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderExportItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderExportItemMultiError) AllErrors() []error { return m }
+
+// OrderExportItemValidationError is the validation error returned by
+// OrderExportItem.Validate if the designated constraints aren't met.
+type OrderExportItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderExportItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderExportItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderExportItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderExportItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderExportItemValidationError) ErrorName() string { return "OrderExportItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderExportItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderExportItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderExportItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderExportItemValidationError{}
+
+// Validate checks the field values on UpdateProfileReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProfileReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProfileReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProfileReqMultiError, or nil if none found.
+func (m *UpdateProfileReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProfileReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProfileReqValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProfileReqValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProfileReqValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.NickName != nil {
+
+		if l := utf8.RuneCountInString(m.GetNickName()); l < 1 || l > 25 {
+			err := UpdateProfileReqValidationError{
+				field:  "NickName",
+				reason: "value length must be between 1 and 25 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Gender != nil {
+
+		if _, ok := _UpdateProfileReq_Gender_InLookup[m.GetGender()]; !ok {
+			err := UpdateProfileReqValidationError{
+				field:  "Gender",
+				reason: "value must be in list [male female]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Birthday != nil {
+		// no validation rules for Birthday
+	}
+
+	if len(errors) > 0 {
+		return UpdateProfileReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProfileReqMultiError is an error wrapping multiple validation errors
+// returned by UpdateProfileReq.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateProfileReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProfileReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProfileReqMultiError) AllErrors() []error { return m }
+
+// UpdateProfileReqValidationError is the validation error returned by
+// UpdateProfileReq.Validate if the designated constraints aren't met.
+type UpdateProfileReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProfileReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProfileReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProfileReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProfileReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProfileReqValidationError) ErrorName() string { return "UpdateProfileReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateProfileReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProfileReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProfileReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProfileReqValidationError{}
+
+var _UpdateProfileReq_Gender_InLookup = map[string]struct{}{
+	"male":   {},
+	"female": {},
+}
+
+// Validate checks the field values on CaptchaReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CaptchaReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CaptchaReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CaptchaReplyMultiError, or
+// nil if none found.
+func (m *CaptchaReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CaptchaReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaptchaId
+
+	// no validation rules for PicPath
+
+	// no validation rules for Ans
+
+	if len(errors) > 0 {
+		return CaptchaReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CaptchaReplyMultiError is an error wrapping multiple validation errors
+// returned by CaptchaReply.ValidateAll() if the designated constraints aren't met.
+type CaptchaReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CaptchaReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CaptchaReplyMultiError) AllErrors() []error { return m }
+
+// CaptchaReplyValidationError is the validation error returned by
+// CaptchaReply.Validate if the designated constraints aren't met.
+type CaptchaReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptchaReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptchaReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptchaReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptchaReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptchaReplyValidationError) ErrorName() string { return "CaptchaReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CaptchaReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptchaReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptchaReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptchaReplyValidationError{}
+
+// Validate checks the field values on GuestTokenReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GuestTokenReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuestTokenReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GuestTokenReplyMultiError, or nil if none found.
+func (m *GuestTokenReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuestTokenReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return GuestTokenReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuestTokenReplyMultiError is an error wrapping multiple validation errors
+// returned by GuestTokenReply.ValidateAll() if the designated constraints
+// aren't met.
+type GuestTokenReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuestTokenReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuestTokenReplyMultiError) AllErrors() []error { return m }
+
+// GuestTokenReplyValidationError is the validation error returned by
+// GuestTokenReply.Validate if the designated constraints aren't met.
+type GuestTokenReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuestTokenReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuestTokenReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuestTokenReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuestTokenReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuestTokenReplyValidationError) ErrorName() string { return "GuestTokenReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuestTokenReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuestTokenReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuestTokenReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuestTokenReplyValidationError{}
+
+// Validate checks the field values on CartItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CartItemMultiError, or nil
+// if none found.
+func (m *CartItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GoodsId
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for GoodsName
+
+	// no validation rules for SkuId
+
+	// no validation rules for SkuName
+
+	// no validation rules for GoodsPrice
+
+	// no validation rules for CurrentPrice
+
+	// no validation rules for GoodsNum
+
+	// no validation rules for IsSelect
+
+	// no validation rules for OnSale
+
+	// no validation rules for Stock
+
+	// no validation rules for PriceChanged
+
+	// no validation rules for OutOfStock
+
+	// no validation rules for OverLimit
+
+	if len(errors) > 0 {
+		return CartItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartItemMultiError is an error wrapping multiple validation errors returned
+// by CartItem.ValidateAll() if the designated constraints aren't met.
+type CartItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartItemMultiError) AllErrors() []error { return m }
+
+// CartItemValidationError is the validation error returned by
+// CartItem.Validate if the designated constraints aren't met.
+type CartItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartItemValidationError) ErrorName() string { return "CartItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartItemValidationError{}
+
+// Validate checks the field values on CartListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CartListReplyMultiError, or
+// nil if none found.
+func (m *CartListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CartListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartListReplyMultiError is an error wrapping multiple validation errors
+// returned by CartListReply.ValidateAll() if the designated constraints
+// aren't met.
+type CartListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartListReplyMultiError) AllErrors() []error { return m }
+
+// CartListReplyValidationError is the validation error returned by
+// CartListReply.Validate if the designated constraints aren't met.
+type CartListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartListReplyValidationError) ErrorName() string { return "CartListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartListReplyValidationError{}
+
+// Validate checks the field values on GuestCartReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GuestCartReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuestCartReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GuestCartReqMultiError, or
+// nil if none found.
+func (m *GuestCartReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuestCartReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() <= 0 {
+		err := GuestCartReqValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsNum() <= 0 {
+		err := GuestCartReqValidationError{
+			field:  "GoodsNum",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GuestCartReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuestCartReqMultiError is an error wrapping multiple validation errors
+// returned by GuestCartReq.ValidateAll() if the designated constraints aren't met.
+type GuestCartReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuestCartReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuestCartReqMultiError) AllErrors() []error { return m }
+
+// GuestCartReqValidationError is the validation error returned by
+// GuestCartReq.Validate if the designated constraints aren't met.
+type GuestCartReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuestCartReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuestCartReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuestCartReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuestCartReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuestCartReqValidationError) ErrorName() string { return "GuestCartReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuestCartReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuestCartReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuestCartReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuestCartReqValidationError{}
+
+// Validate checks the field values on DeleteCartReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteCartReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCartReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteCartReqMultiError, or
+// nil if none found.
+func (m *DeleteCartReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCartReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetSkuIds()) < 1 {
+		err := DeleteCartReqValidationError{
+			field:  "SkuIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCartReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCartReqMultiError is an error wrapping multiple validation errors
+// returned by DeleteCartReq.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteCartReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCartReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCartReqMultiError) AllErrors() []error { return m }
+
+// DeleteCartReqValidationError is the validation error returned by
+// DeleteCartReq.Validate if the designated constraints aren't met.
+type DeleteCartReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCartReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCartReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCartReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCartReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCartReqValidationError) ErrorName() string { return "DeleteCartReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteCartReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCartReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCartReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCartReqValidationError{}
+
+// Validate checks the field values on CartReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CartReqMultiError, or nil if none found.
+func (m *CartReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() <= 0 {
+		err := CartReqValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsNum() <= 0 {
+		err := CartReqValidationError{
+			field:  "GoodsNum",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CartReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartReqMultiError is an error wrapping multiple validation errors returned
+// by CartReq.ValidateAll() if the designated constraints aren't met.
+type CartReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartReqMultiError) AllErrors() []error { return m }
+
+// CartReqValidationError is the validation error returned by CartReq.Validate
+// if the designated constraints aren't met.
+type CartReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartReqValidationError) ErrorName() string { return "CartReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartReqValidationError{}
+
+// Validate checks the field values on SelectCartReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SelectCartReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelectCartReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SelectCartReqMultiError, or
+// nil if none found.
+func (m *SelectCartReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelectCartReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsSelect
+
+	if len(errors) > 0 {
+		return SelectCartReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelectCartReqMultiError is an error wrapping multiple validation errors
+// returned by SelectCartReq.ValidateAll() if the designated constraints
+// aren't met.
+type SelectCartReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelectCartReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelectCartReqMultiError) AllErrors() []error { return m }
+
+// SelectCartReqValidationError is the validation error returned by
+// SelectCartReq.Validate if the designated constraints aren't met.
+type SelectCartReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelectCartReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelectCartReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelectCartReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelectCartReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelectCartReqValidationError) ErrorName() string { return "SelectCartReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SelectCartReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelectCartReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelectCartReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelectCartReqValidationError{}
+
+// Validate checks the field values on AddressItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressItemMultiError, or
+// nil if none found.
+func (m *AddressItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProvinceCode
+
+	// no validation rules for CityCode
+
+	// no validation rules for DistrictCode
+
+	// no validation rules for Recipient
+
+	// no validation rules for Mobile
+
+	// no validation rules for Detail
+
+	// no validation rules for IsDefault
+
+	if len(errors) > 0 {
+		return AddressItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressItemMultiError is an error wrapping multiple validation errors
+// returned by AddressItem.ValidateAll() if the designated constraints aren't met.
+type AddressItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressItemMultiError) AllErrors() []error { return m }
+
+// AddressItemValidationError is the validation error returned by
+// AddressItem.Validate if the designated constraints aren't met.
+type AddressItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressItemValidationError) ErrorName() string { return "AddressItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressItemValidationError{}
+
+// Validate checks the field values on AddressListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddressListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddressListReplyMultiError, or nil if none found.
+func (m *AddressListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddressListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddressListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddressListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddressListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressListReplyMultiError is an error wrapping multiple validation errors
+// returned by AddressListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AddressListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressListReplyMultiError) AllErrors() []error { return m }
+
+// AddressListReplyValidationError is the validation error returned by
+// AddressListReply.Validate if the designated constraints aren't met.
+type AddressListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressListReplyValidationError) ErrorName() string { return "AddressListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressListReplyValidationError{}
+
+// Validate checks the field values on AddressReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressReqMultiError, or
+// nil if none found.
+func (m *AddressReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if !_AddressReq_ProvinceCode_Pattern.MatchString(m.GetProvinceCode()) {
+		err := AddressReqValidationError{
+			field:  "ProvinceCode",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddressReq_CityCode_Pattern.MatchString(m.GetCityCode()) {
+		err := AddressReqValidationError{
+			field:  "CityCode",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddressReq_DistrictCode_Pattern.MatchString(m.GetDistrictCode()) {
+		err := AddressReqValidationError{
+			field:  "DistrictCode",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetRecipient()); l < 1 || l > 25 {
+		err := AddressReqValidationError{
+			field:  "Recipient",
+			reason: "value length must be between 1 and 25 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddressReq_Mobile_Pattern.MatchString(m.GetMobile()) {
+		err := AddressReqValidationError{
+			field:  "Mobile",
+			reason: "value does not match regex pattern \"^1[3-9][0-9]{9}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetDetail()); l < 1 || l > 200 {
+		err := AddressReqValidationError{
+			field:  "Detail",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsDefault
+
+	if len(errors) > 0 {
+		return AddressReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressReqMultiError is an error wrapping multiple validation errors
+// returned by AddressReq.ValidateAll() if the designated constraints aren't met.
+type AddressReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressReqMultiError) AllErrors() []error { return m }
+
+// AddressReqValidationError is the validation error returned by
+// AddressReq.Validate if the designated constraints aren't met.
+type AddressReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressReqValidationError) ErrorName() string { return "AddressReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressReqValidationError{}
+
+var _AddressReq_ProvinceCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+var _AddressReq_CityCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+var _AddressReq_DistrictCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+var _AddressReq_Mobile_Pattern = regexp.MustCompile("^1[3-9][0-9]{9}$")
+
+// Validate checks the field values on AddressIdReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressIdReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressIdReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressIdReqMultiError, or
+// nil if none found.
+func (m *AddressIdReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressIdReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := AddressIdReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddressIdReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressIdReqMultiError is an error wrapping multiple validation errors
+// returned by AddressIdReq.ValidateAll() if the designated constraints aren't met.
+type AddressIdReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressIdReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressIdReqMultiError) AllErrors() []error { return m }
+
+// AddressIdReqValidationError is the validation error returned by
+// AddressIdReq.Validate if the designated constraints aren't met.
+type AddressIdReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressIdReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressIdReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressIdReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressIdReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressIdReqValidationError) ErrorName() string { return "AddressIdReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressIdReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressIdReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressIdReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressIdReqValidationError{}
+
+// Validate checks the field values on CategoryItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CategoryItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CategoryItemMultiError, or
+// nil if none found.
+func (m *CategoryItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ParentId
+
+	// no validation rules for Level
+
+	// no validation rules for IsTab
+
+	// no validation rules for Sort
+
+	for idx, item := range m.GetSubCategory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryItemValidationError{
+						field:  fmt.Sprintf("SubCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryItemValidationError{
+						field:  fmt.Sprintf("SubCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryItemValidationError{
+					field:  fmt.Sprintf("SubCategory[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CategoryItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryItemMultiError is an error wrapping multiple validation errors
+// returned by CategoryItem.ValidateAll() if the designated constraints aren't met.
+type CategoryItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryItemMultiError) AllErrors() []error { return m }
+
+// CategoryItemValidationError is the validation error returned by
+// CategoryItem.Validate if the designated constraints aren't met.
+type CategoryItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryItemValidationError) ErrorName() string { return "CategoryItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CategoryItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryItemValidationError{}
+
+// Validate checks the field values on CategoryTreeReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CategoryTreeReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryTreeReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryTreeReplyMultiError, or nil if none found.
+func (m *CategoryTreeReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryTreeReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CategoryTreeReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CategoryTreeReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CategoryTreeReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CategoryTreeReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryTreeReplyMultiError is an error wrapping multiple validation errors
+// returned by CategoryTreeReply.ValidateAll() if the designated constraints
+// aren't met.
+type CategoryTreeReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryTreeReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryTreeReplyMultiError) AllErrors() []error { return m }
+
+// CategoryTreeReplyValidationError is the validation error returned by
+// CategoryTreeReply.Validate if the designated constraints aren't met.
+type CategoryTreeReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryTreeReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryTreeReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryTreeReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryTreeReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryTreeReplyValidationError) ErrorName() string {
+	return "CategoryTreeReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryTreeReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryTreeReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryTreeReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryTreeReplyValidationError{}
+
+// Validate checks the field values on SubCategoryReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SubCategoryReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubCategoryReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubCategoryReqMultiError,
+// or nil if none found.
+func (m *SubCategoryReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubCategoryReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := SubCategoryReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubCategoryReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubCategoryReqMultiError is an error wrapping multiple validation errors
+// returned by SubCategoryReq.ValidateAll() if the designated constraints
+// aren't met.
+type SubCategoryReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubCategoryReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubCategoryReqMultiError) AllErrors() []error { return m }
+
+// SubCategoryReqValidationError is the validation error returned by
+// SubCategoryReq.Validate if the designated constraints aren't met.
+type SubCategoryReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubCategoryReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubCategoryReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubCategoryReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubCategoryReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubCategoryReqValidationError) ErrorName() string { return "SubCategoryReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubCategoryReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubCategoryReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubCategoryReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubCategoryReqValidationError{}
+
+// Validate checks the field values on SubCategoryReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubCategoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubCategoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubCategoryReplyMultiError, or nil if none found.
+func (m *SubCategoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubCategoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubCategoryReplyValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubCategoryReplyValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubCategoryReplyValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSubCategory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubCategoryReplyValidationError{
+						field:  fmt.Sprintf("SubCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubCategoryReplyValidationError{
+						field:  fmt.Sprintf("SubCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubCategoryReplyValidationError{
+					field:  fmt.Sprintf("SubCategory[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubCategoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubCategoryReplyMultiError is an error wrapping multiple validation errors
+// returned by SubCategoryReply.ValidateAll() if the designated constraints
+// aren't met.
+type SubCategoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubCategoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubCategoryReplyMultiError) AllErrors() []error { return m }
+
+// SubCategoryReplyValidationError is the validation error returned by
+// SubCategoryReply.Validate if the designated constraints aren't met.
+type SubCategoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubCategoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubCategoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubCategoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubCategoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubCategoryReplyValidationError) ErrorName() string { return "SubCategoryReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubCategoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubCategoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubCategoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubCategoryReplyValidationError{}
+
+// Validate checks the field values on BrandListReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BrandListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrandListReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BrandListReqMultiError, or
+// nil if none found.
+func (m *BrandListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrandListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPages() < 0 {
+		err := BrandListReqValidationError{
+			field:  "Pages",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPagePerNums(); val < 0 || val > 100 {
+		err := BrandListReqValidationError{
+			field:  "PagePerNums",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BrandListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrandListReqMultiError is an error wrapping multiple validation errors
+// returned by BrandListReq.ValidateAll() if the designated constraints aren't met.
+type BrandListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrandListReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrandListReqMultiError) AllErrors() []error { return m }
+
+// BrandListReqValidationError is the validation error returned by
+// BrandListReq.Validate if the designated constraints aren't met.
+type BrandListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandListReqValidationError) ErrorName() string { return "BrandListReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrandListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrandListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandListReqValidationError{}
+
+// Validate checks the field values on BrandItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BrandItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrandItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BrandItemMultiError, or nil
+// if none found.
+func (m *BrandItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrandItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Logo
+
+	// no validation rules for Desc
+
+	if len(errors) > 0 {
+		return BrandItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrandItemMultiError is an error wrapping multiple validation errors returned
+// by BrandItem.ValidateAll() if the designated constraints aren't met.
+type BrandItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrandItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrandItemMultiError) AllErrors() []error { return m }
+
+// BrandItemValidationError is the validation error returned by
+// BrandItem.Validate if the designated constraints aren't met.
+type BrandItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandItemValidationError) ErrorName() string { return "BrandItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrandItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrandItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandItemValidationError{}
+
+// Validate checks the field values on BrandListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BrandListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrandListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BrandListReplyMultiError,
+// or nil if none found.
+func (m *BrandListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrandListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BrandListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BrandListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BrandListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BrandListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrandListReplyMultiError is an error wrapping multiple validation errors
+// returned by BrandListReply.ValidateAll() if the designated constraints
+// aren't met.
+type BrandListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrandListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrandListReplyMultiError) AllErrors() []error { return m }
+
+// BrandListReplyValidationError is the validation error returned by
+// BrandListReply.Validate if the designated constraints aren't met.
+type BrandListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandListReplyValidationError) ErrorName() string { return "BrandListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrandListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrandListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandListReplyValidationError{}
+
+// Validate checks the field values on GoodsSearchReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GoodsSearchReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsSearchReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GoodsSearchReqMultiError,
+// or nil if none found.
+func (m *GoodsSearchReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsSearchReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetKeywords()) > 50 {
+		err := GoodsSearchReqValidationError{
+			field:  "Keywords",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CategoryId
+
+	// no validation rules for BrandId
+
+	if m.GetMinPrice() < 0 {
+		err := GoodsSearchReqValidationError{
+			field:  "MinPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxPrice() < 0 {
+		err := GoodsSearchReqValidationError{
+			field:  "MaxPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsHot
+
+	// no validation rules for IsNew
+
+	if m.GetPages() < 0 {
+		err := GoodsSearchReqValidationError{
+			field:  "Pages",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPagePerNums(); val < 0 || val > 100 {
+		err := GoodsSearchReqValidationError{
+			field:  "PagePerNums",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsSearchReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsSearchReqMultiError is an error wrapping multiple validation errors
+// returned by GoodsSearchReq.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsSearchReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsSearchReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsSearchReqMultiError) AllErrors() []error { return m }
+
+// GoodsSearchReqValidationError is the validation error returned by
+// GoodsSearchReq.Validate if the designated constraints aren't met.
+type GoodsSearchReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsSearchReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsSearchReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsSearchReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsSearchReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsSearchReqValidationError) ErrorName() string { return "GoodsSearchReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoodsSearchReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsSearchReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsSearchReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsSearchReqValidationError{}
+
+// Validate checks the field values on GoodsItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GoodsItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GoodsItemMultiError, or nil
+// if none found.
+func (m *GoodsItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CategoryId
+
+	// no validation rules for BrandId
+
+	// no validation rules for Name
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for ClickNum
+
+	// no validation rules for SoldNum
+
+	// no validation rules for FavNum
+
+	// no validation rules for MarketPrice
+
+	// no validation rules for GoodsBrief
+
+	// no validation rules for ShipFree
+
+	// no validation rules for Image
+
+	// no validation rules for IsNew
+
+	// no validation rules for IsHot
+
+	// no validation rules for OnSale
+
+	if len(errors) > 0 {
+		return GoodsItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsItemMultiError is an error wrapping multiple validation errors returned
+// by GoodsItem.ValidateAll() if the designated constraints aren't met.
+type GoodsItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsItemMultiError) AllErrors() []error { return m }
+
+// GoodsItemValidationError is the validation error returned by
+// GoodsItem.Validate if the designated constraints aren't met.
+type GoodsItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsItemValidationError) ErrorName() string { return "GoodsItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoodsItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsItemValidationError{}
+
+// Validate checks the field values on GoodsListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GoodsListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GoodsListReplyMultiError,
+// or nil if none found.
+func (m *GoodsListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsListReplyMultiError is an error wrapping multiple validation errors
+// returned by GoodsListReply.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsListReplyMultiError) AllErrors() []error { return m }
+
+// GoodsListReplyValidationError is the validation error returned by
+// GoodsListReply.Validate if the designated constraints aren't met.
+type GoodsListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsListReplyValidationError) ErrorName() string { return "GoodsListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoodsListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsListReplyValidationError{}
+
+// Validate checks the field values on GoodsDetailReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GoodsDetailReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsDetailReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GoodsDetailReqMultiError,
+// or nil if none found.
+func (m *GoodsDetailReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsDetailReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GoodsDetailReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsDetailReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsDetailReqMultiError is an error wrapping multiple validation errors
+// returned by GoodsDetailReq.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsDetailReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsDetailReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsDetailReqMultiError) AllErrors() []error { return m }
+
+// GoodsDetailReqValidationError is the validation error returned by
+// GoodsDetailReq.Validate if the designated constraints aren't met.
+type GoodsDetailReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsDetailReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsDetailReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsDetailReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsDetailReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsDetailReqValidationError) ErrorName() string { return "GoodsDetailReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoodsDetailReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsDetailReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsDetailReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsDetailReqValidationError{}
+
+// Validate checks the field values on SkuItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SkuItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SkuItemMultiError, or nil if none found.
+func (m *SkuItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GoodsId
+
+	// no validation rules for SkuName
+
+	// no validation rules for SkuCode
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for Points
+
+	// no validation rules for Pic
+
+	// no validation rules for Stock
+
+	// no validation rules for OnSale
+
+	// no validation rules for PurchaseLimit
+
+	if len(errors) > 0 {
+		return SkuItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuItemMultiError is an error wrapping multiple validation errors returned
+// by SkuItem.ValidateAll() if the designated constraints aren't met.
+type SkuItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuItemMultiError) AllErrors() []error { return m }
+
+// SkuItemValidationError is the validation error returned by SkuItem.Validate
+// if the designated constraints aren't met.
+type SkuItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuItemValidationError) ErrorName() string { return "SkuItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuItemValidationError{}
+
+// Validate checks the field values on SkuListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SkuListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SkuListReplyMultiError, or
+// nil if none found.
+func (m *SkuListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SkuListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SkuListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SkuListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SkuListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuListReplyMultiError is an error wrapping multiple validation errors
+// returned by SkuListReply.ValidateAll() if the designated constraints aren't met.
+type SkuListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuListReplyMultiError) AllErrors() []error { return m }
+
+// SkuListReplyValidationError is the validation error returned by
+// SkuListReply.Validate if the designated constraints aren't met.
+type SkuListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuListReplyValidationError) ErrorName() string { return "SkuListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuListReplyValidationError{}
+
+// Validate checks the field values on AdminIdReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminIdReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminIdReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminIdReqMultiError, or
+// nil if none found.
+func (m *AdminIdReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminIdReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := AdminIdReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminIdReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminIdReqMultiError is an error wrapping multiple validation errors
+// returned by AdminIdReq.ValidateAll() if the designated constraints aren't met.
+type AdminIdReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminIdReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminIdReqMultiError) AllErrors() []error { return m }
+
+// AdminIdReqValidationError is the validation error returned by
+// AdminIdReq.Validate if the designated constraints aren't met.
+type AdminIdReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminIdReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminIdReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminIdReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminIdReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminIdReqValidationError) ErrorName() string { return "AdminIdReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminIdReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminIdReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminIdReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminIdReqValidationError{}
+
+// Validate checks the field values on AdminIdReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminIdReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminIdReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminIdReplyMultiError, or
+// nil if none found.
+func (m *AdminIdReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminIdReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AdminIdReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminIdReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminIdReply.ValidateAll() if the designated constraints aren't met.
+type AdminIdReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminIdReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminIdReplyMultiError) AllErrors() []error { return m }
+
+// AdminIdReplyValidationError is the validation error returned by
+// AdminIdReply.Validate if the designated constraints aren't met.
+type AdminIdReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminIdReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminIdReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminIdReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminIdReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminIdReplyValidationError) ErrorName() string { return "AdminIdReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminIdReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminIdReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminIdReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminIdReplyValidationError{}
+
+// Validate checks the field values on AdminCategoryReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminCategoryReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminCategoryReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminCategoryReqMultiError, or nil if none found.
+func (m *AdminCategoryReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminCategoryReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := AdminCategoryReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetParentId() < 0 {
+		err := AdminCategoryReqValidationError{
+			field:  "ParentId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdminCategoryReq_Level_InLookup[m.GetLevel()]; !ok {
+		err := AdminCategoryReqValidationError{
+			field:  "Level",
+			reason: "value must be in list [1 2 3]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsTab
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return AdminCategoryReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminCategoryReqMultiError is an error wrapping multiple validation errors
+// returned by AdminCategoryReq.ValidateAll() if the designated constraints
+// aren't met.
+type AdminCategoryReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminCategoryReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminCategoryReqMultiError) AllErrors() []error { return m }
+
+// AdminCategoryReqValidationError is the validation error returned by
+// AdminCategoryReq.Validate if the designated constraints aren't met.
+type AdminCategoryReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminCategoryReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminCategoryReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminCategoryReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminCategoryReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminCategoryReqValidationError) ErrorName() string { return "AdminCategoryReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminCategoryReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminCategoryReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminCategoryReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminCategoryReqValidationError{}
+
+var _AdminCategoryReq_Level_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on AdminBrandReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminBrandReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminBrandReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminBrandReqMultiError, or
+// nil if none found.
+func (m *AdminBrandReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminBrandReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := AdminBrandReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Logo
+
+	// no validation rules for Desc
+
+	// no validation rules for IsTab
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return AdminBrandReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminBrandReqMultiError is an error wrapping multiple validation errors
+// returned by AdminBrandReq.ValidateAll() if the designated constraints
+// aren't met.
+type AdminBrandReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminBrandReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminBrandReqMultiError) AllErrors() []error { return m }
+
+// AdminBrandReqValidationError is the validation error returned by
+// AdminBrandReq.Validate if the designated constraints aren't met.
+type AdminBrandReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminBrandReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminBrandReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminBrandReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminBrandReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminBrandReqValidationError) ErrorName() string { return "AdminBrandReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminBrandReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminBrandReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminBrandReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminBrandReqValidationError{}
+
+// Validate checks the field values on AdminGoodsReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminGoodsReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminGoodsReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminGoodsReqMultiError, or
+// nil if none found.
+func (m *AdminGoodsReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminGoodsReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCategoryId() <= 0 {
+		err := AdminGoodsReqValidationError{
+			field:  "CategoryId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBrandId() <= 0 {
+		err := AdminGoodsReqValidationError{
+			field:  "BrandId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTypeId() <= 0 {
+		err := AdminGoodsReqValidationError{
+			field:  "TypeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := AdminGoodsReqValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for NameAlias
+
+	// no validation rules for GoodsTags
+
+	if utf8.RuneCountInString(m.GetGoodsSn()) < 1 {
+		err := AdminGoodsReqValidationError{
+			field:  "GoodsSn",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetShopPrice() < 0 {
+		err := AdminGoodsReqValidationError{
+			field:  "ShopPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMarketPrice() < 0 {
+		err := AdminGoodsReqValidationError{
+			field:  "MarketPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetInventory() < 0 {
+		err := AdminGoodsReqValidationError{
+			field:  "Inventory",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for GoodsBrief
+
+	// no validation rules for Image
+
+	// no validation rules for ShipFree
+
+	// no validation rules for ShipId
+
+	// no validation rules for IsNew
+
+	// no validation rules for IsHot
+
+	// no validation rules for OnSale
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminGoodsReqValidationError{
+						field:  fmt.Sprintf("Skus[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminGoodsReqValidationError{
+						field:  fmt.Sprintf("Skus[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminGoodsReqValidationError{
+					field:  fmt.Sprintf("Skus[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminGoodsReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminGoodsReqMultiError is an error wrapping multiple validation errors
+// returned by AdminGoodsReq.ValidateAll() if the designated constraints
+// aren't met.
+type AdminGoodsReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminGoodsReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminGoodsReqMultiError) AllErrors() []error { return m }
+
+// AdminGoodsReqValidationError is the validation error returned by
+// AdminGoodsReq.Validate if the designated constraints aren't met.
+type AdminGoodsReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminGoodsReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminGoodsReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminGoodsReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminGoodsReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminGoodsReqValidationError) ErrorName() string { return "AdminGoodsReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminGoodsReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminGoodsReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminGoodsReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminGoodsReqValidationError{}
+
+// Validate checks the field values on AdminSku with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminSku) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSku with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminSkuMultiError, or nil
+// if none found.
+func (m *AdminSku) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSku) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetSkuName()) < 1 {
+		err := AdminSkuValidationError{
+			field:  "SkuName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := AdminSkuValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBarCode()) < 1 {
+		err := AdminSkuValidationError{
+			field:  "BarCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() < 0 {
+		err := AdminSkuValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPromotionPrice() < 0 {
+		err := AdminSkuValidationError{
+			field:  "PromotionPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Points
+
+	// no validation rules for Image
+
+	// no validation rules for Sort
+
+	if m.GetInventory() < 0 {
+		err := AdminSkuValidationError{
+			field:  "Inventory",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPurchaseLimit() < 0 {
+		err := AdminSkuValidationError{
+			field:  "PurchaseLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSpecs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSkuValidationError{
+						field:  fmt.Sprintf("Specs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSkuValidationError{
+						field:  fmt.Sprintf("Specs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSkuValidationError{
+					field:  fmt.Sprintf("Specs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAttrGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSkuValidationError{
+						field:  fmt.Sprintf("AttrGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSkuValidationError{
+						field:  fmt.Sprintf("AttrGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSkuValidationError{
+					field:  fmt.Sprintf("AttrGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminSkuMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSkuMultiError is an error wrapping multiple validation errors returned
+// by AdminSku.ValidateAll() if the designated constraints aren't met.
+type AdminSkuMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSkuMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSkuMultiError) AllErrors() []error { return m }
+
+// AdminSkuValidationError is the validation error returned by
+// AdminSku.Validate if the designated constraints aren't met.
+type AdminSkuValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSkuValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSkuValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSkuValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSkuValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSkuValidationError) ErrorName() string { return "AdminSkuValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminSkuValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSku.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSkuValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSkuValidationError{}
+
+// Validate checks the field values on AdminSkuPriceReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminSkuPriceReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSkuPriceReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSkuPriceReqMultiError, or nil if none found.
+func (m *AdminSkuPriceReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSkuPriceReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() <= 0 {
+		err := AdminSkuPriceReqValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := AdminSkuPriceReqValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPromotionPrice() < 0 {
+		err := AdminSkuPriceReqValidationError{
+			field:  "PromotionPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for EffectiveUntil
+
+	// no validation rules for Remark
+
+	if len(errors) > 0 {
+		return AdminSkuPriceReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSkuPriceReqMultiError is an error wrapping multiple validation errors
+// returned by AdminSkuPriceReq.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSkuPriceReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSkuPriceReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSkuPriceReqMultiError) AllErrors() []error { return m }
+
+// AdminSkuPriceReqValidationError is the validation error returned by
+// AdminSkuPriceReq.Validate if the designated constraints aren't met.
+type AdminSkuPriceReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSkuPriceReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSkuPriceReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSkuPriceReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSkuPriceReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSkuPriceReqValidationError) ErrorName() string { return "AdminSkuPriceReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminSkuPriceReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSkuPriceReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSkuPriceReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSkuPriceReqValidationError{}
+
+// Validate checks the field values on AdminSkuPriceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSkuPriceReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSkuPriceReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSkuPriceReplyMultiError, or nil if none found.
+func (m *AdminSkuPriceReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSkuPriceReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SkuId
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for EffectiveUntil
+
+	// no validation rules for Status
+
+	// no validation rules for Remark
+
+	if len(errors) > 0 {
+		return AdminSkuPriceReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSkuPriceReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminSkuPriceReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSkuPriceReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSkuPriceReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSkuPriceReplyMultiError) AllErrors() []error { return m }
+
+// AdminSkuPriceReplyValidationError is the validation error returned by
+// AdminSkuPriceReply.Validate if the designated constraints aren't met.
+type AdminSkuPriceReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSkuPriceReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSkuPriceReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSkuPriceReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSkuPriceReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSkuPriceReplyValidationError) ErrorName() string {
+	return "AdminSkuPriceReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSkuPriceReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSkuPriceReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSkuPriceReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSkuPriceReplyValidationError{}
+
+// Validate checks the field values on AdminSkuLimitReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminSkuLimitReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSkuLimitReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSkuLimitReqMultiError, or nil if none found.
+func (m *AdminSkuLimitReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSkuLimitReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() <= 0 {
+		err := AdminSkuLimitReqValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPurchaseLimit() < 0 {
+		err := AdminSkuLimitReqValidationError{
+			field:  "PurchaseLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminSkuLimitReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSkuLimitReqMultiError is an error wrapping multiple validation errors
+// returned by AdminSkuLimitReq.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSkuLimitReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSkuLimitReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSkuLimitReqMultiError) AllErrors() []error { return m }
+
+// AdminSkuLimitReqValidationError is the validation error returned by
+// AdminSkuLimitReq.Validate if the designated constraints aren't met.
+type AdminSkuLimitReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSkuLimitReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSkuLimitReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSkuLimitReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSkuLimitReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSkuLimitReqValidationError) ErrorName() string { return "AdminSkuLimitReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminSkuLimitReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSkuLimitReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSkuLimitReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSkuLimitReqValidationError{}
+
+// Validate checks the field values on AdminUserListReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReqMultiError, or nil if none found.
+func (m *AdminUserListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pages
+
+	if m.GetPagePerNums() > 100 {
+		err := AdminUserListReqValidationError{
+			field:  "PagePerNums",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMobile()) > 11 {
+		err := AdminUserListReqValidationError{
+			field:  "Mobile",
+			reason: "value length must be at most 11 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNickName()) > 25 {
+		err := AdminUserListReqValidationError{
+			field:  "NickName",
+			reason: "value length must be at most 25 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdminUserListReq_Role_InLookup[m.GetRole()]; !ok {
+		err := AdminUserListReqValidationError{
+			field:  "Role",
+			reason: "value must be in list [0 1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGender() != "" {
+
+		if _, ok := _AdminUserListReq_Gender_InLookup[m.GetGender()]; !ok {
+			err := AdminUserListReqValidationError{
+				field:  "Gender",
+				reason: "value must be in list [male female]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetStartTime() < 0 {
+		err := AdminUserListReqValidationError{
+			field:  "StartTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() < 0 {
+		err := AdminUserListReqValidationError{
+			field:  "EndTime",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminUserListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReqMultiError is an error wrapping multiple validation errors
+// returned by AdminUserListReq.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReqMultiError) AllErrors() []error { return m }
+
+// AdminUserListReqValidationError is the validation error returned by
+// AdminUserListReq.Validate if the designated constraints aren't met.
+type AdminUserListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReqValidationError) ErrorName() string { return "AdminUserListReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReqValidationError{}
+
+var _AdminUserListReq_Role_InLookup = map[int32]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+var _AdminUserListReq_Gender_InLookup = map[string]struct{}{
+	"male":   {},
+	"female": {},
+}
+
+// Validate checks the field values on AdminUserDisabledReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserDisabledReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserDisabledReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserDisabledReqMultiError, or nil if none found.
+func (m *AdminUserDisabledReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserDisabledReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := AdminUserDisabledReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Disabled
+
+	if len(errors) > 0 {
+		return AdminUserDisabledReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserDisabledReqMultiError is an error wrapping multiple validation
+// errors returned by AdminUserDisabledReq.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUserDisabledReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserDisabledReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserDisabledReqMultiError) AllErrors() []error { return m }
+
+// AdminUserDisabledReqValidationError is the validation error returned by
+// AdminUserDisabledReq.Validate if the designated constraints aren't met.
+type AdminUserDisabledReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserDisabledReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserDisabledReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserDisabledReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserDisabledReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserDisabledReqValidationError) ErrorName() string {
+	return "AdminUserDisabledReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserDisabledReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserDisabledReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserDisabledReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserDisabledReqValidationError{}
+
+// Validate checks the field values on AdminUserRoleReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserRoleReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserRoleReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserRoleReqMultiError, or nil if none found.
+func (m *AdminUserRoleReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserRoleReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := AdminUserRoleReqValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdminUserRoleReq_Role_InLookup[m.GetRole()]; !ok {
+		err := AdminUserRoleReqValidationError{
+			field:  "Role",
+			reason: "value must be in list [1 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminUserRoleReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserRoleReqMultiError is an error wrapping multiple validation errors
+// returned by AdminUserRoleReq.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserRoleReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserRoleReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserRoleReqMultiError) AllErrors() []error { return m }
+
+// AdminUserRoleReqValidationError is the validation error returned by
+// AdminUserRoleReq.Validate if the designated constraints aren't met.
+type AdminUserRoleReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserRoleReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserRoleReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserRoleReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserRoleReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserRoleReqValidationError) ErrorName() string { return "AdminUserRoleReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminUserRoleReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserRoleReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserRoleReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserRoleReqValidationError{}
+
+var _AdminUserRoleReq_Role_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUserListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUserListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUserListReplyMultiError, or nil if none found.
+func (m *AdminUserListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUserListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminUserListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminUserListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminUserListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUserListReplyMultiError is an error wrapping multiple validation errors
+// returned by AdminUserListReply.ValidateAll() if the designated constraints
+// aren't met.
+type AdminUserListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUserListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUserListReplyMultiError) AllErrors() []error { return m }
+
+// AdminUserListReplyValidationError is the validation error returned by
+// AdminUserListReply.Validate if the designated constraints aren't met.
+type AdminUserListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUserListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUserListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUserListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUserListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUserListReplyValidationError) ErrorName() string {
+	return "AdminUserListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUserListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUserListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUserListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUserListReplyValidationError{}
+
+// Validate checks the field values on AdminUnlockLoginReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminUnlockLoginReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminUnlockLoginReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminUnlockLoginReqMultiError, or nil if none found.
+func (m *AdminUnlockLoginReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminUnlockLoginReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMobile() != "" {
+
+		if utf8.RuneCountInString(m.GetMobile()) != 11 {
+			err := AdminUnlockLoginReqValidationError{
+				field:  "Mobile",
+				reason: "value length must be 11 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+
+		}
+
+	}
+
+	if m.GetIp() != "" {
+
+		if utf8.RuneCountInString(m.GetIp()) > 64 {
+			err := AdminUnlockLoginReqValidationError{
+				field:  "Ip",
+				reason: "value length must be at most 64 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminUnlockLoginReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminUnlockLoginReqMultiError is an error wrapping multiple validation
+// errors returned by AdminUnlockLoginReq.ValidateAll() if the designated
+// constraints aren't met.
+type AdminUnlockLoginReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminUnlockLoginReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminUnlockLoginReqMultiError) AllErrors() []error { return m }
+
+// AdminUnlockLoginReqValidationError is the validation error returned by
+// AdminUnlockLoginReq.Validate if the designated constraints aren't met.
+type AdminUnlockLoginReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminUnlockLoginReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminUnlockLoginReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminUnlockLoginReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminUnlockLoginReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminUnlockLoginReqValidationError) ErrorName() string {
+	return "AdminUnlockLoginReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminUnlockLoginReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminUnlockLoginReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminUnlockLoginReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminUnlockLoginReqValidationError{}
+
+// Validate checks the field values on AdminLockEventListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLockEventListReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLockEventListReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLockEventListReqMultiError, or nil if none found.
+func (m *AdminLockEventListReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLockEventListReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mobile
+
+	// no validation rules for Ip
+
+	// no validation rules for Pages
+
+	if m.GetPagePerNums() > 100 {
+		err := AdminLockEventListReqValidationError{
+			field:  "PagePerNums",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminLockEventListReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLockEventListReqMultiError is an error wrapping multiple validation
+// errors returned by AdminLockEventListReq.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLockEventListReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLockEventListReqMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLockEventListReqMultiError) AllErrors() []error { return m }
+
+// AdminLockEventListReqValidationError is the validation error returned by
+// AdminLockEventListReq.Validate if the designated constraints aren't met.
+type AdminLockEventListReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLockEventListReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLockEventListReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLockEventListReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLockEventListReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLockEventListReqValidationError) ErrorName() string {
+	return "AdminLockEventListReqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLockEventListReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLockEventListReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLockEventListReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLockEventListReqValidationError{}
+
+// Validate checks the field values on LockEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LockEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LockEventMultiError, or nil
+// if none found.
+func (m *LockEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Mobile
+
+	// no validation rules for Ip
+
+	// no validation rules for Action
+
+	// no validation rules for Scope
+
+	// no validation rules for Reason
+
+	// no validation rules for Failures
+
+	// no validation rules for Until
+
+	// no validation rules for Operator
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return LockEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockEventMultiError is an error wrapping multiple validation errors returned
+// by LockEvent.ValidateAll() if the designated constraints aren't met.
+type LockEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockEventMultiError) AllErrors() []error { return m }
+
+// LockEventValidationError is the validation error returned by
+// LockEvent.Validate if the designated constraints aren't met.
+type LockEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockEventValidationError) ErrorName() string { return "LockEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LockEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockEventValidationError{}
+
+// Validate checks the field values on AdminLockEventListReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminLockEventListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminLockEventListReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminLockEventListReplyMultiError, or nil if none found.
+func (m *AdminLockEventListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminLockEventListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminLockEventListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminLockEventListReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminLockEventListReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminLockEventListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminLockEventListReplyMultiError is an error wrapping multiple validation
+// errors returned by AdminLockEventListReply.ValidateAll() if the designated
+// constraints aren't met.
+type AdminLockEventListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminLockEventListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminLockEventListReplyMultiError) AllErrors() []error { return m }
+
+// AdminLockEventListReplyValidationError is the validation error returned by
+// AdminLockEventListReply.Validate if the designated constraints aren't met.
+type AdminLockEventListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminLockEventListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminLockEventListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminLockEventListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminLockEventListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminLockEventListReplyValidationError) ErrorName() string {
+	return "AdminLockEventListReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminLockEventListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminLockEventListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminLockEventListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminLockEventListReplyValidationError{}
+
+// Validate checks the field values on AdminSku_Spec with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminSku_Spec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSku_Spec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminSku_SpecMultiError, or
+// nil if none found.
+func (m *AdminSku_Spec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSku_Spec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSpecId() <= 0 {
+		err := AdminSku_SpecValidationError{
+			field:  "SpecId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValueId() <= 0 {
+		err := AdminSku_SpecValidationError{
+			field:  "ValueId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminSku_SpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSku_SpecMultiError is an error wrapping multiple validation errors
+// returned by AdminSku_Spec.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSku_SpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSku_SpecMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSku_SpecMultiError) AllErrors() []error { return m }
+
+// AdminSku_SpecValidationError is the validation error returned by
+// AdminSku_Spec.Validate if the designated constraints aren't met.
+type AdminSku_SpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSku_SpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSku_SpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSku_SpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSku_SpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSku_SpecValidationError) ErrorName() string { return "AdminSku_SpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminSku_SpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSku_Spec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSku_SpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSku_SpecValidationError{}
+
+// Validate checks the field values on AdminSku_Attr with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminSku_Attr) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSku_Attr with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminSku_AttrMultiError, or
+// nil if none found.
+func (m *AdminSku_Attr) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSku_Attr) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAttrId() <= 0 {
+		err := AdminSku_AttrValidationError{
+			field:  "AttrId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAttrName()) < 1 {
+		err := AdminSku_AttrValidationError{
+			field:  "AttrName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetValueId() <= 0 {
+		err := AdminSku_AttrValidationError{
+			field:  "ValueId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetValueName()) < 1 {
+		err := AdminSku_AttrValidationError{
+			field:  "ValueName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdminSku_AttrMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSku_AttrMultiError is an error wrapping multiple validation errors
+// returned by AdminSku_Attr.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSku_AttrMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSku_AttrMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSku_AttrMultiError) AllErrors() []error { return m }
+
+// AdminSku_AttrValidationError is the validation error returned by
+// AdminSku_Attr.Validate if the designated constraints aren't met.
+type AdminSku_AttrValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSku_AttrValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSku_AttrValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSku_AttrValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSku_AttrValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSku_AttrValidationError) ErrorName() string { return "AdminSku_AttrValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminSku_AttrValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSku_Attr.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSku_AttrValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSku_AttrValidationError{}
+
+// Validate checks the field values on AdminSku_AttrGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdminSku_AttrGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminSku_AttrGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdminSku_AttrGroupMultiError, or nil if none found.
+func (m *AdminSku_AttrGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminSku_AttrGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for GroupName
+
+	for idx, item := range m.GetAttrs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AdminSku_AttrGroupValidationError{
+						field:  fmt.Sprintf("Attrs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AdminSku_AttrGroupValidationError{
+						field:  fmt.Sprintf("Attrs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AdminSku_AttrGroupValidationError{
+					field:  fmt.Sprintf("Attrs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AdminSku_AttrGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminSku_AttrGroupMultiError is an error wrapping multiple validation errors
+// returned by AdminSku_AttrGroup.ValidateAll() if the designated constraints
+// aren't met.
+type AdminSku_AttrGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminSku_AttrGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminSku_AttrGroupMultiError) AllErrors() []error { return m }
+
+// AdminSku_AttrGroupValidationError is the validation error returned by
+// AdminSku_AttrGroup.Validate if the designated constraints aren't met.
+type AdminSku_AttrGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminSku_AttrGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminSku_AttrGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminSku_AttrGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminSku_AttrGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminSku_AttrGroupValidationError) ErrorName() string {
+	return "AdminSku_AttrGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdminSku_AttrGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminSku_AttrGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminSku_AttrGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminSku_AttrGroupValidationError{}
@@ -20,10 +20,56 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Lushop_Register_FullMethodName = "/lushop.lushop.v1.Lushop/Register"
-	Lushop_Login_FullMethodName    = "/lushop.lushop.v1.Lushop/Login"
-	Lushop_Captcha_FullMethodName  = "/lushop.lushop.v1.Lushop/Captcha"
-	Lushop_Detail_FullMethodName   = "/lushop.lushop.v1.Lushop/Detail"
+	Lushop_Register_FullMethodName              = "/lushop.lushop.v1.Lushop/Register"
+	Lushop_Login_FullMethodName                 = "/lushop.lushop.v1.Lushop/Login"
+	Lushop_SmsLogin_FullMethodName              = "/lushop.lushop.v1.Lushop/SmsLogin"
+	Lushop_SendSmsCode_FullMethodName           = "/lushop.lushop.v1.Lushop/SendSmsCode"
+	Lushop_VerifySmsCode_FullMethodName         = "/lushop.lushop.v1.Lushop/VerifySmsCode"
+	Lushop_ChangePassword_FullMethodName        = "/lushop.lushop.v1.Lushop/ChangePassword"
+	Lushop_ResetPassword_FullMethodName         = "/lushop.lushop.v1.Lushop/ResetPassword"
+	Lushop_Refresh_FullMethodName               = "/lushop.lushop.v1.Lushop/Refresh"
+	Lushop_Logout_FullMethodName                = "/lushop.lushop.v1.Lushop/Logout"
+	Lushop_Captcha_FullMethodName               = "/lushop.lushop.v1.Lushop/Captcha"
+	Lushop_Detail_FullMethodName                = "/lushop.lushop.v1.Lushop/Detail"
+	Lushop_DeleteAccount_FullMethodName         = "/lushop.lushop.v1.Lushop/DeleteAccount"
+	Lushop_ExportData_FullMethodName            = "/lushop.lushop.v1.Lushop/ExportData"
+	Lushop_UpdateProfile_FullMethodName         = "/lushop.lushop.v1.Lushop/UpdateProfile"
+	Lushop_CategoryTree_FullMethodName          = "/lushop.lushop.v1.Lushop/CategoryTree"
+	Lushop_SubCategory_FullMethodName           = "/lushop.lushop.v1.Lushop/SubCategory"
+	Lushop_BrandList_FullMethodName             = "/lushop.lushop.v1.Lushop/BrandList"
+	Lushop_GoodsSearch_FullMethodName           = "/lushop.lushop.v1.Lushop/GoodsSearch"
+	Lushop_GoodsDetail_FullMethodName           = "/lushop.lushop.v1.Lushop/GoodsDetail"
+	Lushop_GoodsSkuList_FullMethodName          = "/lushop.lushop.v1.Lushop/GoodsSkuList"
+	Lushop_AdminCreateCategory_FullMethodName   = "/lushop.lushop.v1.Lushop/AdminCreateCategory"
+	Lushop_AdminUpdateCategory_FullMethodName   = "/lushop.lushop.v1.Lushop/AdminUpdateCategory"
+	Lushop_AdminDeleteCategory_FullMethodName   = "/lushop.lushop.v1.Lushop/AdminDeleteCategory"
+	Lushop_AdminCreateBrand_FullMethodName      = "/lushop.lushop.v1.Lushop/AdminCreateBrand"
+	Lushop_AdminUpdateBrand_FullMethodName      = "/lushop.lushop.v1.Lushop/AdminUpdateBrand"
+	Lushop_AdminDeleteBrand_FullMethodName      = "/lushop.lushop.v1.Lushop/AdminDeleteBrand"
+	Lushop_AdminCreateGoods_FullMethodName      = "/lushop.lushop.v1.Lushop/AdminCreateGoods"
+	Lushop_AdminChangeSkuPrice_FullMethodName   = "/lushop.lushop.v1.Lushop/AdminChangeSkuPrice"
+	Lushop_AdminSkuPurchaseLimit_FullMethodName = "/lushop.lushop.v1.Lushop/AdminSkuPurchaseLimit"
+	Lushop_AdminUserList_FullMethodName         = "/lushop.lushop.v1.Lushop/AdminUserList"
+	Lushop_AdminUserDetail_FullMethodName       = "/lushop.lushop.v1.Lushop/AdminUserDetail"
+	Lushop_AdminSetUserDisabled_FullMethodName  = "/lushop.lushop.v1.Lushop/AdminSetUserDisabled"
+	Lushop_AdminChangeUserRole_FullMethodName   = "/lushop.lushop.v1.Lushop/AdminChangeUserRole"
+	Lushop_AdminUnlockLogin_FullMethodName      = "/lushop.lushop.v1.Lushop/AdminUnlockLogin"
+	Lushop_AdminLockEventList_FullMethodName    = "/lushop.lushop.v1.Lushop/AdminLockEventList"
+	Lushop_ListAddress_FullMethodName           = "/lushop.lushop.v1.Lushop/ListAddress"
+	Lushop_CreateAddress_FullMethodName         = "/lushop.lushop.v1.Lushop/CreateAddress"
+	Lushop_UpdateAddress_FullMethodName         = "/lushop.lushop.v1.Lushop/UpdateAddress"
+	Lushop_DeleteAddress_FullMethodName         = "/lushop.lushop.v1.Lushop/DeleteAddress"
+	Lushop_SetDefaultAddress_FullMethodName     = "/lushop.lushop.v1.Lushop/SetDefaultAddress"
+	Lushop_ListCart_FullMethodName              = "/lushop.lushop.v1.Lushop/ListCart"
+	Lushop_CreateCart_FullMethodName            = "/lushop.lushop.v1.Lushop/CreateCart"
+	Lushop_UpdateCart_FullMethodName            = "/lushop.lushop.v1.Lushop/UpdateCart"
+	Lushop_DeleteCart_FullMethodName            = "/lushop.lushop.v1.Lushop/DeleteCart"
+	Lushop_SelectCart_FullMethodName            = "/lushop.lushop.v1.Lushop/SelectCart"
+	Lushop_GuestToken_FullMethodName            = "/lushop.lushop.v1.Lushop/GuestToken"
+	Lushop_ListGuestCart_FullMethodName         = "/lushop.lushop.v1.Lushop/ListGuestCart"
+	Lushop_CreateGuestCart_FullMethodName       = "/lushop.lushop.v1.Lushop/CreateGuestCart"
+	Lushop_UpdateGuestCart_FullMethodName       = "/lushop.lushop.v1.Lushop/UpdateGuestCart"
+	Lushop_DeleteGuestCart_FullMethodName       = "/lushop.lushop.v1.Lushop/DeleteGuestCart"
 )
 
 // LushopClient is the client API for Lushop service.
@@ -34,8 +80,71 @@ const (
 type LushopClient interface {
 	Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterReply, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*RegisterReply, error)
+	// 短信验证码登录，只能登录已注册的手机号
+	SmsLogin(ctx context.Context, in *SmsLoginReq, opts ...grpc.CallOption) (*RegisterReply, error)
+	// 发送短信验证码
+	SendSmsCode(ctx context.Context, in *SendSmsCodeReq, opts ...grpc.CallOption) (*SendSmsCodeReply, error)
+	// 校验短信验证码，校验通过后验证码仍然有效，提交注册等表单时才会使用掉
+	VerifySmsCode(ctx context.Context, in *VerifySmsCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 修改密码，之前签发的 token 全部失效，返回新的 token
+	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*RegisterReply, error)
+	// 通过短信验证码重置密码
+	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 使用 refresh token 换取新的 token，旧的 refresh token 失效
+	Refresh(ctx context.Context, in *RefreshReq, opts ...grpc.CallOption) (*RegisterReply, error)
+	// 退出登录，当前 token 和 refresh token 都失效
+	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Captcha(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CaptchaReply, error)
 	Detail(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserDetailResponse, error)
+	// 注销账号，需要验证密码，注销后手机号和昵称被匿名化，购物车被清空
+	DeleteAccount(ctx context.Context, in *DeleteAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 导出个人数据，返回 JSON 附件
+	ExportData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserDataExport, error)
+	// 修改个人资料，只更新请求中出现的字段
+	UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UserDetailResponse, error)
+	// 商品分类、品牌和商品，不需要登录
+	CategoryTree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryTreeReply, error)
+	SubCategory(ctx context.Context, in *SubCategoryReq, opts ...grpc.CallOption) (*SubCategoryReply, error)
+	BrandList(ctx context.Context, in *BrandListReq, opts ...grpc.CallOption) (*BrandListReply, error)
+	GoodsSearch(ctx context.Context, in *GoodsSearchReq, opts ...grpc.CallOption) (*GoodsListReply, error)
+	GoodsDetail(ctx context.Context, in *GoodsDetailReq, opts ...grpc.CallOption) (*GoodsItem, error)
+	GoodsSkuList(ctx context.Context, in *GoodsDetailReq, opts ...grpc.CallOption) (*SkuListReply, error)
+	// 后台管理接口，默认只允许管理员访问，可以在配置 auth.policies 中按接口修改允许的角色
+	AdminCreateCategory(ctx context.Context, in *AdminCategoryReq, opts ...grpc.CallOption) (*CategoryItem, error)
+	AdminUpdateCategory(ctx context.Context, in *AdminCategoryReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AdminDeleteCategory(ctx context.Context, in *AdminIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AdminCreateBrand(ctx context.Context, in *AdminBrandReq, opts ...grpc.CallOption) (*BrandItem, error)
+	AdminUpdateBrand(ctx context.Context, in *AdminBrandReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AdminDeleteBrand(ctx context.Context, in *AdminIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AdminCreateGoods(ctx context.Context, in *AdminGoodsReq, opts ...grpc.CallOption) (*AdminIdReply, error)
+	AdminChangeSkuPrice(ctx context.Context, in *AdminSkuPriceReq, opts ...grpc.CallOption) (*AdminSkuPriceReply, error)
+	AdminSkuPurchaseLimit(ctx context.Context, in *AdminSkuLimitReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AdminUserList(ctx context.Context, in *AdminUserListReq, opts ...grpc.CallOption) (*AdminUserListReply, error)
+	AdminUserDetail(ctx context.Context, in *AdminIdReq, opts ...grpc.CallOption) (*UserDetailResponse, error)
+	// 禁用或启用账号、修改角色，用户之前签发的 token 全部失效
+	AdminSetUserDisabled(ctx context.Context, in *AdminUserDisabledReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AdminChangeUserRole(ctx context.Context, in *AdminUserRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 登录锁定，手动解锁并查看锁定记录
+	AdminUnlockLogin(ctx context.Context, in *AdminUnlockLoginReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AdminLockEventList(ctx context.Context, in *AdminLockEventListReq, opts ...grpc.CallOption) (*AdminLockEventListReply, error)
+	// 用户收货地址，用户ID从 token 中获取
+	ListAddress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AddressListReply, error)
+	CreateAddress(ctx context.Context, in *AddressReq, opts ...grpc.CallOption) (*AddressItem, error)
+	UpdateAddress(ctx context.Context, in *AddressReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetDefaultAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 用户购物车，用户ID从 token 中获取
+	ListCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CartListReply, error)
+	CreateCart(ctx context.Context, in *CartReq, opts ...grpc.CallOption) (*CartItem, error)
+	UpdateCart(ctx context.Context, in *CartReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCart(ctx context.Context, in *DeleteCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SelectCart(ctx context.Context, in *SelectCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 游客购物车，请求头 X-Device-Token 携带网关签发的设备 token，登录或注册后合并到用户购物车
+	GuestToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GuestTokenReply, error)
+	ListGuestCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CartListReply, error)
+	CreateGuestCart(ctx context.Context, in *GuestCartReq, opts ...grpc.CallOption) (*CartItem, error)
+	UpdateGuestCart(ctx context.Context, in *GuestCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteGuestCart(ctx context.Context, in *DeleteCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type lushopClient struct {
@@ -66,6 +175,76 @@ func (c *lushopClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *lushopClient) SmsLogin(ctx context.Context, in *SmsLoginReq, opts ...grpc.CallOption) (*RegisterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterReply)
+	err := c.cc.Invoke(ctx, Lushop_SmsLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) SendSmsCode(ctx context.Context, in *SendSmsCodeReq, opts ...grpc.CallOption) (*SendSmsCodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendSmsCodeReply)
+	err := c.cc.Invoke(ctx, Lushop_SendSmsCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) VerifySmsCode(ctx context.Context, in *VerifySmsCodeReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_VerifySmsCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*RegisterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterReply)
+	err := c.cc.Invoke(ctx, Lushop_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) Refresh(ctx context.Context, in *RefreshReq, opts ...grpc.CallOption) (*RegisterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterReply)
+	err := c.cc.Invoke(ctx, Lushop_Refresh_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lushopClient) Captcha(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CaptchaReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CaptchaReply)
@@ -73,140 +252,1559 @@ func (c *lushopClient) Captcha(ctx context.Context, in *emptypb.Empty, opts ...g
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *lushopClient) Detail(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDetailResponse)
+	err := c.cc.Invoke(ctx, Lushop_Detail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) DeleteAccount(ctx context.Context, in *DeleteAccountReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) ExportData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, Lushop_ExportData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UserDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDetailResponse)
+	err := c.cc.Invoke(ctx, Lushop_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) CategoryTree(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryTreeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryTreeReply)
+	err := c.cc.Invoke(ctx, Lushop_CategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) SubCategory(ctx context.Context, in *SubCategoryReq, opts ...grpc.CallOption) (*SubCategoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubCategoryReply)
+	err := c.cc.Invoke(ctx, Lushop_SubCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) BrandList(ctx context.Context, in *BrandListReq, opts ...grpc.CallOption) (*BrandListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandListReply)
+	err := c.cc.Invoke(ctx, Lushop_BrandList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) GoodsSearch(ctx context.Context, in *GoodsSearchReq, opts ...grpc.CallOption) (*GoodsListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsListReply)
+	err := c.cc.Invoke(ctx, Lushop_GoodsSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) GoodsDetail(ctx context.Context, in *GoodsDetailReq, opts ...grpc.CallOption) (*GoodsItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsItem)
+	err := c.cc.Invoke(ctx, Lushop_GoodsDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) GoodsSkuList(ctx context.Context, in *GoodsDetailReq, opts ...grpc.CallOption) (*SkuListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListReply)
+	err := c.cc.Invoke(ctx, Lushop_GoodsSkuList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminCreateCategory(ctx context.Context, in *AdminCategoryReq, opts ...grpc.CallOption) (*CategoryItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryItem)
+	err := c.cc.Invoke(ctx, Lushop_AdminCreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminUpdateCategory(ctx context.Context, in *AdminCategoryReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminUpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminDeleteCategory(ctx context.Context, in *AdminIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminDeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminCreateBrand(ctx context.Context, in *AdminBrandReq, opts ...grpc.CallOption) (*BrandItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandItem)
+	err := c.cc.Invoke(ctx, Lushop_AdminCreateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminUpdateBrand(ctx context.Context, in *AdminBrandReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminUpdateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminDeleteBrand(ctx context.Context, in *AdminIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminDeleteBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminCreateGoods(ctx context.Context, in *AdminGoodsReq, opts ...grpc.CallOption) (*AdminIdReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminIdReply)
+	err := c.cc.Invoke(ctx, Lushop_AdminCreateGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminChangeSkuPrice(ctx context.Context, in *AdminSkuPriceReq, opts ...grpc.CallOption) (*AdminSkuPriceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSkuPriceReply)
+	err := c.cc.Invoke(ctx, Lushop_AdminChangeSkuPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminSkuPurchaseLimit(ctx context.Context, in *AdminSkuLimitReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminSkuPurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminUserList(ctx context.Context, in *AdminUserListReq, opts ...grpc.CallOption) (*AdminUserListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUserListReply)
+	err := c.cc.Invoke(ctx, Lushop_AdminUserList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminUserDetail(ctx context.Context, in *AdminIdReq, opts ...grpc.CallOption) (*UserDetailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDetailResponse)
+	err := c.cc.Invoke(ctx, Lushop_AdminUserDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminSetUserDisabled(ctx context.Context, in *AdminUserDisabledReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminSetUserDisabled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminChangeUserRole(ctx context.Context, in *AdminUserRoleReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminChangeUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminUnlockLogin(ctx context.Context, in *AdminUnlockLoginReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_AdminUnlockLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) AdminLockEventList(ctx context.Context, in *AdminLockEventListReq, opts ...grpc.CallOption) (*AdminLockEventListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminLockEventListReply)
+	err := c.cc.Invoke(ctx, Lushop_AdminLockEventList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) ListAddress(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AddressListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressListReply)
+	err := c.cc.Invoke(ctx, Lushop_ListAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) CreateAddress(ctx context.Context, in *AddressReq, opts ...grpc.CallOption) (*AddressItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressItem)
+	err := c.cc.Invoke(ctx, Lushop_CreateAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) UpdateAddress(ctx context.Context, in *AddressReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_UpdateAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) DeleteAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_DeleteAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) SetDefaultAddress(ctx context.Context, in *AddressIdReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_SetDefaultAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) ListCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CartListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartListReply)
+	err := c.cc.Invoke(ctx, Lushop_ListCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) CreateCart(ctx context.Context, in *CartReq, opts ...grpc.CallOption) (*CartItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartItem)
+	err := c.cc.Invoke(ctx, Lushop_CreateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) UpdateCart(ctx context.Context, in *CartReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_UpdateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) DeleteCart(ctx context.Context, in *DeleteCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_DeleteCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) SelectCart(ctx context.Context, in *SelectCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_SelectCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) GuestToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GuestTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GuestTokenReply)
+	err := c.cc.Invoke(ctx, Lushop_GuestToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) ListGuestCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CartListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartListReply)
+	err := c.cc.Invoke(ctx, Lushop_ListGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) CreateGuestCart(ctx context.Context, in *GuestCartReq, opts ...grpc.CallOption) (*CartItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartItem)
+	err := c.cc.Invoke(ctx, Lushop_CreateGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) UpdateGuestCart(ctx context.Context, in *GuestCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_UpdateGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lushopClient) DeleteGuestCart(ctx context.Context, in *DeleteCartReq, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Lushop_DeleteGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LushopServer is the server API for Lushop service.
+// All implementations must embed UnimplementedLushopServer
+// for forward compatibility.
+//
+// The Shop service definition.
+type LushopServer interface {
+	Register(context.Context, *RegisterReq) (*RegisterReply, error)
+	Login(context.Context, *LoginReq) (*RegisterReply, error)
+	// 短信验证码登录，只能登录已注册的手机号
+	SmsLogin(context.Context, *SmsLoginReq) (*RegisterReply, error)
+	// 发送短信验证码
+	SendSmsCode(context.Context, *SendSmsCodeReq) (*SendSmsCodeReply, error)
+	// 校验短信验证码，校验通过后验证码仍然有效，提交注册等表单时才会使用掉
+	VerifySmsCode(context.Context, *VerifySmsCodeReq) (*emptypb.Empty, error)
+	// 修改密码，之前签发的 token 全部失效，返回新的 token
+	ChangePassword(context.Context, *ChangePasswordReq) (*RegisterReply, error)
+	// 通过短信验证码重置密码
+	ResetPassword(context.Context, *ResetPasswordReq) (*emptypb.Empty, error)
+	// 使用 refresh token 换取新的 token，旧的 refresh token 失效
+	Refresh(context.Context, *RefreshReq) (*RegisterReply, error)
+	// 退出登录，当前 token 和 refresh token 都失效
+	Logout(context.Context, *LogoutReq) (*emptypb.Empty, error)
+	Captcha(context.Context, *emptypb.Empty) (*CaptchaReply, error)
+	Detail(context.Context, *emptypb.Empty) (*UserDetailResponse, error)
+	// 注销账号，需要验证密码，注销后手机号和昵称被匿名化，购物车被清空
+	DeleteAccount(context.Context, *DeleteAccountReq) (*emptypb.Empty, error)
+	// 导出个人数据，返回 JSON 附件
+	ExportData(context.Context, *emptypb.Empty) (*UserDataExport, error)
+	// 修改个人资料，只更新请求中出现的字段
+	UpdateProfile(context.Context, *UpdateProfileReq) (*UserDetailResponse, error)
+	// 商品分类、品牌和商品，不需要登录
+	CategoryTree(context.Context, *emptypb.Empty) (*CategoryTreeReply, error)
+	SubCategory(context.Context, *SubCategoryReq) (*SubCategoryReply, error)
+	BrandList(context.Context, *BrandListReq) (*BrandListReply, error)
+	GoodsSearch(context.Context, *GoodsSearchReq) (*GoodsListReply, error)
+	GoodsDetail(context.Context, *GoodsDetailReq) (*GoodsItem, error)
+	GoodsSkuList(context.Context, *GoodsDetailReq) (*SkuListReply, error)
+	// 后台管理接口，默认只允许管理员访问，可以在配置 auth.policies 中按接口修改允许的角色
+	AdminCreateCategory(context.Context, *AdminCategoryReq) (*CategoryItem, error)
+	AdminUpdateCategory(context.Context, *AdminCategoryReq) (*emptypb.Empty, error)
+	AdminDeleteCategory(context.Context, *AdminIdReq) (*emptypb.Empty, error)
+	AdminCreateBrand(context.Context, *AdminBrandReq) (*BrandItem, error)
+	AdminUpdateBrand(context.Context, *AdminBrandReq) (*emptypb.Empty, error)
+	AdminDeleteBrand(context.Context, *AdminIdReq) (*emptypb.Empty, error)
+	AdminCreateGoods(context.Context, *AdminGoodsReq) (*AdminIdReply, error)
+	AdminChangeSkuPrice(context.Context, *AdminSkuPriceReq) (*AdminSkuPriceReply, error)
+	AdminSkuPurchaseLimit(context.Context, *AdminSkuLimitReq) (*emptypb.Empty, error)
+	AdminUserList(context.Context, *AdminUserListReq) (*AdminUserListReply, error)
+	AdminUserDetail(context.Context, *AdminIdReq) (*UserDetailResponse, error)
+	// 禁用或启用账号、修改角色，用户之前签发的 token 全部失效
+	AdminSetUserDisabled(context.Context, *AdminUserDisabledReq) (*emptypb.Empty, error)
+	AdminChangeUserRole(context.Context, *AdminUserRoleReq) (*emptypb.Empty, error)
+	// 登录锁定，手动解锁并查看锁定记录
+	AdminUnlockLogin(context.Context, *AdminUnlockLoginReq) (*emptypb.Empty, error)
+	AdminLockEventList(context.Context, *AdminLockEventListReq) (*AdminLockEventListReply, error)
+	// 用户收货地址，用户ID从 token 中获取
+	ListAddress(context.Context, *emptypb.Empty) (*AddressListReply, error)
+	CreateAddress(context.Context, *AddressReq) (*AddressItem, error)
+	UpdateAddress(context.Context, *AddressReq) (*emptypb.Empty, error)
+	DeleteAddress(context.Context, *AddressIdReq) (*emptypb.Empty, error)
+	SetDefaultAddress(context.Context, *AddressIdReq) (*emptypb.Empty, error)
+	// 用户购物车，用户ID从 token 中获取
+	ListCart(context.Context, *emptypb.Empty) (*CartListReply, error)
+	CreateCart(context.Context, *CartReq) (*CartItem, error)
+	UpdateCart(context.Context, *CartReq) (*emptypb.Empty, error)
+	DeleteCart(context.Context, *DeleteCartReq) (*emptypb.Empty, error)
+	SelectCart(context.Context, *SelectCartReq) (*emptypb.Empty, error)
+	// 游客购物车，请求头 X-Device-Token 携带网关签发的设备 token，登录或注册后合并到用户购物车
+	GuestToken(context.Context, *emptypb.Empty) (*GuestTokenReply, error)
+	ListGuestCart(context.Context, *emptypb.Empty) (*CartListReply, error)
+	CreateGuestCart(context.Context, *GuestCartReq) (*CartItem, error)
+	UpdateGuestCart(context.Context, *GuestCartReq) (*emptypb.Empty, error)
+	DeleteGuestCart(context.Context, *DeleteCartReq) (*emptypb.Empty, error)
+	mustEmbedUnimplementedLushopServer()
+}
+
+// UnimplementedLushopServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLushopServer struct{}
+
+func (UnimplementedLushopServer) Register(context.Context, *RegisterReq) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedLushopServer) Login(context.Context, *LoginReq) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedLushopServer) SmsLogin(context.Context, *SmsLoginReq) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SmsLogin not implemented")
+}
+func (UnimplementedLushopServer) SendSmsCode(context.Context, *SendSmsCodeReq) (*SendSmsCodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSmsCode not implemented")
+}
+func (UnimplementedLushopServer) VerifySmsCode(context.Context, *VerifySmsCodeReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySmsCode not implemented")
+}
+func (UnimplementedLushopServer) ChangePassword(context.Context, *ChangePasswordReq) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedLushopServer) ResetPassword(context.Context, *ResetPasswordReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedLushopServer) Refresh(context.Context, *RefreshReq) (*RegisterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refresh not implemented")
+}
+func (UnimplementedLushopServer) Logout(context.Context, *LogoutReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedLushopServer) Captcha(context.Context, *emptypb.Empty) (*CaptchaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Captcha not implemented")
+}
+func (UnimplementedLushopServer) Detail(context.Context, *emptypb.Empty) (*UserDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Detail not implemented")
+}
+func (UnimplementedLushopServer) DeleteAccount(context.Context, *DeleteAccountReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedLushopServer) ExportData(context.Context, *emptypb.Empty) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (UnimplementedLushopServer) UpdateProfile(context.Context, *UpdateProfileReq) (*UserDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedLushopServer) CategoryTree(context.Context, *emptypb.Empty) (*CategoryTreeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryTree not implemented")
+}
+func (UnimplementedLushopServer) SubCategory(context.Context, *SubCategoryReq) (*SubCategoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubCategory not implemented")
+}
+func (UnimplementedLushopServer) BrandList(context.Context, *BrandListReq) (*BrandListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrandList not implemented")
+}
+func (UnimplementedLushopServer) GoodsSearch(context.Context, *GoodsSearchReq) (*GoodsListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsSearch not implemented")
+}
+func (UnimplementedLushopServer) GoodsDetail(context.Context, *GoodsDetailReq) (*GoodsItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsDetail not implemented")
+}
+func (UnimplementedLushopServer) GoodsSkuList(context.Context, *GoodsDetailReq) (*SkuListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsSkuList not implemented")
+}
+func (UnimplementedLushopServer) AdminCreateCategory(context.Context, *AdminCategoryReq) (*CategoryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateCategory not implemented")
+}
+func (UnimplementedLushopServer) AdminUpdateCategory(context.Context, *AdminCategoryReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateCategory not implemented")
+}
+func (UnimplementedLushopServer) AdminDeleteCategory(context.Context, *AdminIdReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteCategory not implemented")
+}
+func (UnimplementedLushopServer) AdminCreateBrand(context.Context, *AdminBrandReq) (*BrandItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateBrand not implemented")
+}
+func (UnimplementedLushopServer) AdminUpdateBrand(context.Context, *AdminBrandReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateBrand not implemented")
+}
+func (UnimplementedLushopServer) AdminDeleteBrand(context.Context, *AdminIdReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminDeleteBrand not implemented")
+}
+func (UnimplementedLushopServer) AdminCreateGoods(context.Context, *AdminGoodsReq) (*AdminIdReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminCreateGoods not implemented")
+}
+func (UnimplementedLushopServer) AdminChangeSkuPrice(context.Context, *AdminSkuPriceReq) (*AdminSkuPriceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminChangeSkuPrice not implemented")
+}
+func (UnimplementedLushopServer) AdminSkuPurchaseLimit(context.Context, *AdminSkuLimitReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSkuPurchaseLimit not implemented")
+}
+func (UnimplementedLushopServer) AdminUserList(context.Context, *AdminUserListReq) (*AdminUserListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserList not implemented")
+}
+func (UnimplementedLushopServer) AdminUserDetail(context.Context, *AdminIdReq) (*UserDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUserDetail not implemented")
+}
+func (UnimplementedLushopServer) AdminSetUserDisabled(context.Context, *AdminUserDisabledReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminSetUserDisabled not implemented")
+}
+func (UnimplementedLushopServer) AdminChangeUserRole(context.Context, *AdminUserRoleReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminChangeUserRole not implemented")
+}
+func (UnimplementedLushopServer) AdminUnlockLogin(context.Context, *AdminUnlockLoginReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminUnlockLogin not implemented")
+}
+func (UnimplementedLushopServer) AdminLockEventList(context.Context, *AdminLockEventListReq) (*AdminLockEventListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminLockEventList not implemented")
+}
+func (UnimplementedLushopServer) ListAddress(context.Context, *emptypb.Empty) (*AddressListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddress not implemented")
+}
+func (UnimplementedLushopServer) CreateAddress(context.Context, *AddressReq) (*AddressItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddress not implemented")
+}
+func (UnimplementedLushopServer) UpdateAddress(context.Context, *AddressReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddress not implemented")
+}
+func (UnimplementedLushopServer) DeleteAddress(context.Context, *AddressIdReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddress not implemented")
+}
+func (UnimplementedLushopServer) SetDefaultAddress(context.Context, *AddressIdReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAddress not implemented")
+}
+func (UnimplementedLushopServer) ListCart(context.Context, *emptypb.Empty) (*CartListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCart not implemented")
+}
+func (UnimplementedLushopServer) CreateCart(context.Context, *CartReq) (*CartItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCart not implemented")
+}
+func (UnimplementedLushopServer) UpdateCart(context.Context, *CartReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCart not implemented")
+}
+func (UnimplementedLushopServer) DeleteCart(context.Context, *DeleteCartReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCart not implemented")
+}
+func (UnimplementedLushopServer) SelectCart(context.Context, *SelectCartReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectCart not implemented")
+}
+func (UnimplementedLushopServer) GuestToken(context.Context, *emptypb.Empty) (*GuestTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuestToken not implemented")
+}
+func (UnimplementedLushopServer) ListGuestCart(context.Context, *emptypb.Empty) (*CartListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuestCart not implemented")
+}
+func (UnimplementedLushopServer) CreateGuestCart(context.Context, *GuestCartReq) (*CartItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
+func (UnimplementedLushopServer) UpdateGuestCart(context.Context, *GuestCartReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuestCart not implemented")
+}
+func (UnimplementedLushopServer) DeleteGuestCart(context.Context, *DeleteCartReq) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuestCart not implemented")
+}
+func (UnimplementedLushopServer) mustEmbedUnimplementedLushopServer() {}
+func (UnimplementedLushopServer) testEmbeddedByValue()                {}
+
+// UnsafeLushopServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LushopServer will
+// result in compilation errors.
+type UnsafeLushopServer interface {
+	mustEmbedUnimplementedLushopServer()
+}
+
+func RegisterLushopServer(s grpc.ServiceRegistrar, srv LushopServer) {
+	// If the following call pancis, it indicates UnimplementedLushopServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Lushop_ServiceDesc, srv)
+}
+
+func _Lushop_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).Register(ctx, req.(*RegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_SmsLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SmsLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).SmsLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_SmsLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).SmsLogin(ctx, req.(*SmsLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_SendSmsCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSmsCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).SendSmsCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_SendSmsCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).SendSmsCode(ctx, req.(*SendSmsCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_VerifySmsCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySmsCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).VerifySmsCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_VerifySmsCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).VerifySmsCode(ctx, req.(*VerifySmsCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_Refresh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).Refresh(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_Refresh_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).Refresh(ctx, req.(*RefreshReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).Logout(ctx, req.(*LogoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_Captcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).Captcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_Captcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).Captcha(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_Detail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).Detail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_Detail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).Detail(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).DeleteAccount(ctx, req.(*DeleteAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).ExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_ExportData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).ExportData(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).UpdateProfile(ctx, req.(*UpdateProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_CategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).CategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_CategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).CategoryTree(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_SubCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).SubCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_SubCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).SubCategory(ctx, req.(*SubCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_BrandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).BrandList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_BrandList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).BrandList(ctx, req.(*BrandListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_GoodsSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsSearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).GoodsSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_GoodsSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).GoodsSearch(ctx, req.(*GoodsSearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_GoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsDetailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).GoodsDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_GoodsDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).GoodsDetail(ctx, req.(*GoodsDetailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_GoodsSkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsDetailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).GoodsSkuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_GoodsSkuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).GoodsSkuList(ctx, req.(*GoodsDetailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminCreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminCreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminCreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminCreateCategory(ctx, req.(*AdminCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminUpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminUpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminUpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminUpdateCategory(ctx, req.(*AdminCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminDeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminDeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminDeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminDeleteCategory(ctx, req.(*AdminIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminCreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminCreateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminCreateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminCreateBrand(ctx, req.(*AdminBrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminUpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminBrandReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminUpdateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminUpdateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminUpdateBrand(ctx, req.(*AdminBrandReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminDeleteBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminDeleteBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminDeleteBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminDeleteBrand(ctx, req.(*AdminIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminCreateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGoodsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminCreateGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminCreateGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminCreateGoods(ctx, req.(*AdminGoodsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminChangeSkuPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSkuPriceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminChangeSkuPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminChangeSkuPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminChangeSkuPrice(ctx, req.(*AdminSkuPriceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminSkuPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSkuLimitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminSkuPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminSkuPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminSkuPurchaseLimit(ctx, req.(*AdminSkuLimitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminUserList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminUserList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminUserList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminUserList(ctx, req.(*AdminUserListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminUserDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminUserDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminUserDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminUserDetail(ctx, req.(*AdminIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminSetUserDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserDisabledReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminSetUserDisabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminSetUserDisabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminSetUserDisabled(ctx, req.(*AdminUserDisabledReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminChangeUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUserRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminChangeUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminChangeUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminChangeUserRole(ctx, req.(*AdminUserRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminUnlockLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUnlockLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminUnlockLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminUnlockLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminUnlockLogin(ctx, req.(*AdminUnlockLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_AdminLockEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminLockEventListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).AdminLockEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_AdminLockEventList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).AdminLockEventList(ctx, req.(*AdminLockEventListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_ListAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).ListAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_ListAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).ListAddress(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).CreateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_CreateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).CreateAddress(ctx, req.(*AddressReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lushop_UpdateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).UpdateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_UpdateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).UpdateAddress(ctx, req.(*AddressReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *lushopClient) Detail(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserDetailResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UserDetailResponse)
-	err := c.cc.Invoke(ctx, Lushop_Detail_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _Lushop_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressIdReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(LushopServer).DeleteAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_DeleteAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).DeleteAddress(ctx, req.(*AddressIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// LushopServer is the server API for Lushop service.
-// All implementations must embed UnimplementedLushopServer
-// for forward compatibility.
-//
-// The Shop service definition.
-type LushopServer interface {
-	Register(context.Context, *RegisterReq) (*RegisterReply, error)
-	Login(context.Context, *LoginReq) (*RegisterReply, error)
-	Captcha(context.Context, *emptypb.Empty) (*CaptchaReply, error)
-	Detail(context.Context, *emptypb.Empty) (*UserDetailResponse, error)
-	mustEmbedUnimplementedLushopServer()
+func _Lushop_SetDefaultAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).SetDefaultAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_SetDefaultAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).SetDefaultAddress(ctx, req.(*AddressIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedLushopServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedLushopServer struct{}
-
-func (UnimplementedLushopServer) Register(context.Context, *RegisterReq) (*RegisterReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+func _Lushop_ListCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).ListCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_ListCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).ListCart(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLushopServer) Login(context.Context, *LoginReq) (*RegisterReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func _Lushop_CreateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).CreateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_CreateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).CreateCart(ctx, req.(*CartReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLushopServer) Captcha(context.Context, *emptypb.Empty) (*CaptchaReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Captcha not implemented")
+
+func _Lushop_UpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).UpdateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_UpdateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).UpdateCart(ctx, req.(*CartReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLushopServer) Detail(context.Context, *emptypb.Empty) (*UserDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Detail not implemented")
+
+func _Lushop_DeleteCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).DeleteCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_DeleteCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).DeleteCart(ctx, req.(*DeleteCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLushopServer) mustEmbedUnimplementedLushopServer() {}
-func (UnimplementedLushopServer) testEmbeddedByValue()                {}
 
-// UnsafeLushopServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LushopServer will
-// result in compilation errors.
-type UnsafeLushopServer interface {
-	mustEmbedUnimplementedLushopServer()
+func _Lushop_SelectCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectCartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LushopServer).SelectCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_SelectCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).SelectCart(ctx, req.(*SelectCartReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLushopServer(s grpc.ServiceRegistrar, srv LushopServer) {
-	// If the following call pancis, it indicates UnimplementedLushopServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Lushop_GuestToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Lushop_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(LushopServer).GuestToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lushop_GuestToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LushopServer).GuestToken(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Lushop_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterReq)
+func _Lushop_ListGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LushopServer).Register(ctx, in)
+		return srv.(LushopServer).ListGuestCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lushop_Register_FullMethodName,
+		FullMethod: Lushop_ListGuestCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LushopServer).Register(ctx, req.(*RegisterReq))
+		return srv.(LushopServer).ListGuestCart(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lushop_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Lushop_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LushopServer).Login(ctx, in)
+		return srv.(LushopServer).CreateGuestCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lushop_Login_FullMethodName,
+		FullMethod: Lushop_CreateGuestCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LushopServer).Login(ctx, req.(*LoginReq))
+		return srv.(LushopServer).CreateGuestCart(ctx, req.(*GuestCartReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lushop_Captcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Lushop_UpdateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LushopServer).Captcha(ctx, in)
+		return srv.(LushopServer).UpdateGuestCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lushop_Captcha_FullMethodName,
+		FullMethod: Lushop_UpdateGuestCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LushopServer).Captcha(ctx, req.(*emptypb.Empty))
+		return srv.(LushopServer).UpdateGuestCart(ctx, req.(*GuestCartReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lushop_Detail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Lushop_DeleteGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCartReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LushopServer).Detail(ctx, in)
+		return srv.(LushopServer).DeleteGuestCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Lushop_Detail_FullMethodName,
+		FullMethod: Lushop_DeleteGuestCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LushopServer).Detail(ctx, req.(*emptypb.Empty))
+		return srv.(LushopServer).DeleteGuestCart(ctx, req.(*DeleteCartReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -226,6 +1824,34 @@ var Lushop_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Lushop_Login_Handler,
 		},
+		{
+			MethodName: "SmsLogin",
+			Handler:    _Lushop_SmsLogin_Handler,
+		},
+		{
+			MethodName: "SendSmsCode",
+			Handler:    _Lushop_SendSmsCode_Handler,
+		},
+		{
+			MethodName: "VerifySmsCode",
+			Handler:    _Lushop_VerifySmsCode_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Lushop_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _Lushop_ResetPassword_Handler,
+		},
+		{
+			MethodName: "Refresh",
+			Handler:    _Lushop_Refresh_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Lushop_Logout_Handler,
+		},
 		{
 			MethodName: "Captcha",
 			Handler:    _Lushop_Captcha_Handler,
@@ -234,6 +1860,162 @@ var Lushop_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Detail",
 			Handler:    _Lushop_Detail_Handler,
 		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Lushop_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "ExportData",
+			Handler:    _Lushop_ExportData_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Lushop_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "CategoryTree",
+			Handler:    _Lushop_CategoryTree_Handler,
+		},
+		{
+			MethodName: "SubCategory",
+			Handler:    _Lushop_SubCategory_Handler,
+		},
+		{
+			MethodName: "BrandList",
+			Handler:    _Lushop_BrandList_Handler,
+		},
+		{
+			MethodName: "GoodsSearch",
+			Handler:    _Lushop_GoodsSearch_Handler,
+		},
+		{
+			MethodName: "GoodsDetail",
+			Handler:    _Lushop_GoodsDetail_Handler,
+		},
+		{
+			MethodName: "GoodsSkuList",
+			Handler:    _Lushop_GoodsSkuList_Handler,
+		},
+		{
+			MethodName: "AdminCreateCategory",
+			Handler:    _Lushop_AdminCreateCategory_Handler,
+		},
+		{
+			MethodName: "AdminUpdateCategory",
+			Handler:    _Lushop_AdminUpdateCategory_Handler,
+		},
+		{
+			MethodName: "AdminDeleteCategory",
+			Handler:    _Lushop_AdminDeleteCategory_Handler,
+		},
+		{
+			MethodName: "AdminCreateBrand",
+			Handler:    _Lushop_AdminCreateBrand_Handler,
+		},
+		{
+			MethodName: "AdminUpdateBrand",
+			Handler:    _Lushop_AdminUpdateBrand_Handler,
+		},
+		{
+			MethodName: "AdminDeleteBrand",
+			Handler:    _Lushop_AdminDeleteBrand_Handler,
+		},
+		{
+			MethodName: "AdminCreateGoods",
+			Handler:    _Lushop_AdminCreateGoods_Handler,
+		},
+		{
+			MethodName: "AdminChangeSkuPrice",
+			Handler:    _Lushop_AdminChangeSkuPrice_Handler,
+		},
+		{
+			MethodName: "AdminSkuPurchaseLimit",
+			Handler:    _Lushop_AdminSkuPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "AdminUserList",
+			Handler:    _Lushop_AdminUserList_Handler,
+		},
+		{
+			MethodName: "AdminUserDetail",
+			Handler:    _Lushop_AdminUserDetail_Handler,
+		},
+		{
+			MethodName: "AdminSetUserDisabled",
+			Handler:    _Lushop_AdminSetUserDisabled_Handler,
+		},
+		{
+			MethodName: "AdminChangeUserRole",
+			Handler:    _Lushop_AdminChangeUserRole_Handler,
+		},
+		{
+			MethodName: "AdminUnlockLogin",
+			Handler:    _Lushop_AdminUnlockLogin_Handler,
+		},
+		{
+			MethodName: "AdminLockEventList",
+			Handler:    _Lushop_AdminLockEventList_Handler,
+		},
+		{
+			MethodName: "ListAddress",
+			Handler:    _Lushop_ListAddress_Handler,
+		},
+		{
+			MethodName: "CreateAddress",
+			Handler:    _Lushop_CreateAddress_Handler,
+		},
+		{
+			MethodName: "UpdateAddress",
+			Handler:    _Lushop_UpdateAddress_Handler,
+		},
+		{
+			MethodName: "DeleteAddress",
+			Handler:    _Lushop_DeleteAddress_Handler,
+		},
+		{
+			MethodName: "SetDefaultAddress",
+			Handler:    _Lushop_SetDefaultAddress_Handler,
+		},
+		{
+			MethodName: "ListCart",
+			Handler:    _Lushop_ListCart_Handler,
+		},
+		{
+			MethodName: "CreateCart",
+			Handler:    _Lushop_CreateCart_Handler,
+		},
+		{
+			MethodName: "UpdateCart",
+			Handler:    _Lushop_UpdateCart_Handler,
+		},
+		{
+			MethodName: "DeleteCart",
+			Handler:    _Lushop_DeleteCart_Handler,
+		},
+		{
+			MethodName: "SelectCart",
+			Handler:    _Lushop_SelectCart_Handler,
+		},
+		{
+			MethodName: "GuestToken",
+			Handler:    _Lushop_GuestToken_Handler,
+		},
+		{
+			MethodName: "ListGuestCart",
+			Handler:    _Lushop_ListGuestCart_Handler,
+		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _Lushop_CreateGuestCart_Handler,
+		},
+		{
+			MethodName: "UpdateGuestCart",
+			Handler:    _Lushop_UpdateGuestCart_Handler,
+		},
+		{
+			MethodName: "DeleteGuestCart",
+			Handler:    _Lushop_DeleteGuestCart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lushop/v1/lushop.proto",
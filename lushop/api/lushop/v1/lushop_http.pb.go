@@ -20,24 +20,179 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationLushopAdminChangeSkuPrice = "/lushop.lushop.v1.Lushop/AdminChangeSkuPrice"
+const OperationLushopAdminChangeUserRole = "/lushop.lushop.v1.Lushop/AdminChangeUserRole"
+const OperationLushopAdminCreateBrand = "/lushop.lushop.v1.Lushop/AdminCreateBrand"
+const OperationLushopAdminCreateCategory = "/lushop.lushop.v1.Lushop/AdminCreateCategory"
+const OperationLushopAdminCreateGoods = "/lushop.lushop.v1.Lushop/AdminCreateGoods"
+const OperationLushopAdminDeleteBrand = "/lushop.lushop.v1.Lushop/AdminDeleteBrand"
+const OperationLushopAdminDeleteCategory = "/lushop.lushop.v1.Lushop/AdminDeleteCategory"
+const OperationLushopAdminLockEventList = "/lushop.lushop.v1.Lushop/AdminLockEventList"
+const OperationLushopAdminSetUserDisabled = "/lushop.lushop.v1.Lushop/AdminSetUserDisabled"
+const OperationLushopAdminSkuPurchaseLimit = "/lushop.lushop.v1.Lushop/AdminSkuPurchaseLimit"
+const OperationLushopAdminUnlockLogin = "/lushop.lushop.v1.Lushop/AdminUnlockLogin"
+const OperationLushopAdminUpdateBrand = "/lushop.lushop.v1.Lushop/AdminUpdateBrand"
+const OperationLushopAdminUpdateCategory = "/lushop.lushop.v1.Lushop/AdminUpdateCategory"
+const OperationLushopAdminUserDetail = "/lushop.lushop.v1.Lushop/AdminUserDetail"
+const OperationLushopAdminUserList = "/lushop.lushop.v1.Lushop/AdminUserList"
+const OperationLushopBrandList = "/lushop.lushop.v1.Lushop/BrandList"
 const OperationLushopCaptcha = "/lushop.lushop.v1.Lushop/Captcha"
+const OperationLushopCategoryTree = "/lushop.lushop.v1.Lushop/CategoryTree"
+const OperationLushopChangePassword = "/lushop.lushop.v1.Lushop/ChangePassword"
+const OperationLushopCreateAddress = "/lushop.lushop.v1.Lushop/CreateAddress"
+const OperationLushopCreateCart = "/lushop.lushop.v1.Lushop/CreateCart"
+const OperationLushopCreateGuestCart = "/lushop.lushop.v1.Lushop/CreateGuestCart"
+const OperationLushopDeleteAccount = "/lushop.lushop.v1.Lushop/DeleteAccount"
+const OperationLushopDeleteAddress = "/lushop.lushop.v1.Lushop/DeleteAddress"
+const OperationLushopDeleteCart = "/lushop.lushop.v1.Lushop/DeleteCart"
+const OperationLushopDeleteGuestCart = "/lushop.lushop.v1.Lushop/DeleteGuestCart"
 const OperationLushopDetail = "/lushop.lushop.v1.Lushop/Detail"
+const OperationLushopExportData = "/lushop.lushop.v1.Lushop/ExportData"
+const OperationLushopGoodsDetail = "/lushop.lushop.v1.Lushop/GoodsDetail"
+const OperationLushopGoodsSearch = "/lushop.lushop.v1.Lushop/GoodsSearch"
+const OperationLushopGoodsSkuList = "/lushop.lushop.v1.Lushop/GoodsSkuList"
+const OperationLushopGuestToken = "/lushop.lushop.v1.Lushop/GuestToken"
+const OperationLushopListAddress = "/lushop.lushop.v1.Lushop/ListAddress"
+const OperationLushopListCart = "/lushop.lushop.v1.Lushop/ListCart"
+const OperationLushopListGuestCart = "/lushop.lushop.v1.Lushop/ListGuestCart"
 const OperationLushopLogin = "/lushop.lushop.v1.Lushop/Login"
+const OperationLushopLogout = "/lushop.lushop.v1.Lushop/Logout"
+const OperationLushopRefresh = "/lushop.lushop.v1.Lushop/Refresh"
 const OperationLushopRegister = "/lushop.lushop.v1.Lushop/Register"
+const OperationLushopResetPassword = "/lushop.lushop.v1.Lushop/ResetPassword"
+const OperationLushopSelectCart = "/lushop.lushop.v1.Lushop/SelectCart"
+const OperationLushopSendSmsCode = "/lushop.lushop.v1.Lushop/SendSmsCode"
+const OperationLushopSetDefaultAddress = "/lushop.lushop.v1.Lushop/SetDefaultAddress"
+const OperationLushopSmsLogin = "/lushop.lushop.v1.Lushop/SmsLogin"
+const OperationLushopSubCategory = "/lushop.lushop.v1.Lushop/SubCategory"
+const OperationLushopUpdateAddress = "/lushop.lushop.v1.Lushop/UpdateAddress"
+const OperationLushopUpdateCart = "/lushop.lushop.v1.Lushop/UpdateCart"
+const OperationLushopUpdateGuestCart = "/lushop.lushop.v1.Lushop/UpdateGuestCart"
+const OperationLushopUpdateProfile = "/lushop.lushop.v1.Lushop/UpdateProfile"
+const OperationLushopVerifySmsCode = "/lushop.lushop.v1.Lushop/VerifySmsCode"
 
 type LushopHTTPServer interface {
+	AdminChangeSkuPrice(context.Context, *AdminSkuPriceReq) (*AdminSkuPriceReply, error)
+	AdminChangeUserRole(context.Context, *AdminUserRoleReq) (*emptypb.Empty, error)
+	AdminCreateBrand(context.Context, *AdminBrandReq) (*BrandItem, error)
+	// AdminCreateCategory 后台管理接口，默认只允许管理员访问，可以在配置 auth.policies 中按接口修改允许的角色
+	AdminCreateCategory(context.Context, *AdminCategoryReq) (*CategoryItem, error)
+	AdminCreateGoods(context.Context, *AdminGoodsReq) (*AdminIdReply, error)
+	AdminDeleteBrand(context.Context, *AdminIdReq) (*emptypb.Empty, error)
+	AdminDeleteCategory(context.Context, *AdminIdReq) (*emptypb.Empty, error)
+	AdminLockEventList(context.Context, *AdminLockEventListReq) (*AdminLockEventListReply, error)
+	// AdminSetUserDisabled 禁用或启用账号、修改角色，用户之前签发的 token 全部失效
+	AdminSetUserDisabled(context.Context, *AdminUserDisabledReq) (*emptypb.Empty, error)
+	AdminSkuPurchaseLimit(context.Context, *AdminSkuLimitReq) (*emptypb.Empty, error)
+	// AdminUnlockLogin 登录锁定，手动解锁并查看锁定记录
+	AdminUnlockLogin(context.Context, *AdminUnlockLoginReq) (*emptypb.Empty, error)
+	AdminUpdateBrand(context.Context, *AdminBrandReq) (*emptypb.Empty, error)
+	AdminUpdateCategory(context.Context, *AdminCategoryReq) (*emptypb.Empty, error)
+	AdminUserDetail(context.Context, *AdminIdReq) (*UserDetailResponse, error)
+	AdminUserList(context.Context, *AdminUserListReq) (*AdminUserListReply, error)
+	BrandList(context.Context, *BrandListReq) (*BrandListReply, error)
 	Captcha(context.Context, *emptypb.Empty) (*CaptchaReply, error)
+	// CategoryTree 商品分类、品牌和商品，不需要登录
+	CategoryTree(context.Context, *emptypb.Empty) (*CategoryTreeReply, error)
+	// ChangePassword 修改密码，之前签发的 token 全部失效，返回新的 token
+	ChangePassword(context.Context, *ChangePasswordReq) (*RegisterReply, error)
+	CreateAddress(context.Context, *AddressReq) (*AddressItem, error)
+	CreateCart(context.Context, *CartReq) (*CartItem, error)
+	CreateGuestCart(context.Context, *GuestCartReq) (*CartItem, error)
+	// DeleteAccount 注销账号，需要验证密码，注销后手机号和昵称被匿名化，购物车被清空
+	DeleteAccount(context.Context, *DeleteAccountReq) (*emptypb.Empty, error)
+	DeleteAddress(context.Context, *AddressIdReq) (*emptypb.Empty, error)
+	DeleteCart(context.Context, *DeleteCartReq) (*emptypb.Empty, error)
+	DeleteGuestCart(context.Context, *DeleteCartReq) (*emptypb.Empty, error)
 	Detail(context.Context, *emptypb.Empty) (*UserDetailResponse, error)
+	// ExportData 导出个人数据，返回 JSON 附件
+	ExportData(context.Context, *emptypb.Empty) (*UserDataExport, error)
+	GoodsDetail(context.Context, *GoodsDetailReq) (*GoodsItem, error)
+	GoodsSearch(context.Context, *GoodsSearchReq) (*GoodsListReply, error)
+	GoodsSkuList(context.Context, *GoodsDetailReq) (*SkuListReply, error)
+	// GuestToken 游客购物车，请求头 X-Device-Token 携带网关签发的设备 token，登录或注册后合并到用户购物车
+	GuestToken(context.Context, *emptypb.Empty) (*GuestTokenReply, error)
+	// ListAddress 用户收货地址，用户ID从 token 中获取
+	ListAddress(context.Context, *emptypb.Empty) (*AddressListReply, error)
+	// ListCart 用户购物车，用户ID从 token 中获取
+	ListCart(context.Context, *emptypb.Empty) (*CartListReply, error)
+	ListGuestCart(context.Context, *emptypb.Empty) (*CartListReply, error)
 	Login(context.Context, *LoginReq) (*RegisterReply, error)
+	// Logout 退出登录，当前 token 和 refresh token 都失效
+	Logout(context.Context, *LogoutReq) (*emptypb.Empty, error)
+	// Refresh 使用 refresh token 换取新的 token，旧的 refresh token 失效
+	Refresh(context.Context, *RefreshReq) (*RegisterReply, error)
 	Register(context.Context, *RegisterReq) (*RegisterReply, error)
+	// ResetPassword 通过短信验证码重置密码
+	ResetPassword(context.Context, *ResetPasswordReq) (*emptypb.Empty, error)
+	SelectCart(context.Context, *SelectCartReq) (*emptypb.Empty, error)
+	// SendSmsCode 发送短信验证码
+	SendSmsCode(context.Context, *SendSmsCodeReq) (*SendSmsCodeReply, error)
+	SetDefaultAddress(context.Context, *AddressIdReq) (*emptypb.Empty, error)
+	// SmsLogin 短信验证码登录，只能登录已注册的手机号
+	SmsLogin(context.Context, *SmsLoginReq) (*RegisterReply, error)
+	SubCategory(context.Context, *SubCategoryReq) (*SubCategoryReply, error)
+	UpdateAddress(context.Context, *AddressReq) (*emptypb.Empty, error)
+	UpdateCart(context.Context, *CartReq) (*emptypb.Empty, error)
+	UpdateGuestCart(context.Context, *GuestCartReq) (*emptypb.Empty, error)
+	// UpdateProfile 修改个人资料，只更新请求中出现的字段
+	UpdateProfile(context.Context, *UpdateProfileReq) (*UserDetailResponse, error)
+	// VerifySmsCode 校验短信验证码，校验通过后验证码仍然有效，提交注册等表单时才会使用掉
+	VerifySmsCode(context.Context, *VerifySmsCodeReq) (*emptypb.Empty, error)
 }
 
 func RegisterLushopHTTPServer(s *http.Server, srv LushopHTTPServer) {
 	r := s.Route("/")
 	r.POST("/api/user/register", _Lushop_Register0_HTTP_Handler(srv))
 	r.POST("/api/user/login", _Lushop_Login0_HTTP_Handler(srv))
+	r.POST("/api/user/sms-login", _Lushop_SmsLogin0_HTTP_Handler(srv))
+	r.POST("/api/sms/send", _Lushop_SendSmsCode0_HTTP_Handler(srv))
+	r.POST("/api/sms/verify", _Lushop_VerifySmsCode0_HTTP_Handler(srv))
+	r.PUT("/api/user/password", _Lushop_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/api/user/password/reset", _Lushop_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/api/user/refresh", _Lushop_Refresh0_HTTP_Handler(srv))
+	r.POST("/api/user/logout", _Lushop_Logout0_HTTP_Handler(srv))
 	r.POST("/api/user/captcha", _Lushop_Captcha0_HTTP_Handler(srv))
 	r.GET("/api/user/detail", _Lushop_Detail0_HTTP_Handler(srv))
+	r.POST("/api/user/account/delete", _Lushop_DeleteAccount0_HTTP_Handler(srv))
+	r.GET("/api/user/export", _Lushop_ExportData0_HTTP_Handler(srv))
+	r.PUT("/api/user/profile", _Lushop_UpdateProfile0_HTTP_Handler(srv))
+	r.GET("/api/category", _Lushop_CategoryTree0_HTTP_Handler(srv))
+	r.GET("/api/category/{id}", _Lushop_SubCategory0_HTTP_Handler(srv))
+	r.GET("/api/brand", _Lushop_BrandList0_HTTP_Handler(srv))
+	r.GET("/api/goods", _Lushop_GoodsSearch0_HTTP_Handler(srv))
+	r.GET("/api/goods/{id}", _Lushop_GoodsDetail0_HTTP_Handler(srv))
+	r.GET("/api/goods/{id}/sku", _Lushop_GoodsSkuList0_HTTP_Handler(srv))
+	r.POST("/api/admin/category", _Lushop_AdminCreateCategory0_HTTP_Handler(srv))
+	r.PUT("/api/admin/category/{id}", _Lushop_AdminUpdateCategory0_HTTP_Handler(srv))
+	r.DELETE("/api/admin/category/{id}", _Lushop_AdminDeleteCategory0_HTTP_Handler(srv))
+	r.POST("/api/admin/brand", _Lushop_AdminCreateBrand0_HTTP_Handler(srv))
+	r.PUT("/api/admin/brand/{id}", _Lushop_AdminUpdateBrand0_HTTP_Handler(srv))
+	r.DELETE("/api/admin/brand/{id}", _Lushop_AdminDeleteBrand0_HTTP_Handler(srv))
+	r.POST("/api/admin/goods", _Lushop_AdminCreateGoods0_HTTP_Handler(srv))
+	r.PUT("/api/admin/sku/{skuId}/price", _Lushop_AdminChangeSkuPrice0_HTTP_Handler(srv))
+	r.PUT("/api/admin/sku/{skuId}/limit", _Lushop_AdminSkuPurchaseLimit0_HTTP_Handler(srv))
+	r.GET("/api/admin/user", _Lushop_AdminUserList0_HTTP_Handler(srv))
+	r.GET("/api/admin/user/{id}", _Lushop_AdminUserDetail0_HTTP_Handler(srv))
+	r.PUT("/api/admin/user/{id}/disabled", _Lushop_AdminSetUserDisabled0_HTTP_Handler(srv))
+	r.PUT("/api/admin/user/{id}/role", _Lushop_AdminChangeUserRole0_HTTP_Handler(srv))
+	r.POST("/api/admin/login/unlock", _Lushop_AdminUnlockLogin0_HTTP_Handler(srv))
+	r.GET("/api/admin/login/lock-event", _Lushop_AdminLockEventList0_HTTP_Handler(srv))
+	r.GET("/api/address", _Lushop_ListAddress0_HTTP_Handler(srv))
+	r.POST("/api/address", _Lushop_CreateAddress0_HTTP_Handler(srv))
+	r.PUT("/api/address/{id}", _Lushop_UpdateAddress0_HTTP_Handler(srv))
+	r.DELETE("/api/address/{id}", _Lushop_DeleteAddress0_HTTP_Handler(srv))
+	r.PUT("/api/address/{id}/default", _Lushop_SetDefaultAddress0_HTTP_Handler(srv))
+	r.GET("/api/cart", _Lushop_ListCart0_HTTP_Handler(srv))
+	r.POST("/api/cart", _Lushop_CreateCart0_HTTP_Handler(srv))
+	r.PUT("/api/cart", _Lushop_UpdateCart0_HTTP_Handler(srv))
+	r.DELETE("/api/cart", _Lushop_DeleteCart0_HTTP_Handler(srv))
+	r.PUT("/api/cart/select", _Lushop_SelectCart0_HTTP_Handler(srv))
+	r.POST("/api/cart/guest/token", _Lushop_GuestToken0_HTTP_Handler(srv))
+	r.GET("/api/cart/guest", _Lushop_ListGuestCart0_HTTP_Handler(srv))
+	r.POST("/api/cart/guest", _Lushop_CreateGuestCart0_HTTP_Handler(srv))
+	r.PUT("/api/cart/guest", _Lushop_UpdateGuestCart0_HTTP_Handler(srv))
+	r.DELETE("/api/cart/guest", _Lushop_DeleteGuestCart0_HTTP_Handler(srv))
 }
 
 func _Lushop_Register0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
@@ -84,6 +239,160 @@ func _Lushop_Login0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) er
 	}
 }
 
+func _Lushop_SmsLogin0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SmsLoginReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopSmsLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SmsLogin(ctx, req.(*SmsLoginReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_SendSmsCode0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendSmsCodeReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopSendSmsCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendSmsCode(ctx, req.(*SendSmsCodeReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendSmsCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_VerifySmsCode0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifySmsCodeReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopVerifySmsCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifySmsCode(ctx, req.(*VerifySmsCodeReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_ChangePassword0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_ResetPassword0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_Refresh0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopRefresh)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Refresh(ctx, req.(*RefreshReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RegisterReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_Logout0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Lushop_Captcha0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in emptypb.Empty
@@ -125,65 +434,1592 @@ func _Lushop_Detail0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) e
 	}
 }
 
-type LushopHTTPClient interface {
-	Captcha(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CaptchaReply, err error)
-	Detail(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *UserDetailResponse, err error)
-	Login(ctx context.Context, req *LoginReq, opts ...http.CallOption) (rsp *RegisterReply, err error)
-	Register(ctx context.Context, req *RegisterReq, opts ...http.CallOption) (rsp *RegisterReply, err error)
+func _Lushop_DeleteAccount0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAccountReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopDeleteAccount)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAccount(ctx, req.(*DeleteAccountReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
 }
 
-type LushopHTTPClientImpl struct {
-	cc *http.Client
+func _Lushop_ExportData0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopExportData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportData(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserDataExport)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewLushopHTTPClient(client *http.Client) LushopHTTPClient {
-	return &LushopHTTPClientImpl{client}
+func _Lushop_UpdateProfile0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateProfileReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopUpdateProfile)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateProfile(ctx, req.(*UpdateProfileReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserDetailResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *LushopHTTPClientImpl) Captcha(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*CaptchaReply, error) {
-	var out CaptchaReply
-	pattern := "/api/user/captcha"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationLushopCaptcha))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Lushop_CategoryTree0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopCategoryTree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CategoryTree(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryTreeReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *LushopHTTPClientImpl) Detail(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*UserDetailResponse, error) {
-	var out UserDetailResponse
-	pattern := "/api/user/detail"
-	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationLushopDetail))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Lushop_SubCategory0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SubCategoryReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopSubCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SubCategory(ctx, req.(*SubCategoryReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SubCategoryReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *LushopHTTPClientImpl) Login(ctx context.Context, in *LoginReq, opts ...http.CallOption) (*RegisterReply, error) {
-	var out RegisterReply
-	pattern := "/api/user/login"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationLushopLogin))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _Lushop_BrandList0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BrandListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopBrandList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BrandList(ctx, req.(*BrandListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BrandListReply)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *LushopHTTPClientImpl) Register(ctx context.Context, in *RegisterReq, opts ...http.CallOption) (*RegisterReply, error) {
-	var out RegisterReply
-	pattern := "/api/user/register"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationLushopRegister))
+func _Lushop_GoodsSearch0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsSearchReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopGoodsSearch)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoodsSearch(ctx, req.(*GoodsSearchReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_GoodsDetail0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsDetailReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopGoodsDetail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoodsDetail(ctx, req.(*GoodsDetailReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GoodsItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_GoodsSkuList0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GoodsDetailReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopGoodsSkuList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GoodsSkuList(ctx, req.(*GoodsDetailReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SkuListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminCreateCategory0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCategoryReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminCreateCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCreateCategory(ctx, req.(*AdminCategoryReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CategoryItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminUpdateCategory0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCategoryReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminUpdateCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUpdateCategory(ctx, req.(*AdminCategoryReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminDeleteCategory0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminIdReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminDeleteCategory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDeleteCategory(ctx, req.(*AdminIdReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminCreateBrand0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminBrandReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminCreateBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCreateBrand(ctx, req.(*AdminBrandReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BrandItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminUpdateBrand0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminBrandReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminUpdateBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUpdateBrand(ctx, req.(*AdminBrandReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminDeleteBrand0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminIdReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminDeleteBrand)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDeleteBrand(ctx, req.(*AdminIdReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminCreateGoods0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminGoodsReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminCreateGoods)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCreateGoods(ctx, req.(*AdminGoodsReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminIdReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminChangeSkuPrice0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSkuPriceReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminChangeSkuPrice)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminChangeSkuPrice(ctx, req.(*AdminSkuPriceReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSkuPriceReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminSkuPurchaseLimit0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSkuLimitReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminSkuPurchaseLimit)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSkuPurchaseLimit(ctx, req.(*AdminSkuLimitReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminUserList0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminUserList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserList(ctx, req.(*AdminUserListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUserListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminUserDetail0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminIdReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminUserDetail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUserDetail(ctx, req.(*AdminIdReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserDetailResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminSetUserDisabled0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserDisabledReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminSetUserDisabled)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSetUserDisabled(ctx, req.(*AdminUserDisabledReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminChangeUserRole0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUserRoleReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminChangeUserRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminChangeUserRole(ctx, req.(*AdminUserRoleReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminUnlockLogin0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUnlockLoginReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminUnlockLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUnlockLogin(ctx, req.(*AdminUnlockLoginReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_AdminLockEventList0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminLockEventListReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopAdminLockEventList)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminLockEventList(ctx, req.(*AdminLockEventListReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminLockEventListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_ListAddress0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopListAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAddress(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddressListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_CreateAddress0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddressReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopCreateAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAddress(ctx, req.(*AddressReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AddressItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_UpdateAddress0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddressReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopUpdateAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateAddress(ctx, req.(*AddressReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_DeleteAddress0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddressIdReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopDeleteAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAddress(ctx, req.(*AddressIdReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_SetDefaultAddress0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AddressIdReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopSetDefaultAddress)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetDefaultAddress(ctx, req.(*AddressIdReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_ListCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopListCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListCart(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CartListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_CreateCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CartReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopCreateCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateCart(ctx, req.(*CartReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CartItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_UpdateCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CartReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopUpdateCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateCart(ctx, req.(*CartReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_DeleteCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCartReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopDeleteCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteCart(ctx, req.(*DeleteCartReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_SelectCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SelectCartReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopSelectCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SelectCart(ctx, req.(*SelectCartReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_GuestToken0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopGuestToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GuestToken(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GuestTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_ListGuestCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopListGuestCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListGuestCart(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CartListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_CreateGuestCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GuestCartReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopCreateGuestCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateGuestCart(ctx, req.(*GuestCartReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CartItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_UpdateGuestCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GuestCartReq
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopUpdateGuestCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateGuestCart(ctx, req.(*GuestCartReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Lushop_DeleteGuestCart0_HTTP_Handler(srv LushopHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteCartReq
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLushopDeleteGuestCart)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteGuestCart(ctx, req.(*DeleteCartReq))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+type LushopHTTPClient interface {
+	AdminChangeSkuPrice(ctx context.Context, req *AdminSkuPriceReq, opts ...http.CallOption) (rsp *AdminSkuPriceReply, err error)
+	AdminChangeUserRole(ctx context.Context, req *AdminUserRoleReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AdminCreateBrand(ctx context.Context, req *AdminBrandReq, opts ...http.CallOption) (rsp *BrandItem, err error)
+	// AdminCreateCategory 后台管理接口，默认只允许管理员访问，可以在配置 auth.policies 中按接口修改允许的角色
+	AdminCreateCategory(ctx context.Context, req *AdminCategoryReq, opts ...http.CallOption) (rsp *CategoryItem, err error)
+	AdminCreateGoods(ctx context.Context, req *AdminGoodsReq, opts ...http.CallOption) (rsp *AdminIdReply, err error)
+	AdminDeleteBrand(ctx context.Context, req *AdminIdReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AdminDeleteCategory(ctx context.Context, req *AdminIdReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AdminLockEventList(ctx context.Context, req *AdminLockEventListReq, opts ...http.CallOption) (rsp *AdminLockEventListReply, err error)
+	// AdminSetUserDisabled 禁用或启用账号、修改角色，用户之前签发的 token 全部失效
+	AdminSetUserDisabled(ctx context.Context, req *AdminUserDisabledReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AdminSkuPurchaseLimit(ctx context.Context, req *AdminSkuLimitReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// AdminUnlockLogin 登录锁定，手动解锁并查看锁定记录
+	AdminUnlockLogin(ctx context.Context, req *AdminUnlockLoginReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AdminUpdateBrand(ctx context.Context, req *AdminBrandReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AdminUpdateCategory(ctx context.Context, req *AdminCategoryReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	AdminUserDetail(ctx context.Context, req *AdminIdReq, opts ...http.CallOption) (rsp *UserDetailResponse, err error)
+	AdminUserList(ctx context.Context, req *AdminUserListReq, opts ...http.CallOption) (rsp *AdminUserListReply, err error)
+	BrandList(ctx context.Context, req *BrandListReq, opts ...http.CallOption) (rsp *BrandListReply, err error)
+	Captcha(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CaptchaReply, err error)
+	// CategoryTree 商品分类、品牌和商品，不需要登录
+	CategoryTree(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CategoryTreeReply, err error)
+	// ChangePassword 修改密码，之前签发的 token 全部失效，返回新的 token
+	ChangePassword(ctx context.Context, req *ChangePasswordReq, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	CreateAddress(ctx context.Context, req *AddressReq, opts ...http.CallOption) (rsp *AddressItem, err error)
+	CreateCart(ctx context.Context, req *CartReq, opts ...http.CallOption) (rsp *CartItem, err error)
+	CreateGuestCart(ctx context.Context, req *GuestCartReq, opts ...http.CallOption) (rsp *CartItem, err error)
+	// DeleteAccount 注销账号，需要验证密码，注销后手机号和昵称被匿名化，购物车被清空
+	DeleteAccount(ctx context.Context, req *DeleteAccountReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteAddress(ctx context.Context, req *AddressIdReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteCart(ctx context.Context, req *DeleteCartReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	DeleteGuestCart(ctx context.Context, req *DeleteCartReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	Detail(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *UserDetailResponse, err error)
+	// ExportData 导出个人数据，返回 JSON 附件
+	ExportData(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *UserDataExport, err error)
+	GoodsDetail(ctx context.Context, req *GoodsDetailReq, opts ...http.CallOption) (rsp *GoodsItem, err error)
+	GoodsSearch(ctx context.Context, req *GoodsSearchReq, opts ...http.CallOption) (rsp *GoodsListReply, err error)
+	GoodsSkuList(ctx context.Context, req *GoodsDetailReq, opts ...http.CallOption) (rsp *SkuListReply, err error)
+	// GuestToken 游客购物车，请求头 X-Device-Token 携带网关签发的设备 token，登录或注册后合并到用户购物车
+	GuestToken(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *GuestTokenReply, err error)
+	// ListAddress 用户收货地址，用户ID从 token 中获取
+	ListAddress(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *AddressListReply, err error)
+	// ListCart 用户购物车，用户ID从 token 中获取
+	ListCart(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CartListReply, err error)
+	ListGuestCart(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *CartListReply, err error)
+	Login(ctx context.Context, req *LoginReq, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	// Logout 退出登录，当前 token 和 refresh token 都失效
+	Logout(ctx context.Context, req *LogoutReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// Refresh 使用 refresh token 换取新的 token，旧的 refresh token 失效
+	Refresh(ctx context.Context, req *RefreshReq, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	Register(ctx context.Context, req *RegisterReq, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	// ResetPassword 通过短信验证码重置密码
+	ResetPassword(ctx context.Context, req *ResetPasswordReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	SelectCart(ctx context.Context, req *SelectCartReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// SendSmsCode 发送短信验证码
+	SendSmsCode(ctx context.Context, req *SendSmsCodeReq, opts ...http.CallOption) (rsp *SendSmsCodeReply, err error)
+	SetDefaultAddress(ctx context.Context, req *AddressIdReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// SmsLogin 短信验证码登录，只能登录已注册的手机号
+	SmsLogin(ctx context.Context, req *SmsLoginReq, opts ...http.CallOption) (rsp *RegisterReply, err error)
+	SubCategory(ctx context.Context, req *SubCategoryReq, opts ...http.CallOption) (rsp *SubCategoryReply, err error)
+	UpdateAddress(ctx context.Context, req *AddressReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UpdateCart(ctx context.Context, req *CartReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	UpdateGuestCart(ctx context.Context, req *GuestCartReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// UpdateProfile 修改个人资料，只更新请求中出现的字段
+	UpdateProfile(ctx context.Context, req *UpdateProfileReq, opts ...http.CallOption) (rsp *UserDetailResponse, err error)
+	// VerifySmsCode 校验短信验证码，校验通过后验证码仍然有效，提交注册等表单时才会使用掉
+	VerifySmsCode(ctx context.Context, req *VerifySmsCodeReq, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+}
+
+type LushopHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewLushopHTTPClient(client *http.Client) LushopHTTPClient {
+	return &LushopHTTPClientImpl{client}
+}
+
+func (c *LushopHTTPClientImpl) AdminChangeSkuPrice(ctx context.Context, in *AdminSkuPriceReq, opts ...http.CallOption) (*AdminSkuPriceReply, error) {
+	var out AdminSkuPriceReply
+	pattern := "/api/admin/sku/{skuId}/price"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminChangeSkuPrice))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminChangeUserRole(ctx context.Context, in *AdminUserRoleReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/user/{id}/role"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminChangeUserRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminCreateBrand(ctx context.Context, in *AdminBrandReq, opts ...http.CallOption) (*BrandItem, error) {
+	var out BrandItem
+	pattern := "/api/admin/brand"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminCreateBrand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminCreateCategory 后台管理接口，默认只允许管理员访问，可以在配置 auth.policies 中按接口修改允许的角色
+func (c *LushopHTTPClientImpl) AdminCreateCategory(ctx context.Context, in *AdminCategoryReq, opts ...http.CallOption) (*CategoryItem, error) {
+	var out CategoryItem
+	pattern := "/api/admin/category"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminCreateCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminCreateGoods(ctx context.Context, in *AdminGoodsReq, opts ...http.CallOption) (*AdminIdReply, error) {
+	var out AdminIdReply
+	pattern := "/api/admin/goods"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminCreateGoods))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminDeleteBrand(ctx context.Context, in *AdminIdReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/brand/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopAdminDeleteBrand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminDeleteCategory(ctx context.Context, in *AdminIdReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/category/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopAdminDeleteCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminLockEventList(ctx context.Context, in *AdminLockEventListReq, opts ...http.CallOption) (*AdminLockEventListReply, error) {
+	var out AdminLockEventListReply
+	pattern := "/api/admin/login/lock-event"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopAdminLockEventList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminSetUserDisabled 禁用或启用账号、修改角色，用户之前签发的 token 全部失效
+func (c *LushopHTTPClientImpl) AdminSetUserDisabled(ctx context.Context, in *AdminUserDisabledReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/user/{id}/disabled"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminSetUserDisabled))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminSkuPurchaseLimit(ctx context.Context, in *AdminSkuLimitReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/sku/{skuId}/limit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminSkuPurchaseLimit))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdminUnlockLogin 登录锁定，手动解锁并查看锁定记录
+func (c *LushopHTTPClientImpl) AdminUnlockLogin(ctx context.Context, in *AdminUnlockLoginReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/login/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminUnlockLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminUpdateBrand(ctx context.Context, in *AdminBrandReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/brand/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminUpdateBrand))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminUpdateCategory(ctx context.Context, in *AdminCategoryReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/admin/category/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopAdminUpdateCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminUserDetail(ctx context.Context, in *AdminIdReq, opts ...http.CallOption) (*UserDetailResponse, error) {
+	var out UserDetailResponse
+	pattern := "/api/admin/user/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopAdminUserDetail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) AdminUserList(ctx context.Context, in *AdminUserListReq, opts ...http.CallOption) (*AdminUserListReply, error) {
+	var out AdminUserListReply
+	pattern := "/api/admin/user"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopAdminUserList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) BrandList(ctx context.Context, in *BrandListReq, opts ...http.CallOption) (*BrandListReply, error) {
+	var out BrandListReply
+	pattern := "/api/brand"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopBrandList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) Captcha(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*CaptchaReply, error) {
+	var out CaptchaReply
+	pattern := "/api/user/captcha"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopCaptcha))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CategoryTree 商品分类、品牌和商品，不需要登录
+func (c *LushopHTTPClientImpl) CategoryTree(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*CategoryTreeReply, error) {
+	var out CategoryTreeReply
+	pattern := "/api/category"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopCategoryTree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ChangePassword 修改密码，之前签发的 token 全部失效，返回新的 token
+func (c *LushopHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...http.CallOption) (*RegisterReply, error) {
+	var out RegisterReply
+	pattern := "/api/user/password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) CreateAddress(ctx context.Context, in *AddressReq, opts ...http.CallOption) (*AddressItem, error) {
+	var out AddressItem
+	pattern := "/api/address"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopCreateAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) CreateCart(ctx context.Context, in *CartReq, opts ...http.CallOption) (*CartItem, error) {
+	var out CartItem
+	pattern := "/api/cart"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopCreateCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) CreateGuestCart(ctx context.Context, in *GuestCartReq, opts ...http.CallOption) (*CartItem, error) {
+	var out CartItem
+	pattern := "/api/cart/guest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopCreateGuestCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteAccount 注销账号，需要验证密码，注销后手机号和昵称被匿名化，购物车被清空
+func (c *LushopHTTPClientImpl) DeleteAccount(ctx context.Context, in *DeleteAccountReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/user/account/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopDeleteAccount))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) DeleteAddress(ctx context.Context, in *AddressIdReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/address/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopDeleteAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) DeleteCart(ctx context.Context, in *DeleteCartReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/cart"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopDeleteCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) DeleteGuestCart(ctx context.Context, in *DeleteCartReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/cart/guest"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopDeleteGuestCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) Detail(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*UserDetailResponse, error) {
+	var out UserDetailResponse
+	pattern := "/api/user/detail"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopDetail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportData 导出个人数据，返回 JSON 附件
+func (c *LushopHTTPClientImpl) ExportData(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*UserDataExport, error) {
+	var out UserDataExport
+	pattern := "/api/user/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopExportData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) GoodsDetail(ctx context.Context, in *GoodsDetailReq, opts ...http.CallOption) (*GoodsItem, error) {
+	var out GoodsItem
+	pattern := "/api/goods/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopGoodsDetail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) GoodsSearch(ctx context.Context, in *GoodsSearchReq, opts ...http.CallOption) (*GoodsListReply, error) {
+	var out GoodsListReply
+	pattern := "/api/goods"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopGoodsSearch))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) GoodsSkuList(ctx context.Context, in *GoodsDetailReq, opts ...http.CallOption) (*SkuListReply, error) {
+	var out SkuListReply
+	pattern := "/api/goods/{id}/sku"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopGoodsSkuList))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GuestToken 游客购物车，请求头 X-Device-Token 携带网关签发的设备 token，登录或注册后合并到用户购物车
+func (c *LushopHTTPClientImpl) GuestToken(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*GuestTokenReply, error) {
+	var out GuestTokenReply
+	pattern := "/api/cart/guest/token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopGuestToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAddress 用户收货地址，用户ID从 token 中获取
+func (c *LushopHTTPClientImpl) ListAddress(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*AddressListReply, error) {
+	var out AddressListReply
+	pattern := "/api/address"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopListAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCart 用户购物车，用户ID从 token 中获取
+func (c *LushopHTTPClientImpl) ListCart(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*CartListReply, error) {
+	var out CartListReply
+	pattern := "/api/cart"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopListCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) ListGuestCart(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*CartListReply, error) {
+	var out CartListReply
+	pattern := "/api/cart/guest"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopListGuestCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) Login(ctx context.Context, in *LoginReq, opts ...http.CallOption) (*RegisterReply, error) {
+	var out RegisterReply
+	pattern := "/api/user/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Logout 退出登录，当前 token 和 refresh token 都失效
+func (c *LushopHTTPClientImpl) Logout(ctx context.Context, in *LogoutReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/user/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Refresh 使用 refresh token 换取新的 token，旧的 refresh token 失效
+func (c *LushopHTTPClientImpl) Refresh(ctx context.Context, in *RefreshReq, opts ...http.CallOption) (*RegisterReply, error) {
+	var out RegisterReply
+	pattern := "/api/user/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopRefresh))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) Register(ctx context.Context, in *RegisterReq, opts ...http.CallOption) (*RegisterReply, error) {
+	var out RegisterReply
+	pattern := "/api/user/register"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopRegister))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetPassword 通过短信验证码重置密码
+func (c *LushopHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/user/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) SelectCart(ctx context.Context, in *SelectCartReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/cart/select"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopSelectCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendSmsCode 发送短信验证码
+func (c *LushopHTTPClientImpl) SendSmsCode(ctx context.Context, in *SendSmsCodeReq, opts ...http.CallOption) (*SendSmsCodeReply, error) {
+	var out SendSmsCodeReply
+	pattern := "/api/sms/send"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopSendSmsCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) SetDefaultAddress(ctx context.Context, in *AddressIdReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/address/{id}/default"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopSetDefaultAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SmsLogin 短信验证码登录，只能登录已注册的手机号
+func (c *LushopHTTPClientImpl) SmsLogin(ctx context.Context, in *SmsLoginReq, opts ...http.CallOption) (*RegisterReply, error) {
+	var out RegisterReply
+	pattern := "/api/user/sms-login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopSmsLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) SubCategory(ctx context.Context, in *SubCategoryReq, opts ...http.CallOption) (*SubCategoryReply, error) {
+	var out SubCategoryReply
+	pattern := "/api/category/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLushopSubCategory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) UpdateAddress(ctx context.Context, in *AddressReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/address/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopUpdateAddress))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) UpdateCart(ctx context.Context, in *CartReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/cart"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopUpdateCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *LushopHTTPClientImpl) UpdateGuestCart(ctx context.Context, in *GuestCartReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/cart/guest"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopUpdateGuestCart))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProfile 修改个人资料，只更新请求中出现的字段
+func (c *LushopHTTPClientImpl) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...http.CallOption) (*UserDetailResponse, error) {
+	var out UserDetailResponse
+	pattern := "/api/user/profile"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopUpdateProfile))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifySmsCode 校验短信验证码，校验通过后验证码仍然有效，提交注册等表单时才会使用掉
+func (c *LushopHTTPClientImpl) VerifySmsCode(ctx context.Context, in *VerifySmsCodeReq, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/api/sms/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLushopVerifySmsCode))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
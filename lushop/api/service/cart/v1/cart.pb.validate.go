@@ -0,0 +1,3220 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: service/cart/v1/cart.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CartInfoReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartInfoReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartInfoReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CartInfoReplyMultiError, or
+// nil if none found.
+func (m *CartInfoReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartInfoReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for GoodsId
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for GoodsName
+
+	// no validation rules for SkuId
+
+	// no validation rules for GoodsPrice
+
+	// no validation rules for GoodsNum
+
+	// no validation rules for IsSelect
+
+	// no validation rules for SkuName
+
+	// no validation rules for CurrentPrice
+
+	// no validation rules for OnSale
+
+	// no validation rules for Stock
+
+	// no validation rules for PriceChanged
+
+	// no validation rules for OutOfStock
+
+	// no validation rules for OverLimit
+
+	if len(errors) > 0 {
+		return CartInfoReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartInfoReplyMultiError is an error wrapping multiple validation errors
+// returned by CartInfoReply.ValidateAll() if the designated constraints
+// aren't met.
+type CartInfoReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartInfoReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartInfoReplyMultiError) AllErrors() []error { return m }
+
+// CartInfoReplyValidationError is the validation error returned by
+// CartInfoReply.Validate if the designated constraints aren't met.
+type CartInfoReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartInfoReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartInfoReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartInfoReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartInfoReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartInfoReplyValidationError) ErrorName() string { return "CartInfoReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartInfoReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartInfoReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartInfoReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartInfoReplyValidationError{}
+
+// Validate checks the field values on CreateCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCartRequestMultiError, or nil if none found.
+func (m *CreateCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetUserId() <= 0 {
+		err := CreateCartRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for GoodsId
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for GoodsName
+
+	if m.GetSkuId() <= 0 {
+		err := CreateCartRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for GoodsPrice
+
+	if m.GetGoodsNum() <= 0 {
+		err := CreateCartRequestValidationError{
+			field:  "GoodsNum",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetIsSelect() != true {
+		err := CreateCartRequestValidationError{
+			field:  "IsSelect",
+			reason: "value must equal true",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCartRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCartRequestMultiError) AllErrors() []error { return m }
+
+// CreateCartRequestValidationError is the validation error returned by
+// CreateCartRequest.Validate if the designated constraints aren't met.
+type CreateCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCartRequestValidationError) ErrorName() string {
+	return "CreateCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCartRequestValidationError{}
+
+// Validate checks the field values on UpdateCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCartRequestMultiError, or nil if none found.
+func (m *UpdateCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UpdateCartRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSkuId() <= 0 {
+		err := UpdateCartRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsNum() <= 0 {
+		err := UpdateCartRequestValidationError{
+			field:  "GoodsNum",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCartRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCartRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCartRequestValidationError is the validation error returned by
+// UpdateCartRequest.Validate if the designated constraints aren't met.
+type UpdateCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCartRequestValidationError) ErrorName() string {
+	return "UpdateCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCartRequestValidationError{}
+
+// Validate checks the field values on UpdateCartReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCartReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCartReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCartReplyMultiError, or nil if none found.
+func (m *UpdateCartReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCartReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateCartReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCartReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateCartReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateCartReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCartReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCartReplyMultiError) AllErrors() []error { return m }
+
+// UpdateCartReplyValidationError is the validation error returned by
+// UpdateCartReply.Validate if the designated constraints aren't met.
+type UpdateCartReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCartReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCartReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCartReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCartReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCartReplyValidationError) ErrorName() string { return "UpdateCartReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateCartReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCartReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCartReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCartReplyValidationError{}
+
+// Validate checks the field values on CheckResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckResponseMultiError, or
+// nil if none found.
+func (m *CheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return CheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResponseMultiError is an error wrapping multiple validation errors
+// returned by CheckResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResponseMultiError) AllErrors() []error { return m }
+
+// CheckResponseValidationError is the validation error returned by
+// CheckResponse.Validate if the designated constraints aren't met.
+type CheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResponseValidationError) ErrorName() string { return "CheckResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResponseValidationError{}
+
+// Validate checks the field values on DeleteCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCartRequestMultiError, or nil if none found.
+func (m *DeleteCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := DeleteCartRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSkuIds()) < 1 {
+		err := DeleteCartRequestValidationError{
+			field:  "SkuIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCartRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCartRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCartRequestValidationError is the validation error returned by
+// DeleteCartRequest.Validate if the designated constraints aren't met.
+type DeleteCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCartRequestValidationError) ErrorName() string {
+	return "DeleteCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCartRequestValidationError{}
+
+// Validate checks the field values on DeleteCartReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCartReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCartReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCartReplyMultiError, or nil if none found.
+func (m *DeleteCartReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCartReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCartReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCartReplyMultiError is an error wrapping multiple validation errors
+// returned by DeleteCartReply.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteCartReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCartReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCartReplyMultiError) AllErrors() []error { return m }
+
+// DeleteCartReplyValidationError is the validation error returned by
+// DeleteCartReply.Validate if the designated constraints aren't met.
+type DeleteCartReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCartReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCartReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCartReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCartReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCartReplyValidationError) ErrorName() string { return "DeleteCartReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteCartReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCartReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCartReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCartReplyValidationError{}
+
+// Validate checks the field values on GetCartRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCartRequestMultiError,
+// or nil if none found.
+func (m *GetCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCartRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCartRequestMultiError) AllErrors() []error { return m }
+
+// GetCartRequestValidationError is the validation error returned by
+// GetCartRequest.Validate if the designated constraints aren't met.
+type GetCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCartRequestValidationError) ErrorName() string { return "GetCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCartRequestValidationError{}
+
+// Validate checks the field values on GetCartReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetCartReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCartReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetCartReplyMultiError, or
+// nil if none found.
+func (m *GetCartReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCartReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCartReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCartReplyMultiError is an error wrapping multiple validation errors
+// returned by GetCartReply.ValidateAll() if the designated constraints aren't met.
+type GetCartReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCartReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCartReplyMultiError) AllErrors() []error { return m }
+
+// GetCartReplyValidationError is the validation error returned by
+// GetCartReply.Validate if the designated constraints aren't met.
+type GetCartReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCartReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCartReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCartReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCartReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCartReplyValidationError) ErrorName() string { return "GetCartReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetCartReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCartReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCartReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCartReplyValidationError{}
+
+// Validate checks the field values on ClearCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClearCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearCartRequestMultiError, or nil if none found.
+func (m *ClearCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ClearCartRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearCartRequestMultiError is an error wrapping multiple validation errors
+// returned by ClearCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClearCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearCartRequestMultiError) AllErrors() []error { return m }
+
+// ClearCartRequestValidationError is the validation error returned by
+// ClearCartRequest.Validate if the designated constraints aren't met.
+type ClearCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearCartRequestValidationError) ErrorName() string { return "ClearCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClearCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearCartRequestValidationError{}
+
+// Validate checks the field values on SelectCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SelectCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelectCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SelectCartRequestMultiError, or nil if none found.
+func (m *SelectCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelectCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := SelectCartRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsSelect
+
+	if len(errors) > 0 {
+		return SelectCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SelectCartRequestMultiError is an error wrapping multiple validation errors
+// returned by SelectCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SelectCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelectCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelectCartRequestMultiError) AllErrors() []error { return m }
+
+// SelectCartRequestValidationError is the validation error returned by
+// SelectCartRequest.Validate if the designated constraints aren't met.
+type SelectCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelectCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelectCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelectCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelectCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelectCartRequestValidationError) ErrorName() string {
+	return "SelectCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SelectCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelectCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelectCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelectCartRequestValidationError{}
+
+// Validate checks the field values on ListCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCartRequestMultiError, or nil if none found.
+func (m *ListCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ListCartRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCartRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCartRequestMultiError) AllErrors() []error { return m }
+
+// ListCartRequestValidationError is the validation error returned by
+// ListCartRequest.Validate if the designated constraints aren't met.
+type ListCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCartRequestValidationError) ErrorName() string { return "ListCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCartRequestValidationError{}
+
+// Validate checks the field values on CartListReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CartListReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartListReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CartListReplyMultiError, or
+// nil if none found.
+func (m *CartListReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartListReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CartListReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CartListReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CartListReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CartListReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartListReplyMultiError is an error wrapping multiple validation errors
+// returned by CartListReply.ValidateAll() if the designated constraints
+// aren't met.
+type CartListReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartListReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartListReplyMultiError) AllErrors() []error { return m }
+
+// CartListReplyValidationError is the validation error returned by
+// CartListReply.Validate if the designated constraints aren't met.
+type CartListReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartListReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartListReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartListReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartListReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartListReplyValidationError) ErrorName() string { return "CartListReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartListReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartListReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartListReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartListReplyValidationError{}
+
+// Validate checks the field values on GuestTokenRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GuestTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuestTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GuestTokenRequestMultiError, or nil if none found.
+func (m *GuestTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuestTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 16 || l > 64 {
+		err := GuestTokenRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 16 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GuestTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuestTokenRequestMultiError is an error wrapping multiple validation errors
+// returned by GuestTokenRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GuestTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuestTokenRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuestTokenRequestMultiError) AllErrors() []error { return m }
+
+// GuestTokenRequestValidationError is the validation error returned by
+// GuestTokenRequest.Validate if the designated constraints aren't met.
+type GuestTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuestTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuestTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuestTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuestTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuestTokenRequestValidationError) ErrorName() string {
+	return "GuestTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GuestTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuestTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuestTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuestTokenRequestValidationError{}
+
+// Validate checks the field values on GuestCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GuestCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GuestCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GuestCartRequestMultiError, or nil if none found.
+func (m *GuestCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GuestCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 16 || l > 64 {
+		err := GuestCartRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 16 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSkuId() <= 0 {
+		err := GuestCartRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsNum() <= 0 {
+		err := GuestCartRequestValidationError{
+			field:  "GoodsNum",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GuestCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GuestCartRequestMultiError is an error wrapping multiple validation errors
+// returned by GuestCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GuestCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GuestCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GuestCartRequestMultiError) AllErrors() []error { return m }
+
+// GuestCartRequestValidationError is the validation error returned by
+// GuestCartRequest.Validate if the designated constraints aren't met.
+type GuestCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GuestCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GuestCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GuestCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GuestCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GuestCartRequestValidationError) ErrorName() string { return "GuestCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GuestCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGuestCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GuestCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GuestCartRequestValidationError{}
+
+// Validate checks the field values on DeleteGuestCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteGuestCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteGuestCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteGuestCartRequestMultiError, or nil if none found.
+func (m *DeleteGuestCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteGuestCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 16 || l > 64 {
+		err := DeleteGuestCartRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 16 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSkuIds()) < 1 {
+		err := DeleteGuestCartRequestValidationError{
+			field:  "SkuIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteGuestCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteGuestCartRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteGuestCartRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteGuestCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteGuestCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteGuestCartRequestMultiError) AllErrors() []error { return m }
+
+// DeleteGuestCartRequestValidationError is the validation error returned by
+// DeleteGuestCartRequest.Validate if the designated constraints aren't met.
+type DeleteGuestCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGuestCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGuestCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGuestCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGuestCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGuestCartRequestValidationError) ErrorName() string {
+	return "DeleteGuestCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGuestCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGuestCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGuestCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGuestCartRequestValidationError{}
+
+// Validate checks the field values on MergeGuestCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MergeGuestCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeGuestCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeGuestCartRequestMultiError, or nil if none found.
+func (m *MergeGuestCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeGuestCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetToken()); l < 16 || l > 64 {
+		err := MergeGuestCartRequestValidationError{
+			field:  "Token",
+			reason: "value length must be between 16 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() <= 0 {
+		err := MergeGuestCartRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeGuestCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeGuestCartRequestMultiError is an error wrapping multiple validation
+// errors returned by MergeGuestCartRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MergeGuestCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeGuestCartRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeGuestCartRequestMultiError) AllErrors() []error { return m }
+
+// MergeGuestCartRequestValidationError is the validation error returned by
+// MergeGuestCartRequest.Validate if the designated constraints aren't met.
+type MergeGuestCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeGuestCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeGuestCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeGuestCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeGuestCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeGuestCartRequestValidationError) ErrorName() string {
+	return "MergeGuestCartRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeGuestCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeGuestCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeGuestCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeGuestCartRequestValidationError{}
+
+// Validate checks the field values on CheckoutPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckoutPreviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckoutPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckoutPreviewRequestMultiError, or nil if none found.
+func (m *CheckoutPreviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckoutPreviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := CheckoutPreviewRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CheckoutPreviewRequest_CouponCodes_Unique := make(map[string]struct{}, len(m.GetCouponCodes()))
+
+	for idx, item := range m.GetCouponCodes() {
+		_, _ = idx, item
+
+		if _, exists := _CheckoutPreviewRequest_CouponCodes_Unique[item]; exists {
+			err := CheckoutPreviewRequestValidationError{
+				field:  fmt.Sprintf("CouponCodes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CheckoutPreviewRequest_CouponCodes_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for CouponCodes[idx]
+	}
+
+	if len(errors) > 0 {
+		return CheckoutPreviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckoutPreviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckoutPreviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckoutPreviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckoutPreviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckoutPreviewRequestMultiError) AllErrors() []error { return m }
+
+// CheckoutPreviewRequestValidationError is the validation error returned by
+// CheckoutPreviewRequest.Validate if the designated constraints aren't met.
+type CheckoutPreviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckoutPreviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckoutPreviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckoutPreviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckoutPreviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckoutPreviewRequestValidationError) ErrorName() string {
+	return "CheckoutPreviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckoutPreviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckoutPreviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckoutPreviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckoutPreviewRequestValidationError{}
+
+// Validate checks the field values on CheckoutLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckoutLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckoutLine with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckoutLineMultiError, or
+// nil if none found.
+func (m *CheckoutLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckoutLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SkuId
+
+	// no validation rules for GoodsId
+
+	// no validation rules for GoodsName
+
+	// no validation rules for SkuName
+
+	// no validation rules for GoodsNum
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for Subtotal
+
+	// no validation rules for PromotionDiscount
+
+	// no validation rules for CouponDiscount
+
+	// no validation rules for Payable
+
+	// no validation rules for Points
+
+	// no validation rules for ShipFree
+
+	// no validation rules for ShipId
+
+	if len(errors) > 0 {
+		return CheckoutLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckoutLineMultiError is an error wrapping multiple validation errors
+// returned by CheckoutLine.ValidateAll() if the designated constraints aren't met.
+type CheckoutLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckoutLineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckoutLineMultiError) AllErrors() []error { return m }
+
+// CheckoutLineValidationError is the validation error returned by
+// CheckoutLine.Validate if the designated constraints aren't met.
+type CheckoutLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckoutLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckoutLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckoutLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckoutLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckoutLineValidationError) ErrorName() string { return "CheckoutLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckoutLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckoutLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckoutLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckoutLineValidationError{}
+
+// Validate checks the field values on CouponResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CouponResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CouponResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CouponResultMultiError, or
+// nil if none found.
+func (m *CouponResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CouponResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for Discount
+
+	// no validation rules for Applied
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return CouponResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CouponResultMultiError is an error wrapping multiple validation errors
+// returned by CouponResult.ValidateAll() if the designated constraints aren't met.
+type CouponResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CouponResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CouponResultMultiError) AllErrors() []error { return m }
+
+// CouponResultValidationError is the validation error returned by
+// CouponResult.Validate if the designated constraints aren't met.
+type CouponResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CouponResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CouponResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CouponResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CouponResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CouponResultValidationError) ErrorName() string { return "CouponResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CouponResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCouponResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CouponResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CouponResultValidationError{}
+
+// Validate checks the field values on CheckoutPreviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckoutPreviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckoutPreviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckoutPreviewReplyMultiError, or nil if none found.
+func (m *CheckoutPreviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckoutPreviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckoutPreviewReplyValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckoutPreviewReplyValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckoutPreviewReplyValidationError{
+					field:  fmt.Sprintf("Lines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCoupons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckoutPreviewReplyValidationError{
+						field:  fmt.Sprintf("Coupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckoutPreviewReplyValidationError{
+						field:  fmt.Sprintf("Coupons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckoutPreviewReplyValidationError{
+					field:  fmt.Sprintf("Coupons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Subtotal
+
+	// no validation rules for PromotionDiscount
+
+	// no validation rules for CouponDiscount
+
+	// no validation rules for Shipping
+
+	// no validation rules for Points
+
+	// no validation rules for Payable
+
+	if len(errors) > 0 {
+		return CheckoutPreviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckoutPreviewReplyMultiError is an error wrapping multiple validation
+// errors returned by CheckoutPreviewReply.ValidateAll() if the designated
+// constraints aren't met.
+type CheckoutPreviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckoutPreviewReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckoutPreviewReplyMultiError) AllErrors() []error { return m }
+
+// CheckoutPreviewReplyValidationError is the validation error returned by
+// CheckoutPreviewReply.Validate if the designated constraints aren't met.
+type CheckoutPreviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckoutPreviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckoutPreviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckoutPreviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckoutPreviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckoutPreviewReplyValidationError) ErrorName() string {
+	return "CheckoutPreviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckoutPreviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckoutPreviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckoutPreviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckoutPreviewReplyValidationError{}
+
+// Validate checks the field values on CartSkusRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CartSkusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CartSkusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CartSkusRequestMultiError, or nil if none found.
+func (m *CartSkusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CartSkusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := CartSkusRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSkuIds()) < 1 {
+		err := CartSkusRequestValidationError{
+			field:  "SkuIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CartSkusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CartSkusRequestMultiError is an error wrapping multiple validation errors
+// returned by CartSkusRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CartSkusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CartSkusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CartSkusRequestMultiError) AllErrors() []error { return m }
+
+// CartSkusRequestValidationError is the validation error returned by
+// CartSkusRequest.Validate if the designated constraints aren't met.
+type CartSkusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CartSkusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CartSkusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CartSkusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CartSkusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CartSkusRequestValidationError) ErrorName() string { return "CartSkusRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CartSkusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCartSkusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CartSkusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CartSkusRequestValidationError{}
+
+// Validate checks the field values on WishlistRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WishlistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WishlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WishlistRequestMultiError, or nil if none found.
+func (m *WishlistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WishlistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := WishlistRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsId() <= 0 {
+		err := WishlistRequestValidationError{
+			field:  "GoodsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WishlistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WishlistRequestMultiError is an error wrapping multiple validation errors
+// returned by WishlistRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WishlistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WishlistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WishlistRequestMultiError) AllErrors() []error { return m }
+
+// WishlistRequestValidationError is the validation error returned by
+// WishlistRequest.Validate if the designated constraints aren't met.
+type WishlistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WishlistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WishlistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WishlistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WishlistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WishlistRequestValidationError) ErrorName() string { return "WishlistRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WishlistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWishlistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WishlistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WishlistRequestValidationError{}
+
+// Validate checks the field values on RemoveWishlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveWishlistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveWishlistRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveWishlistRequestMultiError, or nil if none found.
+func (m *RemoveWishlistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveWishlistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RemoveWishlistRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetGoodsIds()) < 1 {
+		err := RemoveWishlistRequestValidationError{
+			field:  "GoodsIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveWishlistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveWishlistRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveWishlistRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveWishlistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveWishlistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveWishlistRequestMultiError) AllErrors() []error { return m }
+
+// RemoveWishlistRequestValidationError is the validation error returned by
+// RemoveWishlistRequest.Validate if the designated constraints aren't met.
+type RemoveWishlistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveWishlistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveWishlistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveWishlistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveWishlistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveWishlistRequestValidationError) ErrorName() string {
+	return "RemoveWishlistRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveWishlistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveWishlistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveWishlistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveWishlistRequestValidationError{}
+
+// Validate checks the field values on WishlistItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WishlistItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WishlistItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WishlistItemMultiError, or
+// nil if none found.
+func (m *WishlistItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WishlistItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GoodsId
+
+	// no validation rules for Name
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for Image
+
+	// no validation rules for MarketPrice
+
+	// no validation rules for OnSale
+
+	// no validation rules for FavNum
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return WishlistItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// WishlistItemMultiError is an error wrapping multiple validation errors
+// returned by WishlistItem.ValidateAll() if the designated constraints aren't met.
+type WishlistItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WishlistItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WishlistItemMultiError) AllErrors() []error { return m }
+
+// WishlistItemValidationError is the validation error returned by
+// WishlistItem.Validate if the designated constraints aren't met.
+type WishlistItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WishlistItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WishlistItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WishlistItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WishlistItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WishlistItemValidationError) ErrorName() string { return "WishlistItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WishlistItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWishlistItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WishlistItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WishlistItemValidationError{}
+
+// Validate checks the field values on WishlistReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WishlistReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WishlistReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WishlistReplyMultiError, or
+// nil if none found.
+func (m *WishlistReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WishlistReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WishlistReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WishlistReplyValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WishlistReplyValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WishlistReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// WishlistReplyMultiError is an error wrapping multiple validation errors
+// returned by WishlistReply.ValidateAll() if the designated constraints
+// aren't met.
+type WishlistReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WishlistReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WishlistReplyMultiError) AllErrors() []error { return m }
+
+// WishlistReplyValidationError is the validation error returned by
+// WishlistReply.Validate if the designated constraints aren't met.
+type WishlistReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WishlistReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WishlistReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WishlistReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WishlistReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WishlistReplyValidationError) ErrorName() string { return "WishlistReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WishlistReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWishlistReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WishlistReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WishlistReplyValidationError{}
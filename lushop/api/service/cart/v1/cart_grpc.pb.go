@@ -0,0 +1,851 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: service/cart/v1/cart.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Cart_CreateCart_FullMethodName      = "/cart.v1.Cart/CreateCart"
+	Cart_UpdateCart_FullMethodName      = "/cart.v1.Cart/UpdateCart"
+	Cart_DeleteCart_FullMethodName      = "/cart.v1.Cart/DeleteCart"
+	Cart_ClearCart_FullMethodName       = "/cart.v1.Cart/ClearCart"
+	Cart_SelectCart_FullMethodName      = "/cart.v1.Cart/SelectCart"
+	Cart_ListCart_FullMethodName        = "/cart.v1.Cart/ListCart"
+	Cart_CheckoutPreview_FullMethodName = "/cart.v1.Cart/CheckoutPreview"
+	Cart_SaveForLater_FullMethodName    = "/cart.v1.Cart/SaveForLater"
+	Cart_ListSaved_FullMethodName       = "/cart.v1.Cart/ListSaved"
+	Cart_MoveSavedToCart_FullMethodName = "/cart.v1.Cart/MoveSavedToCart"
+	Cart_DeleteSaved_FullMethodName     = "/cart.v1.Cart/DeleteSaved"
+	Cart_MoveToWishlist_FullMethodName  = "/cart.v1.Cart/MoveToWishlist"
+	Cart_AddWishlist_FullMethodName     = "/cart.v1.Cart/AddWishlist"
+	Cart_RemoveWishlist_FullMethodName  = "/cart.v1.Cart/RemoveWishlist"
+	Cart_ListWishlist_FullMethodName    = "/cart.v1.Cart/ListWishlist"
+	Cart_CreateGuestCart_FullMethodName = "/cart.v1.Cart/CreateGuestCart"
+	Cart_UpdateGuestCart_FullMethodName = "/cart.v1.Cart/UpdateGuestCart"
+	Cart_DeleteGuestCart_FullMethodName = "/cart.v1.Cart/DeleteGuestCart"
+	Cart_ListGuestCart_FullMethodName   = "/cart.v1.Cart/ListGuestCart"
+	Cart_MergeGuestCart_FullMethodName  = "/cart.v1.Cart/MergeGuestCart"
+)
+
+// CartClient is the client API for Cart service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 购物车
+type CartClient interface {
+	CreateCart(ctx context.Context, in *CreateCartRequest, opts ...grpc.CallOption) (*CartInfoReply, error)
+	UpdateCart(ctx context.Context, in *UpdateCartRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	DeleteCart(ctx context.Context, in *DeleteCartRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	SelectCart(ctx context.Context, in *SelectCartRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*CartListReply, error)
+	CheckoutPreview(ctx context.Context, in *CheckoutPreviewRequest, opts ...grpc.CallOption) (*CheckoutPreviewReply, error)
+	// 稍后购买和收藏夹
+	SaveForLater(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	ListSaved(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*CartListReply, error)
+	MoveSavedToCart(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	DeleteSaved(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	MoveToWishlist(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	AddWishlist(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	RemoveWishlist(ctx context.Context, in *RemoveWishlistRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	ListWishlist(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*WishlistReply, error)
+	// 游客购物车，使用网关签发的设备 token 区分
+	CreateGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*CartInfoReply, error)
+	UpdateGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	DeleteGuestCart(ctx context.Context, in *DeleteGuestCartRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	ListGuestCart(ctx context.Context, in *GuestTokenRequest, opts ...grpc.CallOption) (*CartListReply, error)
+	MergeGuestCart(ctx context.Context, in *MergeGuestCartRequest, opts ...grpc.CallOption) (*CartListReply, error)
+}
+
+type cartClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCartClient(cc grpc.ClientConnInterface) CartClient {
+	return &cartClient{cc}
+}
+
+func (c *cartClient) CreateCart(ctx context.Context, in *CreateCartRequest, opts ...grpc.CallOption) (*CartInfoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartInfoReply)
+	err := c.cc.Invoke(ctx, Cart_CreateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) UpdateCart(ctx context.Context, in *UpdateCartRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_UpdateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) DeleteCart(ctx context.Context, in *DeleteCartRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_DeleteCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_ClearCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) SelectCart(ctx context.Context, in *SelectCartRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_SelectCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*CartListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartListReply)
+	err := c.cc.Invoke(ctx, Cart_ListCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) CheckoutPreview(ctx context.Context, in *CheckoutPreviewRequest, opts ...grpc.CallOption) (*CheckoutPreviewReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutPreviewReply)
+	err := c.cc.Invoke(ctx, Cart_CheckoutPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) SaveForLater(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_SaveForLater_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListSaved(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*CartListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartListReply)
+	err := c.cc.Invoke(ctx, Cart_ListSaved_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MoveSavedToCart(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_MoveSavedToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) DeleteSaved(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_DeleteSaved_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MoveToWishlist(ctx context.Context, in *CartSkusRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_MoveToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) AddWishlist(ctx context.Context, in *WishlistRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_AddWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveWishlist(ctx context.Context, in *RemoveWishlistRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_RemoveWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListWishlist(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*WishlistReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WishlistReply)
+	err := c.cc.Invoke(ctx, Cart_ListWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) CreateGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*CartInfoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartInfoReply)
+	err := c.cc.Invoke(ctx, Cart_CreateGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) UpdateGuestCart(ctx context.Context, in *GuestCartRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_UpdateGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) DeleteGuestCart(ctx context.Context, in *DeleteGuestCartRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResponse)
+	err := c.cc.Invoke(ctx, Cart_DeleteGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListGuestCart(ctx context.Context, in *GuestTokenRequest, opts ...grpc.CallOption) (*CartListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartListReply)
+	err := c.cc.Invoke(ctx, Cart_ListGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MergeGuestCart(ctx context.Context, in *MergeGuestCartRequest, opts ...grpc.CallOption) (*CartListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartListReply)
+	err := c.cc.Invoke(ctx, Cart_MergeGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CartServer is the server API for Cart service.
+// All implementations must embed UnimplementedCartServer
+// for forward compatibility.
+//
+// 购物车
+type CartServer interface {
+	CreateCart(context.Context, *CreateCartRequest) (*CartInfoReply, error)
+	UpdateCart(context.Context, *UpdateCartRequest) (*CheckResponse, error)
+	DeleteCart(context.Context, *DeleteCartRequest) (*CheckResponse, error)
+	ClearCart(context.Context, *ClearCartRequest) (*CheckResponse, error)
+	SelectCart(context.Context, *SelectCartRequest) (*CheckResponse, error)
+	ListCart(context.Context, *ListCartRequest) (*CartListReply, error)
+	CheckoutPreview(context.Context, *CheckoutPreviewRequest) (*CheckoutPreviewReply, error)
+	// 稍后购买和收藏夹
+	SaveForLater(context.Context, *CartSkusRequest) (*CheckResponse, error)
+	ListSaved(context.Context, *ListCartRequest) (*CartListReply, error)
+	MoveSavedToCart(context.Context, *CartSkusRequest) (*CheckResponse, error)
+	DeleteSaved(context.Context, *CartSkusRequest) (*CheckResponse, error)
+	MoveToWishlist(context.Context, *CartSkusRequest) (*CheckResponse, error)
+	AddWishlist(context.Context, *WishlistRequest) (*CheckResponse, error)
+	RemoveWishlist(context.Context, *RemoveWishlistRequest) (*CheckResponse, error)
+	ListWishlist(context.Context, *ListCartRequest) (*WishlistReply, error)
+	// 游客购物车，使用网关签发的设备 token 区分
+	CreateGuestCart(context.Context, *GuestCartRequest) (*CartInfoReply, error)
+	UpdateGuestCart(context.Context, *GuestCartRequest) (*CheckResponse, error)
+	DeleteGuestCart(context.Context, *DeleteGuestCartRequest) (*CheckResponse, error)
+	ListGuestCart(context.Context, *GuestTokenRequest) (*CartListReply, error)
+	MergeGuestCart(context.Context, *MergeGuestCartRequest) (*CartListReply, error)
+	mustEmbedUnimplementedCartServer()
+}
+
+// UnimplementedCartServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCartServer struct{}
+
+func (UnimplementedCartServer) CreateCart(context.Context, *CreateCartRequest) (*CartInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCart not implemented")
+}
+func (UnimplementedCartServer) UpdateCart(context.Context, *UpdateCartRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCart not implemented")
+}
+func (UnimplementedCartServer) DeleteCart(context.Context, *DeleteCartRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCart not implemented")
+}
+func (UnimplementedCartServer) ClearCart(context.Context, *ClearCartRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
+func (UnimplementedCartServer) SelectCart(context.Context, *SelectCartRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectCart not implemented")
+}
+func (UnimplementedCartServer) ListCart(context.Context, *ListCartRequest) (*CartListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCart not implemented")
+}
+func (UnimplementedCartServer) CheckoutPreview(context.Context, *CheckoutPreviewRequest) (*CheckoutPreviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckoutPreview not implemented")
+}
+func (UnimplementedCartServer) SaveForLater(context.Context, *CartSkusRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveForLater not implemented")
+}
+func (UnimplementedCartServer) ListSaved(context.Context, *ListCartRequest) (*CartListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSaved not implemented")
+}
+func (UnimplementedCartServer) MoveSavedToCart(context.Context, *CartSkusRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveSavedToCart not implemented")
+}
+func (UnimplementedCartServer) DeleteSaved(context.Context, *CartSkusRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSaved not implemented")
+}
+func (UnimplementedCartServer) MoveToWishlist(context.Context, *CartSkusRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToWishlist not implemented")
+}
+func (UnimplementedCartServer) AddWishlist(context.Context, *WishlistRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWishlist not implemented")
+}
+func (UnimplementedCartServer) RemoveWishlist(context.Context, *RemoveWishlistRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWishlist not implemented")
+}
+func (UnimplementedCartServer) ListWishlist(context.Context, *ListCartRequest) (*WishlistReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWishlist not implemented")
+}
+func (UnimplementedCartServer) CreateGuestCart(context.Context, *GuestCartRequest) (*CartInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
+func (UnimplementedCartServer) UpdateGuestCart(context.Context, *GuestCartRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGuestCart not implemented")
+}
+func (UnimplementedCartServer) DeleteGuestCart(context.Context, *DeleteGuestCartRequest) (*CheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGuestCart not implemented")
+}
+func (UnimplementedCartServer) ListGuestCart(context.Context, *GuestTokenRequest) (*CartListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuestCart not implemented")
+}
+func (UnimplementedCartServer) MergeGuestCart(context.Context, *MergeGuestCartRequest) (*CartListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuestCart not implemented")
+}
+func (UnimplementedCartServer) mustEmbedUnimplementedCartServer() {}
+func (UnimplementedCartServer) testEmbeddedByValue()              {}
+
+// UnsafeCartServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CartServer will
+// result in compilation errors.
+type UnsafeCartServer interface {
+	mustEmbedUnimplementedCartServer()
+}
+
+func RegisterCartServer(s grpc.ServiceRegistrar, srv CartServer) {
+	// If the following call pancis, it indicates UnimplementedCartServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Cart_ServiceDesc, srv)
+}
+
+func _Cart_CreateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).CreateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_CreateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).CreateCart(ctx, req.(*CreateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_UpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).UpdateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_UpdateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).UpdateCart(ctx, req.(*UpdateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_DeleteCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).DeleteCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_DeleteCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).DeleteCart(ctx, req.(*DeleteCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ClearCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_SelectCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).SelectCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_SelectCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).SelectCart(ctx, req.(*SelectCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListCart(ctx, req.(*ListCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_CheckoutPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).CheckoutPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_CheckoutPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).CheckoutPreview(ctx, req.(*CheckoutPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_SaveForLater_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).SaveForLater(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_SaveForLater_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).SaveForLater(ctx, req.(*CartSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListSaved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListSaved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListSaved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListSaved(ctx, req.(*ListCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MoveSavedToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MoveSavedToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MoveSavedToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MoveSavedToCart(ctx, req.(*CartSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_DeleteSaved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).DeleteSaved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_DeleteSaved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).DeleteSaved(ctx, req.(*CartSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MoveToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MoveToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MoveToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MoveToWishlist(ctx, req.(*CartSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_AddWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).AddWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_AddWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).AddWishlist(ctx, req.(*WishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveWishlist(ctx, req.(*RemoveWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListWishlist(ctx, req.(*ListCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).CreateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_CreateGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).CreateGuestCart(ctx, req.(*GuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_UpdateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).UpdateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_UpdateGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).UpdateGuestCart(ctx, req.(*GuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_DeleteGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).DeleteGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_DeleteGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).DeleteGuestCart(ctx, req.(*DeleteGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListGuestCart(ctx, req.(*GuestTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MergeGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MergeGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MergeGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MergeGuestCart(ctx, req.(*MergeGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Cart_ServiceDesc is the grpc.ServiceDesc for Cart service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Cart_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cart.v1.Cart",
+	HandlerType: (*CartServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCart",
+			Handler:    _Cart_CreateCart_Handler,
+		},
+		{
+			MethodName: "UpdateCart",
+			Handler:    _Cart_UpdateCart_Handler,
+		},
+		{
+			MethodName: "DeleteCart",
+			Handler:    _Cart_DeleteCart_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _Cart_ClearCart_Handler,
+		},
+		{
+			MethodName: "SelectCart",
+			Handler:    _Cart_SelectCart_Handler,
+		},
+		{
+			MethodName: "ListCart",
+			Handler:    _Cart_ListCart_Handler,
+		},
+		{
+			MethodName: "CheckoutPreview",
+			Handler:    _Cart_CheckoutPreview_Handler,
+		},
+		{
+			MethodName: "SaveForLater",
+			Handler:    _Cart_SaveForLater_Handler,
+		},
+		{
+			MethodName: "ListSaved",
+			Handler:    _Cart_ListSaved_Handler,
+		},
+		{
+			MethodName: "MoveSavedToCart",
+			Handler:    _Cart_MoveSavedToCart_Handler,
+		},
+		{
+			MethodName: "DeleteSaved",
+			Handler:    _Cart_DeleteSaved_Handler,
+		},
+		{
+			MethodName: "MoveToWishlist",
+			Handler:    _Cart_MoveToWishlist_Handler,
+		},
+		{
+			MethodName: "AddWishlist",
+			Handler:    _Cart_AddWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveWishlist",
+			Handler:    _Cart_RemoveWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlist",
+			Handler:    _Cart_ListWishlist_Handler,
+		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _Cart_CreateGuestCart_Handler,
+		},
+		{
+			MethodName: "UpdateGuestCart",
+			Handler:    _Cart_UpdateGuestCart_Handler,
+		},
+		{
+			MethodName: "DeleteGuestCart",
+			Handler:    _Cart_DeleteGuestCart_Handler,
+		},
+		{
+			MethodName: "ListGuestCart",
+			Handler:    _Cart_ListGuestCart_Handler,
+		},
+		{
+			MethodName: "MergeGuestCart",
+			Handler:    _Cart_MergeGuestCart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/cart/v1/cart.proto",
+}
@@ -0,0 +1,1282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: service/goods/v1/goods.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Goods_GetAllCategoryList_FullMethodName       = "/goods.v1.Goods/GetAllCategoryList"
+	Goods_CreateCategory_FullMethodName           = "/goods.v1.Goods/CreateCategory"
+	Goods_GetSubCategory_FullMethodName           = "/goods.v1.Goods/GetSubCategory"
+	Goods_DeleteCategory_FullMethodName           = "/goods.v1.Goods/DeleteCategory"
+	Goods_UpdateCategory_FullMethodName           = "/goods.v1.Goods/UpdateCategory"
+	Goods_BrandList_FullMethodName                = "/goods.v1.Goods/BrandList"
+	Goods_CreateBrand_FullMethodName              = "/goods.v1.Goods/CreateBrand"
+	Goods_DeleteBrand_FullMethodName              = "/goods.v1.Goods/DeleteBrand"
+	Goods_UpdateBrand_FullMethodName              = "/goods.v1.Goods/UpdateBrand"
+	Goods_CreateGoodsSpecification_FullMethodName = "/goods.v1.Goods/CreateGoodsSpecification"
+	Goods_CreateGoodsType_FullMethodName          = "/goods.v1.Goods/CreateGoodsType"
+	Goods_GoodsTypeList_FullMethodName            = "/goods.v1.Goods/GoodsTypeList"
+	Goods_UpdateGoodsType_FullMethodName          = "/goods.v1.Goods/UpdateGoodsType"
+	Goods_DeleteGoodsType_FullMethodName          = "/goods.v1.Goods/DeleteGoodsType"
+	Goods_GetGoodsTypeTemplate_FullMethodName     = "/goods.v1.Goods/GetGoodsTypeTemplate"
+	Goods_CreateAttrGroup_FullMethodName          = "/goods.v1.Goods/CreateAttrGroup"
+	Goods_CreateAttrValue_FullMethodName          = "/goods.v1.Goods/CreateAttrValue"
+	Goods_CreateGoods_FullMethodName              = "/goods.v1.Goods/CreateGoods"
+	Goods_UpdateGoods_FullMethodName              = "/goods.v1.Goods/UpdateGoods"
+	Goods_GoodsList_FullMethodName                = "/goods.v1.Goods/GoodsList"
+	Goods_GetGoodsDetail_FullMethodName           = "/goods.v1.Goods/GetGoodsDetail"
+	Goods_BatchGetGoods_FullMethodName            = "/goods.v1.Goods/BatchGetGoods"
+	Goods_ApplyFavEvents_FullMethodName           = "/goods.v1.Goods/ApplyFavEvents"
+	Goods_SkuList_FullMethodName                  = "/goods.v1.Goods/SkuList"
+	Goods_GoodsSkuList_FullMethodName             = "/goods.v1.Goods/GoodsSkuList"
+	Goods_UpdateSkuPurchaseLimit_FullMethodName   = "/goods.v1.Goods/UpdateSkuPurchaseLimit"
+	Goods_ChangeSkuPrice_FullMethodName           = "/goods.v1.Goods/ChangeSkuPrice"
+	Goods_SkuPriceAt_FullMethodName               = "/goods.v1.Goods/SkuPriceAt"
+	Goods_SkuPriceHistory_FullMethodName          = "/goods.v1.Goods/SkuPriceHistory"
+	Goods_UploadMedia_FullMethodName              = "/goods.v1.Goods/UploadMedia"
+	Goods_MediaGC_FullMethodName                  = "/goods.v1.Goods/MediaGC"
+)
+
+// GoodsClient is the client API for Goods service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GoodsClient interface {
+	// 商品分类
+	GetAllCategoryList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryListResponse, error)
+	CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*CategoryInfoResponse, error)
+	GetSubCategory(ctx context.Context, in *CategoryListRequest, opts ...grpc.CallOption) (*SubCategoryListResponse, error)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 商品品牌
+	BrandList(ctx context.Context, in *BrandListRequest, opts ...grpc.CallOption) (*BrandListResponse, error)
+	CreateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*BrandInfoResponse, error)
+	DeleteBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 商品规格或属性信息
+	CreateGoodsSpecification(ctx context.Context, in *SpecificationRequest, opts ...grpc.CallOption) (*SpecificationResponse, error)
+	// 商品类型 goods_property_names
+	// 商品类型不同于商品分类，指的是依据某一类商品的相同属性归纳成的属性集合 // 手机类型都有屏幕尺寸、网络制式等共同的属性
+	CreateGoodsType(ctx context.Context, in *GoodsTypeRequest, opts ...grpc.CallOption) (*GoodsTypeResponse, error)
+	GoodsTypeList(ctx context.Context, in *GoodsTypeListRequest, opts ...grpc.CallOption) (*GoodsTypeListResponse, error)
+	UpdateGoodsType(ctx context.Context, in *UpdateGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteGoodsType(ctx context.Context, in *DeleteGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetGoodsTypeTemplate(ctx context.Context, in *GoodsTypeTemplateRequest, opts ...grpc.CallOption) (*GoodsTypeTemplateResponse, error)
+	// 商品参数
+	CreateAttrGroup(ctx context.Context, in *AttrGroupRequest, opts ...grpc.CallOption) (*AttrGroupResponse, error)
+	CreateAttrValue(ctx context.Context, in *AttrRequest, opts ...grpc.CallOption) (*AttrResponse, error)
+	// 商品
+	CreateGoods(ctx context.Context, in *CreateGoodsRequest, opts ...grpc.CallOption) (*CreateGoodsResponse, error)
+	UpdateGoods(ctx context.Context, in *CreateGoodsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error)
+	BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	ApplyFavEvents(ctx context.Context, in *FavEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Sku
+	SkuList(ctx context.Context, in *SkuListRequest, opts ...grpc.CallOption) (*SkuListResponse, error)
+	GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error)
+	UpdateSkuPurchaseLimit(ctx context.Context, in *SkuPurchaseLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 商品价格
+	ChangeSkuPrice(ctx context.Context, in *ChangeSkuPriceRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error)
+	SkuPriceAt(ctx context.Context, in *SkuPriceAtRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error)
+	SkuPriceHistory(ctx context.Context, in *SkuPriceHistoryRequest, opts ...grpc.CallOption) (*SkuPriceHistoryResponse, error)
+	// 媒体文件
+	UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error)
+	MediaGC(ctx context.Context, in *MediaGCRequest, opts ...grpc.CallOption) (*MediaGCResponse, error)
+}
+
+type goodsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGoodsClient(cc grpc.ClientConnInterface) GoodsClient {
+	return &goodsClient{cc}
+}
+
+func (c *goodsClient) GetAllCategoryList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryListResponse)
+	err := c.cc.Invoke(ctx, Goods_GetAllCategoryList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*CategoryInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetSubCategory(ctx context.Context, in *CategoryListRequest, opts ...grpc.CallOption) (*SubCategoryListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubCategoryListResponse)
+	err := c.cc.Invoke(ctx, Goods_GetSubCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BrandList(ctx context.Context, in *BrandListRequest, opts ...grpc.CallOption) (*BrandListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandListResponse)
+	err := c.cc.Invoke(ctx, Goods_BrandList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*BrandInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrandInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateBrand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateGoodsSpecification(ctx context.Context, in *SpecificationRequest, opts ...grpc.CallOption) (*SpecificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpecificationResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateGoodsSpecification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateGoodsType(ctx context.Context, in *GoodsTypeRequest, opts ...grpc.CallOption) (*GoodsTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsTypeResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateGoodsType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GoodsTypeList(ctx context.Context, in *GoodsTypeListRequest, opts ...grpc.CallOption) (*GoodsTypeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsTypeListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsTypeList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateGoodsType(ctx context.Context, in *UpdateGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoodsType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteGoodsType(ctx context.Context, in *DeleteGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteGoodsType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetGoodsTypeTemplate(ctx context.Context, in *GoodsTypeTemplateRequest, opts ...grpc.CallOption) (*GoodsTypeTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsTypeTemplateResponse)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsTypeTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateAttrGroup(ctx context.Context, in *AttrGroupRequest, opts ...grpc.CallOption) (*AttrGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttrGroupResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateAttrGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateAttrValue(ctx context.Context, in *AttrRequest, opts ...grpc.CallOption) (*AttrResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttrResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateAttrValue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateGoods(ctx context.Context, in *CreateGoodsRequest, opts ...grpc.CallOption) (*CreateGoodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGoodsResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateGoods(ctx context.Context, in *CreateGoodsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...grpc.CallOption) (*GoodsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsListResponse)
+	err := c.cc.Invoke(ctx, Goods_BatchGetGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ApplyFavEvents(ctx context.Context, in *FavEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_ApplyFavEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SkuList(ctx context.Context, in *SkuListRequest, opts ...grpc.CallOption) (*SkuListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_SkuList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsSkuList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateSkuPurchaseLimit(ctx context.Context, in *SkuPurchaseLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateSkuPurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ChangeSkuPrice(ctx context.Context, in *ChangeSkuPriceRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuPriceResponse)
+	err := c.cc.Invoke(ctx, Goods_ChangeSkuPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SkuPriceAt(ctx context.Context, in *SkuPriceAtRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuPriceResponse)
+	err := c.cc.Invoke(ctx, Goods_SkuPriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SkuPriceHistory(ctx context.Context, in *SkuPriceHistoryRequest, opts ...grpc.CallOption) (*SkuPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Goods_SkuPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaResponse)
+	err := c.cc.Invoke(ctx, Goods_UploadMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) MediaGC(ctx context.Context, in *MediaGCRequest, opts ...grpc.CallOption) (*MediaGCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaGCResponse)
+	err := c.cc.Invoke(ctx, Goods_MediaGC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoodsServer is the server API for Goods service.
+// All implementations must embed UnimplementedGoodsServer
+// for forward compatibility.
+type GoodsServer interface {
+	// 商品分类
+	GetAllCategoryList(context.Context, *emptypb.Empty) (*CategoryListResponse, error)
+	CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error)
+	GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	UpdateCategory(context.Context, *CategoryInfoRequest) (*emptypb.Empty, error)
+	// 商品品牌
+	BrandList(context.Context, *BrandListRequest) (*BrandListResponse, error)
+	CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error)
+	DeleteBrand(context.Context, *BrandRequest) (*emptypb.Empty, error)
+	UpdateBrand(context.Context, *BrandRequest) (*emptypb.Empty, error)
+	// 商品规格或属性信息
+	CreateGoodsSpecification(context.Context, *SpecificationRequest) (*SpecificationResponse, error)
+	// 商品类型 goods_property_names
+	// 商品类型不同于商品分类，指的是依据某一类商品的相同属性归纳成的属性集合 // 手机类型都有屏幕尺寸、网络制式等共同的属性
+	CreateGoodsType(context.Context, *GoodsTypeRequest) (*GoodsTypeResponse, error)
+	GoodsTypeList(context.Context, *GoodsTypeListRequest) (*GoodsTypeListResponse, error)
+	UpdateGoodsType(context.Context, *UpdateGoodsTypeRequest) (*emptypb.Empty, error)
+	DeleteGoodsType(context.Context, *DeleteGoodsTypeRequest) (*emptypb.Empty, error)
+	GetGoodsTypeTemplate(context.Context, *GoodsTypeTemplateRequest) (*GoodsTypeTemplateResponse, error)
+	// 商品参数
+	CreateAttrGroup(context.Context, *AttrGroupRequest) (*AttrGroupResponse, error)
+	CreateAttrValue(context.Context, *AttrRequest) (*AttrResponse, error)
+	// 商品
+	CreateGoods(context.Context, *CreateGoodsRequest) (*CreateGoodsResponse, error)
+	UpdateGoods(context.Context, *CreateGoodsRequest) (*emptypb.Empty, error)
+	GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
+	GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error)
+	BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error)
+	ApplyFavEvents(context.Context, *FavEventsRequest) (*emptypb.Empty, error)
+	// Sku
+	SkuList(context.Context, *SkuListRequest) (*SkuListResponse, error)
+	GoodsSkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error)
+	UpdateSkuPurchaseLimit(context.Context, *SkuPurchaseLimitRequest) (*emptypb.Empty, error)
+	// 商品价格
+	ChangeSkuPrice(context.Context, *ChangeSkuPriceRequest) (*SkuPriceResponse, error)
+	SkuPriceAt(context.Context, *SkuPriceAtRequest) (*SkuPriceResponse, error)
+	SkuPriceHistory(context.Context, *SkuPriceHistoryRequest) (*SkuPriceHistoryResponse, error)
+	// 媒体文件
+	UploadMedia(context.Context, *UploadMediaRequest) (*MediaResponse, error)
+	MediaGC(context.Context, *MediaGCRequest) (*MediaGCResponse, error)
+	mustEmbedUnimplementedGoodsServer()
+}
+
+// UnimplementedGoodsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGoodsServer struct{}
+
+func (UnimplementedGoodsServer) GetAllCategoryList(context.Context, *emptypb.Empty) (*CategoryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategoryList not implemented")
+}
+func (UnimplementedGoodsServer) CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedGoodsServer) GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubCategory not implemented")
+}
+func (UnimplementedGoodsServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedGoodsServer) UpdateCategory(context.Context, *CategoryInfoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedGoodsServer) BrandList(context.Context, *BrandListRequest) (*BrandListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrandList not implemented")
+}
+func (UnimplementedGoodsServer) CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
+}
+func (UnimplementedGoodsServer) DeleteBrand(context.Context, *BrandRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBrand not implemented")
+}
+func (UnimplementedGoodsServer) UpdateBrand(context.Context, *BrandRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrand not implemented")
+}
+func (UnimplementedGoodsServer) CreateGoodsSpecification(context.Context, *SpecificationRequest) (*SpecificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoodsSpecification not implemented")
+}
+func (UnimplementedGoodsServer) CreateGoodsType(context.Context, *GoodsTypeRequest) (*GoodsTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoodsType not implemented")
+}
+func (UnimplementedGoodsServer) GoodsTypeList(context.Context, *GoodsTypeListRequest) (*GoodsTypeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsTypeList not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoodsType(context.Context, *UpdateGoodsTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoodsType not implemented")
+}
+func (UnimplementedGoodsServer) DeleteGoodsType(context.Context, *DeleteGoodsTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoodsType not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsTypeTemplate(context.Context, *GoodsTypeTemplateRequest) (*GoodsTypeTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsTypeTemplate not implemented")
+}
+func (UnimplementedGoodsServer) CreateAttrGroup(context.Context, *AttrGroupRequest) (*AttrGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttrGroup not implemented")
+}
+func (UnimplementedGoodsServer) CreateAttrValue(context.Context, *AttrRequest) (*AttrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttrValue not implemented")
+}
+func (UnimplementedGoodsServer) CreateGoods(context.Context, *CreateGoodsRequest) (*CreateGoodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGoods not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoods(context.Context, *CreateGoodsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoods not implemented")
+}
+func (UnimplementedGoodsServer) GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsList not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsDetail not implemented")
+}
+func (UnimplementedGoodsServer) BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGoods not implemented")
+}
+func (UnimplementedGoodsServer) ApplyFavEvents(context.Context, *FavEventsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyFavEvents not implemented")
+}
+func (UnimplementedGoodsServer) SkuList(context.Context, *SkuListRequest) (*SkuListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkuList not implemented")
+}
+func (UnimplementedGoodsServer) GoodsSkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsSkuList not implemented")
+}
+func (UnimplementedGoodsServer) UpdateSkuPurchaseLimit(context.Context, *SkuPurchaseLimitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSkuPurchaseLimit not implemented")
+}
+func (UnimplementedGoodsServer) ChangeSkuPrice(context.Context, *ChangeSkuPriceRequest) (*SkuPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSkuPrice not implemented")
+}
+func (UnimplementedGoodsServer) SkuPriceAt(context.Context, *SkuPriceAtRequest) (*SkuPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkuPriceAt not implemented")
+}
+func (UnimplementedGoodsServer) SkuPriceHistory(context.Context, *SkuPriceHistoryRequest) (*SkuPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkuPriceHistory not implemented")
+}
+func (UnimplementedGoodsServer) UploadMedia(context.Context, *UploadMediaRequest) (*MediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedGoodsServer) MediaGC(context.Context, *MediaGCRequest) (*MediaGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGC not implemented")
+}
+func (UnimplementedGoodsServer) mustEmbedUnimplementedGoodsServer() {}
+func (UnimplementedGoodsServer) testEmbeddedByValue()               {}
+
+// UnsafeGoodsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoodsServer will
+// result in compilation errors.
+type UnsafeGoodsServer interface {
+	mustEmbedUnimplementedGoodsServer()
+}
+
+func RegisterGoodsServer(s grpc.ServiceRegistrar, srv GoodsServer) {
+	// If the following call pancis, it indicates UnimplementedGoodsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Goods_ServiceDesc, srv)
+}
+
+func _Goods_GetAllCategoryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetAllCategoryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetAllCategoryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetAllCategoryList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateCategory(ctx, req.(*CategoryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetSubCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetSubCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetSubCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetSubCategory(ctx, req.(*CategoryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateCategory(ctx, req.(*CategoryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BrandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BrandList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BrandList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BrandList(ctx, req.(*BrandListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateBrand(ctx, req.(*BrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteBrand(ctx, req.(*BrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateBrand(ctx, req.(*BrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateGoodsSpecification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateGoodsSpecification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateGoodsSpecification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateGoodsSpecification(ctx, req.(*SpecificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateGoodsType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateGoodsType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateGoodsType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateGoodsType(ctx, req.(*GoodsTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GoodsTypeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsTypeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsTypeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsTypeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsTypeList(ctx, req.(*GoodsTypeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateGoodsType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoodsTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoodsType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoodsType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoodsType(ctx, req.(*UpdateGoodsTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteGoodsType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoodsTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteGoodsType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteGoodsType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteGoodsType(ctx, req.(*DeleteGoodsTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetGoodsTypeTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsTypeTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsTypeTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsTypeTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsTypeTemplate(ctx, req.(*GoodsTypeTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateAttrGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttrGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateAttrGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateAttrGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateAttrGroup(ctx, req.(*AttrGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateAttrValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateAttrValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateAttrValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateAttrValue(ctx, req.(*AttrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateGoods(ctx, req.(*CreateGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoods(ctx, req.(*CreateGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GoodsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsList(ctx, req.(*GoodsFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsDetail(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BatchGetGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGoodsIdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchGetGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchGetGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchGetGoods(ctx, req.(*BatchGoodsIdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ApplyFavEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ApplyFavEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ApplyFavEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ApplyFavEvents(ctx, req.(*FavEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SkuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SkuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SkuList(ctx, req.(*SkuListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GoodsSkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsSkuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsSkuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsSkuList(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateSkuPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuPurchaseLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateSkuPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateSkuPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateSkuPurchaseLimit(ctx, req.(*SkuPurchaseLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ChangeSkuPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSkuPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ChangeSkuPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ChangeSkuPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ChangeSkuPrice(ctx, req.(*ChangeSkuPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SkuPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SkuPriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SkuPriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SkuPriceAt(ctx, req.(*SkuPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SkuPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SkuPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SkuPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SkuPriceHistory(ctx, req.(*SkuPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UploadMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UploadMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UploadMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UploadMedia(ctx, req.(*UploadMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_MediaGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MediaGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).MediaGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_MediaGC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).MediaGC(ctx, req.(*MediaGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Goods_ServiceDesc is the grpc.ServiceDesc for Goods service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Goods_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "goods.v1.Goods",
+	HandlerType: (*GoodsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllCategoryList",
+			Handler:    _Goods_GetAllCategoryList_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _Goods_CreateCategory_Handler,
+		},
+		{
+			MethodName: "GetSubCategory",
+			Handler:    _Goods_GetSubCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _Goods_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _Goods_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "BrandList",
+			Handler:    _Goods_BrandList_Handler,
+		},
+		{
+			MethodName: "CreateBrand",
+			Handler:    _Goods_CreateBrand_Handler,
+		},
+		{
+			MethodName: "DeleteBrand",
+			Handler:    _Goods_DeleteBrand_Handler,
+		},
+		{
+			MethodName: "UpdateBrand",
+			Handler:    _Goods_UpdateBrand_Handler,
+		},
+		{
+			MethodName: "CreateGoodsSpecification",
+			Handler:    _Goods_CreateGoodsSpecification_Handler,
+		},
+		{
+			MethodName: "CreateGoodsType",
+			Handler:    _Goods_CreateGoodsType_Handler,
+		},
+		{
+			MethodName: "GoodsTypeList",
+			Handler:    _Goods_GoodsTypeList_Handler,
+		},
+		{
+			MethodName: "UpdateGoodsType",
+			Handler:    _Goods_UpdateGoodsType_Handler,
+		},
+		{
+			MethodName: "DeleteGoodsType",
+			Handler:    _Goods_DeleteGoodsType_Handler,
+		},
+		{
+			MethodName: "GetGoodsTypeTemplate",
+			Handler:    _Goods_GetGoodsTypeTemplate_Handler,
+		},
+		{
+			MethodName: "CreateAttrGroup",
+			Handler:    _Goods_CreateAttrGroup_Handler,
+		},
+		{
+			MethodName: "CreateAttrValue",
+			Handler:    _Goods_CreateAttrValue_Handler,
+		},
+		{
+			MethodName: "CreateGoods",
+			Handler:    _Goods_CreateGoods_Handler,
+		},
+		{
+			MethodName: "UpdateGoods",
+			Handler:    _Goods_UpdateGoods_Handler,
+		},
+		{
+			MethodName: "GoodsList",
+			Handler:    _Goods_GoodsList_Handler,
+		},
+		{
+			MethodName: "GetGoodsDetail",
+			Handler:    _Goods_GetGoodsDetail_Handler,
+		},
+		{
+			MethodName: "BatchGetGoods",
+			Handler:    _Goods_BatchGetGoods_Handler,
+		},
+		{
+			MethodName: "ApplyFavEvents",
+			Handler:    _Goods_ApplyFavEvents_Handler,
+		},
+		{
+			MethodName: "SkuList",
+			Handler:    _Goods_SkuList_Handler,
+		},
+		{
+			MethodName: "GoodsSkuList",
+			Handler:    _Goods_GoodsSkuList_Handler,
+		},
+		{
+			MethodName: "UpdateSkuPurchaseLimit",
+			Handler:    _Goods_UpdateSkuPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "ChangeSkuPrice",
+			Handler:    _Goods_ChangeSkuPrice_Handler,
+		},
+		{
+			MethodName: "SkuPriceAt",
+			Handler:    _Goods_SkuPriceAt_Handler,
+		},
+		{
+			MethodName: "SkuPriceHistory",
+			Handler:    _Goods_SkuPriceHistory_Handler,
+		},
+		{
+			MethodName: "UploadMedia",
+			Handler:    _Goods_UploadMedia_Handler,
+		},
+		{
+			MethodName: "MediaGC",
+			Handler:    _Goods_MediaGC_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/goods/v1/goods.proto",
+}
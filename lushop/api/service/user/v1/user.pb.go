@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -85,12 +86,14 @@ func (x *CreateUserInfo) GetMobile() string {
 type UserInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Mobile        string                 `protobuf:"bytes,3,opt,name=mobile,proto3" json:"mobile,omitempty"`
 	NickName      string                 `protobuf:"bytes,4,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	Birthday      uint64                 `protobuf:"varint,5,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Birthday      *int64                 `protobuf:"varint,5,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"` // unix 秒，没有设置生日时不返回
 	Gender        string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`
 	Role          int32                  `protobuf:"varint,7,opt,name=role,proto3" json:"role,omitempty"`
+	TokenVersion  int64                  `protobuf:"varint,8,opt,name=tokenVersion,proto3" json:"tokenVersion,omitempty"` // 修改密码后加一，之前签发的 token 失效
+	Disabled      bool                   `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`         // 账号已禁用
+	CreatedAt     uint64                 `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`      // 注册时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -132,13 +135,6 @@ func (x *UserInfoResponse) GetId() int64 {
 	return 0
 }
 
-func (x *UserInfoResponse) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
 func (x *UserInfoResponse) GetMobile() string {
 	if x != nil {
 		return x.Mobile
@@ -153,9 +149,9 @@ func (x *UserInfoResponse) GetNickName() string {
 	return ""
 }
 
-func (x *UserInfoResponse) GetBirthday() uint64 {
-	if x != nil {
-		return x.Birthday
+func (x *UserInfoResponse) GetBirthday() int64 {
+	if x != nil && x.Birthday != nil {
+		return *x.Birthday
 	}
 	return 0
 }
@@ -174,6 +170,27 @@ func (x *UserInfoResponse) GetRole() int32 {
 	return 0
 }
 
+func (x *UserInfoResponse) GetTokenVersion() int64 {
+	if x != nil {
+		return x.TokenVersion
+	}
+	return 0
+}
+
+func (x *UserInfoResponse) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *UserInfoResponse) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
 // 用户列表
 type UserListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -280,6 +297,213 @@ func (x *PageInfo) GetPSize() uint32 {
 	return 0
 }
 
+// 用户搜索，条件为空时不过滤
+type UserSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`     // 手机号前缀
+	NickName      string                 `protobuf:"bytes,2,opt,name=nickName,proto3" json:"nickName,omitempty"` // 昵称包含
+	Role          int32                  `protobuf:"varint,3,opt,name=role,proto3" json:"role,omitempty"`
+	Gender        string                 `protobuf:"bytes,4,opt,name=gender,proto3" json:"gender,omitempty"`
+	StartTime     uint64                 `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"` // 注册时间范围，包含开始时间，不包含结束时间
+	EndTime       uint64                 `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Pn            uint32                 `protobuf:"varint,7,opt,name=pn,proto3" json:"pn,omitempty"`
+	PSize         uint32                 `protobuf:"varint,8,opt,name=pSize,proto3" json:"pSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSearchRequest) Reset() {
+	*x = UserSearchRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSearchRequest) ProtoMessage() {}
+
+func (x *UserSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSearchRequest.ProtoReflect.Descriptor instead.
+func (*UserSearchRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserSearchRequest) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *UserSearchRequest) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *UserSearchRequest) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *UserSearchRequest) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UserSearchRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *UserSearchRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *UserSearchRequest) GetPn() uint32 {
+	if x != nil {
+		return x.Pn
+	}
+	return 0
+}
+
+func (x *UserSearchRequest) GetPSize() uint32 {
+	if x != nil {
+		return x.PSize
+	}
+	return 0
+}
+
+// 禁用或启用账号
+type UserDisabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Disabled      bool                   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDisabledRequest) Reset() {
+	*x = UserDisabledRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDisabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDisabledRequest) ProtoMessage() {}
+
+func (x *UserDisabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDisabledRequest.ProtoReflect.Descriptor instead.
+func (*UserDisabledRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserDisabledRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserDisabledRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// 修改用户角色
+type ChangeRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeRoleRequest) Reset() {
+	*x = ChangeRoleRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeRoleRequest) ProtoMessage() {}
+
+func (x *ChangeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeRoleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangeRoleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeRoleRequest) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
 // 手机号请求
 type MobileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -290,7 +514,7 @@ type MobileRequest struct {
 
 func (x *MobileRequest) Reset() {
 	*x = MobileRequest{}
-	mi := &file_service_user_v1_user_proto_msgTypes[4]
+	mi := &file_service_user_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +526,7 @@ func (x *MobileRequest) String() string {
 func (*MobileRequest) ProtoMessage() {}
 
 func (x *MobileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_user_v1_user_proto_msgTypes[4]
+	mi := &file_service_user_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +539,7 @@ func (x *MobileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MobileRequest.ProtoReflect.Descriptor instead.
 func (*MobileRequest) Descriptor() ([]byte, []int) {
-	return file_service_user_v1_user_proto_rawDescGZIP(), []int{4}
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MobileRequest) GetMobile() string {
@@ -335,7 +559,7 @@ type IdRequest struct {
 
 func (x *IdRequest) Reset() {
 	*x = IdRequest{}
-	mi := &file_service_user_v1_user_proto_msgTypes[5]
+	mi := &file_service_user_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +571,7 @@ func (x *IdRequest) String() string {
 func (*IdRequest) ProtoMessage() {}
 
 func (x *IdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_user_v1_user_proto_msgTypes[5]
+	mi := &file_service_user_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +584,7 @@ func (x *IdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IdRequest.ProtoReflect.Descriptor instead.
 func (*IdRequest) Descriptor() ([]byte, []int) {
-	return file_service_user_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IdRequest) GetId() int64 {
@@ -376,14 +600,15 @@ type UpdateUserInfo struct {
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	NickName      string                 `protobuf:"bytes,2,opt,name=nickName,proto3" json:"nickName,omitempty"`
 	Gender        string                 `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`
-	Birthday      uint64                 `protobuf:"varint,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	Birthday      *int64                 `protobuf:"varint,4,opt,name=birthday,proto3,oneof" json:"birthday,omitempty"` // unix 秒，updateMask 中有 birthday 但没有设置时清空生日
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=updateMask,proto3" json:"updateMask,omitempty"`    // 需要更新的字段 nickName、gender、birthday，为空时全部更新
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateUserInfo) Reset() {
 	*x = UpdateUserInfo{}
-	mi := &file_service_user_v1_user_proto_msgTypes[6]
+	mi := &file_service_user_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +620,7 @@ func (x *UpdateUserInfo) String() string {
 func (*UpdateUserInfo) ProtoMessage() {}
 
 func (x *UpdateUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_service_user_v1_user_proto_msgTypes[6]
+	mi := &file_service_user_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +633,7 @@ func (x *UpdateUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserInfo.ProtoReflect.Descriptor instead.
 func (*UpdateUserInfo) Descriptor() ([]byte, []int) {
-	return file_service_user_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateUserInfo) GetId() int64 {
@@ -432,37 +657,44 @@ func (x *UpdateUserInfo) GetGender() string {
 	return ""
 }
 
-func (x *UpdateUserInfo) GetBirthday() uint64 {
-	if x != nil {
-		return x.Birthday
+func (x *UpdateUserInfo) GetBirthday() int64 {
+	if x != nil && x.Birthday != nil {
+		return *x.Birthday
 	}
 	return 0
 }
 
-// 密码验证请求
-type PasswordCheckInfo struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Password          string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	EncryptedPassword string                 `protobuf:"bytes,2,opt,name=encryptedPassword,proto3" json:"encryptedPassword,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *UpdateUserInfo) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
 }
 
-func (x *PasswordCheckInfo) Reset() {
-	*x = PasswordCheckInfo{}
-	mi := &file_service_user_v1_user_proto_msgTypes[7]
+// 账号密码验证请求
+type VerifyCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyCredentialsRequest) Reset() {
+	*x = VerifyCredentialsRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordCheckInfo) String() string {
+func (x *VerifyCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordCheckInfo) ProtoMessage() {}
+func (*VerifyCredentialsRequest) ProtoMessage() {}
 
-func (x *PasswordCheckInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_service_user_v1_user_proto_msgTypes[7]
+func (x *VerifyCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,48 +705,50 @@ func (x *PasswordCheckInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordCheckInfo.ProtoReflect.Descriptor instead.
-func (*PasswordCheckInfo) Descriptor() ([]byte, []int) {
-	return file_service_user_v1_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use VerifyCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PasswordCheckInfo) GetPassword() string {
+func (x *VerifyCredentialsRequest) GetMobile() string {
 	if x != nil {
-		return x.Password
+		return x.Mobile
 	}
 	return ""
 }
 
-func (x *PasswordCheckInfo) GetEncryptedPassword() string {
+func (x *VerifyCredentialsRequest) GetPassword() string {
 	if x != nil {
-		return x.EncryptedPassword
+		return x.Password
 	}
 	return ""
 }
 
-// 密码验证响应
-type CheckResponse struct {
+// 修改密码请求
+type ChangePasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckResponse) Reset() {
-	*x = CheckResponse{}
-	mi := &file_service_user_v1_user_proto_msgTypes[8]
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckResponse) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckResponse) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *CheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_user_v1_user_proto_msgTypes[8]
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,64 +759,768 @@ func (x *CheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
-func (*CheckResponse) Descriptor() ([]byte, []int) {
-	return file_service_user_v1_user_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CheckResponse) GetSuccess() bool {
+func (x *ChangePasswordRequest) GetId() int64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-var File_service_user_v1_user_proto protoreflect.FileDescriptor
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
 
-const file_service_user_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x1aservice/user/v1/user.proto\x12\vapi.user.v1\x1a\x1bgoogle/protobuf/empty.proto\"`\n" +
-	"\x0eCreateUserInfo\x12\x1a\n" +
-	"\bnickName\x18\x01 \x01(\tR\bnickName\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
-	"\x06mobile\x18\x03 \x01(\tR\x06mobile\"\xba\x01\n" +
-	"\x10UserInfoResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
-	"\x06mobile\x18\x03 \x01(\tR\x06mobile\x12\x1a\n" +
-	"\bnickName\x18\x04 \x01(\tR\bnickName\x12\x1a\n" +
-	"\bbirthday\x18\x05 \x01(\x04R\bbirthday\x12\x16\n" +
-	"\x06gender\x18\x06 \x01(\tR\x06gender\x12\x12\n" +
-	"\x04role\x18\a \x01(\x05R\x04role\"[\n" +
-	"\x10UserListResponse\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x05R\x05total\x121\n" +
-	"\x04data\x18\x02 \x03(\v2\x1d.api.user.v1.UserInfoResponseR\x04data\"0\n" +
-	"\bPageInfo\x12\x0e\n" +
-	"\x02pn\x18\x01 \x01(\rR\x02pn\x12\x14\n" +
-	"\x05pSize\x18\x02 \x01(\rR\x05pSize\"'\n" +
-	"\rMobileRequest\x12\x16\n" +
-	"\x06mobile\x18\x01 \x01(\tR\x06mobile\"\x1b\n" +
-	"\tIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"p\n" +
-	"\x0eUpdateUserInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
-	"\bnickName\x18\x02 \x01(\tR\bnickName\x12\x16\n" +
-	"\x06gender\x18\x03 \x01(\tR\x06gender\x12\x1a\n" +
-	"\bbirthday\x18\x04 \x01(\x04R\bbirthday\"]\n" +
-	"\x11PasswordCheckInfo\x12\x1a\n" +
-	"\bpassword\x18\x01 \x01(\tR\bpassword\x12,\n" +
-	"\x11encryptedPassword\x18\x02 \x01(\tR\x11encryptedPassword\")\n" +
-	"\rCheckResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xc5\x03\n" +
-	"\x04User\x12J\n" +
-	"\n" +
-	"CreateUser\x12\x1b.api.user.v1.CreateUserInfo\x1a\x1d.api.user.v1.UserInfoResponse\"\x00\x12E\n" +
-	"\vGetUserList\x12\x15.api.user.v1.PageInfo\x1a\x1d.api.user.v1.UserListResponse\"\x00\x12N\n" +
-	"\x0fGetUserByMobile\x12\x1a.api.user.v1.MobileRequest\x1a\x1d.api.user.v1.UserInfoResponse\"\x00\x12F\n" +
-	"\vGetUserById\x12\x16.api.user.v1.IdRequest\x1a\x1d.api.user.v1.UserInfoResponse\"\x00\x12C\n" +
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// 重置密码请求
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	Ticket        string                 `protobuf:"bytes,3,opt,name=ticket,proto3" json:"ticket,omitempty"` // 网关验证手机验证码后用 auth.reset_ticket_key 签名的一次性凭证
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ResetPasswordRequest) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+// 修改密码后的 token 版本
+type TokenVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenVersion  int64                  `protobuf:"varint,1,opt,name=tokenVersion,proto3" json:"tokenVersion,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenVersionResponse) Reset() {
+	*x = TokenVersionResponse{}
+	mi := &file_service_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenVersionResponse) ProtoMessage() {}
+
+func (x *TokenVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenVersionResponse.ProtoReflect.Descriptor instead.
+func (*TokenVersionResponse) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TokenVersionResponse) GetTokenVersion() int64 {
+	if x != nil {
+		return x.TokenVersion
+	}
+	return 0
+}
+
+func (x *TokenVersionResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 收货地址，地区使用国家行政区划代码
+type AddressInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	ProvinceCode  string                 `protobuf:"bytes,3,opt,name=provinceCode,proto3" json:"provinceCode,omitempty"`
+	CityCode      string                 `protobuf:"bytes,4,opt,name=cityCode,proto3" json:"cityCode,omitempty"`
+	DistrictCode  string                 `protobuf:"bytes,5,opt,name=districtCode,proto3" json:"districtCode,omitempty"`
+	Recipient     string                 `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"` // 收货人
+	Mobile        string                 `protobuf:"bytes,7,opt,name=mobile,proto3" json:"mobile,omitempty"`       // 收货人手机号
+	Detail        string                 `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"`       // 详细地址
+	IsDefault     bool                   `protobuf:"varint,9,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressInfo) Reset() {
+	*x = AddressInfo{}
+	mi := &file_service_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressInfo) ProtoMessage() {}
+
+func (x *AddressInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressInfo.ProtoReflect.Descriptor instead.
+func (*AddressInfo) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddressInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddressInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddressInfo) GetProvinceCode() string {
+	if x != nil {
+		return x.ProvinceCode
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetDistrictCode() string {
+	if x != nil {
+		return x.DistrictCode
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AddressInfo) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// 收货地址请求，只能操作 userId 自己的地址
+type AddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressRequest) Reset() {
+	*x = AddressRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressRequest) ProtoMessage() {}
+
+func (x *AddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressRequest.ProtoReflect.Descriptor instead.
+func (*AddressRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddressRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddressRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 用户ID请求
+type UserIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIdRequest) Reset() {
+	*x = UserIdRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdRequest) ProtoMessage() {}
+
+func (x *UserIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdRequest.ProtoReflect.Descriptor instead.
+func (*UserIdRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserIdRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 收货地址列表，默认地址排在最前面
+type AddressListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*AddressInfo         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressListResponse) Reset() {
+	*x = AddressListResponse{}
+	mi := &file_service_user_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressListResponse) ProtoMessage() {}
+
+func (x *AddressListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressListResponse.ProtoReflect.Descriptor instead.
+func (*AddressListResponse) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddressListResponse) GetData() []*AddressInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 登录锁定事件
+type LockEventInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mobile        string                 `protobuf:"bytes,2,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // lock 锁定 unlock 解锁
+	Scope         string                 `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`   // mobile 按手机号锁定 ip 按 IP 锁定
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Failures      int64                  `protobuf:"varint,7,opt,name=failures,proto3" json:"failures,omitempty"` // 锁定时窗口内的失败次数
+	Until         uint64                 `protobuf:"varint,8,opt,name=until,proto3" json:"until,omitempty"`       // 锁定到期时间
+	Operator      int64                  `protobuf:"varint,9,opt,name=operator,proto3" json:"operator,omitempty"` // 手动解锁的管理员ID
+	CreatedAt     uint64                 `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockEventInfo) Reset() {
+	*x = LockEventInfo{}
+	mi := &file_service_user_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockEventInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockEventInfo) ProtoMessage() {}
+
+func (x *LockEventInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockEventInfo.ProtoReflect.Descriptor instead.
+func (*LockEventInfo) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LockEventInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LockEventInfo) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *LockEventInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LockEventInfo) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LockEventInfo) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *LockEventInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LockEventInfo) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *LockEventInfo) GetUntil() uint64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *LockEventInfo) GetOperator() int64 {
+	if x != nil {
+		return x.Operator
+	}
+	return 0
+}
+
+func (x *LockEventInfo) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 登录锁定事件列表请求
+type LockEventListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mobile        string                 `protobuf:"bytes,1,opt,name=mobile,proto3" json:"mobile,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
+	Pn            uint32                 `protobuf:"varint,3,opt,name=pn,proto3" json:"pn,omitempty"`
+	PSize         uint32                 `protobuf:"varint,4,opt,name=pSize,proto3" json:"pSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockEventListRequest) Reset() {
+	*x = LockEventListRequest{}
+	mi := &file_service_user_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockEventListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockEventListRequest) ProtoMessage() {}
+
+func (x *LockEventListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockEventListRequest.ProtoReflect.Descriptor instead.
+func (*LockEventListRequest) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LockEventListRequest) GetMobile() string {
+	if x != nil {
+		return x.Mobile
+	}
+	return ""
+}
+
+func (x *LockEventListRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *LockEventListRequest) GetPn() uint32 {
+	if x != nil {
+		return x.Pn
+	}
+	return 0
+}
+
+func (x *LockEventListRequest) GetPSize() uint32 {
+	if x != nil {
+		return x.PSize
+	}
+	return 0
+}
+
+// 登录锁定事件列表
+type LockEventListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*LockEventInfo       `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockEventListResponse) Reset() {
+	*x = LockEventListResponse{}
+	mi := &file_service_user_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockEventListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockEventListResponse) ProtoMessage() {}
+
+func (x *LockEventListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_user_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockEventListResponse.ProtoReflect.Descriptor instead.
+func (*LockEventListResponse) Descriptor() ([]byte, []int) {
+	return file_service_user_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LockEventListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LockEventListResponse) GetData() []*LockEventInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_service_user_v1_user_proto protoreflect.FileDescriptor
+
+const file_service_user_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x1aservice/user/v1/user.proto\x12\vapi.user.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"`\n" +
+	"\x0eCreateUserInfo\x12\x1a\n" +
+	"\bnickName\x18\x01 \x01(\tR\bnickName\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06mobile\x18\x03 \x01(\tR\x06mobile\"\x9e\x02\n" +
+	"\x10UserInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06mobile\x18\x03 \x01(\tR\x06mobile\x12\x1a\n" +
+	"\bnickName\x18\x04 \x01(\tR\bnickName\x12\x1f\n" +
+	"\bbirthday\x18\x05 \x01(\x03H\x00R\bbirthday\x88\x01\x01\x12\x16\n" +
+	"\x06gender\x18\x06 \x01(\tR\x06gender\x12\x12\n" +
+	"\x04role\x18\a \x01(\x05R\x04role\x12\"\n" +
+	"\ftokenVersion\x18\b \x01(\x03R\ftokenVersion\x12\x1a\n" +
+	"\bdisabled\x18\t \x01(\bR\bdisabled\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\x04R\tcreatedAtB\v\n" +
+	"\t_birthdayJ\x04\b\x02\x10\x03R\bpassword\"[\n" +
+	"\x10UserListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x121\n" +
+	"\x04data\x18\x02 \x03(\v2\x1d.api.user.v1.UserInfoResponseR\x04data\"0\n" +
+	"\bPageInfo\x12\x0e\n" +
+	"\x02pn\x18\x01 \x01(\rR\x02pn\x12\x14\n" +
+	"\x05pSize\x18\x02 \x01(\rR\x05pSize\"\xd1\x01\n" +
+	"\x11UserSearchRequest\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x1a\n" +
+	"\bnickName\x18\x02 \x01(\tR\bnickName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\x05R\x04role\x12\x16\n" +
+	"\x06gender\x18\x04 \x01(\tR\x06gender\x12\x1c\n" +
+	"\tstartTime\x18\x05 \x01(\x04R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x06 \x01(\x04R\aendTime\x12\x0e\n" +
+	"\x02pn\x18\a \x01(\rR\x02pn\x12\x14\n" +
+	"\x05pSize\x18\b \x01(\rR\x05pSize\"A\n" +
+	"\x13UserDisabledRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\"7\n" +
+	"\x11ChangeRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\x05R\x04role\"'\n" +
+	"\rMobileRequest\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\"\x1b\n" +
+	"\tIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xbe\x01\n" +
+	"\x0eUpdateUserInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bnickName\x18\x02 \x01(\tR\bnickName\x12\x16\n" +
+	"\x06gender\x18\x03 \x01(\tR\x06gender\x12\x1f\n" +
+	"\bbirthday\x18\x04 \x01(\x03H\x00R\bbirthday\x88\x01\x01\x12:\n" +
+	"\n" +
+	"updateMask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMaskB\v\n" +
+	"\t_birthday\"N\n" +
+	"\x18VerifyCredentialsRequest\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"k\n" +
+	"\x15ChangePasswordRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
+	"\voldPassword\x18\x02 \x01(\tR\voldPassword\x12 \n" +
+	"\vnewPassword\x18\x03 \x01(\tR\vnewPassword\"h\n" +
+	"\x14ResetPasswordRequest\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\x12\x16\n" +
+	"\x06ticket\x18\x03 \x01(\tR\x06ticket\"J\n" +
+	"\x14TokenVersionResponse\x12\"\n" +
+	"\ftokenVersion\x18\x01 \x01(\x03R\ftokenVersion\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\x85\x02\n" +
+	"\vAddressInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\"\n" +
+	"\fprovinceCode\x18\x03 \x01(\tR\fprovinceCode\x12\x1a\n" +
+	"\bcityCode\x18\x04 \x01(\tR\bcityCode\x12\"\n" +
+	"\fdistrictCode\x18\x05 \x01(\tR\fdistrictCode\x12\x1c\n" +
+	"\trecipient\x18\x06 \x01(\tR\trecipient\x12\x16\n" +
+	"\x06mobile\x18\a \x01(\tR\x06mobile\x12\x16\n" +
+	"\x06detail\x18\b \x01(\tR\x06detail\x12\x1c\n" +
+	"\tisDefault\x18\t \x01(\bR\tisDefault\"8\n" +
+	"\x0eAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\"'\n" +
+	"\rUserIdRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\"C\n" +
+	"\x13AddressListResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x18.api.user.v1.AddressInfoR\x04data\"\xf9\x01\n" +
+	"\rLockEventInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06mobile\x18\x02 \x01(\tR\x06mobile\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x14\n" +
+	"\x05scope\x18\x05 \x01(\tR\x05scope\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bfailures\x18\a \x01(\x03R\bfailures\x12\x14\n" +
+	"\x05until\x18\b \x01(\x04R\x05until\x12\x1a\n" +
+	"\boperator\x18\t \x01(\x03R\boperator\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\x04R\tcreatedAt\"d\n" +
+	"\x14LockEventListRequest\x12\x16\n" +
+	"\x06mobile\x18\x01 \x01(\tR\x06mobile\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x0e\n" +
+	"\x02pn\x18\x03 \x01(\rR\x02pn\x12\x14\n" +
+	"\x05pSize\x18\x04 \x01(\rR\x05pSize\"]\n" +
+	"\x15LockEventListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12.\n" +
+	"\x04data\x18\x02 \x03(\v2\x1a.api.user.v1.LockEventInfoR\x04data2\xe5\v\n" +
+	"\x04User\x12J\n" +
+	"\n" +
+	"CreateUser\x12\x1b.api.user.v1.CreateUserInfo\x1a\x1d.api.user.v1.UserInfoResponse\"\x00\x12E\n" +
+	"\vGetUserList\x12\x15.api.user.v1.PageInfo\x1a\x1d.api.user.v1.UserListResponse\"\x00\x12M\n" +
+	"\n" +
+	"SearchUser\x12\x1e.api.user.v1.UserSearchRequest\x1a\x1d.api.user.v1.UserListResponse\"\x00\x12N\n" +
+	"\x0fGetUserByMobile\x12\x1a.api.user.v1.MobileRequest\x1a\x1d.api.user.v1.UserInfoResponse\"\x00\x12F\n" +
+	"\vGetUserById\x12\x16.api.user.v1.IdRequest\x1a\x1d.api.user.v1.UserInfoResponse\"\x00\x12C\n" +
+	"\n" +
+	"UpdateUser\x12\x1b.api.user.v1.UpdateUserInfo\x1a\x16.google.protobuf.Empty\"\x00\x12[\n" +
+	"\x11VerifyCredentials\x12%.api.user.v1.VerifyCredentialsRequest\x1a\x1d.api.user.v1.UserInfoResponse\"\x00\x12Y\n" +
+	"\x0eChangePassword\x12\".api.user.v1.ChangePasswordRequest\x1a!.api.user.v1.TokenVersionResponse\"\x00\x12W\n" +
+	"\rResetPassword\x12!.api.user.v1.ResetPasswordRequest\x1a!.api.user.v1.TokenVersionResponse\"\x00\x12X\n" +
+	"\x0fSetUserDisabled\x12 .api.user.v1.UserDisabledRequest\x1a!.api.user.v1.TokenVersionResponse\"\x00\x12Q\n" +
+	"\n" +
+	"ChangeRole\x12\x1e.api.user.v1.ChangeRoleRequest\x1a!.api.user.v1.TokenVersionResponse\"\x00\x12I\n" +
 	"\n" +
-	"UpdateUser\x12\x1b.api.user.v1.UpdateUserInfo\x1a\x16.google.protobuf.Empty\"\x00\x12M\n" +
-	"\rCheckPassword\x12\x1e.api.user.v1.PasswordCheckInfo\x1a\x1a.api.user.v1.CheckResponse\"\x00B$\n" +
+	"DeleteUser\x12\x16.api.user.v1.IdRequest\x1a!.api.user.v1.TokenVersionResponse\"\x00\x12E\n" +
+	"\rCreateAddress\x12\x18.api.user.v1.AddressInfo\x1a\x18.api.user.v1.AddressInfo\"\x00\x12C\n" +
+	"\rUpdateAddress\x12\x18.api.user.v1.AddressInfo\x1a\x16.google.protobuf.Empty\"\x00\x12F\n" +
+	"\rDeleteAddress\x12\x1b.api.user.v1.AddressRequest\x1a\x16.google.protobuf.Empty\"\x00\x12O\n" +
+	"\rListAddresses\x12\x1a.api.user.v1.UserIdRequest\x1a .api.user.v1.AddressListResponse\"\x00\x12J\n" +
+	"\x11SetDefaultAddress\x12\x1b.api.user.v1.AddressRequest\x1a\x16.google.protobuf.Empty\"\x00\x12G\n" +
+	"\x0fCreateLockEvent\x12\x1a.api.user.v1.LockEventInfo\x1a\x16.google.protobuf.Empty\"\x00\x12[\n" +
+	"\x10GetLockEventList\x12!.api.user.v1.LockEventListRequest\x1a\".api.user.v1.LockEventListResponse\"\x00B$\n" +
 	"\vapi.user.v1P\x01Z\x13user/api/user/v1;v1b\x06proto3"
 
 var (
@@ -597,38 +1535,80 @@ func file_service_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_service_user_v1_user_proto_rawDescData
 }
 
-var file_service_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_service_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_service_user_v1_user_proto_goTypes = []any{
-	(*CreateUserInfo)(nil),    // 0: api.user.v1.CreateUserInfo
-	(*UserInfoResponse)(nil),  // 1: api.user.v1.UserInfoResponse
-	(*UserListResponse)(nil),  // 2: api.user.v1.UserListResponse
-	(*PageInfo)(nil),          // 3: api.user.v1.PageInfo
-	(*MobileRequest)(nil),     // 4: api.user.v1.MobileRequest
-	(*IdRequest)(nil),         // 5: api.user.v1.IdRequest
-	(*UpdateUserInfo)(nil),    // 6: api.user.v1.UpdateUserInfo
-	(*PasswordCheckInfo)(nil), // 7: api.user.v1.PasswordCheckInfo
-	(*CheckResponse)(nil),     // 8: api.user.v1.CheckResponse
-	(*emptypb.Empty)(nil),     // 9: google.protobuf.Empty
+	(*CreateUserInfo)(nil),           // 0: api.user.v1.CreateUserInfo
+	(*UserInfoResponse)(nil),         // 1: api.user.v1.UserInfoResponse
+	(*UserListResponse)(nil),         // 2: api.user.v1.UserListResponse
+	(*PageInfo)(nil),                 // 3: api.user.v1.PageInfo
+	(*UserSearchRequest)(nil),        // 4: api.user.v1.UserSearchRequest
+	(*UserDisabledRequest)(nil),      // 5: api.user.v1.UserDisabledRequest
+	(*ChangeRoleRequest)(nil),        // 6: api.user.v1.ChangeRoleRequest
+	(*MobileRequest)(nil),            // 7: api.user.v1.MobileRequest
+	(*IdRequest)(nil),                // 8: api.user.v1.IdRequest
+	(*UpdateUserInfo)(nil),           // 9: api.user.v1.UpdateUserInfo
+	(*VerifyCredentialsRequest)(nil), // 10: api.user.v1.VerifyCredentialsRequest
+	(*ChangePasswordRequest)(nil),    // 11: api.user.v1.ChangePasswordRequest
+	(*ResetPasswordRequest)(nil),     // 12: api.user.v1.ResetPasswordRequest
+	(*TokenVersionResponse)(nil),     // 13: api.user.v1.TokenVersionResponse
+	(*AddressInfo)(nil),              // 14: api.user.v1.AddressInfo
+	(*AddressRequest)(nil),           // 15: api.user.v1.AddressRequest
+	(*UserIdRequest)(nil),            // 16: api.user.v1.UserIdRequest
+	(*AddressListResponse)(nil),      // 17: api.user.v1.AddressListResponse
+	(*LockEventInfo)(nil),            // 18: api.user.v1.LockEventInfo
+	(*LockEventListRequest)(nil),     // 19: api.user.v1.LockEventListRequest
+	(*LockEventListResponse)(nil),    // 20: api.user.v1.LockEventListResponse
+	(*fieldmaskpb.FieldMask)(nil),    // 21: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),            // 22: google.protobuf.Empty
 }
 var file_service_user_v1_user_proto_depIdxs = []int32{
-	1, // 0: api.user.v1.UserListResponse.data:type_name -> api.user.v1.UserInfoResponse
-	0, // 1: api.user.v1.User.CreateUser:input_type -> api.user.v1.CreateUserInfo
-	3, // 2: api.user.v1.User.GetUserList:input_type -> api.user.v1.PageInfo
-	4, // 3: api.user.v1.User.GetUserByMobile:input_type -> api.user.v1.MobileRequest
-	5, // 4: api.user.v1.User.GetUserById:input_type -> api.user.v1.IdRequest
-	6, // 5: api.user.v1.User.UpdateUser:input_type -> api.user.v1.UpdateUserInfo
-	7, // 6: api.user.v1.User.CheckPassword:input_type -> api.user.v1.PasswordCheckInfo
-	1, // 7: api.user.v1.User.CreateUser:output_type -> api.user.v1.UserInfoResponse
-	2, // 8: api.user.v1.User.GetUserList:output_type -> api.user.v1.UserListResponse
-	1, // 9: api.user.v1.User.GetUserByMobile:output_type -> api.user.v1.UserInfoResponse
-	1, // 10: api.user.v1.User.GetUserById:output_type -> api.user.v1.UserInfoResponse
-	9, // 11: api.user.v1.User.UpdateUser:output_type -> google.protobuf.Empty
-	8, // 12: api.user.v1.User.CheckPassword:output_type -> api.user.v1.CheckResponse
-	7, // [7:13] is the sub-list for method output_type
-	1, // [1:7] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: api.user.v1.UserListResponse.data:type_name -> api.user.v1.UserInfoResponse
+	21, // 1: api.user.v1.UpdateUserInfo.updateMask:type_name -> google.protobuf.FieldMask
+	14, // 2: api.user.v1.AddressListResponse.data:type_name -> api.user.v1.AddressInfo
+	18, // 3: api.user.v1.LockEventListResponse.data:type_name -> api.user.v1.LockEventInfo
+	0,  // 4: api.user.v1.User.CreateUser:input_type -> api.user.v1.CreateUserInfo
+	3,  // 5: api.user.v1.User.GetUserList:input_type -> api.user.v1.PageInfo
+	4,  // 6: api.user.v1.User.SearchUser:input_type -> api.user.v1.UserSearchRequest
+	7,  // 7: api.user.v1.User.GetUserByMobile:input_type -> api.user.v1.MobileRequest
+	8,  // 8: api.user.v1.User.GetUserById:input_type -> api.user.v1.IdRequest
+	9,  // 9: api.user.v1.User.UpdateUser:input_type -> api.user.v1.UpdateUserInfo
+	10, // 10: api.user.v1.User.VerifyCredentials:input_type -> api.user.v1.VerifyCredentialsRequest
+	11, // 11: api.user.v1.User.ChangePassword:input_type -> api.user.v1.ChangePasswordRequest
+	12, // 12: api.user.v1.User.ResetPassword:input_type -> api.user.v1.ResetPasswordRequest
+	5,  // 13: api.user.v1.User.SetUserDisabled:input_type -> api.user.v1.UserDisabledRequest
+	6,  // 14: api.user.v1.User.ChangeRole:input_type -> api.user.v1.ChangeRoleRequest
+	8,  // 15: api.user.v1.User.DeleteUser:input_type -> api.user.v1.IdRequest
+	14, // 16: api.user.v1.User.CreateAddress:input_type -> api.user.v1.AddressInfo
+	14, // 17: api.user.v1.User.UpdateAddress:input_type -> api.user.v1.AddressInfo
+	15, // 18: api.user.v1.User.DeleteAddress:input_type -> api.user.v1.AddressRequest
+	16, // 19: api.user.v1.User.ListAddresses:input_type -> api.user.v1.UserIdRequest
+	15, // 20: api.user.v1.User.SetDefaultAddress:input_type -> api.user.v1.AddressRequest
+	18, // 21: api.user.v1.User.CreateLockEvent:input_type -> api.user.v1.LockEventInfo
+	19, // 22: api.user.v1.User.GetLockEventList:input_type -> api.user.v1.LockEventListRequest
+	1,  // 23: api.user.v1.User.CreateUser:output_type -> api.user.v1.UserInfoResponse
+	2,  // 24: api.user.v1.User.GetUserList:output_type -> api.user.v1.UserListResponse
+	2,  // 25: api.user.v1.User.SearchUser:output_type -> api.user.v1.UserListResponse
+	1,  // 26: api.user.v1.User.GetUserByMobile:output_type -> api.user.v1.UserInfoResponse
+	1,  // 27: api.user.v1.User.GetUserById:output_type -> api.user.v1.UserInfoResponse
+	22, // 28: api.user.v1.User.UpdateUser:output_type -> google.protobuf.Empty
+	1,  // 29: api.user.v1.User.VerifyCredentials:output_type -> api.user.v1.UserInfoResponse
+	13, // 30: api.user.v1.User.ChangePassword:output_type -> api.user.v1.TokenVersionResponse
+	13, // 31: api.user.v1.User.ResetPassword:output_type -> api.user.v1.TokenVersionResponse
+	13, // 32: api.user.v1.User.SetUserDisabled:output_type -> api.user.v1.TokenVersionResponse
+	13, // 33: api.user.v1.User.ChangeRole:output_type -> api.user.v1.TokenVersionResponse
+	13, // 34: api.user.v1.User.DeleteUser:output_type -> api.user.v1.TokenVersionResponse
+	14, // 35: api.user.v1.User.CreateAddress:output_type -> api.user.v1.AddressInfo
+	22, // 36: api.user.v1.User.UpdateAddress:output_type -> google.protobuf.Empty
+	22, // 37: api.user.v1.User.DeleteAddress:output_type -> google.protobuf.Empty
+	17, // 38: api.user.v1.User.ListAddresses:output_type -> api.user.v1.AddressListResponse
+	22, // 39: api.user.v1.User.SetDefaultAddress:output_type -> google.protobuf.Empty
+	22, // 40: api.user.v1.User.CreateLockEvent:output_type -> google.protobuf.Empty
+	20, // 41: api.user.v1.User.GetLockEventList:output_type -> api.user.v1.LockEventListResponse
+	23, // [23:42] is the sub-list for method output_type
+	4,  // [4:23] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_service_user_v1_user_proto_init() }
@@ -636,13 +1616,15 @@ func file_service_user_v1_user_proto_init() {
 	if File_service_user_v1_user_proto != nil {
 		return
 	}
+	file_service_user_v1_user_proto_msgTypes[1].OneofWrappers = []any{}
+	file_service_user_v1_user_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_user_v1_user_proto_rawDesc), len(file_service_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
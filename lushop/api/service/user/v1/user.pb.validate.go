@@ -165,18 +165,24 @@ func (m *UserInfoResponse) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for Password
-
 	// no validation rules for Mobile
 
 	// no validation rules for NickName
 
-	// no validation rules for Birthday
-
 	// no validation rules for Gender
 
 	// no validation rules for Role
 
+	// no validation rules for TokenVersion
+
+	// no validation rules for Disabled
+
+	// no validation rules for CreatedAt
+
+	if m.Birthday != nil {
+		// no validation rules for Birthday
+	}
+
 	if len(errors) > 0 {
 		return UserInfoResponseMultiError(errors)
 	}
@@ -494,22 +500,22 @@ var _ interface {
 	ErrorName() string
 } = PageInfoValidationError{}
 
-// Validate checks the field values on MobileRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *MobileRequest) Validate() error {
+// Validate checks the field values on UserSearchRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserSearchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MobileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MobileRequestMultiError, or
-// nil if none found.
-func (m *MobileRequest) ValidateAll() error {
+// ValidateAll checks the field values on UserSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserSearchRequestMultiError, or nil if none found.
+func (m *UserSearchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MobileRequest) validate(all bool) error {
+func (m *UserSearchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -518,20 +524,34 @@ func (m *MobileRequest) validate(all bool) error {
 
 	// no validation rules for Mobile
 
+	// no validation rules for NickName
+
+	// no validation rules for Role
+
+	// no validation rules for Gender
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for Pn
+
+	// no validation rules for PSize
+
 	if len(errors) > 0 {
-		return MobileRequestMultiError(errors)
+		return UserSearchRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MobileRequestMultiError is an error wrapping multiple validation errors
-// returned by MobileRequest.ValidateAll() if the designated constraints
+// UserSearchRequestMultiError is an error wrapping multiple validation errors
+// returned by UserSearchRequest.ValidateAll() if the designated constraints
 // aren't met.
-type MobileRequestMultiError []error
+type UserSearchRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MobileRequestMultiError) Error() string {
+func (m UserSearchRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -540,11 +560,11 @@ func (m MobileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MobileRequestMultiError) AllErrors() []error { return m }
+func (m UserSearchRequestMultiError) AllErrors() []error { return m }
 
-// MobileRequestValidationError is the validation error returned by
-// MobileRequest.Validate if the designated constraints aren't met.
-type MobileRequestValidationError struct {
+// UserSearchRequestValidationError is the validation error returned by
+// UserSearchRequest.Validate if the designated constraints aren't met.
+type UserSearchRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -552,22 +572,24 @@ type MobileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MobileRequestValidationError) Field() string { return e.field }
+func (e UserSearchRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MobileRequestValidationError) Reason() string { return e.reason }
+func (e UserSearchRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MobileRequestValidationError) Cause() error { return e.cause }
+func (e UserSearchRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MobileRequestValidationError) Key() bool { return e.key }
+func (e UserSearchRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MobileRequestValidationError) ErrorName() string { return "MobileRequestValidationError" }
+func (e UserSearchRequestValidationError) ErrorName() string {
+	return "UserSearchRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MobileRequestValidationError) Error() string {
+func (e UserSearchRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -579,14 +601,14 @@ func (e MobileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMobileRequest.%s: %s%s",
+		"invalid %sUserSearchRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MobileRequestValidationError{}
+var _ error = UserSearchRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -594,24 +616,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MobileRequestValidationError{}
+} = UserSearchRequestValidationError{}
 
-// Validate checks the field values on IdRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *IdRequest) Validate() error {
+// Validate checks the field values on UserDisabledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserDisabledRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IdRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in IdRequestMultiError, or nil
-// if none found.
-func (m *IdRequest) ValidateAll() error {
+// ValidateAll checks the field values on UserDisabledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserDisabledRequestMultiError, or nil if none found.
+func (m *UserDisabledRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IdRequest) validate(all bool) error {
+func (m *UserDisabledRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -620,19 +642,22 @@ func (m *IdRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Disabled
+
 	if len(errors) > 0 {
-		return IdRequestMultiError(errors)
+		return UserDisabledRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IdRequestMultiError is an error wrapping multiple validation errors returned
-// by IdRequest.ValidateAll() if the designated constraints aren't met.
-type IdRequestMultiError []error
+// UserDisabledRequestMultiError is an error wrapping multiple validation
+// errors returned by UserDisabledRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserDisabledRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IdRequestMultiError) Error() string {
+func (m UserDisabledRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -641,11 +666,11 @@ func (m IdRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IdRequestMultiError) AllErrors() []error { return m }
+func (m UserDisabledRequestMultiError) AllErrors() []error { return m }
 
-// IdRequestValidationError is the validation error returned by
-// IdRequest.Validate if the designated constraints aren't met.
-type IdRequestValidationError struct {
+// UserDisabledRequestValidationError is the validation error returned by
+// UserDisabledRequest.Validate if the designated constraints aren't met.
+type UserDisabledRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -653,22 +678,24 @@ type IdRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IdRequestValidationError) Field() string { return e.field }
+func (e UserDisabledRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IdRequestValidationError) Reason() string { return e.reason }
+func (e UserDisabledRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IdRequestValidationError) Cause() error { return e.cause }
+func (e UserDisabledRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IdRequestValidationError) Key() bool { return e.key }
+func (e UserDisabledRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IdRequestValidationError) ErrorName() string { return "IdRequestValidationError" }
+func (e UserDisabledRequestValidationError) ErrorName() string {
+	return "UserDisabledRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e IdRequestValidationError) Error() string {
+func (e UserDisabledRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -680,14 +707,14 @@ func (e IdRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIdRequest.%s: %s%s",
+		"invalid %sUserDisabledRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IdRequestValidationError{}
+var _ error = UserDisabledRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -695,24 +722,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IdRequestValidationError{}
+} = UserDisabledRequestValidationError{}
 
-// Validate checks the field values on UpdateUserInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *UpdateUserInfo) Validate() error {
+// Validate checks the field values on ChangeRoleRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChangeRoleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateUserInfo with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in UpdateUserInfoMultiError,
-// or nil if none found.
-func (m *UpdateUserInfo) ValidateAll() error {
+// ValidateAll checks the field values on ChangeRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeRoleRequestMultiError, or nil if none found.
+func (m *ChangeRoleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateUserInfo) validate(all bool) error {
+func (m *ChangeRoleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -721,26 +748,22 @@ func (m *UpdateUserInfo) validate(all bool) error {
 
 	// no validation rules for Id
 
-	// no validation rules for NickName
-
-	// no validation rules for Gender
-
-	// no validation rules for Birthday
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return UpdateUserInfoMultiError(errors)
+		return ChangeRoleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateUserInfoMultiError is an error wrapping multiple validation errors
-// returned by UpdateUserInfo.ValidateAll() if the designated constraints
+// ChangeRoleRequestMultiError is an error wrapping multiple validation errors
+// returned by ChangeRoleRequest.ValidateAll() if the designated constraints
 // aren't met.
-type UpdateUserInfoMultiError []error
+type ChangeRoleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateUserInfoMultiError) Error() string {
+func (m ChangeRoleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -749,11 +772,11 @@ func (m UpdateUserInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateUserInfoMultiError) AllErrors() []error { return m }
+func (m ChangeRoleRequestMultiError) AllErrors() []error { return m }
 
-// UpdateUserInfoValidationError is the validation error returned by
-// UpdateUserInfo.Validate if the designated constraints aren't met.
-type UpdateUserInfoValidationError struct {
+// ChangeRoleRequestValidationError is the validation error returned by
+// ChangeRoleRequest.Validate if the designated constraints aren't met.
+type ChangeRoleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -761,22 +784,24 @@ type UpdateUserInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserInfoValidationError) Field() string { return e.field }
+func (e ChangeRoleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserInfoValidationError) Reason() string { return e.reason }
+func (e ChangeRoleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserInfoValidationError) Cause() error { return e.cause }
+func (e ChangeRoleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserInfoValidationError) Key() bool { return e.key }
+func (e ChangeRoleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserInfoValidationError) ErrorName() string { return "UpdateUserInfoValidationError" }
+func (e ChangeRoleRequestValidationError) ErrorName() string {
+	return "ChangeRoleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UpdateUserInfoValidationError) Error() string {
+func (e ChangeRoleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -788,14 +813,14 @@ func (e UpdateUserInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserInfo.%s: %s%s",
+		"invalid %sChangeRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserInfoValidationError{}
+var _ error = ChangeRoleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -803,48 +828,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserInfoValidationError{}
+} = ChangeRoleRequestValidationError{}
 
-// Validate checks the field values on PasswordCheckInfo with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PasswordCheckInfo) Validate() error {
+// Validate checks the field values on MobileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MobileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PasswordCheckInfo with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PasswordCheckInfoMultiError, or nil if none found.
-func (m *PasswordCheckInfo) ValidateAll() error {
+// ValidateAll checks the field values on MobileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MobileRequestMultiError, or
+// nil if none found.
+func (m *MobileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PasswordCheckInfo) validate(all bool) error {
+func (m *MobileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Password
-
-	// no validation rules for EncryptedPassword
+	// no validation rules for Mobile
 
 	if len(errors) > 0 {
-		return PasswordCheckInfoMultiError(errors)
+		return MobileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PasswordCheckInfoMultiError is an error wrapping multiple validation errors
-// returned by PasswordCheckInfo.ValidateAll() if the designated constraints
+// MobileRequestMultiError is an error wrapping multiple validation errors
+// returned by MobileRequest.ValidateAll() if the designated constraints
 // aren't met.
-type PasswordCheckInfoMultiError []error
+type MobileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PasswordCheckInfoMultiError) Error() string {
+func (m MobileRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -853,11 +876,11 @@ func (m PasswordCheckInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PasswordCheckInfoMultiError) AllErrors() []error { return m }
+func (m MobileRequestMultiError) AllErrors() []error { return m }
 
-// PasswordCheckInfoValidationError is the validation error returned by
-// PasswordCheckInfo.Validate if the designated constraints aren't met.
-type PasswordCheckInfoValidationError struct {
+// MobileRequestValidationError is the validation error returned by
+// MobileRequest.Validate if the designated constraints aren't met.
+type MobileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -865,24 +888,22 @@ type PasswordCheckInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e PasswordCheckInfoValidationError) Field() string { return e.field }
+func (e MobileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PasswordCheckInfoValidationError) Reason() string { return e.reason }
+func (e MobileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PasswordCheckInfoValidationError) Cause() error { return e.cause }
+func (e MobileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PasswordCheckInfoValidationError) Key() bool { return e.key }
+func (e MobileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PasswordCheckInfoValidationError) ErrorName() string {
-	return "PasswordCheckInfoValidationError"
-}
+func (e MobileRequestValidationError) ErrorName() string { return "MobileRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PasswordCheckInfoValidationError) Error() string {
+func (e MobileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -894,14 +915,14 @@ func (e PasswordCheckInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPasswordCheckInfo.%s: %s%s",
+		"invalid %sMobileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PasswordCheckInfoValidationError{}
+var _ error = MobileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -909,46 +930,45 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PasswordCheckInfoValidationError{}
+} = MobileRequestValidationError{}
 
-// Validate checks the field values on CheckResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on IdRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CheckResponse) Validate() error {
+func (m *IdRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CheckResponseMultiError, or
-// nil if none found.
-func (m *CheckResponse) ValidateAll() error {
+// ValidateAll checks the field values on IdRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdRequestMultiError, or nil
+// if none found.
+func (m *IdRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckResponse) validate(all bool) error {
+func (m *IdRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return CheckResponseMultiError(errors)
+		return IdRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckResponseMultiError is an error wrapping multiple validation errors
-// returned by CheckResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CheckResponseMultiError []error
+// IdRequestMultiError is an error wrapping multiple validation errors returned
+// by IdRequest.ValidateAll() if the designated constraints aren't met.
+type IdRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckResponseMultiError) Error() string {
+func (m IdRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -957,11 +977,11 @@ func (m CheckResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckResponseMultiError) AllErrors() []error { return m }
+func (m IdRequestMultiError) AllErrors() []error { return m }
 
-// CheckResponseValidationError is the validation error returned by
-// CheckResponse.Validate if the designated constraints aren't met.
-type CheckResponseValidationError struct {
+// IdRequestValidationError is the validation error returned by
+// IdRequest.Validate if the designated constraints aren't met.
+type IdRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -969,22 +989,1416 @@ type CheckResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckResponseValidationError) Field() string { return e.field }
+func (e IdRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckResponseValidationError) Reason() string { return e.reason }
+func (e IdRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckResponseValidationError) Cause() error { return e.cause }
+func (e IdRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckResponseValidationError) Key() bool { return e.key }
+func (e IdRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckResponseValidationError) ErrorName() string { return "CheckResponseValidationError" }
+func (e IdRequestValidationError) ErrorName() string { return "IdRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdRequestValidationError{}
+
+// Validate checks the field values on UpdateUserInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateUserInfoMultiError,
+// or nil if none found.
+func (m *UpdateUserInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for NickName
+
+	// no validation rules for Gender
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserInfoValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserInfoValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserInfoValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Birthday != nil {
+		// no validation rules for Birthday
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserInfoMultiError is an error wrapping multiple validation errors
+// returned by UpdateUserInfo.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUserInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserInfoMultiError) AllErrors() []error { return m }
+
+// UpdateUserInfoValidationError is the validation error returned by
+// UpdateUserInfo.Validate if the designated constraints aren't met.
+type UpdateUserInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserInfoValidationError) ErrorName() string { return "UpdateUserInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateUserInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserInfoValidationError{}
+
+// Validate checks the field values on VerifyCredentialsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyCredentialsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyCredentialsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyCredentialsRequestMultiError, or nil if none found.
+func (m *VerifyCredentialsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyCredentialsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mobile
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return VerifyCredentialsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyCredentialsRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyCredentialsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyCredentialsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyCredentialsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyCredentialsRequestMultiError) AllErrors() []error { return m }
+
+// VerifyCredentialsRequestValidationError is the validation error returned by
+// VerifyCredentialsRequest.Validate if the designated constraints aren't met.
+type VerifyCredentialsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyCredentialsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyCredentialsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyCredentialsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyCredentialsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyCredentialsRequestValidationError) ErrorName() string {
+	return "VerifyCredentialsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyCredentialsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyCredentialsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyCredentialsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyCredentialsRequestValidationError{}
+
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OldPassword
+
+	// no validation rules for NewPassword
+
+	if len(errors) > 0 {
+		return ChangePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRequestValidationError{}
+
+// Validate checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordRequestMultiError, or nil if none found.
+func (m *ResetPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mobile
+
+	// no validation rules for NewPassword
+
+	// no validation rules for Ticket
+
+	if len(errors) > 0 {
+		return ResetPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetPasswordRequestValidationError is the validation error returned by
+// ResetPasswordRequest.Validate if the designated constraints aren't met.
+type ResetPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordRequestValidationError) ErrorName() string {
+	return "ResetPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordRequestValidationError{}
+
+// Validate checks the field values on TokenVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TokenVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenVersionResponseMultiError, or nil if none found.
+func (m *TokenVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TokenVersion
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return TokenVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by TokenVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TokenVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenVersionResponseMultiError) AllErrors() []error { return m }
+
+// TokenVersionResponseValidationError is the validation error returned by
+// TokenVersionResponse.Validate if the designated constraints aren't met.
+type TokenVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenVersionResponseValidationError) ErrorName() string {
+	return "TokenVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TokenVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenVersionResponseValidationError{}
+
+// Validate checks the field values on AddressInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressInfoMultiError, or
+// nil if none found.
+func (m *AddressInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for ProvinceCode
+
+	// no validation rules for CityCode
+
+	// no validation rules for DistrictCode
+
+	// no validation rules for Recipient
+
+	// no validation rules for Mobile
+
+	// no validation rules for Detail
+
+	// no validation rules for IsDefault
+
+	if len(errors) > 0 {
+		return AddressInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressInfoMultiError is an error wrapping multiple validation errors
+// returned by AddressInfo.ValidateAll() if the designated constraints aren't met.
+type AddressInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressInfoMultiError) AllErrors() []error { return m }
+
+// AddressInfoValidationError is the validation error returned by
+// AddressInfo.Validate if the designated constraints aren't met.
+type AddressInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressInfoValidationError) ErrorName() string { return "AddressInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressInfoValidationError{}
+
+// Validate checks the field values on AddressRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressRequestMultiError,
+// or nil if none found.
+func (m *AddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return AddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressRequestMultiError is an error wrapping multiple validation errors
+// returned by AddressRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressRequestMultiError) AllErrors() []error { return m }
+
+// AddressRequestValidationError is the validation error returned by
+// AddressRequest.Validate if the designated constraints aren't met.
+type AddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressRequestValidationError) ErrorName() string { return "AddressRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressRequestValidationError{}
+
+// Validate checks the field values on UserIdRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserIdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserIdRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserIdRequestMultiError, or
+// nil if none found.
+func (m *UserIdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserIdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return UserIdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserIdRequestMultiError is an error wrapping multiple validation errors
+// returned by UserIdRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UserIdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserIdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserIdRequestMultiError) AllErrors() []error { return m }
+
+// UserIdRequestValidationError is the validation error returned by
+// UserIdRequest.Validate if the designated constraints aren't met.
+type UserIdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserIdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserIdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserIdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserIdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserIdRequestValidationError) ErrorName() string { return "UserIdRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserIdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserIdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserIdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserIdRequestValidationError{}
+
+// Validate checks the field values on AddressListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddressListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddressListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddressListResponseMultiError, or nil if none found.
+func (m *AddressListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddressListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddressListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddressListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddressListResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddressListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddressListResponseMultiError is an error wrapping multiple validation
+// errors returned by AddressListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddressListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressListResponseMultiError) AllErrors() []error { return m }
+
+// AddressListResponseValidationError is the validation error returned by
+// AddressListResponse.Validate if the designated constraints aren't met.
+type AddressListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressListResponseValidationError) ErrorName() string {
+	return "AddressListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddressListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddressListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressListResponseValidationError{}
+
+// Validate checks the field values on LockEventInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LockEventInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockEventInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LockEventInfoMultiError, or
+// nil if none found.
+func (m *LockEventInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockEventInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Mobile
+
+	// no validation rules for Ip
+
+	// no validation rules for Action
+
+	// no validation rules for Scope
+
+	// no validation rules for Reason
+
+	// no validation rules for Failures
+
+	// no validation rules for Until
+
+	// no validation rules for Operator
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return LockEventInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockEventInfoMultiError is an error wrapping multiple validation errors
+// returned by LockEventInfo.ValidateAll() if the designated constraints
+// aren't met.
+type LockEventInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockEventInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockEventInfoMultiError) AllErrors() []error { return m }
+
+// LockEventInfoValidationError is the validation error returned by
+// LockEventInfo.Validate if the designated constraints aren't met.
+type LockEventInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockEventInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockEventInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockEventInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockEventInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockEventInfoValidationError) ErrorName() string { return "LockEventInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LockEventInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockEventInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockEventInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockEventInfoValidationError{}
+
+// Validate checks the field values on LockEventListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LockEventListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockEventListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockEventListRequestMultiError, or nil if none found.
+func (m *LockEventListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockEventListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Mobile
+
+	// no validation rules for Ip
+
+	// no validation rules for Pn
+
+	// no validation rules for PSize
+
+	if len(errors) > 0 {
+		return LockEventListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockEventListRequestMultiError is an error wrapping multiple validation
+// errors returned by LockEventListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type LockEventListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockEventListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockEventListRequestMultiError) AllErrors() []error { return m }
+
+// LockEventListRequestValidationError is the validation error returned by
+// LockEventListRequest.Validate if the designated constraints aren't met.
+type LockEventListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockEventListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockEventListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockEventListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockEventListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockEventListRequestValidationError) ErrorName() string {
+	return "LockEventListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LockEventListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLockEventListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LockEventListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LockEventListRequestValidationError{}
+
+// Validate checks the field values on LockEventListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LockEventListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LockEventListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LockEventListResponseMultiError, or nil if none found.
+func (m *LockEventListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LockEventListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LockEventListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LockEventListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LockEventListResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LockEventListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LockEventListResponseMultiError is an error wrapping multiple validation
+// errors returned by LockEventListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type LockEventListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LockEventListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LockEventListResponseMultiError) AllErrors() []error { return m }
+
+// LockEventListResponseValidationError is the validation error returned by
+// LockEventListResponse.Validate if the designated constraints aren't met.
+type LockEventListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LockEventListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LockEventListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LockEventListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LockEventListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LockEventListResponseValidationError) ErrorName() string {
+	return "LockEventListResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CheckResponseValidationError) Error() string {
+func (e LockEventListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -996,14 +2410,14 @@ func (e CheckResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckResponse.%s: %s%s",
+		"invalid %sLockEventListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CheckResponseValidationError{}
+var _ error = LockEventListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1011,4 +2425,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckResponseValidationError{}
+} = LockEventListResponseValidationError{}
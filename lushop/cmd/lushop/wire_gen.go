@@ -22,15 +22,47 @@ import (
 func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, confService *conf.Service, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
 	discovery := data.NewDiscovery(registry)
 	userClient := data.NewUserServiceClient(auth, confService, discovery)
-	dataData, err := data.NewData(confData, userClient, logger)
+	cartClient, err := data.NewCartServiceClient(confService, discovery)
+	if err != nil {
+		return nil, nil, err
+	}
+	goodsClient := data.NewGoodsServiceClient(confService, discovery)
+	client := data.NewRedis(confData)
+	dataData, err := data.NewData(confData, client, userClient, cartClient, goodsClient, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger, auth)
-	lushopService := service.NewLushopService(userUsecase, logger)
-	httpServer := server.NewHTTPServer(confServer, auth, lushopService, logger)
-	grpcServer := server.NewGRPCServer(confServer, lushopService, logger)
+	cartRepo := data.NewCartRepo(dataData, logger)
+	tokenRepo := data.NewTokenRepo(dataData, logger)
+	tokenUsecase := biz.NewTokenUsecase(tokenRepo, userRepo, auth, logger)
+	loginGuardRepo := data.NewLoginGuardRepo(dataData, logger)
+	loginGuardUsecase := biz.NewLoginGuardUsecase(loginGuardRepo, userRepo, auth, logger)
+	captchaCaptcha := data.NewCaptcha(auth, client)
+	smsRepo := data.NewSmsRepo(dataData, logger)
+	smsSender, err := data.NewSmsSender(auth, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	clientIP, err := biz.NewClientIP(auth)
+	if err != nil {
+		return nil, nil, err
+	}
+	smsUsecase, err := biz.NewSmsUsecase(smsRepo, smsSender, clientIP, auth, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	userUsecase := biz.NewUserUsecase(userRepo, cartRepo, tokenUsecase, loginGuardUsecase, captchaCaptcha, smsUsecase, clientIP, logger)
+	cartUsecase := biz.NewCartUsecase(cartRepo, logger)
+	addressRepo := data.NewAddressRepo(dataData, logger)
+	addressUsecase := biz.NewAddressUsecase(addressRepo, logger)
+	accountUsecase := biz.NewAccountUsecase(userRepo, addressRepo, cartRepo, tokenUsecase, logger)
+	goodsRepo := data.NewGoodsRepo(dataData, logger)
+	goodsAdminRepo := data.NewGoodsAdminRepo(dataData, logger)
+	goodsUsecase := biz.NewGoodsUsecase(goodsRepo, goodsAdminRepo, logger)
+	lushopService := service.NewLushopService(userUsecase, cartUsecase, goodsUsecase, tokenUsecase, smsUsecase, addressUsecase, accountUsecase, logger)
+	httpServer := server.NewHTTPServer(confServer, auth, lushopService, tokenUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, auth, lushopService, logger)
 	registrar := data.NewRegister(registry)
 	app := newApp(logger, httpServer, grpcServer, registrar)
 	return app, func() {
@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	v1 "lushop/api/lushop/v1"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var ErrAccountPasswordInvalid = kerrors.BadRequest("ACCOUNT_PASSWORD_INVALID", "密码错误")
+
+// AccountUsecase 账号注销和个人数据导出，需要汇总用户服务和购物车服务的数据
+type AccountUsecase struct {
+	uRepo UserRepo
+	aRepo AddressRepo
+	cRepo CartRepo
+	tu    *TokenUsecase
+	log   *log.Helper
+}
+
+func NewAccountUsecase(uRepo UserRepo, aRepo AddressRepo, cRepo CartRepo, tu *TokenUsecase, logger log.Logger) *AccountUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "usecase/account"))
+	return &AccountUsecase{uRepo: uRepo, aRepo: aRepo, cRepo: cRepo, tu: tu, log: helper}
+}
+
+// DeleteAccount 验证密码后注销账号。先由用户服务匿名化用户并删除收货地址，用户ID保留，
+// 订单等数据的引用不受影响，然后让已签发的 token 失效，最后尽量清空购物车、稍后购买和收藏夹，
+// 这些清理失败只记录日志，不影响注销结果
+func (uc *AccountUsecase) DeleteAccount(ctx context.Context, req *v1.DeleteAccountReq) (*emptypb.Empty, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.uRepo.UserById(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.uRepo.VerifyCredentials(ctx, user.Mobile, req.Password); err != nil {
+		if kerrors.IsUnauthorized(err) {
+			return nil, ErrAccountPasswordInvalid
+		}
+		return nil, err
+	}
+
+	version, err := uc.uRepo.DeleteUser(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tu.BumpVersion(ctx, uid, version); err != nil {
+		// 用户已经删除，refresh token 换取 token 时查不到用户，这里只影响还没过期的 access token
+		uc.log.Errorf("bump token version error, user: %d, err: %v", uid, err)
+	}
+	uc.clearLists(ctx, uid)
+	return &emptypb.Empty{}, nil
+}
+
+// clearLists 清空购物车、稍后购买和收藏夹，收藏夹通过取消收藏清空，商品的收藏数同时减一
+func (uc *AccountUsecase) clearLists(ctx context.Context, uid int64) {
+	if err := uc.cRepo.Clear(ctx, uid); err != nil {
+		uc.log.Errorf("clear cart error, user: %d, err: %v", uid, err)
+	}
+	if saved, err := uc.cRepo.ListSaved(ctx, uid); err != nil {
+		uc.log.Errorf("list saved error, user: %d, err: %v", uid, err)
+	} else if len(saved) > 0 {
+		skuIds := make([]int64, 0, len(saved))
+		for _, item := range saved {
+			skuIds = append(skuIds, item.SkuId)
+		}
+		if err := uc.cRepo.DeleteSaved(ctx, uid, skuIds...); err != nil {
+			uc.log.Errorf("delete saved error, user: %d, err: %v", uid, err)
+		}
+	}
+	if wishlist, err := uc.cRepo.ListWishlist(ctx, uid); err != nil {
+		uc.log.Errorf("list wishlist error, user: %d, err: %v", uid, err)
+	} else if len(wishlist) > 0 {
+		goodsIds := make([]int64, 0, len(wishlist))
+		for _, item := range wishlist {
+			goodsIds = append(goodsIds, item.GoodsId)
+		}
+		if err := uc.cRepo.RemoveWishlist(ctx, uid, goodsIds...); err != nil {
+			uc.log.Errorf("remove wishlist error, user: %d, err: %v", uid, err)
+		}
+	}
+}
+
+// ExportData 导出个人资料、收货地址、购物车、稍后购买和收藏夹，通过响应头提示浏览器下载为 JSON 文件。
+// 还没有订单服务，orders 始终为空数组，接入订单服务后在这里补充
+func (uc *AccountUsecase) ExportData(ctx context.Context) (*v1.UserDataExport, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.uRepo.UserById(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := uc.aRepo.List(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	cart, err := uc.cRepo.List(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	saved, err := uc.cRepo.ListSaved(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	wishlist, err := uc.cRepo.ListWishlist(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	rsp := &v1.UserDataExport{
+		ExportedAt: now.Unix(),
+		Profile:    userDetail(user),
+		Cart:       cartListReply(cart).List,
+		Saved:      cartListReply(saved).List,
+		Orders:     []*v1.OrderExportItem{},
+	}
+	for _, a := range addresses {
+		rsp.Addresses = append(rsp.Addresses, addressItem(a))
+	}
+	for _, w := range wishlist {
+		rsp.Wishlist = append(rsp.Wishlist, &v1.WishlistExportItem{
+			GoodsId:   w.GoodsId,
+			GoodsName: w.GoodsName,
+			GoodsSn:   w.GoodsSn,
+			CreatedAt: w.CreatedAt,
+		})
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		name := fmt.Sprintf("lushop-user-%d-%s.json", uid, now.Format("20060102150405"))
+		tr.ReplyHeader().Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	}
+	return rsp, nil
+}
@@ -0,0 +1,77 @@
+package biz_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "lushop/api/lushop/v1"
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt5 "github.com/golang-jwt/jwt/v5"
+)
+
+func newAccountUsecase(cRepo *fakeCartRepo) (*biz.AccountUsecase, *fakeUserRepo, *fakeTokenRepo, context.Context) {
+	tu, tRepo, uRepo := newTokenUsecase(&biz.User{ID: 1, Mobile: "13803881388", Password: "lucien123", TokenVersion: 2})
+	uRepo.calls = cRepo.calls
+	uc := biz.NewAccountUsecase(uRepo, nil, cRepo, tu, log.DefaultLogger)
+	ctx := jwt.NewContext(context.Background(), jwt5.MapClaims{"ID": float64(1)})
+	return uc, uRepo, tRepo, ctx
+}
+
+func TestDeleteAccount(t *testing.T) {
+	var calls []string
+	cRepo := &fakeCartRepo{
+		saved:    []*biz.CartItem{{SkuId: 11}},
+		wishlist: []*biz.WishlistItem{{GoodsId: 21}},
+		calls:    &calls,
+	}
+	uc, _, tRepo, ctx := newAccountUsecase(cRepo)
+	if _, err := uc.DeleteAccount(ctx, &v1.DeleteAccountReq{Password: "lucien123"}); err != nil {
+		t.Fatal(err)
+	}
+	// 先删除用户，再清理购物车、稍后购买和收藏夹
+	want := []string{"DeleteUser", "Clear", "DeleteSaved", "RemoveWishlist"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	if tRepo.versions[1] != 3 {
+		t.Fatalf("token version = %d, want 3", tRepo.versions[1])
+	}
+}
+
+func TestDeleteAccountClearFailed(t *testing.T) {
+	// 清理失败不影响注销结果，后面的清理继续执行
+	var calls []string
+	cRepo := &fakeCartRepo{
+		wishlist: []*biz.WishlistItem{{GoodsId: 21}},
+		calls:    &calls,
+		err:      errors.ServiceUnavailable("CART_UNAVAILABLE", "cart unavailable"),
+	}
+	uc, uRepo, _, ctx := newAccountUsecase(cRepo)
+	if _, err := uc.DeleteAccount(ctx, &v1.DeleteAccountReq{Password: "lucien123"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(uRepo.users) != 0 {
+		t.Fatal("user not deleted")
+	}
+	want := []string{"DeleteUser", "Clear", "RemoveWishlist"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestDeleteAccountPasswordInvalid(t *testing.T) {
+	var calls []string
+	uc, _, _, ctx := newAccountUsecase(&fakeCartRepo{calls: &calls})
+	_, err := uc.DeleteAccount(ctx, &v1.DeleteAccountReq{Password: "wrong"})
+	if err != biz.ErrAccountPasswordInvalid {
+		t.Fatalf("err = %v, want %v", err, biz.ErrAccountPasswordInvalid)
+	}
+	if len(calls) != 0 {
+		t.Fatalf("calls = %v, want none", calls)
+	}
+}
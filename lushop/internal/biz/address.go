@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	v1 "lushop/api/lushop/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// 收货地址
+type Address struct {
+	ID           int64
+	UserID       int64
+	ProvinceCode string
+	CityCode     string
+	DistrictCode string
+	Recipient    string
+	Mobile       string
+	Detail       string
+	IsDefault    bool
+}
+
+type AddressRepo interface {
+	List(ctx context.Context, userId int64) ([]*Address, error)
+	Create(ctx context.Context, a *Address) (*Address, error)
+	Update(ctx context.Context, a *Address) error
+	Delete(ctx context.Context, userId, id int64) error
+	SetDefault(ctx context.Context, userId, id int64) error
+}
+
+type AddressUsecase struct {
+	aRepo AddressRepo
+	log   *log.Helper
+}
+
+func NewAddressUsecase(repo AddressRepo, logger log.Logger) *AddressUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "usecase/address"))
+	return &AddressUsecase{aRepo: repo, log: helper}
+}
+
+func (uc *AddressUsecase) ListAddress(ctx context.Context) (*v1.AddressListReply, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := uc.aRepo.List(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	rv := &v1.AddressListReply{}
+	for _, a := range list {
+		rv.List = append(rv.List, addressItem(a))
+	}
+	return rv, nil
+}
+
+func (uc *AddressUsecase) CreateAddress(ctx context.Context, req *v1.AddressReq) (*v1.AddressItem, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := uc.aRepo.Create(ctx, addressFromReq(uid, req))
+	if err != nil {
+		return nil, err
+	}
+	return addressItem(a), nil
+}
+
+func (uc *AddressUsecase) UpdateAddress(ctx context.Context, req *v1.AddressReq) (*emptypb.Empty, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.aRepo.Update(ctx, addressFromReq(uid, req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (uc *AddressUsecase) DeleteAddress(ctx context.Context, req *v1.AddressIdReq) (*emptypb.Empty, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.aRepo.Delete(ctx, uid, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 设为默认地址，原来的默认地址会被取消
+func (uc *AddressUsecase) SetDefaultAddress(ctx context.Context, req *v1.AddressIdReq) (*emptypb.Empty, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.aRepo.SetDefault(ctx, uid, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func addressFromReq(userId int64, req *v1.AddressReq) *Address {
+	return &Address{
+		ID:           req.Id,
+		UserID:       userId,
+		ProvinceCode: req.ProvinceCode,
+		CityCode:     req.CityCode,
+		DistrictCode: req.DistrictCode,
+		Recipient:    req.Recipient,
+		Mobile:       req.Mobile,
+		Detail:       req.Detail,
+		IsDefault:    req.IsDefault,
+	}
+}
+
+func addressItem(a *Address) *v1.AddressItem {
+	return &v1.AddressItem{
+		Id:           a.ID,
+		ProvinceCode: a.ProvinceCode,
+		CityCode:     a.CityCode,
+		DistrictCode: a.DistrictCode,
+		Recipient:    a.Recipient,
+		Mobile:       a.Mobile,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+	}
+}
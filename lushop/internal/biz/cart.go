@@ -0,0 +1,229 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	v1 "lushop/api/lushop/v1"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// DeviceTokenHeader 游客购物车使用的设备 token 请求头
+const DeviceTokenHeader = "X-Device-Token"
+
+var ErrDeviceTokenInvalid = errors.BadRequest("DEVICE_TOKEN_INVALID", "设备 token 无效")
+
+// 购物车商品
+type CartItem struct {
+	ID           int64
+	GoodsId      int64
+	GoodsSn      string
+	GoodsName    string
+	SkuId        int64
+	SkuName      string
+	GoodsPrice   int64
+	CurrentPrice int64
+	GoodsNum     int32
+	IsSelect     bool
+	OnSale       bool
+	Stock        int64
+	PriceChanged bool
+	OutOfStock   bool
+	OverLimit    bool
+}
+
+// WishlistItem 收藏夹中的商品
+type WishlistItem struct {
+	ID        int64
+	GoodsId   int64
+	GoodsName string
+	GoodsSn   string
+	CreatedAt int64
+}
+
+type CartRepo interface {
+	List(ctx context.Context, userId int64) ([]*CartItem, error)
+	Create(ctx context.Context, userId, skuId int64, num int32) (*CartItem, error)
+	Update(ctx context.Context, userId, skuId int64, num int32) error
+	Delete(ctx context.Context, userId int64, skuIds ...int64) error
+	Select(ctx context.Context, userId int64, isSelect bool, skuIds ...int64) error
+	Clear(ctx context.Context, userId int64) error
+	ListSaved(ctx context.Context, userId int64) ([]*CartItem, error)
+	DeleteSaved(ctx context.Context, userId int64, skuIds ...int64) error
+	ListWishlist(ctx context.Context, userId int64) ([]*WishlistItem, error)
+	// RemoveWishlist 取消收藏，购物车服务同时发送商品收藏数减一的事件
+	RemoveWishlist(ctx context.Context, userId int64, goodsIds ...int64) error
+	GuestList(ctx context.Context, token string) ([]*CartItem, error)
+	GuestCreate(ctx context.Context, token string, skuId int64, num int32) (*CartItem, error)
+	GuestUpdate(ctx context.Context, token string, skuId int64, num int32) error
+	GuestDelete(ctx context.Context, token string, skuIds ...int64) error
+	MergeGuest(ctx context.Context, token string, userId int64) error
+}
+
+type CartUsecase struct {
+	cRepo CartRepo
+	log   *log.Helper
+}
+
+func NewCartUsecase(repo CartRepo, logger log.Logger) *CartUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "usecase/cart"))
+	return &CartUsecase{cRepo: repo, log: helper}
+}
+
+func (uc *CartUsecase) ListCart(ctx context.Context) (*v1.CartListReply, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := uc.cRepo.List(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return cartListReply(list), nil
+}
+
+func (uc *CartUsecase) CreateCart(ctx context.Context, req *v1.CartReq) (*v1.CartItem, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	item, err := uc.cRepo.Create(ctx, uid, req.SkuId, req.GoodsNum)
+	if err != nil {
+		return nil, err
+	}
+	return cartItemReply(item), nil
+}
+
+func (uc *CartUsecase) UpdateCart(ctx context.Context, req *v1.CartReq) (*emptypb.Empty, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cRepo.Update(ctx, uid, req.SkuId, req.GoodsNum); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (uc *CartUsecase) DeleteCart(ctx context.Context, req *v1.DeleteCartReq) (*emptypb.Empty, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cRepo.Delete(ctx, uid, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 选中或取消选中购物车商品，没有指定商品时操作所有商品
+func (uc *CartUsecase) SelectCart(ctx context.Context, req *v1.SelectCartReq) (*emptypb.Empty, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cRepo.Select(ctx, uid, req.IsSelect, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 签发游客的设备 token
+func (uc *CartUsecase) GuestToken(ctx context.Context) (*v1.GuestTokenReply, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return &v1.GuestTokenReply{Token: hex.EncodeToString(b)}, nil
+}
+
+func (uc *CartUsecase) ListGuestCart(ctx context.Context) (*v1.CartListReply, error) {
+	token, err := deviceToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := uc.cRepo.GuestList(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return cartListReply(list), nil
+}
+
+func (uc *CartUsecase) CreateGuestCart(ctx context.Context, req *v1.GuestCartReq) (*v1.CartItem, error) {
+	token, err := deviceToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	item, err := uc.cRepo.GuestCreate(ctx, token, req.SkuId, req.GoodsNum)
+	if err != nil {
+		return nil, err
+	}
+	return cartItemReply(item), nil
+}
+
+func (uc *CartUsecase) UpdateGuestCart(ctx context.Context, req *v1.GuestCartReq) (*emptypb.Empty, error) {
+	token, err := deviceToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cRepo.GuestUpdate(ctx, token, req.SkuId, req.GoodsNum); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (uc *CartUsecase) DeleteGuestCart(ctx context.Context, req *v1.DeleteCartReq) (*emptypb.Empty, error) {
+	token, err := deviceToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cRepo.GuestDelete(ctx, token, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 从请求头取出设备 token，token 为网关签发的 32 位十六进制字符串
+func deviceToken(ctx context.Context) (string, error) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return "", ErrDeviceTokenInvalid
+	}
+	token := tr.RequestHeader().Get(DeviceTokenHeader)
+	if b, err := hex.DecodeString(token); err != nil || len(b) != 16 {
+		return "", ErrDeviceTokenInvalid
+	}
+	return token, nil
+}
+
+func cartListReply(list []*CartItem) *v1.CartListReply {
+	rsp := &v1.CartListReply{}
+	for _, item := range list {
+		rsp.List = append(rsp.List, cartItemReply(item))
+	}
+	return rsp
+}
+
+func cartItemReply(item *CartItem) *v1.CartItem {
+	return &v1.CartItem{
+		Id:           item.ID,
+		GoodsId:      item.GoodsId,
+		GoodsSn:      item.GoodsSn,
+		GoodsName:    item.GoodsName,
+		SkuId:        item.SkuId,
+		SkuName:      item.SkuName,
+		GoodsPrice:   item.GoodsPrice,
+		CurrentPrice: item.CurrentPrice,
+		GoodsNum:     item.GoodsNum,
+		IsSelect:     item.IsSelect,
+		OnSale:       item.OnSale,
+		Stock:        item.Stock,
+		PriceChanged: item.PriceChanged,
+		OutOfStock:   item.OutOfStock,
+		OverLimit:    item.OverLimit,
+	}
+}
@@ -0,0 +1,99 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"lushop/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientIP 获取客户端IP，用于登录失败和短信发送的 IP 限制。
+// 只有直接连接的地址是可信代理时才使用代理设置的请求头，否则请求头可以被客户端任意伪造
+type ClientIP struct {
+	trusted []*net.IPNet
+}
+
+// NewClientIP 解析配置的可信代理，配置错误时启动失败
+func NewClientIP(conf *conf.Auth) (*ClientIP, error) {
+	c := &ClientIP{}
+	for _, proxy := range conf.GetTrustedProxies() {
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("auth.trusted_proxies: invalid proxy %q: %w", proxy, err)
+		}
+		c.trusted = append(c.trusted, ipNet)
+	}
+	return c, nil
+}
+
+// FromContext 直接连接的地址不是可信代理时返回该地址；是可信代理时优先使用 X-Real-IP，
+// 其次从右向左取 X-Forwarded-For 中第一个不是可信代理的地址
+func (c *ClientIP) FromContext(ctx context.Context) string {
+	remote := remoteIP(ctx)
+	if remote == "" || !c.isTrusted(remote) {
+		return remote
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return remote
+	}
+	if ip := strings.TrimSpace(tr.RequestHeader().Get("X-Real-IP")); net.ParseIP(ip) != nil {
+		return ip
+	}
+	client := remote
+	hops := strings.Split(tr.RequestHeader().Get("X-Forwarded-For"), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(hops[i])
+		if net.ParseIP(ip) == nil {
+			break
+		}
+		client = ip
+		if !c.isTrusted(ip) {
+			break
+		}
+	}
+	return client
+}
+
+func (c *ClientIP) isTrusted(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range c.trusted {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteIP 直接连接的地址，http 为 RemoteAddr，grpc 为 peer 地址
+func remoteIP(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if ht, ok := tr.(khttp.Transporter); ok {
+			if host, _, err := net.SplitHostPort(ht.Request().RemoteAddr); err == nil {
+				return host
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+package biz_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"lushop/internal/biz"
+	"lushop/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// headerTransport grpc 请求的 transport，只提供请求头
+type headerTransport struct {
+	header transport.Header
+}
+
+func (t *headerTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *headerTransport) Endpoint() string                { return "" }
+func (t *headerTransport) Operation() string               { return "" }
+func (t *headerTransport) RequestHeader() transport.Header { return t.header }
+func (t *headerTransport) ReplyHeader() transport.Header   { return nil }
+
+type headerCarrier metadata.MD
+
+func (h headerCarrier) Get(key string) string {
+	if v := metadata.MD(h).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+func (h headerCarrier) Set(key, value string)      { metadata.MD(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { metadata.MD(h).Append(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return metadata.MD(h).Get(key) }
+
+func TestClientIP(t *testing.T) {
+	resolver, err := biz.NewClientIP(&conf.Auth{TrustedProxies: []string{"127.0.0.1", "10.0.0.0/8"}})
+	if err != nil {
+		t.Fatalf("new client ip: %v", err)
+	}
+	tests := []struct {
+		name    string
+		remote  string
+		headers map[string]string
+		want    string
+	}{
+		{"不可信的地址忽略 X-Real-IP", "1.1.1.1", map[string]string{"X-Real-IP": "9.9.9.9"}, "1.1.1.1"},
+		{"不可信的地址忽略 X-Forwarded-For", "1.1.1.1", map[string]string{"X-Forwarded-For": "9.9.9.9"}, "1.1.1.1"},
+		{"可信代理使用 X-Real-IP", "127.0.0.1", map[string]string{"X-Real-IP": "2.2.2.2"}, "2.2.2.2"},
+		{"可信代理跳过多级代理", "127.0.0.1", map[string]string{"X-Forwarded-For": "2.2.2.2, 10.0.0.2"}, "2.2.2.2"},
+		{"客户端伪造的地址在最左边", "127.0.0.1", map[string]string{"X-Forwarded-For": "9.9.9.9, 2.2.2.2"}, "2.2.2.2"},
+		{"可信代理没有请求头", "10.1.2.3", nil, "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := metadata.MD{}
+			for k, v := range tt.headers {
+				md.Set(k, v)
+			}
+			ctx := transport.NewServerContext(context.Background(), &headerTransport{header: headerCarrier(md)})
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.remote), Port: 5000}})
+			if got := resolver.FromContext(ctx); got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientIPInvalidProxy(t *testing.T) {
+	if _, err := biz.NewClientIP(&conf.Auth{TrustedProxies: []string{"not-an-ip"}}); err == nil {
+		t.Fatal("invalid trusted proxy should fail")
+	}
+}
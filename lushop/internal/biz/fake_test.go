@@ -0,0 +1,224 @@
+package biz_test
+
+import (
+	"context"
+	"time"
+
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// fakeUserRepo 只实现测试用到的方法，其他方法调用时 panic
+type fakeUserRepo struct {
+	biz.UserRepo
+	users   map[int64]*biz.User
+	events  []*biz.LockEvent
+	updated []string  // 最近一次 UpdateProfile 更新的字段
+	calls   *[]string // 不为 nil 时记录 DeleteUser 的调用顺序
+}
+
+func (r *fakeUserRepo) UserById(ctx context.Context, id int64) (*biz.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, errors.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	return u, nil
+}
+
+func (r *fakeUserRepo) VerifyCredentials(ctx context.Context, mobile, password string) (*biz.User, error) {
+	for _, u := range r.users {
+		if u.Mobile == mobile && u.Password == password {
+			return u, nil
+		}
+	}
+	return nil, errors.Unauthorized("INVALID_CREDENTIALS", "手机号或密码错误")
+}
+
+func (r *fakeUserRepo) DeleteUser(ctx context.Context, id int64) (int64, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return 0, errors.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	delete(r.users, id)
+	if r.calls != nil {
+		*r.calls = append(*r.calls, "DeleteUser")
+	}
+	return u.TokenVersion + 1, nil
+}
+
+func (r *fakeUserRepo) UpdateProfile(ctx context.Context, u *biz.User, fields []string) error {
+	user, ok := r.users[u.ID]
+	if !ok {
+		return errors.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	for _, field := range fields {
+		switch field {
+		case biz.ProfileFieldNickName:
+			user.NickName = u.NickName
+		case biz.ProfileFieldGender:
+			user.Gender = u.Gender
+		case biz.ProfileFieldBirthday:
+			user.Birthday = u.Birthday
+		}
+	}
+	r.updated = fields
+	return nil
+}
+
+func (r *fakeUserRepo) CreateLockEvent(ctx context.Context, e *biz.LockEvent) error {
+	r.events = append(r.events, e)
+	return nil
+}
+
+// fakeCartRepo 记录账号注销时的调用顺序，err 不为 nil 时清理购物车失败
+type fakeCartRepo struct {
+	biz.CartRepo
+	saved    []*biz.CartItem
+	wishlist []*biz.WishlistItem
+	calls    *[]string
+	err      error
+}
+
+func (r *fakeCartRepo) Clear(ctx context.Context, userId int64) error {
+	*r.calls = append(*r.calls, "Clear")
+	return r.err
+}
+
+func (r *fakeCartRepo) ListSaved(ctx context.Context, userId int64) ([]*biz.CartItem, error) {
+	return r.saved, nil
+}
+
+func (r *fakeCartRepo) DeleteSaved(ctx context.Context, userId int64, skuIds ...int64) error {
+	*r.calls = append(*r.calls, "DeleteSaved")
+	return r.err
+}
+
+func (r *fakeCartRepo) ListWishlist(ctx context.Context, userId int64) ([]*biz.WishlistItem, error) {
+	return r.wishlist, nil
+}
+
+func (r *fakeCartRepo) RemoveWishlist(ctx context.Context, userId int64, goodsIds ...int64) error {
+	*r.calls = append(*r.calls, "RemoveWishlist")
+	return r.err
+}
+
+type fakeRefresh struct {
+	session *biz.RefreshSession
+	used    bool
+}
+
+// fakeTokenRepo 内存中的 TokenRepo，行为和 redis 实现一致
+type fakeTokenRepo struct {
+	refresh  map[string]*fakeRefresh
+	revoked  map[string]bool
+	denied   map[string]bool
+	versions map[int64]int64
+}
+
+func newFakeTokenRepo() *fakeTokenRepo {
+	return &fakeTokenRepo{
+		refresh:  make(map[string]*fakeRefresh),
+		revoked:  make(map[string]bool),
+		denied:   make(map[string]bool),
+		versions: make(map[int64]int64),
+	}
+}
+
+func (r *fakeTokenRepo) SaveRefresh(ctx context.Context, hash string, s *biz.RefreshSession, ttl time.Duration) error {
+	session := *s
+	r.refresh[hash] = &fakeRefresh{session: &session}
+	return nil
+}
+
+func (r *fakeTokenRepo) ConsumeRefresh(ctx context.Context, hash string) (*biz.RefreshSession, error) {
+	t, ok := r.refresh[hash]
+	if !ok {
+		return nil, biz.ErrRefreshTokenInvalid
+	}
+	if t.used {
+		return t.session, biz.ErrRefreshTokenReused
+	}
+	t.used = true
+	return t.session, nil
+}
+
+func (r *fakeTokenRepo) GetRefresh(ctx context.Context, hash string) (*biz.RefreshSession, error) {
+	t, ok := r.refresh[hash]
+	if !ok {
+		return nil, biz.ErrRefreshTokenInvalid
+	}
+	return t.session, nil
+}
+
+func (r *fakeTokenRepo) RevokeFamily(ctx context.Context, family string) error {
+	r.revoked[family] = true
+	return nil
+}
+
+func (r *fakeTokenRepo) FamilyActive(ctx context.Context, family string) (bool, error) {
+	return !r.revoked[family], nil
+}
+
+func (r *fakeTokenRepo) DenyAccess(ctx context.Context, jti string, ttl time.Duration) error {
+	r.denied[jti] = true
+	return nil
+}
+
+func (r *fakeTokenRepo) AccessDenied(ctx context.Context, jti string) (bool, error) {
+	return r.denied[jti], nil
+}
+
+func (r *fakeTokenRepo) TokenVersion(ctx context.Context, userId int64) (int64, bool, error) {
+	v, ok := r.versions[userId]
+	return v, ok, nil
+}
+
+func (r *fakeTokenRepo) SetTokenVersion(ctx context.Context, userId, version int64) error {
+	if v, ok := r.versions[userId]; !ok || version > v {
+		r.versions[userId] = version
+	}
+	return nil
+}
+
+// fakeGuardRepo 内存中的 LoginGuardRepo，不区分滑动窗口
+type fakeGuardRepo struct {
+	failures map[string]int64
+	locks    map[string]time.Duration
+}
+
+func newFakeGuardRepo() *fakeGuardRepo {
+	return &fakeGuardRepo{failures: make(map[string]int64), locks: make(map[string]time.Duration)}
+}
+
+func (r *fakeGuardRepo) AddFailure(ctx context.Context, scope, key string, window time.Duration) (int64, error) {
+	r.failures[scope+":"+key]++
+	return r.failures[scope+":"+key], nil
+}
+
+func (r *fakeGuardRepo) Failures(ctx context.Context, scope, key string, window time.Duration) (int64, error) {
+	return r.failures[scope+":"+key], nil
+}
+
+func (r *fakeGuardRepo) ClearFailures(ctx context.Context, scope, key string) error {
+	delete(r.failures, scope+":"+key)
+	return nil
+}
+
+func (r *fakeGuardRepo) Lock(ctx context.Context, scope, key string, ttl time.Duration) (bool, error) {
+	if _, ok := r.locks[scope+":"+key]; ok {
+		return false, nil
+	}
+	r.locks[scope+":"+key] = ttl
+	return true, nil
+}
+
+func (r *fakeGuardRepo) LockTTL(ctx context.Context, scope, key string) (time.Duration, error) {
+	return r.locks[scope+":"+key], nil
+}
+
+func (r *fakeGuardRepo) Unlock(ctx context.Context, scope, key string) (bool, error) {
+	_, ok := r.locks[scope+":"+key]
+	delete(r.locks, scope+":"+key)
+	return ok, nil
+}
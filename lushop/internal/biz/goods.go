@@ -0,0 +1,270 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	v1 "lushop/api/lushop/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var ErrGoodsNotFound = errors.New("goods not found")
+
+// 分页的默认值
+const (
+	defaultPage     = 1
+	defaultPageSize = 10
+)
+
+// 商品分类
+type Category struct {
+	ID               int32
+	Name             string
+	ParentCategoryID int32
+	Level            int32
+	IsTab            bool
+	Sort             int32
+	SubCategory      []*Category
+}
+
+type Brand struct {
+	ID    int32
+	Name  string
+	Logo  string
+	Desc  string
+	IsTab bool
+	Sort  int32
+}
+
+type Goods struct {
+	ID          int64
+	CategoryID  int32
+	BrandID     int32
+	Name        string
+	GoodsSn     string
+	ClickNum    int64
+	SoldNum     int64
+	FavNum      int64
+	MarketPrice int64
+	GoodsBrief  string
+	ShipFree    bool
+	Image       string
+	Images      []string
+	IsNew       bool
+	IsHot       bool
+	OnSale      bool
+}
+
+type Sku struct {
+	ID             int64
+	GoodsID        int64
+	SkuName        string
+	SkuCode        string
+	Price          int64
+	PromotionPrice int64
+	Points         int64
+	Pic            string
+	Stock          int64
+	OnSale         bool
+	PurchaseLimit  int32
+}
+
+// 商品搜索条件
+type GoodsFilter struct {
+	Keywords    string
+	CategoryID  int32
+	BrandID     int32
+	MinPrice    int64
+	MaxPrice    int64
+	IsHot       bool
+	IsNew       bool
+	Pages       int64
+	PagePerNums int64
+}
+
+type GoodsRepo interface {
+	CategoryTree(ctx context.Context) ([]*Category, error)
+	SubCategory(ctx context.Context, id int32) (*Category, error)
+	BrandList(ctx context.Context, pages, pagePerNums int32) (int32, []*Brand, error)
+	GoodsSearch(ctx context.Context, f *GoodsFilter) (int64, []*Goods, error)
+	GoodsDetail(ctx context.Context, id int64) (*Goods, error)
+	GoodsSkuList(ctx context.Context, goodsId int64) ([]*Sku, error)
+}
+
+type GoodsUsecase struct {
+	gRepo GoodsRepo
+	aRepo GoodsAdminRepo
+	log   *log.Helper
+}
+
+func NewGoodsUsecase(repo GoodsRepo, aRepo GoodsAdminRepo, logger log.Logger) *GoodsUsecase {
+	helper := log.NewHelper(log.With(logger, "module", "usecase/goods"))
+	return &GoodsUsecase{gRepo: repo, aRepo: aRepo, log: helper}
+}
+
+// 所有商品分类，按层级嵌套
+func (uc *GoodsUsecase) CategoryTree(ctx context.Context) (*v1.CategoryTreeReply, error) {
+	list, err := uc.gRepo.CategoryTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.CategoryTreeReply{}
+	for _, c := range list {
+		rsp.List = append(rsp.List, categoryItem(c))
+	}
+	return rsp, nil
+}
+
+// 分类信息和它的下一级分类
+func (uc *GoodsUsecase) SubCategory(ctx context.Context, req *v1.SubCategoryReq) (*v1.SubCategoryReply, error) {
+	c, err := uc.gRepo.SubCategory(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.SubCategoryReply{Info: categoryItem(&Category{
+		ID:               c.ID,
+		Name:             c.Name,
+		ParentCategoryID: c.ParentCategoryID,
+		Level:            c.Level,
+		IsTab:            c.IsTab,
+		Sort:             c.Sort,
+	})}
+	for _, sub := range c.SubCategory {
+		rsp.SubCategory = append(rsp.SubCategory, categoryItem(sub))
+	}
+	return rsp, nil
+}
+
+func (uc *GoodsUsecase) BrandList(ctx context.Context, req *v1.BrandListReq) (*v1.BrandListReply, error) {
+	pages, size := req.Pages, req.PagePerNums
+	if pages <= 0 {
+		pages = defaultPage
+	}
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	total, list, err := uc.gRepo.BrandList(ctx, pages, size)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.BrandListReply{Total: total}
+	for _, b := range list {
+		rsp.List = append(rsp.List, &v1.BrandItem{
+			Id:   b.ID,
+			Name: b.Name,
+			Logo: b.Logo,
+			Desc: b.Desc,
+		})
+	}
+	return rsp, nil
+}
+
+// 商品搜索，没有传分页参数时返回第一页
+func (uc *GoodsUsecase) GoodsSearch(ctx context.Context, req *v1.GoodsSearchReq) (*v1.GoodsListReply, error) {
+	f := &GoodsFilter{
+		Keywords:    req.Keywords,
+		CategoryID:  req.CategoryId,
+		BrandID:     req.BrandId,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		IsHot:       req.IsHot,
+		IsNew:       req.IsNew,
+		Pages:       req.Pages,
+		PagePerNums: req.PagePerNums,
+	}
+	if f.Pages <= 0 {
+		f.Pages = defaultPage
+	}
+	if f.PagePerNums <= 0 {
+		f.PagePerNums = defaultPageSize
+	}
+	total, list, err := uc.gRepo.GoodsSearch(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.GoodsListReply{Total: total}
+	for _, g := range list {
+		rsp.List = append(rsp.List, goodsItem(g))
+	}
+	return rsp, nil
+}
+
+// 商品详情，下架的商品不返回
+func (uc *GoodsUsecase) GoodsDetail(ctx context.Context, req *v1.GoodsDetailReq) (*v1.GoodsItem, error) {
+	goods, err := uc.gRepo.GoodsDetail(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !goods.OnSale {
+		return nil, ErrGoodsNotFound
+	}
+	return goodsItem(goods), nil
+}
+
+// 商品的 sku 列表，下架的商品不返回
+func (uc *GoodsUsecase) GoodsSkuList(ctx context.Context, req *v1.GoodsDetailReq) (*v1.SkuListReply, error) {
+	goods, err := uc.gRepo.GoodsDetail(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !goods.OnSale {
+		return nil, ErrGoodsNotFound
+	}
+	list, err := uc.gRepo.GoodsSkuList(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.SkuListReply{}
+	for _, sku := range list {
+		rsp.List = append(rsp.List, &v1.SkuItem{
+			Id:             sku.ID,
+			GoodsId:        sku.GoodsID,
+			SkuName:        sku.SkuName,
+			SkuCode:        sku.SkuCode,
+			Price:          sku.Price,
+			PromotionPrice: sku.PromotionPrice,
+			Points:         sku.Points,
+			Pic:            sku.Pic,
+			Stock:          sku.Stock,
+			OnSale:         sku.OnSale,
+			PurchaseLimit:  sku.PurchaseLimit,
+		})
+	}
+	return rsp, nil
+}
+
+func categoryItem(c *Category) *v1.CategoryItem {
+	item := &v1.CategoryItem{
+		Id:       c.ID,
+		Name:     c.Name,
+		ParentId: c.ParentCategoryID,
+		Level:    c.Level,
+		IsTab:    c.IsTab,
+		Sort:     c.Sort,
+	}
+	for _, sub := range c.SubCategory {
+		item.SubCategory = append(item.SubCategory, categoryItem(sub))
+	}
+	return item
+}
+
+func goodsItem(g *Goods) *v1.GoodsItem {
+	return &v1.GoodsItem{
+		Id:          g.ID,
+		CategoryId:  g.CategoryID,
+		BrandId:     g.BrandID,
+		Name:        g.Name,
+		GoodsSn:     g.GoodsSn,
+		ClickNum:    g.ClickNum,
+		SoldNum:     g.SoldNum,
+		FavNum:      g.FavNum,
+		MarketPrice: g.MarketPrice,
+		GoodsBrief:  g.GoodsBrief,
+		ShipFree:    g.ShipFree,
+		Image:       g.Image,
+		Images:      g.Images,
+		IsNew:       g.IsNew,
+		IsHot:       g.IsHot,
+		OnSale:      g.OnSale,
+	}
+}
@@ -0,0 +1,245 @@
+package biz
+
+import (
+	"context"
+	v1 "lushop/api/lushop/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// 后台新增商品
+type GoodsForm struct {
+	CategoryID  int32
+	BrandID     int32
+	TypeID      int64
+	Name        string
+	NameAlias   string
+	GoodsTags   string
+	GoodsSn     string
+	ShopPrice   int64
+	MarketPrice int64
+	Inventory   int64
+	GoodsBrief  string
+	Image       string
+	Images      []string
+	ShipFree    bool
+	ShipID      int32
+	IsNew       bool
+	IsHot       bool
+	OnSale      bool
+	Skus        []*SkuForm
+}
+
+type SkuForm struct {
+	SkuName        string
+	Code           string
+	BarCode        string
+	Price          int64
+	PromotionPrice int64
+	Points         int64
+	Image          string
+	Sort           int32
+	Inventory      int64
+	PurchaseLimit  int32
+	Specs          []*SkuSpec
+	AttrGroups     []*SkuAttrGroup
+}
+
+// sku 的规格和规格值
+type SkuSpec struct {
+	SpecID  int64
+	ValueID int64
+}
+
+// sku 的属性分组和属性值
+type SkuAttrGroup struct {
+	GroupID   int64
+	GroupName string
+	Attrs     []*SkuAttr
+}
+
+type SkuAttr struct {
+	AttrID    int64
+	AttrName  string
+	ValueID   int64
+	ValueName string
+}
+
+// sku 价格记录
+type SkuPrice struct {
+	ID             int64
+	SkuID          int64
+	Price          int64
+	PromotionPrice int64
+	EffectiveFrom  int64
+	EffectiveUntil int64
+	Status         int32
+	Remark         string
+}
+
+type GoodsAdminRepo interface {
+	CreateCategory(ctx context.Context, c *Category) (*Category, error)
+	UpdateCategory(ctx context.Context, c *Category) error
+	DeleteCategory(ctx context.Context, id int32) error
+	CreateBrand(ctx context.Context, b *Brand) (*Brand, error)
+	UpdateBrand(ctx context.Context, b *Brand) error
+	DeleteBrand(ctx context.Context, id int32) error
+	CreateGoods(ctx context.Context, g *GoodsForm) (int64, error)
+	ChangeSkuPrice(ctx context.Context, p *SkuPrice) (*SkuPrice, error)
+	UpdateSkuPurchaseLimit(ctx context.Context, skuId int64, limit int32) error
+}
+
+func (uc *GoodsUsecase) AdminCreateCategory(ctx context.Context, req *v1.AdminCategoryReq) (*v1.CategoryItem, error) {
+	c, err := uc.aRepo.CreateCategory(ctx, categoryForm(req))
+	if err != nil {
+		return nil, err
+	}
+	return categoryItem(c), nil
+}
+
+func (uc *GoodsUsecase) AdminUpdateCategory(ctx context.Context, req *v1.AdminCategoryReq) (*emptypb.Empty, error) {
+	if err := uc.aRepo.UpdateCategory(ctx, categoryForm(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (uc *GoodsUsecase) AdminDeleteCategory(ctx context.Context, req *v1.AdminIdReq) (*emptypb.Empty, error) {
+	if err := uc.aRepo.DeleteCategory(ctx, int32(req.Id)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (uc *GoodsUsecase) AdminCreateBrand(ctx context.Context, req *v1.AdminBrandReq) (*v1.BrandItem, error) {
+	b, err := uc.aRepo.CreateBrand(ctx, brandForm(req))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.BrandItem{Id: b.ID, Name: b.Name, Logo: b.Logo, Desc: b.Desc}, nil
+}
+
+func (uc *GoodsUsecase) AdminUpdateBrand(ctx context.Context, req *v1.AdminBrandReq) (*emptypb.Empty, error) {
+	if err := uc.aRepo.UpdateBrand(ctx, brandForm(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (uc *GoodsUsecase) AdminDeleteBrand(ctx context.Context, req *v1.AdminIdReq) (*emptypb.Empty, error) {
+	if err := uc.aRepo.DeleteBrand(ctx, int32(req.Id)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (uc *GoodsUsecase) AdminCreateGoods(ctx context.Context, req *v1.AdminGoodsReq) (*v1.AdminIdReply, error) {
+	form := &GoodsForm{
+		CategoryID:  req.CategoryId,
+		BrandID:     req.BrandId,
+		TypeID:      req.TypeId,
+		Name:        req.Name,
+		NameAlias:   req.NameAlias,
+		GoodsTags:   req.GoodsTags,
+		GoodsSn:     req.GoodsSn,
+		ShopPrice:   req.ShopPrice,
+		MarketPrice: req.MarketPrice,
+		Inventory:   req.Inventory,
+		GoodsBrief:  req.GoodsBrief,
+		Image:       req.Image,
+		Images:      req.Images,
+		ShipFree:    req.ShipFree,
+		ShipID:      req.ShipId,
+		IsNew:       req.IsNew,
+		IsHot:       req.IsHot,
+		OnSale:      req.OnSale,
+	}
+	for _, sku := range req.Skus {
+		s := &SkuForm{
+			SkuName:        sku.SkuName,
+			Code:           sku.Code,
+			BarCode:        sku.BarCode,
+			Price:          sku.Price,
+			PromotionPrice: sku.PromotionPrice,
+			Points:         sku.Points,
+			Image:          sku.Image,
+			Sort:           sku.Sort,
+			Inventory:      sku.Inventory,
+			PurchaseLimit:  sku.PurchaseLimit,
+		}
+		for _, spec := range sku.Specs {
+			s.Specs = append(s.Specs, &SkuSpec{SpecID: spec.SpecId, ValueID: spec.ValueId})
+		}
+		for _, group := range sku.AttrGroups {
+			g := &SkuAttrGroup{GroupID: group.GroupId, GroupName: group.GroupName}
+			for _, attr := range group.Attrs {
+				g.Attrs = append(g.Attrs, &SkuAttr{
+					AttrID:    attr.AttrId,
+					AttrName:  attr.AttrName,
+					ValueID:   attr.ValueId,
+					ValueName: attr.ValueName,
+				})
+			}
+			s.AttrGroups = append(s.AttrGroups, g)
+		}
+		form.Skus = append(form.Skus, s)
+	}
+	id, err := uc.aRepo.CreateGoods(ctx, form)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminIdReply{Id: id}, nil
+}
+
+func (uc *GoodsUsecase) AdminChangeSkuPrice(ctx context.Context, req *v1.AdminSkuPriceReq) (*v1.AdminSkuPriceReply, error) {
+	p, err := uc.aRepo.ChangeSkuPrice(ctx, &SkuPrice{
+		SkuID:          req.SkuId,
+		Price:          req.Price,
+		PromotionPrice: req.PromotionPrice,
+		EffectiveFrom:  req.EffectiveFrom,
+		EffectiveUntil: req.EffectiveUntil,
+		Remark:         req.Remark,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminSkuPriceReply{
+		Id:             p.ID,
+		SkuId:          p.SkuID,
+		Price:          p.Price,
+		PromotionPrice: p.PromotionPrice,
+		EffectiveFrom:  p.EffectiveFrom,
+		EffectiveUntil: p.EffectiveUntil,
+		Status:         p.Status,
+		Remark:         p.Remark,
+	}, nil
+}
+
+func (uc *GoodsUsecase) AdminSkuPurchaseLimit(ctx context.Context, req *v1.AdminSkuLimitReq) (*emptypb.Empty, error) {
+	if err := uc.aRepo.UpdateSkuPurchaseLimit(ctx, req.SkuId, req.PurchaseLimit); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func categoryForm(req *v1.AdminCategoryReq) *Category {
+	return &Category{
+		ID:               req.Id,
+		Name:             req.Name,
+		ParentCategoryID: req.ParentId,
+		Level:            req.Level,
+		IsTab:            req.IsTab,
+		Sort:             req.Sort,
+	}
+}
+
+func brandForm(req *v1.AdminBrandReq) *Brand {
+	return &Brand{
+		ID:    req.Id,
+		Name:  req.Name,
+		Logo:  req.Logo,
+		Desc:  req.Desc,
+		IsTab: req.IsTab,
+		Sort:  req.Sort,
+	}
+}
@@ -0,0 +1,256 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"lushop/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 登录防暴力破解默认配置
+const (
+	defaultLoginWindow       = 15 * time.Minute
+	defaultMobileMaxFailures = 5
+	defaultIPMaxFailures     = 20
+	defaultLockDuration      = 15 * time.Minute
+	defaultDelayAfter        = 2
+	defaultDelayStep         = time.Second
+	defaultMaxDelay          = 8 * time.Second
+)
+
+// 锁定事件的动作和范围，和用户服务保持一致
+const (
+	LockActionLock   = "lock"
+	LockActionUnlock = "unlock"
+
+	LockScopeMobile = "mobile"
+	LockScopeIP     = "ip"
+)
+
+var ErrUnlockTargetEmpty = errors.BadRequest("UNLOCK_TARGET_EMPTY", "手机号和IP至少填一个")
+
+// LockEvent 登录锁定、解锁记录，保存在用户服务
+type LockEvent struct {
+	ID        int64
+	Mobile    string
+	IP        string
+	Action    string
+	Scope     string
+	Reason    string
+	Failures  int64
+	Until     int64
+	Operator  int64
+	CreatedAt int64
+}
+
+type LoginGuardRepo interface {
+	// AddFailure 在滑动窗口中记一次失败，返回窗口内的失败次数
+	AddFailure(ctx context.Context, scope, key string, window time.Duration) (int64, error)
+	Failures(ctx context.Context, scope, key string, window time.Duration) (int64, error)
+	ClearFailures(ctx context.Context, scope, key string) error
+	// Lock 锁定 ttl 时长，已经锁定时返回 false，多个副本同时触发时只有一个会记录锁定事件
+	Lock(ctx context.Context, scope, key string, ttl time.Duration) (bool, error)
+	// LockTTL 返回剩余锁定时长，没有锁定时返回 0
+	LockTTL(ctx context.Context, scope, key string) (time.Duration, error)
+	Unlock(ctx context.Context, scope, key string) (bool, error)
+}
+
+// LoginGuardUsecase 按手机号和 IP 统计登录失败次数，失败越多响应越慢，超过上限后临时锁定
+type LoginGuardUsecase struct {
+	repo       LoginGuardRepo
+	uRepo      UserRepo
+	window     time.Duration
+	mobileMax  int64
+	ipMax      int64
+	lockTTL    time.Duration
+	delayAfter int64
+	delayStep  time.Duration
+	maxDelay   time.Duration
+	log        *log.Helper
+}
+
+func NewLoginGuardUsecase(repo LoginGuardRepo, uRepo UserRepo, conf *conf.Auth, logger log.Logger) *LoginGuardUsecase {
+	c := conf.GetLoginGuard()
+	uc := &LoginGuardUsecase{
+		repo:       repo,
+		uRepo:      uRepo,
+		window:     c.GetWindow().AsDuration(),
+		mobileMax:  int64(c.GetMobileMaxFailures()),
+		ipMax:      int64(c.GetIpMaxFailures()),
+		lockTTL:    c.GetLockDuration().AsDuration(),
+		delayAfter: int64(c.GetDelayAfter()),
+		delayStep:  c.GetDelayStep().AsDuration(),
+		maxDelay:   c.GetMaxDelay().AsDuration(),
+		log:        log.NewHelper(log.With(logger, "module", "usecase/login_guard")),
+	}
+	if uc.window <= 0 {
+		uc.window = defaultLoginWindow
+	}
+	if uc.mobileMax <= 0 {
+		uc.mobileMax = defaultMobileMaxFailures
+	}
+	if uc.ipMax <= 0 {
+		uc.ipMax = defaultIPMaxFailures
+	}
+	if uc.lockTTL <= 0 {
+		uc.lockTTL = defaultLockDuration
+	}
+	if uc.delayAfter <= 0 {
+		uc.delayAfter = defaultDelayAfter
+	}
+	if uc.delayStep <= 0 {
+		uc.delayStep = defaultDelayStep
+	}
+	if uc.maxDelay <= 0 {
+		uc.maxDelay = defaultMaxDelay
+	}
+	return uc
+}
+
+type guardTarget struct {
+	scope string
+	key   string
+	max   int64
+}
+
+func (uc *LoginGuardUsecase) targets(mobile, ip string) []guardTarget {
+	var l []guardTarget
+	if mobile != "" {
+		l = append(l, guardTarget{scope: LockScopeMobile, key: mobile, max: uc.mobileMax})
+	}
+	if ip != "" {
+		l = append(l, guardTarget{scope: LockScopeIP, key: ip, max: uc.ipMax})
+	}
+	return l
+}
+
+// Check 登录前检查是否已锁定，并按手机号最近的失败次数延迟响应
+func (uc *LoginGuardUsecase) Check(ctx context.Context, mobile, ip string) error {
+	for _, t := range uc.targets(mobile, ip) {
+		ttl, err := uc.repo.LockTTL(ctx, t.scope, t.key)
+		if err != nil {
+			return err
+		}
+		if ttl > 0 {
+			return lockedError(ttl)
+		}
+	}
+	n, err := uc.repo.Failures(ctx, LockScopeMobile, mobile, uc.window)
+	if err != nil {
+		return err
+	}
+	if d := uc.delay(n); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return nil
+}
+
+// Fail 记一次登录失败，达到上限后锁定并记录锁定事件
+func (uc *LoginGuardUsecase) Fail(ctx context.Context, mobile, ip string) {
+	for _, t := range uc.targets(mobile, ip) {
+		n, err := uc.repo.AddFailure(ctx, t.scope, t.key, uc.window)
+		if err != nil {
+			uc.log.Errorf("add login failure error: %v", err)
+			continue
+		}
+		if n < t.max {
+			continue
+		}
+		locked, err := uc.repo.Lock(ctx, t.scope, t.key, uc.lockTTL)
+		if err != nil {
+			uc.log.Errorf("lock login error: %v", err)
+			continue
+		}
+		if !locked {
+			continue
+		}
+		// 解锁后重新计数
+		if err := uc.repo.ClearFailures(ctx, t.scope, t.key); err != nil {
+			uc.log.Errorf("clear login failures error: %v", err)
+		}
+		uc.log.Warnf("login locked, scope: %s, mobile: %s, ip: %s, failures: %d", t.scope, mobile, ip, n)
+		uc.record(ctx, &LockEvent{
+			Mobile:   mobile,
+			IP:       ip,
+			Action:   LockActionLock,
+			Scope:    t.scope,
+			Reason:   "登录失败次数过多",
+			Failures: n,
+			Until:    time.Now().Add(uc.lockTTL).Unix(),
+		})
+	}
+}
+
+// Succeed 登录成功后清空手机号的失败次数，IP 的失败次数不清空，避免用一个自己的账号重置计数
+func (uc *LoginGuardUsecase) Succeed(ctx context.Context, mobile string) {
+	if err := uc.repo.ClearFailures(ctx, LockScopeMobile, mobile); err != nil {
+		uc.log.Errorf("clear login failures error: %v", err)
+	}
+}
+
+// Unlock 管理员手动解锁
+func (uc *LoginGuardUsecase) Unlock(ctx context.Context, mobile, ip string, operator int64) error {
+	targets := uc.targets(mobile, ip)
+	if len(targets) == 0 {
+		return ErrUnlockTargetEmpty
+	}
+	for _, t := range targets {
+		unlocked, err := uc.repo.Unlock(ctx, t.scope, t.key)
+		if err != nil {
+			return err
+		}
+		if err := uc.repo.ClearFailures(ctx, t.scope, t.key); err != nil {
+			return err
+		}
+		if !unlocked {
+			continue
+		}
+		uc.record(ctx, &LockEvent{
+			Mobile:   mobile,
+			IP:       ip,
+			Action:   LockActionUnlock,
+			Scope:    t.scope,
+			Reason:   "管理员解锁",
+			Operator: operator,
+		})
+	}
+	return nil
+}
+
+// record 记录事件失败不影响登录
+func (uc *LoginGuardUsecase) record(ctx context.Context, e *LockEvent) {
+	if err := uc.uRepo.CreateLockEvent(ctx, e); err != nil {
+		uc.log.Errorf("record lock event error: %v", err)
+	}
+}
+
+// delay 失败 delayAfter 次后开始延迟，之后每多失败一次延迟翻倍
+func (uc *LoginGuardUsecase) delay(failures int64) time.Duration {
+	if failures < uc.delayAfter {
+		return 0
+	}
+	d := uc.delayStep
+	for i := uc.delayAfter; i < failures && d < uc.maxDelay; i++ {
+		d *= 2
+	}
+	if d > uc.maxDelay {
+		d = uc.maxDelay
+	}
+	return d
+}
+
+func lockedError(ttl time.Duration) error {
+	seconds := int64((ttl + time.Second - 1) / time.Second)
+	return errors.New(429, "LOGIN_LOCKED", "登录失败次数过多，请稍后再试").
+		WithMetadata(map[string]string{"retry_after": strconv.FormatInt(seconds, 10)})
+}
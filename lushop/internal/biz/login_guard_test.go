@@ -0,0 +1,89 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"lushop/internal/biz"
+	"lushop/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func newLoginGuard() (*biz.LoginGuardUsecase, *fakeGuardRepo, *fakeUserRepo) {
+	repo := newFakeGuardRepo()
+	uRepo := &fakeUserRepo{}
+	c := &conf.Auth{LoginGuard: &conf.Auth_LoginGuard{
+		MobileMaxFailures: 3,
+		IpMaxFailures:     5,
+		DelayAfter:        100, // 测试中不延迟响应
+	}}
+	return biz.NewLoginGuardUsecase(repo, uRepo, c, log.DefaultLogger), repo, uRepo
+}
+
+func TestLoginGuardLock(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, uRepo := newLoginGuard()
+
+	for i := 0; i < 2; i++ {
+		uc.Fail(ctx, "13800000000", "10.0.0.1")
+	}
+	if err := uc.Check(ctx, "13800000000", "10.0.0.1"); err != nil {
+		t.Fatalf("should not lock before max failures: %v", err)
+	}
+
+	uc.Fail(ctx, "13800000000", "10.0.0.1")
+	err := uc.Check(ctx, "13800000000", "10.0.0.1")
+	if se := errors.FromError(err); se.Code != 429 || se.Reason != "LOGIN_LOCKED" {
+		t.Fatalf("got %v, want LOGIN_LOCKED", err)
+	}
+	if se := errors.FromError(err); se.Metadata["retry_after"] != "900" {
+		t.Fatalf("retry_after: got %q", se.Metadata["retry_after"])
+	}
+	if len(uRepo.events) != 1 || uRepo.events[0].Scope != biz.LockScopeMobile {
+		t.Fatalf("lock events: %+v", uRepo.events)
+	}
+	// 锁定后重新计数
+	if n, _ := repo.Failures(ctx, biz.LockScopeMobile, "13800000000", time.Minute); n != 0 {
+		t.Fatalf("failures should be cleared, got %d", n)
+	}
+	// 其他手机号不受影响，IP 还没有达到上限
+	if err := uc.Check(ctx, "13900000000", "10.0.0.1"); err != nil {
+		t.Fatalf("other mobile: %v", err)
+	}
+
+	if err := uc.Unlock(ctx, "13800000000", "", 1); err != nil {
+		t.Fatalf("unlock: %v", err)
+	}
+	if err := uc.Check(ctx, "13800000000", "10.0.0.1"); err != nil {
+		t.Fatalf("after unlock: %v", err)
+	}
+	if len(uRepo.events) != 2 || uRepo.events[1].Action != biz.LockActionUnlock {
+		t.Fatalf("unlock events: %+v", uRepo.events)
+	}
+}
+
+func TestLoginGuardSucceed(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, _ := newLoginGuard()
+
+	uc.Fail(ctx, "13800000000", "10.0.0.1")
+	uc.Fail(ctx, "13800000000", "10.0.0.1")
+	uc.Succeed(ctx, "13800000000")
+	if n, _ := repo.Failures(ctx, biz.LockScopeMobile, "13800000000", time.Minute); n != 0 {
+		t.Fatalf("mobile failures should be cleared, got %d", n)
+	}
+	// IP 的失败次数不清空
+	if n, _ := repo.Failures(ctx, biz.LockScopeIP, "10.0.0.1", time.Minute); n != 2 {
+		t.Fatalf("ip failures: got %d, want 2", n)
+	}
+}
+
+func TestLoginGuardUnlockEmpty(t *testing.T) {
+	uc, _, _ := newLoginGuard()
+	if err := uc.Unlock(context.Background(), "", "", 1); !errors.Is(err, biz.ErrUnlockTargetEmpty) {
+		t.Fatalf("got %v, want %v", err, biz.ErrUnlockTargetEmpty)
+	}
+}
@@ -0,0 +1,72 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	v1 "lushop/api/lushop/v1"
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt5 "github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func newProfileUsecase(u *biz.User) (*biz.UserUsecase, *fakeUserRepo, context.Context) {
+	uRepo := &fakeUserRepo{users: map[int64]*biz.User{u.ID: u}}
+	uc := biz.NewUserUsecase(uRepo, nil, nil, nil, nil, nil, nil, log.DefaultLogger)
+	ctx := jwt.NewContext(context.Background(), jwt5.MapClaims{"ID": float64(u.ID)})
+	return uc, uRepo, ctx
+}
+
+func TestUpdateProfileBirthday(t *testing.T) {
+	uc, uRepo, ctx := newProfileUsecase(&biz.User{ID: 1, NickName: "lucien", Gender: "male"})
+
+	// 1970 年之前的生日是负数
+	rsp, err := uc.UpdateProfile(ctx, &v1.UpdateProfileReq{Birthday: proto.Int64(-152668800)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Birthday == nil || *rsp.Birthday != -152668800 {
+		t.Fatalf("birthday = %v", rsp.Birthday)
+	}
+
+	// 只出现昵称时生日保持不变
+	rsp, err = uc.UpdateProfile(ctx, &v1.UpdateProfileReq{NickName: proto.String("lu")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Birthday == nil || len(uRepo.updated) != 1 || uRepo.updated[0] != biz.ProfileFieldNickName {
+		t.Fatalf("birthday = %v, updated = %v", rsp.Birthday, uRepo.updated)
+	}
+
+	// updateMask 中有 birthday 但没有设置时清空生日
+	rsp, err = uc.UpdateProfile(ctx, &v1.UpdateProfileReq{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{biz.ProfileFieldBirthday}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsp.Birthday != nil {
+		t.Fatalf("birthday = %v, want cleared", *rsp.Birthday)
+	}
+}
+
+func TestUpdateProfileMaskInvalid(t *testing.T) {
+	uc, _, ctx := newProfileUsecase(&biz.User{ID: 1, NickName: "lucien", Gender: "male"})
+
+	_, err := uc.UpdateProfile(ctx, &v1.UpdateProfileReq{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{biz.ProfileFieldNickName}},
+	})
+	if err != biz.ErrProfileFieldMissing {
+		t.Fatalf("err = %v, want %v", err, biz.ErrProfileFieldMissing)
+	}
+	_, err = uc.UpdateProfile(ctx, &v1.UpdateProfileReq{
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+	})
+	if err != biz.ErrProfileMaskInvalid {
+		t.Fatalf("err = %v, want %v", err, biz.ErrProfileMaskInvalid)
+	}
+}
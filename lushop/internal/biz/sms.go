@@ -0,0 +1,205 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	v1 "lushop/api/lushop/v1"
+	"lushop/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// 短信验证码默认配置
+const (
+	defaultSmsCodeTTL          = 5 * time.Minute
+	defaultSmsResendInterval   = time.Minute
+	defaultSmsMobileDailyLimit = 10
+	defaultSmsIPHourlyLimit    = 20
+	defaultSmsMaxAttempts      = 5
+	resetTicketTTL             = 5 * time.Minute // 重置密码凭证只用于紧接着的一次重置请求
+)
+
+var (
+	ErrSmsTooFrequent   = errors.New(429, "SMS_TOO_FREQUENT", "验证码发送过于频繁，请稍后再试")
+	ErrSmsLimitExceeded = errors.New(429, "SMS_LIMIT_EXCEEDED", "验证码发送次数已达上限，请明天再试")
+	ErrSmsCodeInvalid   = errors.BadRequest("SMS_CODE_INVALID", "短信验证码错误或已过期")
+	ErrSmsSendFailed    = errors.InternalServer("SMS_SEND_FAILED", "短信发送失败，请稍后再试")
+)
+
+// SmsSender 短信发送渠道
+type SmsSender interface {
+	Send(ctx context.Context, mobile, scene, code string, ttl time.Duration) error
+}
+
+type SmsRepo interface {
+	// TakeResend 占用手机号的发送间隔，间隔内已经发送过时返回 false
+	TakeResend(ctx context.Context, mobile string, interval time.Duration) (bool, error)
+	// IncrMobileDaily 手机号当天的发送次数加一，返回加一后的次数
+	IncrMobileDaily(ctx context.Context, mobile string) (int64, error)
+	// IncrIPHourly IP 当前小时的发送次数加一，返回加一后的次数
+	IncrIPHourly(ctx context.Context, ip string) (int64, error)
+	// SaveCode 保存验证码的哈希，覆盖之前发送的验证码
+	SaveCode(ctx context.Context, scene, mobile, hash string, ttl time.Duration) error
+	// CheckCode 校验验证码，错误次数达到 maxAttempts 后验证码失效，consume 为 true 时校验通过后删除
+	CheckCode(ctx context.Context, scene, mobile, hash string, maxAttempts int64, consume bool) (bool, error)
+}
+
+type SmsUsecase struct {
+	repo        SmsRepo
+	sender      SmsSender
+	ip          *ClientIP
+	codeTTL     time.Duration
+	resend      time.Duration
+	mobileDaily int64
+	ipHourly    int64
+	maxAttempts int64
+	ticketKey   []byte // 重置密码凭证的签名密钥，和用户服务共用
+	log         *log.Helper
+}
+
+func NewSmsUsecase(repo SmsRepo, sender SmsSender, ip *ClientIP, conf *conf.Auth, logger log.Logger) (*SmsUsecase, error) {
+	if conf.GetResetTicketKey() == "" {
+		return nil, errors.InternalServer("RESET_TICKET_KEY_EMPTY", "auth.reset_ticket_key 没有配置")
+	}
+	c := conf.GetSms()
+	uc := &SmsUsecase{
+		repo:        repo,
+		sender:      sender,
+		ip:          ip,
+		codeTTL:     c.GetCodeTtl().AsDuration(),
+		resend:      c.GetResendInterval().AsDuration(),
+		mobileDaily: int64(c.GetMobileDailyLimit()),
+		ipHourly:    int64(c.GetIpHourlyLimit()),
+		maxAttempts: int64(c.GetMaxAttempts()),
+		ticketKey:   []byte(conf.GetResetTicketKey()),
+		log:         log.NewHelper(log.With(logger, "module", "usecase/sms")),
+	}
+	if uc.codeTTL <= 0 {
+		uc.codeTTL = defaultSmsCodeTTL
+	}
+	if uc.resend <= 0 {
+		uc.resend = defaultSmsResendInterval
+	}
+	if uc.mobileDaily <= 0 {
+		uc.mobileDaily = defaultSmsMobileDailyLimit
+	}
+	if uc.ipHourly <= 0 {
+		uc.ipHourly = defaultSmsIPHourlyLimit
+	}
+	if uc.maxAttempts <= 0 {
+		uc.maxAttempts = defaultSmsMaxAttempts
+	}
+	return uc, nil
+}
+
+// SendCode 发送短信验证码，同一手机号有发送间隔和每日上限，同一 IP 有每小时上限
+func (uc *SmsUsecase) SendCode(ctx context.Context, req *v1.SendSmsCodeReq) (*v1.SendSmsCodeReply, error) {
+	if !validMobile(req.Mobile) {
+		return nil, ErrMobileInvalid
+	}
+	ok, err := uc.repo.TakeResend(ctx, req.Mobile, uc.resend)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrSmsTooFrequent
+	}
+	if ip := uc.ip.FromContext(ctx); ip != "" {
+		n, err := uc.repo.IncrIPHourly(ctx, ip)
+		if err != nil {
+			return nil, err
+		}
+		if n > uc.ipHourly {
+			uc.log.Warnf("sms ip hourly limit exceeded, ip: %s", ip)
+			return nil, ErrSmsTooFrequent
+		}
+	}
+	n, err := uc.repo.IncrMobileDaily(ctx, req.Mobile)
+	if err != nil {
+		return nil, err
+	}
+	if n > uc.mobileDaily {
+		return nil, ErrSmsLimitExceeded
+	}
+
+	code, err := randomCode()
+	if err != nil {
+		return nil, err
+	}
+	scene := smsScene(req.Scene)
+	if err := uc.repo.SaveCode(ctx, scene, req.Mobile, hashToken(code), uc.codeTTL); err != nil {
+		return nil, err
+	}
+	if err := uc.sender.Send(ctx, req.Mobile, scene, code, uc.codeTTL); err != nil {
+		uc.log.Errorf("send sms error: %v", err)
+		return nil, ErrSmsSendFailed
+	}
+	now := time.Now()
+	return &v1.SendSmsCodeReply{
+		ExpiredAt: now.Add(uc.codeTTL).Unix(),
+		ResendAt:  now.Add(uc.resend).Unix(),
+	}, nil
+}
+
+// VerifyCode 校验验证码但不使用掉，用于表单分步填写
+func (uc *SmsUsecase) VerifyCode(ctx context.Context, req *v1.VerifySmsCodeReq) (*emptypb.Empty, error) {
+	if err := uc.Verify(ctx, req.Scene, req.Mobile, req.SmsCode, false); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Verify 校验验证码，consume 为 true 时校验通过后验证码失效
+func (uc *SmsUsecase) Verify(ctx context.Context, scene v1.SmsScene, mobile, code string, consume bool) error {
+	if code == "" {
+		return ErrSmsCodeInvalid
+	}
+	ok, err := uc.repo.CheckCode(ctx, smsScene(scene), mobile, hashToken(code), uc.maxAttempts, consume)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrSmsCodeInvalid
+	}
+	return nil
+}
+
+// ResetTicket 校验并使用掉重置密码的验证码，签发用户服务重置密码需要的一次性凭证，
+// 格式为 base64(手机号:随机串:过期时间戳).base64(HMAC-SHA256)
+func (uc *SmsUsecase) ResetTicket(ctx context.Context, mobile, code string) (string, error) {
+	if err := uc.Verify(ctx, v1.SmsScene_SMS_SCENE_RESET_PASSWORD, mobile, code, true); err != nil {
+		return "", err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	payload := fmt.Sprintf("%s:%s:%d", mobile, nonce, time.Now().Add(resetTicketTTL).Unix())
+	mac := hmac.New(sha256.New, uc.ticketKey)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// smsScene 场景在 redis key 和短信模板中使用的名称，例如 SMS_SCENE_LOGIN -> login
+func smsScene(scene v1.SmsScene) string {
+	return strings.ToLower(strings.TrimPrefix(scene.String(), "SMS_SCENE_"))
+}
+
+// randomCode 生成 6 位数字验证码
+func randomCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
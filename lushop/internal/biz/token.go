@@ -0,0 +1,268 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	v1 "lushop/api/lushop/v1"
+	"lushop/internal/conf"
+	"lushop/internal/pkg/middleware/auth"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt5 "github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// token 默认有效期
+const (
+	defaultAccessTTL  = 15 * time.Minute
+	defaultRefreshTTL = 30 * 24 * time.Hour
+)
+
+var (
+	ErrRefreshTokenInvalid = errors.Unauthorized("REFRESH_TOKEN_INVALID", "登录已过期，请重新登录")
+	ErrRefreshTokenReused  = errors.Unauthorized("REFRESH_TOKEN_REUSED", "登录状态异常，请重新登录")
+	ErrUserDisabled        = errors.Forbidden("USER_DISABLED", "账号已被禁用")
+)
+
+// RefreshSession refresh token 对应的登录会话，同一次登录轮换出来的 refresh token 属于同一个 family
+type RefreshSession struct {
+	UserID       int64
+	Family       string
+	TokenVersion int64 // 签发时用户的 token 版本
+}
+
+type TokenRepo interface {
+	// SaveRefresh 保存 refresh token 的哈希，family 不存在时创建
+	SaveRefresh(ctx context.Context, hash string, s *RefreshSession, ttl time.Duration) error
+	// ConsumeRefresh 将 refresh token 标记为已使用，已经使用过时返回 ErrRefreshTokenReused 和会话
+	ConsumeRefresh(ctx context.Context, hash string) (*RefreshSession, error)
+	// GetRefresh 查询 refresh token 的会话，不标记为已使用
+	GetRefresh(ctx context.Context, hash string) (*RefreshSession, error)
+	// RevokeFamily 吊销一次登录轮换出来的所有 refresh token
+	RevokeFamily(ctx context.Context, family string) error
+	FamilyActive(ctx context.Context, family string) (bool, error)
+	// DenyAccess 将 access token 加入黑名单直到它过期
+	DenyAccess(ctx context.Context, jti string, ttl time.Duration) error
+	AccessDenied(ctx context.Context, jti string) (bool, error)
+	// TokenVersion 缓存的用户 token 版本，没有缓存时 ok 为 false
+	TokenVersion(ctx context.Context, userId int64) (version int64, ok bool, err error)
+	// SetTokenVersion 缓存用户 token 版本，只会变大不会变小
+	SetTokenVersion(ctx context.Context, userId, version int64) error
+}
+
+type TokenUsecase struct {
+	repo       TokenRepo
+	uRepo      UserRepo
+	signingKey string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	log        *log.Helper
+}
+
+func NewTokenUsecase(repo TokenRepo, uRepo UserRepo, conf *conf.Auth, logger log.Logger) *TokenUsecase {
+	uc := &TokenUsecase{
+		repo:       repo,
+		uRepo:      uRepo,
+		signingKey: conf.JwtKey,
+		accessTTL:  conf.GetAccessTtl().AsDuration(),
+		refreshTTL: conf.GetRefreshTtl().AsDuration(),
+		log:        log.NewHelper(log.With(logger, "module", "usecase/token")),
+	}
+	if uc.accessTTL <= 0 {
+		uc.accessTTL = defaultAccessTTL
+	}
+	if uc.refreshTTL <= 0 {
+		uc.refreshTTL = defaultRefreshTTL
+	}
+	return uc
+}
+
+// Issue 登录或注册成功后签发 access token 和新的 refresh token，禁用的账号不签发
+func (uc *TokenUsecase) Issue(ctx context.Context, user *User) (*v1.RegisterReply, error) {
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+	family, err := randomToken()
+	if err != nil {
+		return nil, ErrGenerateTokenFailed
+	}
+	return uc.issue(ctx, user, family)
+}
+
+// Refresh 使用 refresh token 换取新的 access token 和 refresh token，旧的 refresh token 失效。
+// 已经使用过的 refresh token 再次使用说明可能被盗用，吊销这次登录的所有 refresh token
+func (uc *TokenUsecase) Refresh(ctx context.Context, req *v1.RefreshReq) (*v1.RegisterReply, error) {
+	session, err := uc.repo.ConsumeRefresh(ctx, hashToken(req.RefreshToken))
+	if errors.Is(err, ErrRefreshTokenReused) {
+		uc.log.Warnf("refresh token reused, user: %d, family: %s", session.UserID, session.Family)
+		if err := uc.repo.RevokeFamily(ctx, session.Family); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+	if err != nil {
+		return nil, err
+	}
+	if active, err := uc.repo.FamilyActive(ctx, session.Family); err != nil {
+		return nil, err
+	} else if !active {
+		return nil, ErrRefreshTokenInvalid
+	}
+
+	user, err := uc.uRepo.UserById(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// 修改过密码、角色或者账号被禁用，之前登录轮换出来的 refresh token 都失效
+	if user.Disabled || session.TokenVersion != user.TokenVersion {
+		if err := uc.repo.RevokeFamily(ctx, session.Family); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenInvalid
+	}
+	return uc.issue(ctx, user, session.Family)
+}
+
+// Logout 当前的 access token 加入黑名单，吊销 refresh token 所在的登录
+func (uc *TokenUsecase) Logout(ctx context.Context, req *v1.LogoutReq) (*emptypb.Empty, error) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, ErrAuthFailed
+	}
+	c, ok := claims.(jwt5.MapClaims)
+	if !ok {
+		return nil, ErrAuthFailed
+	}
+	if jti, _ := c["jti"].(string); jti != "" {
+		ttl := uc.accessTTL
+		if exp, err := c.GetExpirationTime(); err == nil && exp != nil {
+			ttl = time.Until(exp.Time)
+		}
+		if ttl > 0 {
+			if err := uc.repo.DenyAccess(ctx, jti, ttl); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if req.RefreshToken != "" {
+		// 只读取会话不标记为已使用，其他用户的 refresh token 不受影响
+		session, err := uc.repo.GetRefresh(ctx, hashToken(req.RefreshToken))
+		if err != nil {
+			// refresh token 已过期时只需要吊销 access token
+			return &emptypb.Empty{}, nil
+		}
+		uid, _ := c["ID"].(float64)
+		if session.UserID == int64(uid) {
+			if err := uc.repo.RevokeFamily(ctx, session.Family); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// IsRevoked access token 是否已经吊销，没有 jti 的旧 token 视为已吊销，
+// token 版本低于用户当前版本说明签发后修改过密码、角色或者账号被禁用
+func (uc *TokenUsecase) IsRevoked(ctx context.Context, claims jwt5.MapClaims) (bool, error) {
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return true, nil
+	}
+	if denied, err := uc.repo.AccessDenied(ctx, jti); err != nil || denied {
+		return denied, err
+	}
+	uid, _ := claims["ID"].(float64)
+	version, _ := claims["TokenVersion"].(float64)
+	current, err := uc.tokenVersion(ctx, int64(uid))
+	if err != nil {
+		return false, err
+	}
+	return int64(version) < current, nil
+}
+
+// BumpVersion 修改密码、角色或者禁用账号后更新缓存的 token 版本，之前签发的 token 立即失效
+func (uc *TokenUsecase) BumpVersion(ctx context.Context, userId, version int64) error {
+	return uc.repo.SetTokenVersion(ctx, userId, version)
+}
+
+// tokenVersion 优先读缓存，缓存不存在时从用户服务查询
+func (uc *TokenUsecase) tokenVersion(ctx context.Context, userId int64) (int64, error) {
+	version, ok, err := uc.repo.TokenVersion(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return version, nil
+	}
+	user, err := uc.uRepo.UserById(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	if err := uc.repo.SetTokenVersion(ctx, userId, user.TokenVersion); err != nil {
+		return 0, err
+	}
+	return user.TokenVersion, nil
+}
+
+func (uc *TokenUsecase) issue(ctx context.Context, user *User, family string) (*v1.RegisterReply, error) {
+	jti, err := randomToken()
+	if err != nil {
+		return nil, ErrGenerateTokenFailed
+	}
+	refresh, err := randomToken()
+	if err != nil {
+		return nil, ErrGenerateTokenFailed
+	}
+
+	now := time.Now()
+	claims := auth.CustomClaims{
+		ID:           user.ID,
+		NickName:     user.NickName,
+		AuthorityId:  user.Role,
+		TokenVersion: user.TokenVersion,
+		RegisteredClaims: jwt5.RegisteredClaims{
+			ID:        jti,
+			NotBefore: jwt5.NewNumericDate(now),
+			ExpiresAt: jwt5.NewNumericDate(now.Add(uc.accessTTL)),
+			Issuer:    "lucien",
+		},
+	}
+	token, err := auth.CreateToken(claims, uc.signingKey)
+	if err != nil {
+		return nil, ErrGenerateTokenFailed
+	}
+	session := &RefreshSession{UserID: user.ID, Family: family, TokenVersion: user.TokenVersion}
+	err = uc.repo.SaveRefresh(ctx, hashToken(refresh), session, uc.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RegisterReply{
+		Id:               user.ID,
+		Mobile:           user.Mobile,
+		Username:         user.NickName,
+		Token:            token,
+		ExpiredAt:        now.Add(uc.accessTTL).Unix(),
+		RefreshToken:     refresh,
+		RefreshExpiredAt: now.Add(uc.refreshTTL).Unix(),
+	}, nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// refresh token 只保存哈希，redis 泄漏时无法直接使用
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,160 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	v1 "lushop/api/lushop/v1"
+	"lushop/internal/biz"
+	"lushop/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt5 "github.com/golang-jwt/jwt/v5"
+)
+
+func newTokenUsecase(users ...*biz.User) (*biz.TokenUsecase, *fakeTokenRepo, *fakeUserRepo) {
+	repo := newFakeTokenRepo()
+	uRepo := &fakeUserRepo{users: make(map[int64]*biz.User)}
+	for _, u := range users {
+		uRepo.users[u.ID] = u
+	}
+	return biz.NewTokenUsecase(repo, uRepo, &conf.Auth{JwtKey: "test"}, log.DefaultLogger), repo, uRepo
+}
+
+func TestRefreshRotation(t *testing.T) {
+	ctx := context.Background()
+	uc, _, _ := newTokenUsecase(&biz.User{ID: 1, Role: 1})
+
+	first, err := uc.Issue(ctx, &biz.User{ID: 1, Role: 1})
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	second, err := uc.Refresh(ctx, &v1.RefreshReq{RefreshToken: first.RefreshToken})
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("refresh token should rotate")
+	}
+
+	// 旧的 refresh token 再次使用，吊销整个 family
+	if _, err := uc.Refresh(ctx, &v1.RefreshReq{RefreshToken: first.RefreshToken}); !errors.Is(err, biz.ErrRefreshTokenReused) {
+		t.Fatalf("reuse: got %v, want %v", err, biz.ErrRefreshTokenReused)
+	}
+	if _, err := uc.Refresh(ctx, &v1.RefreshReq{RefreshToken: second.RefreshToken}); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Fatalf("after reuse: got %v, want %v", err, biz.ErrRefreshTokenInvalid)
+	}
+}
+
+func TestRefreshAfterVersionBump(t *testing.T) {
+	ctx := context.Background()
+	user := &biz.User{ID: 1, Role: 1}
+	uc, repo, _ := newTokenUsecase(user)
+
+	reply, err := uc.Issue(ctx, user)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	// 修改密码后用户服务中的版本加一
+	user.TokenVersion = 1
+	if _, err := uc.Refresh(ctx, &v1.RefreshReq{RefreshToken: reply.RefreshToken}); !errors.Is(err, biz.ErrRefreshTokenInvalid) {
+		t.Fatalf("got %v, want %v", err, biz.ErrRefreshTokenInvalid)
+	}
+	if len(repo.revoked) != 1 {
+		t.Fatalf("family should be revoked, revoked: %v", repo.revoked)
+	}
+}
+
+func TestLogoutOtherUserRefresh(t *testing.T) {
+	ctx := context.Background()
+	other := &biz.User{ID: 2, Role: 1}
+	uc, repo, _ := newTokenUsecase(&biz.User{ID: 1, Role: 1}, other)
+
+	reply, err := uc.Issue(ctx, other)
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	// 用户 1 使用用户 2 的 refresh token 退出登录，不会消耗或吊销用户 2 的登录
+	logoutCtx := jwt.NewContext(ctx, jwt5.MapClaims{"ID": float64(1), "jti": "a"})
+	if _, err := uc.Logout(logoutCtx, &v1.LogoutReq{RefreshToken: reply.RefreshToken}); err != nil {
+		t.Fatalf("logout: %v", err)
+	}
+	if len(repo.revoked) != 0 {
+		t.Fatalf("family should not be revoked, revoked: %v", repo.revoked)
+	}
+	if _, err := uc.Refresh(ctx, &v1.RefreshReq{RefreshToken: reply.RefreshToken}); err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+}
+
+func TestIssueDisabledUser(t *testing.T) {
+	uc, _, _ := newTokenUsecase()
+	if _, err := uc.Issue(context.Background(), &biz.User{ID: 1, Disabled: true}); !errors.Is(err, biz.ErrUserDisabled) {
+		t.Fatalf("got %v, want %v", err, biz.ErrUserDisabled)
+	}
+}
+
+func TestIsRevoked(t *testing.T) {
+	claims := func(jti string, version int64) jwt5.MapClaims {
+		return jwt5.MapClaims{"jti": jti, "ID": float64(1), "TokenVersion": float64(version)}
+	}
+	tests := []struct {
+		name    string
+		prepare func(ctx context.Context, uc *biz.TokenUsecase, repo *fakeTokenRepo)
+		claims  jwt5.MapClaims
+		revoked bool
+	}{
+		{
+			name:    "有效的 token",
+			claims:  claims("a", 0),
+			revoked: false,
+		},
+		{
+			name:    "没有 jti 的旧 token",
+			claims:  claims("", 0),
+			revoked: true,
+		},
+		{
+			name: "已经退出登录",
+			prepare: func(ctx context.Context, uc *biz.TokenUsecase, repo *fakeTokenRepo) {
+				repo.denied["a"] = true
+			},
+			claims:  claims("a", 0),
+			revoked: true,
+		},
+		{
+			name: "修改密码后版本升高",
+			prepare: func(ctx context.Context, uc *biz.TokenUsecase, repo *fakeTokenRepo) {
+				_ = uc.BumpVersion(ctx, 1, 1)
+			},
+			claims:  claims("a", 0),
+			revoked: true,
+		},
+		{
+			name: "版本升高后重新签发的 token",
+			prepare: func(ctx context.Context, uc *biz.TokenUsecase, repo *fakeTokenRepo) {
+				_ = uc.BumpVersion(ctx, 1, 1)
+			},
+			claims:  claims("a", 1),
+			revoked: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			uc, repo, _ := newTokenUsecase(&biz.User{ID: 1})
+			if tt.prepare != nil {
+				tt.prepare(ctx, uc, repo)
+			}
+			revoked, err := uc.IsRevoked(ctx, tt.claims)
+			if err != nil {
+				t.Fatalf("is revoked: %v", err)
+			}
+			if revoked != tt.revoked {
+				t.Fatalf("got %v, want %v", revoked, tt.revoked)
+			}
+		})
+	}
+}
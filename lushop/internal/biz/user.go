@@ -4,14 +4,15 @@ import (
 	"context"
 	"errors"
 	v1 "lushop/api/lushop/v1"
-	"lushop/internal/conf"
 	"lushop/internal/pkg/captcha"
-	"lushop/internal/pkg/middleware/auth"
+	"regexp"
 	"time"
 
+	kerrors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	jwt5 "github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // 定义错误
@@ -26,39 +27,86 @@ var (
 	ErrAuthFailed          = errors.New("authentication failed")
 )
 
+var ErrOperateSelf = kerrors.BadRequest("OPERATE_SELF", "不能禁用自己或修改自己的角色")
+
+var (
+	ErrProfileMaskInvalid  = kerrors.BadRequest("UPDATE_MASK_INVALID", "不支持更新的字段")
+	ErrProfileFieldMissing = kerrors.BadRequest("PROFILE_FIELD_MISSING", "updateMask 中的昵称和性别不能为空")
+)
+
 // 定义返回的数据的结构体
 type User struct {
-	ID        int64
-	Mobile    string
-	Password  string
-	NickName  string
-	Birthday  int64
+	ID           int64
+	Mobile       string
+	Password     string
+	NickName     string
+	Birthday     *int64 // unix 秒，nil 表示没有设置
+	Gender       string
+	Role         int
+	TokenVersion int64 // 修改密码后加一，之前签发的 token 失效
+	Disabled     bool
+	CreatedAt    time.Time
+}
+
+// 个人资料可以修改的字段，和用户服务 UpdateUserInfo 的字段名一致
+const (
+	ProfileFieldNickName = "nickName"
+	ProfileFieldGender   = "gender"
+	ProfileFieldBirthday = "birthday"
+)
+
+// UserFilter 后台搜索用户的条件，零值表示不过滤
+type UserFilter struct {
+	Mobile    string // 手机号前缀
+	NickName  string // 昵称包含
+	Role      int32
 	Gender    string
-	Role      int
-	CreatedAt time.Time
+	StartTime int64 // 注册时间范围，unix 秒
+	EndTime   int64
+	Pn        uint32
+	PSize     uint32
 }
 
 type UserRepo interface {
 	CreateUser(c context.Context, u *User) (*User, error)
 	UserByMobile(ctx context.Context, mobile string) (*User, error)
 	UserById(ctx context.Context, Id int64) (*User, error)
-	CheckPassword(ctx context.Context, password, encryptedPassword string) (bool, error)
+	ListUser(ctx context.Context, f *UserFilter) (int32, []*User, error)
+	VerifyCredentials(ctx context.Context, mobile, password string) (*User, error)
+	// ChangePassword 和 ResetPassword 返回修改后的 token 版本
+	ChangePassword(ctx context.Context, id int64, oldPassword, newPassword string) (int64, error)
+	ResetPassword(ctx context.Context, mobile, ticket, newPassword string) (userId int64, version int64, err error)
+	// SetDisabled 和 ChangeRole 返回修改后的 token 版本
+	SetDisabled(ctx context.Context, id int64, disabled bool) (int64, error)
+	ChangeRole(ctx context.Context, id int64, role int32) (int64, error)
+	// DeleteUser 注销账号，返回修改后的 token 版本
+	DeleteUser(ctx context.Context, id int64) (int64, error)
+	// UpdateProfile 只更新 fields 中的字段，字段名见 ProfileField 开头的常量
+	UpdateProfile(ctx context.Context, u *User, fields []string) error
+	CreateLockEvent(ctx context.Context, e *LockEvent) error
+	ListLockEvent(ctx context.Context, mobile, ip string, pn, pSize uint32) (int32, []*LockEvent, error)
 }
 
 type UserUsecase struct {
-	uRepo      UserRepo
-	log        *log.Helper
-	signingKey string // 这里是为了生存 token 的时候可以直接取配置文件里面的配置
+	uRepo UserRepo
+	cRepo CartRepo
+	tu    *TokenUsecase      // 登录和注册成功后签发 token
+	lg    *LoginGuardUsecase // 密码登录失败次数限制
+	cp    *captcha.Captcha   // 图形验证码
+	sms   *SmsUsecase        // 短信验证码
+	ip    *ClientIP          // 登录失败按 IP 计数
+	log   *log.Helper
 }
 
-func NewUserUsecase(repo UserRepo, logger log.Logger, conf *conf.Auth) *UserUsecase {
+func NewUserUsecase(repo UserRepo, cRepo CartRepo, tu *TokenUsecase, lg *LoginGuardUsecase, cp *captcha.Captcha,
+	sms *SmsUsecase, ip *ClientIP, logger log.Logger) *UserUsecase {
 	helper := log.NewHelper(log.With(logger, "module", "usecase/lushop"))
-	return &UserUsecase{uRepo: repo, log: helper, signingKey: conf.JwtKey}
+	return &UserUsecase{uRepo: repo, cRepo: cRepo, tu: tu, lg: lg, cp: cp, sms: sms, ip: ip, log: helper}
 }
 
 // 获取验证码
 func (uc *UserUsecase) GetCaptcha(ctx context.Context) (*v1.CaptchaReply, error) {
-	captchaInfo, err := captcha.GetCaptcha(ctx)
+	captchaInfo, err := uc.cp.GetCaptcha(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -72,24 +120,178 @@ func (uc *UserUsecase) GetCaptcha(ctx context.Context) (*v1.CaptchaReply, error)
 
 // 用户ID获取详情
 func (uc *UserUsecase) UserDetailByID(ctx context.Context) (*v1.UserDetailResponse, error) {
-	// 从上下文取出claims用户权限信息
-	var uid int64
-	if claims, ok := jwt.FromContext(ctx); ok {
-		c := claims.(jwt5.MapClaims)
-		if c["ID"] == nil {
-			return nil, ErrAuthFailed
-		}
-		uid = int64(c["ID"].(float64))
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 	user, err := uc.uRepo.UserById(ctx, uid)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.UserDetailResponse{
-		Id:       user.ID,
-		NickName: user.NickName,
-		Mobile:   user.Mobile,
-	}, nil
+	return userDetail(user), nil
+}
+
+// 修改个人资料，请求中没有出现的字段保持不变，返回修改后的资料
+func (uc *UserUsecase) UpdateProfile(ctx context.Context, req *v1.UpdateProfileReq) (*v1.UserDetailResponse, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user := &User{ID: uid, NickName: req.GetNickName(), Gender: req.GetGender(), Birthday: req.Birthday}
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) == 0 {
+		if req.NickName != nil {
+			fields = append(fields, ProfileFieldNickName)
+		}
+		if req.Gender != nil {
+			fields = append(fields, ProfileFieldGender)
+		}
+		if req.Birthday != nil {
+			fields = append(fields, ProfileFieldBirthday)
+		}
+	}
+	for _, field := range fields {
+		switch field {
+		case ProfileFieldNickName:
+			if req.NickName == nil {
+				return nil, ErrProfileFieldMissing
+			}
+		case ProfileFieldGender:
+			if req.Gender == nil {
+				return nil, ErrProfileFieldMissing
+			}
+		case ProfileFieldBirthday:
+			// 没有设置 birthday 时清空生日
+		default:
+			return nil, ErrProfileMaskInvalid
+		}
+	}
+	// 用户服务在字段为空时会更新全部字段
+	if len(fields) > 0 {
+		if err := uc.uRepo.UpdateProfile(ctx, user, fields); err != nil {
+			return nil, err
+		}
+	}
+	return uc.UserDetailByID(ctx)
+}
+
+// 后台用户列表
+func (uc *UserUsecase) AdminUserList(ctx context.Context, req *v1.AdminUserListReq) (*v1.AdminUserListReply, error) {
+	pages, size := req.Pages, req.PagePerNums
+	if pages == 0 {
+		pages = defaultPage
+	}
+	if size == 0 {
+		size = defaultPageSize
+	}
+	total, list, err := uc.uRepo.ListUser(ctx, &UserFilter{
+		Mobile:    req.Mobile,
+		NickName:  req.NickName,
+		Role:      req.Role,
+		Gender:    req.Gender,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Pn:        pages,
+		PSize:     size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.AdminUserListReply{Total: total}
+	for _, user := range list {
+		rsp.List = append(rsp.List, userDetail(user))
+	}
+	return rsp, nil
+}
+
+// 后台查询用户详情
+func (uc *UserUsecase) AdminUserDetail(ctx context.Context, req *v1.AdminIdReq) (*v1.UserDetailResponse, error) {
+	user, err := uc.uRepo.UserById(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return userDetail(user), nil
+}
+
+// 后台禁用或启用账号，禁用后立即不能登录，已签发的 token 失效
+func (uc *UserUsecase) AdminSetUserDisabled(ctx context.Context, req *v1.AdminUserDisabledReq) (*emptypb.Empty, error) {
+	operator, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if operator == req.Id {
+		return nil, ErrOperateSelf
+	}
+	version, err := uc.uRepo.SetDisabled(ctx, req.Id, req.Disabled)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tu.BumpVersion(ctx, req.Id, version); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 后台修改用户角色，token 中带有角色，已签发的 token 失效
+func (uc *UserUsecase) AdminChangeUserRole(ctx context.Context, req *v1.AdminUserRoleReq) (*emptypb.Empty, error) {
+	operator, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if operator == req.Id {
+		return nil, ErrOperateSelf
+	}
+	version, err := uc.uRepo.ChangeRole(ctx, req.Id, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tu.BumpVersion(ctx, req.Id, version); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 后台手动解锁登录
+func (uc *UserUsecase) AdminUnlockLogin(ctx context.Context, req *v1.AdminUnlockLoginReq) (*emptypb.Empty, error) {
+	operator, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.lg.Unlock(ctx, req.Mobile, req.Ip, operator); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 后台查询登录锁定记录
+func (uc *UserUsecase) AdminLockEventList(ctx context.Context, req *v1.AdminLockEventListReq) (*v1.AdminLockEventListReply, error) {
+	pages, size := req.Pages, req.PagePerNums
+	if pages == 0 {
+		pages = defaultPage
+	}
+	if size == 0 {
+		size = defaultPageSize
+	}
+	total, list, err := uc.uRepo.ListLockEvent(ctx, req.Mobile, req.Ip, pages, size)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.AdminLockEventListReply{Total: total}
+	for _, e := range list {
+		rsp.List = append(rsp.List, &v1.LockEvent{
+			Id:        e.ID,
+			Mobile:    e.Mobile,
+			Ip:        e.IP,
+			Action:    e.Action,
+			Scope:     e.Scope,
+			Reason:    e.Reason,
+			Failures:  e.Failures,
+			Until:     e.Until,
+			Operator:  e.Operator,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return rsp, nil
 }
 
 // 用户密码登录
@@ -101,88 +303,176 @@ func (uc *UserUsecase) PasswordLogin(ctx context.Context, req *v1.LoginReq) (*v1
 	if len(req.Password) <= 0 {
 		return nil, ErrUsernameInvalid
 	}
+	// 已锁定时直接拒绝，失败次数较多时延迟响应
+	ip := uc.ip.FromContext(ctx)
+	if err := uc.lg.Check(ctx, req.Mobile, ip); err != nil {
+		return nil, err
+	}
 	// 验证验证码是否正确
-	if !captcha.Store.Verify(req.CaptchaId, req.Captcha, true) {
+	if !uc.cp.Verify(req.CaptchaId, req.Captcha) {
 		return nil, ErrCaptchaInvalid
 	}
-	// 手机号验证
-	if user, err := uc.uRepo.UserByMobile(ctx, req.Mobile); err != nil {
-		return nil, ErrUserNotFound
-	} else {
-		// 检查密码
-		if passRsp, pasErr := uc.uRepo.CheckPassword(ctx, req.Password, user.Password); pasErr != nil {
-			return nil, ErrPasswordInvalid
-		} else {
-			if passRsp {
-				now := time.Now()
-				expiresAt := now.Add(30 * 24 * time.Hour)
-				claims := auth.CustomClaims{
-					ID:          user.ID,
-					NickName:    user.NickName,
-					AuthorityId: user.Role,
-					RegisteredClaims: jwt5.RegisteredClaims{
-						NotBefore: jwt5.NewNumericDate(now),
-						ExpiresAt: jwt5.NewNumericDate(expiresAt),
-						Issuer:    "lucien",
-					},
-				}
-				token, err := auth.CreateToken(claims, uc.signingKey)
-				if err != nil {
-					return nil, ErrGenerateTokenFailed
-				}
-				return &v1.RegisterReply{
-					Id:        user.ID,
-					Mobile:    user.Mobile,
-					Username:  user.NickName,
-					Token:     token,
-					ExpiredAt: time.Now().Unix() + 60*60*24*30,
-				}, nil
-			} else {
-				return nil, ErrLoginFailed
-			}
+	// 用户服务内部完成手机号查询和密码比对
+	user, err := uc.uRepo.VerifyCredentials(ctx, req.Mobile, req.Password)
+	if err != nil {
+		if kerrors.IsUnauthorized(err) {
+			uc.lg.Fail(ctx, req.Mobile, ip)
+			return nil, ErrLoginFailed
+		}
+		return nil, err
+	}
+	uc.lg.Succeed(ctx, req.Mobile)
+	rsp, err := uc.tu.Issue(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	uc.mergeGuestCart(ctx, user.ID)
+	return rsp, nil
+}
+
+// 短信验证码登录，登录成功后清空密码登录的失败次数
+func (uc *UserUsecase) SmsLogin(ctx context.Context, req *v1.SmsLoginReq) (*v1.RegisterReply, error) {
+	if !validMobile(req.Mobile) {
+		return nil, ErrMobileInvalid
+	}
+	if err := uc.sms.Verify(ctx, v1.SmsScene_SMS_SCENE_LOGIN, req.Mobile, req.SmsCode, true); err != nil {
+		return nil, err
+	}
+	user, err := uc.uRepo.UserByMobile(ctx, req.Mobile)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, ErrUserNotFound
 		}
+		return nil, err
+	}
+	uc.lg.Succeed(ctx, req.Mobile)
+	rsp, err := uc.tu.Issue(ctx, user)
+	if err != nil {
+		return nil, err
 	}
+	uc.mergeGuestCart(ctx, user.ID)
+	return rsp, nil
 }
 
-// 创建用户，用户注册创建后也提供登录状态
+// 修改密码，之前签发的 token 全部失效，返回新的登录状态
+func (uc *UserUsecase) ChangePassword(ctx context.Context, req *v1.ChangePasswordReq) (*v1.RegisterReply, error) {
+	uid, err := userIdFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := uc.uRepo.ChangePassword(ctx, uid, req.OldPassword, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.tu.BumpVersion(ctx, uid, version); err != nil {
+		return nil, err
+	}
+	user, err := uc.uRepo.UserById(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return uc.tu.Issue(ctx, user)
+}
+
+// 通过短信验证码重置密码，之前签发的 token 全部失效
+func (uc *UserUsecase) ResetPassword(ctx context.Context, req *v1.ResetPasswordReq) (*emptypb.Empty, error) {
+	if !validMobile(req.Mobile) {
+		return nil, ErrMobileInvalid
+	}
+	ticket, err := uc.sms.ResetTicket(ctx, req.Mobile, req.SmsCode)
+	if err != nil {
+		return nil, err
+	}
+	uid, version, err := uc.uRepo.ResetPassword(ctx, req.Mobile, ticket, req.NewPassword)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if err := uc.tu.BumpVersion(ctx, uid, version); err != nil {
+		return nil, err
+	}
+	uc.lg.Succeed(ctx, req.Mobile)
+	return &emptypb.Empty{}, nil
+}
+
+// 创建用户，手机号需要通过短信验证，用户注册创建后也提供登录状态
 func (uc *UserUsecase) CreateUser(ctx context.Context, req *v1.RegisterReq) (*v1.RegisterReply, error) {
 	newUser, err := newUser(req.Mobile, req.Username, req.Password)
 	if err != nil {
 		return nil, err
 	}
+	if err := uc.sms.Verify(ctx, v1.SmsScene_SMS_SCENE_REGISTER, req.Mobile, req.SmsCode, true); err != nil {
+		return nil, err
+	}
 	creatuser, err := uc.uRepo.CreateUser(ctx, &newUser)
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now()
-	expiresAt := now.Add(30 * 24 * time.Hour)
-	claims := auth.CustomClaims{
-		ID:          creatuser.ID,
-		NickName:    creatuser.NickName,
-		AuthorityId: creatuser.Role,
-		RegisteredClaims: jwt5.RegisteredClaims{
-			NotBefore: jwt5.NewNumericDate(now),
-			ExpiresAt: jwt5.NewNumericDate(expiresAt),
-			Issuer:    "lucien",
-		},
+	rsp, err := uc.tu.Issue(ctx, creatuser)
+	if err != nil {
+		return nil, err
 	}
-	token, err := auth.CreateToken(claims, uc.signingKey)
+	uc.mergeGuestCart(ctx, creatuser.ID)
+	return rsp, nil
+
+}
+
+// 登录或注册成功后把游客购物车合并到用户购物车，合并失败不影响登录
+func (uc *UserUsecase) mergeGuestCart(ctx context.Context, userId int64) {
+	token, err := deviceToken(ctx)
 	if err != nil {
-		return nil, ErrGenerateTokenFailed
+		return
 	}
-	return &v1.RegisterReply{
-		Id:        creatuser.ID,
-		Mobile:    creatuser.Mobile,
-		Username:  creatuser.NickName,
-		Token:     token,
-		ExpiredAt: time.Now().Unix() + 60*60*24*30,
-	}, nil
+	if err := uc.cRepo.MergeGuest(ctx, token, userId); err != nil {
+		uc.log.Errorf("merge guest cart error: %v", err)
+	}
+}
+
+// 从上下文取出 jwt claims 中的用户ID，接口中的用户ID只能从这里获取
+func userIdFromContext(ctx context.Context) (int64, error) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return 0, ErrAuthFailed
+	}
+	c, ok := claims.(jwt5.MapClaims)
+	if !ok {
+		return 0, ErrAuthFailed
+	}
+	id, ok := c["ID"].(float64)
+	if !ok || id <= 0 {
+		return 0, ErrAuthFailed
+	}
+	return int64(id), nil
+}
+
+func userDetail(user *User) *v1.UserDetailResponse {
+	rsp := &v1.UserDetailResponse{
+		Id:       user.ID,
+		Mobile:   user.Mobile,
+		NickName: user.NickName,
+		Birthday: user.Birthday,
+		Gender:   user.Gender,
+		Role:     int32(user.Role),
+		Disabled: user.Disabled,
+	}
+	if !user.CreatedAt.IsZero() {
+		rsp.CreatedAt = user.CreatedAt.Unix()
+	}
+	return rsp
+}
+
+// 手机号格式校验，11 位大陆手机号
+var mobileRegexp = regexp.MustCompile(`^1[3-9]\d{9}$`)
 
+func validMobile(mobile string) bool {
+	return mobileRegexp.MatchString(mobile)
 }
 
 // 用户结构体生成
 func newUser(mobile, username, password string) (User, error) {
-	if len(mobile) <= 0 || len(mobile) > 13 {
+	if !validMobile(mobile) {
 		return User{}, ErrMobileInvalid
 	}
 	if len(username) <= 0 {
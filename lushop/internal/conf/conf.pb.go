@@ -206,6 +206,7 @@ type Service struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *Service_User          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Goods         *Service_Goods         `protobuf:"bytes,2,opt,name=goods,proto3" json:"goods,omitempty"`
+	Cart          *Service_Cart          `protobuf:"bytes,3,opt,name=cart,proto3" json:"cart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -254,6 +255,13 @@ func (x *Service) GetGoods() *Service_Goods {
 	return nil
 }
 
+func (x *Service) GetCart() *Service_Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
 type Trace struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
@@ -343,10 +351,21 @@ func (x *Registry) GetConsul() *Registry_Consul {
 }
 
 type Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtKey        string                 `protobuf:"bytes,1,opt,name=jwt_key,json=jwtKey,proto3" json:"jwt_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	JwtKey string                 `protobuf:"bytes,1,opt,name=jwt_key,json=jwtKey,proto3" json:"jwt_key,omitempty"`
+	// key 为接口的 operation，例如 /lushop.lushop.v1.Lushop/AdminCreateBrand，
+	// 没有配置策略的后台接口只允许管理员访问
+	Policies   map[string]*Auth_Policy `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AccessTtl  *durationpb.Duration    `protobuf:"bytes,3,opt,name=access_ttl,json=accessTtl,proto3" json:"access_ttl,omitempty"`    // access token 有效期，默认 15 分钟
+	RefreshTtl *durationpb.Duration    `protobuf:"bytes,4,opt,name=refresh_ttl,json=refreshTtl,proto3" json:"refresh_ttl,omitempty"` // refresh token 有效期，默认 30 天
+	LoginGuard *Auth_LoginGuard        `protobuf:"bytes,5,opt,name=login_guard,json=loginGuard,proto3" json:"login_guard,omitempty"`
+	Captcha    *Auth_Captcha           `protobuf:"bytes,6,opt,name=captcha,proto3" json:"captcha,omitempty"`
+	Sms        *Auth_Sms               `protobuf:"bytes,7,opt,name=sms,proto3" json:"sms,omitempty"`
+	// 可信的反向代理 IP 或 CIDR，只有来自这些地址的请求才使用 X-Real-IP 和 X-Forwarded-For 中的客户端 IP
+	TrustedProxies []string `protobuf:"bytes,8,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	ResetTicketKey string   `protobuf:"bytes,9,opt,name=reset_ticket_key,json=resetTicketKey,proto3" json:"reset_ticket_key,omitempty"` // 重置密码凭证的签名密钥，必须配置，和用户服务的 auth.reset_ticket_key 一致
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Auth) Reset() {
@@ -386,6 +405,62 @@ func (x *Auth) GetJwtKey() string {
 	return ""
 }
 
+func (x *Auth) GetPolicies() map[string]*Auth_Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *Auth) GetAccessTtl() *durationpb.Duration {
+	if x != nil {
+		return x.AccessTtl
+	}
+	return nil
+}
+
+func (x *Auth) GetRefreshTtl() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshTtl
+	}
+	return nil
+}
+
+func (x *Auth) GetLoginGuard() *Auth_LoginGuard {
+	if x != nil {
+		return x.LoginGuard
+	}
+	return nil
+}
+
+func (x *Auth) GetCaptcha() *Auth_Captcha {
+	if x != nil {
+		return x.Captcha
+	}
+	return nil
+}
+
+func (x *Auth) GetSms() *Auth_Sms {
+	if x != nil {
+		return x.Sms
+	}
+	return nil
+}
+
+func (x *Auth) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
+func (x *Auth) GetResetTicketKey() string {
+	if x != nil {
+		return x.ResetTicketKey
+	}
+	return ""
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -738,6 +813,50 @@ func (x *Service_Goods) GetEndpoint() string {
 	return ""
 }
 
+type Service_Cart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Service_Cart) Reset() {
+	*x = Service_Cart{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service_Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service_Cart) ProtoMessage() {}
+
+func (x *Service_Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service_Cart.ProtoReflect.Descriptor instead.
+func (*Service_Cart) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *Service_Cart) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
 type Registry_Consul struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -748,7 +867,7 @@ type Registry_Consul struct {
 
 func (x *Registry_Consul) Reset() {
 	*x = Registry_Consul{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -760,7 +879,7 @@ func (x *Registry_Consul) String() string {
 func (*Registry_Consul) ProtoMessage() {}
 
 func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,6 +909,286 @@ func (x *Registry_Consul) GetScheme() string {
 	return ""
 }
 
+type Auth_Policy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []int32                `protobuf:"varint,1,rep,packed,name=roles,proto3" json:"roles,omitempty"` // 允许访问的角色 1 普通用户 2 管理员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Policy) Reset() {
+	*x = Auth_Policy{}
+	mi := &file_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Policy) ProtoMessage() {}
+
+func (x *Auth_Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Policy.ProtoReflect.Descriptor instead.
+func (*Auth_Policy) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *Auth_Policy) GetRoles() []int32 {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type Auth_LoginGuard struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Window            *durationpb.Duration   `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`                                                   // 失败次数统计的滑动窗口，默认 15 分钟
+	MobileMaxFailures int32                  `protobuf:"varint,2,opt,name=mobile_max_failures,json=mobileMaxFailures,proto3" json:"mobile_max_failures,omitempty"` // 窗口内同一手机号允许的失败次数，默认 5
+	IpMaxFailures     int32                  `protobuf:"varint,3,opt,name=ip_max_failures,json=ipMaxFailures,proto3" json:"ip_max_failures,omitempty"`             // 窗口内同一 IP 允许的失败次数，默认 20
+	LockDuration      *durationpb.Duration   `protobuf:"bytes,4,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"`                   // 锁定时长，默认 15 分钟
+	DelayAfter        int32                  `protobuf:"varint,5,opt,name=delay_after,json=delayAfter,proto3" json:"delay_after,omitempty"`                        // 手机号失败多少次后开始延迟响应，默认 2
+	DelayStep         *durationpb.Duration   `protobuf:"bytes,6,opt,name=delay_step,json=delayStep,proto3" json:"delay_step,omitempty"`                            // 延迟基数，之后每多失败一次翻倍，默认 1 秒
+	MaxDelay          *durationpb.Duration   `protobuf:"bytes,7,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`                               // 最大延迟，默认 8 秒
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Auth_LoginGuard) Reset() {
+	*x = Auth_LoginGuard{}
+	mi := &file_conf_conf_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_LoginGuard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_LoginGuard) ProtoMessage() {}
+
+func (x *Auth_LoginGuard) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_LoginGuard.ProtoReflect.Descriptor instead.
+func (*Auth_LoginGuard) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *Auth_LoginGuard) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Auth_LoginGuard) GetMobileMaxFailures() int32 {
+	if x != nil {
+		return x.MobileMaxFailures
+	}
+	return 0
+}
+
+func (x *Auth_LoginGuard) GetIpMaxFailures() int32 {
+	if x != nil {
+		return x.IpMaxFailures
+	}
+	return 0
+}
+
+func (x *Auth_LoginGuard) GetLockDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LockDuration
+	}
+	return nil
+}
+
+func (x *Auth_LoginGuard) GetDelayAfter() int32 {
+	if x != nil {
+		return x.DelayAfter
+	}
+	return 0
+}
+
+func (x *Auth_LoginGuard) GetDelayStep() *durationpb.Duration {
+	if x != nil {
+		return x.DelayStep
+	}
+	return nil
+}
+
+func (x *Auth_LoginGuard) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return nil
+}
+
+type Auth_Captcha struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Store         string                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`                                    // 验证码存储 redis 或 memory，默认 redis，memory 只适合单副本
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`                                        // 验证码有效期，默认 5 分钟
+	ExposeAnswer  bool                   `protobuf:"varint,3,opt,name=expose_answer,json=exposeAnswer,proto3" json:"expose_answer,omitempty"` // 接口返回验证码答案，只能在开发测试环境开启
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Captcha) Reset() {
+	*x = Auth_Captcha{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Captcha) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Captcha) ProtoMessage() {}
+
+func (x *Auth_Captcha) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Captcha.ProtoReflect.Descriptor instead.
+func (*Auth_Captcha) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *Auth_Captcha) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *Auth_Captcha) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Auth_Captcha) GetExposeAnswer() bool {
+	if x != nil {
+		return x.ExposeAnswer
+	}
+	return false
+}
+
+type Auth_Sms struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Sender           string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`                                                // 短信发送渠道，必须配置，log 只打印日志，用于本地开发
+	CodeTtl          *durationpb.Duration   `protobuf:"bytes,2,opt,name=code_ttl,json=codeTtl,proto3" json:"code_ttl,omitempty"`                               // 验证码有效期，默认 5 分钟
+	ResendInterval   *durationpb.Duration   `protobuf:"bytes,3,opt,name=resend_interval,json=resendInterval,proto3" json:"resend_interval,omitempty"`          // 同一手机号两次发送的最小间隔，默认 60 秒
+	MobileDailyLimit int32                  `protobuf:"varint,4,opt,name=mobile_daily_limit,json=mobileDailyLimit,proto3" json:"mobile_daily_limit,omitempty"` // 同一手机号每天最多发送次数，默认 10
+	IpHourlyLimit    int32                  `protobuf:"varint,5,opt,name=ip_hourly_limit,json=ipHourlyLimit,proto3" json:"ip_hourly_limit,omitempty"`          // 同一 IP 每小时最多发送次数，默认 20
+	MaxAttempts      int32                  `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                  // 同一个验证码最多校验错误次数，超过后失效，默认 5
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Auth_Sms) Reset() {
+	*x = Auth_Sms{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Sms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Sms) ProtoMessage() {}
+
+func (x *Auth_Sms) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Sms.ProtoReflect.Descriptor instead.
+func (*Auth_Sms) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *Auth_Sms) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Auth_Sms) GetCodeTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CodeTtl
+	}
+	return nil
+}
+
+func (x *Auth_Sms) GetResendInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ResendInterval
+	}
+	return nil
+}
+
+func (x *Auth_Sms) GetMobileDailyLimit() int32 {
+	if x != nil {
+		return x.MobileDailyLimit
+	}
+	return 0
+}
+
+func (x *Auth_Sms) GetIpHourlyLimit() int32 {
+	if x != nil {
+		return x.IpHourlyLimit
+	}
+	return 0
+}
+
+func (x *Auth_Sms) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
@@ -826,13 +1225,16 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x02db\x18\x04 \x01(\x05R\x02db\x12<\n" +
 	"\fdial_timeout\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vdialTimeout\x12<\n" +
 	"\fread_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\xb1\x01\n" +
+	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\"\x83\x02\n" +
 	"\aService\x12,\n" +
 	"\x04user\x18\x01 \x01(\v2\x18.lushop.api.Service.UserR\x04user\x12/\n" +
-	"\x05goods\x18\x02 \x01(\v2\x19.lushop.api.Service.GoodsR\x05goods\x1a\"\n" +
+	"\x05goods\x18\x02 \x01(\v2\x19.lushop.api.Service.GoodsR\x05goods\x12,\n" +
+	"\x04cart\x18\x03 \x01(\v2\x18.lushop.api.Service.CartR\x04cart\x1a\"\n" +
 	"\x04User\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a#\n" +
 	"\x05Goods\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x1a\"\n" +
+	"\x04Cart\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\"#\n" +
 	"\x05Trace\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\"{\n" +
@@ -840,9 +1242,48 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06consul\x18\x01 \x01(\v2\x1b.lushop.api.Registry.ConsulR\x06consul\x1a:\n" +
 	"\x06Consul\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
-	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"\x1f\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"\xa7\n" +
+	"\n" +
 	"\x04Auth\x12\x17\n" +
-	"\ajwt_key\x18\x01 \x01(\tR\x06jwtKeyB\x1bZ\x19lushop/internal/conf;confb\x06proto3"
+	"\ajwt_key\x18\x01 \x01(\tR\x06jwtKey\x12:\n" +
+	"\bpolicies\x18\x02 \x03(\v2\x1e.lushop.api.Auth.PoliciesEntryR\bpolicies\x128\n" +
+	"\n" +
+	"access_ttl\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\taccessTtl\x12:\n" +
+	"\vrefresh_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"refreshTtl\x12<\n" +
+	"\vlogin_guard\x18\x05 \x01(\v2\x1b.lushop.api.Auth.LoginGuardR\n" +
+	"loginGuard\x122\n" +
+	"\acaptcha\x18\x06 \x01(\v2\x18.lushop.api.Auth.CaptchaR\acaptcha\x12&\n" +
+	"\x03sms\x18\a \x01(\v2\x14.lushop.api.Auth.SmsR\x03sms\x12'\n" +
+	"\x0ftrusted_proxies\x18\b \x03(\tR\x0etrustedProxies\x12(\n" +
+	"\x10reset_ticket_key\x18\t \x01(\tR\x0eresetTicketKey\x1a\x1e\n" +
+	"\x06Policy\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\x05R\x05roles\x1a\xea\x02\n" +
+	"\n" +
+	"LoginGuard\x121\n" +
+	"\x06window\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x06window\x12.\n" +
+	"\x13mobile_max_failures\x18\x02 \x01(\x05R\x11mobileMaxFailures\x12&\n" +
+	"\x0fip_max_failures\x18\x03 \x01(\x05R\ripMaxFailures\x12>\n" +
+	"\rlock_duration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\flockDuration\x12\x1f\n" +
+	"\vdelay_after\x18\x05 \x01(\x05R\n" +
+	"delayAfter\x128\n" +
+	"\n" +
+	"delay_step\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\tdelayStep\x126\n" +
+	"\tmax_delay\x18\a \x01(\v2\x19.google.protobuf.DurationR\bmaxDelay\x1aq\n" +
+	"\aCaptcha\x12\x14\n" +
+	"\x05store\x18\x01 \x01(\tR\x05store\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12#\n" +
+	"\rexpose_answer\x18\x03 \x01(\bR\fexposeAnswer\x1a\x90\x02\n" +
+	"\x03Sms\x12\x16\n" +
+	"\x06sender\x18\x01 \x01(\tR\x06sender\x124\n" +
+	"\bcode_ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\acodeTtl\x12B\n" +
+	"\x0fresend_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x0eresendInterval\x12,\n" +
+	"\x12mobile_daily_limit\x18\x04 \x01(\x05R\x10mobileDailyLimit\x12&\n" +
+	"\x0fip_hourly_limit\x18\x05 \x01(\x05R\ripHourlyLimit\x12!\n" +
+	"\fmax_attempts\x18\x06 \x01(\x05R\vmaxAttempts\x1aT\n" +
+	"\rPoliciesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.lushop.api.Auth.PolicyR\x05value:\x028\x01B\x1bZ\x19lushop/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -856,7 +1297,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: lushop.api.Bootstrap
 	(*Server)(nil),              // 1: lushop.api.Server
@@ -871,8 +1312,14 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Data_Redis)(nil),          // 10: lushop.api.Data.Redis
 	(*Service_User)(nil),        // 11: lushop.api.Service.User
 	(*Service_Goods)(nil),       // 12: lushop.api.Service.Goods
-	(*Registry_Consul)(nil),     // 13: lushop.api.Registry.Consul
-	(*durationpb.Duration)(nil), // 14: google.protobuf.Duration
+	(*Service_Cart)(nil),        // 13: lushop.api.Service.Cart
+	(*Registry_Consul)(nil),     // 14: lushop.api.Registry.Consul
+	(*Auth_Policy)(nil),         // 15: lushop.api.Auth.Policy
+	(*Auth_LoginGuard)(nil),     // 16: lushop.api.Auth.LoginGuard
+	(*Auth_Captcha)(nil),        // 17: lushop.api.Auth.Captcha
+	(*Auth_Sms)(nil),            // 18: lushop.api.Auth.Sms
+	nil,                         // 19: lushop.api.Auth.PoliciesEntry
+	(*durationpb.Duration)(nil), // 20: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: lushop.api.Bootstrap.server:type_name -> lushop.api.Server
@@ -886,17 +1333,32 @@ var file_conf_conf_proto_depIdxs = []int32{
 	10, // 8: lushop.api.Data.redis:type_name -> lushop.api.Data.Redis
 	11, // 9: lushop.api.Service.user:type_name -> lushop.api.Service.User
 	12, // 10: lushop.api.Service.goods:type_name -> lushop.api.Service.Goods
-	13, // 11: lushop.api.Registry.consul:type_name -> lushop.api.Registry.Consul
-	14, // 12: lushop.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	14, // 13: lushop.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	14, // 14: lushop.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	14, // 15: lushop.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	14, // 16: lushop.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	13, // 11: lushop.api.Service.cart:type_name -> lushop.api.Service.Cart
+	14, // 12: lushop.api.Registry.consul:type_name -> lushop.api.Registry.Consul
+	19, // 13: lushop.api.Auth.policies:type_name -> lushop.api.Auth.PoliciesEntry
+	20, // 14: lushop.api.Auth.access_ttl:type_name -> google.protobuf.Duration
+	20, // 15: lushop.api.Auth.refresh_ttl:type_name -> google.protobuf.Duration
+	16, // 16: lushop.api.Auth.login_guard:type_name -> lushop.api.Auth.LoginGuard
+	17, // 17: lushop.api.Auth.captcha:type_name -> lushop.api.Auth.Captcha
+	18, // 18: lushop.api.Auth.sms:type_name -> lushop.api.Auth.Sms
+	20, // 19: lushop.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	20, // 20: lushop.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	20, // 21: lushop.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	20, // 22: lushop.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	20, // 23: lushop.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	20, // 24: lushop.api.Auth.LoginGuard.window:type_name -> google.protobuf.Duration
+	20, // 25: lushop.api.Auth.LoginGuard.lock_duration:type_name -> google.protobuf.Duration
+	20, // 26: lushop.api.Auth.LoginGuard.delay_step:type_name -> google.protobuf.Duration
+	20, // 27: lushop.api.Auth.LoginGuard.max_delay:type_name -> google.protobuf.Duration
+	20, // 28: lushop.api.Auth.Captcha.ttl:type_name -> google.protobuf.Duration
+	20, // 29: lushop.api.Auth.Sms.code_ttl:type_name -> google.protobuf.Duration
+	20, // 30: lushop.api.Auth.Sms.resend_interval:type_name -> google.protobuf.Duration
+	15, // 31: lushop.api.Auth.PoliciesEntry.value:type_name -> lushop.api.Auth.Policy
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -910,7 +1372,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+
+	userService "lushop/api/service/user/v1"
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type addressRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAddressRepo .
+func NewAddressRepo(data *Data, logger log.Logger) biz.AddressRepo {
+	return &addressRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "repo/address")),
+	}
+}
+
+func (a *addressRepo) List(ctx context.Context, userId int64) ([]*biz.Address, error) {
+	rsp, err := a.data.uc.ListAddresses(ctx, &userService.UserIdRequest{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.Address
+	for _, item := range rsp.Data {
+		list = append(list, address(item))
+	}
+	return list, nil
+}
+
+func (a *addressRepo) Create(ctx context.Context, addr *biz.Address) (*biz.Address, error) {
+	rsp, err := a.data.uc.CreateAddress(ctx, addressInfo(addr))
+	if err != nil {
+		return nil, err
+	}
+	return address(rsp), nil
+}
+
+func (a *addressRepo) Update(ctx context.Context, addr *biz.Address) error {
+	_, err := a.data.uc.UpdateAddress(ctx, addressInfo(addr))
+	return err
+}
+
+func (a *addressRepo) Delete(ctx context.Context, userId, id int64) error {
+	_, err := a.data.uc.DeleteAddress(ctx, &userService.AddressRequest{Id: id, UserId: userId})
+	return err
+}
+
+func (a *addressRepo) SetDefault(ctx context.Context, userId, id int64) error {
+	_, err := a.data.uc.SetDefaultAddress(ctx, &userService.AddressRequest{Id: id, UserId: userId})
+	return err
+}
+
+func addressInfo(a *biz.Address) *userService.AddressInfo {
+	return &userService.AddressInfo{
+		Id:           a.ID,
+		UserId:       a.UserID,
+		ProvinceCode: a.ProvinceCode,
+		CityCode:     a.CityCode,
+		DistrictCode: a.DistrictCode,
+		Recipient:    a.Recipient,
+		Mobile:       a.Mobile,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+	}
+}
+
+func address(a *userService.AddressInfo) *biz.Address {
+	return &biz.Address{
+		ID:           a.Id,
+		UserID:       a.UserId,
+		ProvinceCode: a.ProvinceCode,
+		CityCode:     a.CityCode,
+		DistrictCode: a.DistrictCode,
+		Recipient:    a.Recipient,
+		Mobile:       a.Mobile,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+	}
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"time"
+
+	"lushop/internal/conf"
+	"lushop/internal/pkg/captcha"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/mojocn/base64Captcha"
+)
+
+const defaultCaptchaTTL = 5 * time.Minute
+
+// NewCaptcha 按配置选择验证码存储
+func NewCaptcha(ac *conf.Auth, rdb *redis.Client) *captcha.Captcha {
+	c := ac.GetCaptcha()
+	ttl := c.GetTtl().AsDuration()
+	if ttl <= 0 {
+		ttl = defaultCaptchaTTL
+	}
+	var store base64Captcha.Store
+	switch c.GetStore() {
+	case "memory":
+		store = base64Captcha.NewMemoryStore(base64Captcha.GCLimitNumber, ttl)
+	default:
+		store = captcha.NewRedisStore(rdb, ttl)
+	}
+	return captcha.NewCaptcha(store, c.GetExposeAnswer())
+}
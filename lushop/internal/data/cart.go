@@ -0,0 +1,188 @@
+package data
+
+import (
+	"context"
+
+	cartService "lushop/api/service/cart/v1"
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type cartRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewCartRepo .
+func NewCartRepo(data *Data, logger log.Logger) biz.CartRepo {
+	return &cartRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "repo/cart")),
+	}
+}
+
+func (c *cartRepo) List(ctx context.Context, userId int64) ([]*biz.CartItem, error) {
+	rsp, err := c.data.cc.ListCart(ctx, &cartService.ListCartRequest{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.CartItem
+	for _, item := range rsp.Results {
+		list = append(list, cartItem(item))
+	}
+	return list, nil
+}
+
+func (c *cartRepo) Create(ctx context.Context, userId, skuId int64, num int32) (*biz.CartItem, error) {
+	rsp, err := c.data.cc.CreateCart(ctx, &cartService.CreateCartRequest{
+		UserId:   userId,
+		SkuId:    skuId,
+		GoodsNum: num,
+		IsSelect: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cartItem(rsp), nil
+}
+
+func (c *cartRepo) Update(ctx context.Context, userId, skuId int64, num int32) error {
+	_, err := c.data.cc.UpdateCart(ctx, &cartService.UpdateCartRequest{
+		UserId:   userId,
+		SkuId:    skuId,
+		GoodsNum: num,
+	})
+	return err
+}
+
+func (c *cartRepo) Delete(ctx context.Context, userId int64, skuIds ...int64) error {
+	_, err := c.data.cc.DeleteCart(ctx, &cartService.DeleteCartRequest{
+		UserId: userId,
+		SkuIds: skuIds,
+	})
+	return err
+}
+
+func (c *cartRepo) Select(ctx context.Context, userId int64, isSelect bool, skuIds ...int64) error {
+	_, err := c.data.cc.SelectCart(ctx, &cartService.SelectCartRequest{
+		UserId:   userId,
+		SkuIds:   skuIds,
+		IsSelect: isSelect,
+	})
+	return err
+}
+
+func (c *cartRepo) Clear(ctx context.Context, userId int64) error {
+	_, err := c.data.cc.ClearCart(ctx, &cartService.ClearCartRequest{UserId: userId})
+	return err
+}
+
+func (c *cartRepo) ListSaved(ctx context.Context, userId int64) ([]*biz.CartItem, error) {
+	rsp, err := c.data.cc.ListSaved(ctx, &cartService.ListCartRequest{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.CartItem
+	for _, item := range rsp.Results {
+		list = append(list, cartItem(item))
+	}
+	return list, nil
+}
+
+func (c *cartRepo) DeleteSaved(ctx context.Context, userId int64, skuIds ...int64) error {
+	_, err := c.data.cc.DeleteSaved(ctx, &cartService.CartSkusRequest{UserId: userId, SkuIds: skuIds})
+	return err
+}
+
+func (c *cartRepo) ListWishlist(ctx context.Context, userId int64) ([]*biz.WishlistItem, error) {
+	rsp, err := c.data.cc.ListWishlist(ctx, &cartService.ListCartRequest{UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.WishlistItem
+	for _, item := range rsp.Results {
+		list = append(list, &biz.WishlistItem{
+			ID:        item.Id,
+			GoodsId:   item.GoodsId,
+			GoodsName: item.Name,
+			GoodsSn:   item.GoodsSn,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+	return list, nil
+}
+
+func (c *cartRepo) RemoveWishlist(ctx context.Context, userId int64, goodsIds ...int64) error {
+	_, err := c.data.cc.RemoveWishlist(ctx, &cartService.RemoveWishlistRequest{UserId: userId, GoodsIds: goodsIds})
+	return err
+}
+
+func (c *cartRepo) GuestList(ctx context.Context, token string) ([]*biz.CartItem, error) {
+	rsp, err := c.data.cc.ListGuestCart(ctx, &cartService.GuestTokenRequest{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.CartItem
+	for _, item := range rsp.Results {
+		list = append(list, cartItem(item))
+	}
+	return list, nil
+}
+
+func (c *cartRepo) GuestCreate(ctx context.Context, token string, skuId int64, num int32) (*biz.CartItem, error) {
+	rsp, err := c.data.cc.CreateGuestCart(ctx, &cartService.GuestCartRequest{
+		Token:    token,
+		SkuId:    skuId,
+		GoodsNum: num,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cartItem(rsp), nil
+}
+
+func (c *cartRepo) GuestUpdate(ctx context.Context, token string, skuId int64, num int32) error {
+	_, err := c.data.cc.UpdateGuestCart(ctx, &cartService.GuestCartRequest{
+		Token:    token,
+		SkuId:    skuId,
+		GoodsNum: num,
+	})
+	return err
+}
+
+func (c *cartRepo) GuestDelete(ctx context.Context, token string, skuIds ...int64) error {
+	_, err := c.data.cc.DeleteGuestCart(ctx, &cartService.DeleteGuestCartRequest{
+		Token:  token,
+		SkuIds: skuIds,
+	})
+	return err
+}
+
+func (c *cartRepo) MergeGuest(ctx context.Context, token string, userId int64) error {
+	_, err := c.data.cc.MergeGuestCart(ctx, &cartService.MergeGuestCartRequest{
+		Token:  token,
+		UserId: userId,
+	})
+	return err
+}
+
+func cartItem(item *cartService.CartInfoReply) *biz.CartItem {
+	return &biz.CartItem{
+		ID:           item.Id,
+		GoodsId:      item.GoodsId,
+		GoodsSn:      item.GoodsSn,
+		GoodsName:    item.GoodsName,
+		SkuId:        item.SkuId,
+		SkuName:      item.SkuName,
+		GoodsPrice:   item.GoodsPrice,
+		CurrentPrice: item.CurrentPrice,
+		GoodsNum:     item.GoodsNum,
+		IsSelect:     item.IsSelect,
+		OnSale:       item.OnSale,
+		Stock:        item.Stock,
+		PriceChanged: item.PriceChanged,
+		OutOfStock:   item.OutOfStock,
+		OverLimit:    item.OverLimit,
+	}
+}
@@ -2,9 +2,12 @@ package data
 
 import (
 	"context"
+	"errors"
 	"lushop/internal/conf"
 	"time"
 
+	cartV1 "lushop/api/service/cart/v1"
+	goodsV1 "lushop/api/service/goods/v1"
 	userV1 "lushop/api/service/user/v1"
 
 	consul "github.com/go-kratos/kratos/contrib/registry/consul/v2"
@@ -13,24 +16,42 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-redis/redis/v8"
 	"github.com/google/wire"
 	consulAPI "github.com/hashicorp/consul/api"
 	grpcx "google.golang.org/grpc"
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewUserRepo, NewUserServiceClient, NewRegister, NewDiscovery)
+var ProviderSet = wire.NewSet(NewData, NewRedis, NewCaptcha, NewTokenRepo, NewLoginGuardRepo, NewSmsRepo, NewSmsSender,
+	NewUserRepo, NewUserServiceClient, NewCartRepo, NewAddressRepo, NewCartServiceClient, NewGoodsRepo, NewGoodsAdminRepo, NewGoodsServiceClient, NewRegister, NewDiscovery)
 
 // Data .
 type Data struct {
 	log *log.Helper
-	uc  userV1.UserClient // 用户服务的客户端
+	rdb *redis.Client       // token 等状态保存在 redis
+	uc  userV1.UserClient   // 用户服务的客户端
+	cc  cartV1.CartClient   // 购物车服务的客户端
+	gc  goodsV1.GoodsClient // 商品服务的客户端
 }
 
 // NewData .
-func NewData(c *conf.Data, uc userV1.UserClient, logger log.Logger) (*Data, error) {
+func NewData(c *conf.Data, rdb *redis.Client, uc userV1.UserClient, cc cartV1.CartClient, gc goodsV1.GoodsClient,
+	logger log.Logger) (*Data, error) {
 	l := log.NewHelper(log.With(logger, "module", "data"))
-	return &Data{log: l, uc: uc}, nil
+	return &Data{log: l, rdb: rdb, uc: uc, cc: cc, gc: gc}, nil
+}
+
+// NewRedis .
+func NewRedis(c *conf.Data) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:         c.GetRedis().GetAddr(),
+		Password:     c.GetRedis().GetPassword(),
+		DB:           int(c.GetRedis().GetDb()),
+		DialTimeout:  c.GetRedis().GetDialTimeout().AsDuration(),
+		WriteTimeout: c.GetRedis().GetWriteTimeout().AsDuration(),
+		ReadTimeout:  c.GetRedis().GetReadTimeout().AsDuration(),
+	})
 }
 
 // NewUserServiceClient 链接用户grpc服务
@@ -53,6 +74,48 @@ func NewUserServiceClient(ac *conf.Auth, sr *conf.Service, rr registry.Discovery
 	return c
 }
 
+// NewCartServiceClient 链接购物车grpc服务
+func NewCartServiceClient(sr *conf.Service, rr registry.Discovery) (cartV1.CartClient, error) {
+	endpoint := sr.GetCart().GetEndpoint()
+	if endpoint == "" {
+		return nil, errors.New("service.cart.endpoint is not configured")
+	}
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(endpoint),
+		grpc.WithDiscovery(rr),
+		grpc.WithMiddleware(
+			tracing.Client(),
+			recovery.Recovery(),
+		),
+		grpc.WithTimeout(2*time.Second),
+		grpc.WithOptions(grpcx.WithStatsHandler(&tracing.ClientHandler{})),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return cartV1.NewCartClient(conn), nil
+}
+
+// NewGoodsServiceClient 链接商品grpc服务
+func NewGoodsServiceClient(sr *conf.Service, rr registry.Discovery) goodsV1.GoodsClient {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(sr.Goods.Endpoint),
+		grpc.WithDiscovery(rr),
+		grpc.WithMiddleware(
+			tracing.Client(),
+			recovery.Recovery(),
+		),
+		grpc.WithTimeout(2*time.Second),
+		grpc.WithOptions(grpcx.WithStatsHandler(&tracing.ClientHandler{})),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return goodsV1.NewGoodsClient(conn)
+}
+
 // NewRegister add consul
 func NewRegister(conf *conf.Registry) registry.Registrar {
 	c := consulAPI.DefaultConfig()
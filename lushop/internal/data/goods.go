@@ -0,0 +1,162 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+
+	goodsService "lushop/api/service/goods/v1"
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type goodsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewGoodsRepo .
+func NewGoodsRepo(data *Data, logger log.Logger) biz.GoodsRepo {
+	return &goodsRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "repo/goods")),
+	}
+}
+
+// 商品服务返回的分类树是 json 字符串，字段与 biz.Category 一致
+func (g *goodsRepo) CategoryTree(ctx context.Context) ([]*biz.Category, error) {
+	rsp, err := g.data.gc.GetAllCategoryList(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.Category
+	if err := json.Unmarshal([]byte(rsp.JsonData), &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (g *goodsRepo) SubCategory(ctx context.Context, id int32) (*biz.Category, error) {
+	rsp, err := g.data.gc.GetSubCategory(ctx, &goodsService.CategoryListRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	res := category(rsp.Info)
+	for _, sub := range rsp.SubCategory {
+		res.SubCategory = append(res.SubCategory, category(sub))
+	}
+	return res, nil
+}
+
+func (g *goodsRepo) BrandList(ctx context.Context, pages, pagePerNums int32) (int32, []*biz.Brand, error) {
+	rsp, err := g.data.gc.BrandList(ctx, &goodsService.BrandListRequest{
+		Pages:       pages,
+		PagePerNums: pagePerNums,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	var list []*biz.Brand
+	for _, b := range rsp.Data {
+		list = append(list, brand(b))
+	}
+	return rsp.Total, list, nil
+}
+
+func (g *goodsRepo) GoodsSearch(ctx context.Context, f *biz.GoodsFilter) (int64, []*biz.Goods, error) {
+	rsp, err := g.data.gc.GoodsList(ctx, &goodsService.GoodsFilterRequest{
+		Keywords:    f.Keywords,
+		CategoryId:  f.CategoryID,
+		BrandId:     f.BrandID,
+		MinPrice:    f.MinPrice,
+		MaxPrice:    f.MaxPrice,
+		IsHot:       f.IsHot,
+		IsNew:       f.IsNew,
+		Pages:       f.Pages,
+		PagePerNums: f.PagePerNums,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	var list []*biz.Goods
+	for _, item := range rsp.List {
+		list = append(list, goods(item))
+	}
+	return rsp.Total, list, nil
+}
+
+func (g *goodsRepo) GoodsDetail(ctx context.Context, id int64) (*biz.Goods, error) {
+	rsp, err := g.data.gc.GetGoodsDetail(ctx, &goodsService.GoodInfoRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return goods(rsp), nil
+}
+
+func (g *goodsRepo) GoodsSkuList(ctx context.Context, goodsId int64) ([]*biz.Sku, error) {
+	rsp, err := g.data.gc.GoodsSkuList(ctx, &goodsService.GoodInfoRequest{Id: goodsId})
+	if err != nil {
+		return nil, err
+	}
+	var list []*biz.Sku
+	for _, sku := range rsp.List {
+		list = append(list, &biz.Sku{
+			ID:             sku.Id,
+			GoodsID:        sku.GoodsId,
+			SkuName:        sku.SkuName,
+			SkuCode:        sku.SkuCode,
+			Price:          sku.Price,
+			PromotionPrice: sku.PromotionPrice,
+			Points:         sku.Points,
+			Pic:            sku.Pic,
+			Stock:          sku.Inventory,
+			OnSale:         sku.OnSale,
+			PurchaseLimit:  sku.PurchaseLimit,
+		})
+	}
+	return list, nil
+}
+
+func category(c *goodsService.CategoryInfoResponse) *biz.Category {
+	return &biz.Category{
+		ID:               c.GetId(),
+		Name:             c.GetName(),
+		ParentCategoryID: c.GetParentCategory(),
+		Level:            c.GetLevel(),
+		IsTab:            c.GetIsTab(),
+		Sort:             c.GetSort(),
+	}
+}
+
+func brand(b *goodsService.BrandInfoResponse) *biz.Brand {
+	return &biz.Brand{
+		ID:    b.Id,
+		Name:  b.Name,
+		Logo:  b.Logo,
+		Desc:  b.Desc,
+		IsTab: b.IsTab,
+		Sort:  b.Sort,
+	}
+}
+
+func goods(item *goodsService.GoodsInfoResponse) *biz.Goods {
+	return &biz.Goods{
+		ID:          item.Id,
+		CategoryID:  item.CategoryId,
+		BrandID:     item.BrandId,
+		Name:        item.Name,
+		GoodsSn:     item.GoodsSn,
+		ClickNum:    item.ClickNum,
+		SoldNum:     item.SoldNum,
+		FavNum:      item.FavNum,
+		MarketPrice: item.MarketPrice,
+		GoodsBrief:  item.GoodsBrief,
+		ShipFree:    item.ShipFree,
+		Image:       item.Images,
+		Images:      item.GoodsImages,
+		IsNew:       item.IsNew,
+		IsHot:       item.IsHot,
+		OnSale:      item.OnSale,
+	}
+}
@@ -0,0 +1,177 @@
+package data
+
+import (
+	"context"
+
+	goodsService "lushop/api/service/goods/v1"
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type goodsAdminRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewGoodsAdminRepo 商品服务的后台管理接口
+func NewGoodsAdminRepo(data *Data, logger log.Logger) biz.GoodsAdminRepo {
+	return &goodsAdminRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "repo/goods_admin")),
+	}
+}
+
+func (g *goodsAdminRepo) CreateCategory(ctx context.Context, c *biz.Category) (*biz.Category, error) {
+	rsp, err := g.data.gc.CreateCategory(ctx, categoryRequest(c))
+	if err != nil {
+		return nil, err
+	}
+	return category(rsp), nil
+}
+
+func (g *goodsAdminRepo) UpdateCategory(ctx context.Context, c *biz.Category) error {
+	_, err := g.data.gc.UpdateCategory(ctx, categoryRequest(c))
+	return err
+}
+
+func (g *goodsAdminRepo) DeleteCategory(ctx context.Context, id int32) error {
+	_, err := g.data.gc.DeleteCategory(ctx, &goodsService.DeleteCategoryRequest{Id: id})
+	return err
+}
+
+func (g *goodsAdminRepo) CreateBrand(ctx context.Context, b *biz.Brand) (*biz.Brand, error) {
+	rsp, err := g.data.gc.CreateBrand(ctx, brandRequest(b))
+	if err != nil {
+		return nil, err
+	}
+	return brand(rsp), nil
+}
+
+func (g *goodsAdminRepo) UpdateBrand(ctx context.Context, b *biz.Brand) error {
+	_, err := g.data.gc.UpdateBrand(ctx, brandRequest(b))
+	return err
+}
+
+func (g *goodsAdminRepo) DeleteBrand(ctx context.Context, id int32) error {
+	_, err := g.data.gc.DeleteBrand(ctx, &goodsService.BrandRequest{Id: id})
+	return err
+}
+
+func (g *goodsAdminRepo) CreateGoods(ctx context.Context, f *biz.GoodsForm) (int64, error) {
+	req := &goodsService.CreateGoodsRequest{
+		CategoryId:      f.CategoryID,
+		BrandId:         f.BrandID,
+		TypeId:          f.TypeID,
+		Name:            f.Name,
+		NameAlias:       f.NameAlias,
+		GoodsTags:       f.GoodsTags,
+		GoodsSn:         f.GoodsSn,
+		ShopPrice:       f.ShopPrice,
+		MarketPrice:     f.MarketPrice,
+		Inventory:       f.Inventory,
+		GoodsBrief:      f.GoodsBrief,
+		GoodsFrontImage: f.Image,
+		GoodsImages:     f.Images,
+		ShipFree:        f.ShipFree,
+		ShipId:          f.ShipID,
+		IsNew:           f.IsNew,
+		IsHot:           f.IsHot,
+		OnSale:          f.OnSale,
+	}
+	for _, s := range f.Skus {
+		sku := &goodsService.CreateGoodsRequestGoodsSku{
+			SkuName:        s.SkuName,
+			Code:           s.Code,
+			BarCode:        s.BarCode,
+			Price:          s.Price,
+			PromotionPrice: s.PromotionPrice,
+			Points:         s.Points,
+			Image:          s.Image,
+			Sort:           s.Sort,
+			Inventory:      s.Inventory,
+			PurchaseLimit:  s.PurchaseLimit,
+		}
+		for _, spec := range s.Specs {
+			sku.SpecificationInfo = append(sku.SpecificationInfo, &goodsService.CreateGoodsRequestGoodsSkuSpecification{
+				SId: spec.SpecID,
+				VId: spec.ValueID,
+			})
+		}
+		for _, group := range s.AttrGroups {
+			info := &goodsService.CreateGoodsRequestGoodsSkuGroupAttr{
+				GroupId:   group.GroupID,
+				GroupName: group.GroupName,
+			}
+			for _, attr := range group.Attrs {
+				info.AttrInfo = append(info.AttrInfo, &goodsService.CreateGoodsRequestGoodsSkuGroupAttrAttr{
+					AttrId:        attr.AttrID,
+					AttrName:      attr.AttrName,
+					AttrValueId:   attr.ValueID,
+					AttrValueName: attr.ValueName,
+				})
+			}
+			sku.GroupAttrInfo = append(sku.GroupAttrInfo, info)
+		}
+		req.Sku = append(req.Sku, sku)
+	}
+	rsp, err := g.data.gc.CreateGoods(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return rsp.ID, nil
+}
+
+func (g *goodsAdminRepo) ChangeSkuPrice(ctx context.Context, p *biz.SkuPrice) (*biz.SkuPrice, error) {
+	rsp, err := g.data.gc.ChangeSkuPrice(ctx, &goodsService.ChangeSkuPriceRequest{
+		SkuId:          p.SkuID,
+		Price:          p.Price,
+		PromotionPrice: p.PromotionPrice,
+		EffectiveFrom:  p.EffectiveFrom,
+		EffectiveUntil: p.EffectiveUntil,
+		Remark:         p.Remark,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &biz.SkuPrice{
+		ID:             rsp.Id,
+		SkuID:          rsp.SkuId,
+		Price:          rsp.Price,
+		PromotionPrice: rsp.PromotionPrice,
+		EffectiveFrom:  rsp.EffectiveFrom,
+		EffectiveUntil: rsp.EffectiveUntil,
+		Status:         rsp.Status,
+		Remark:         rsp.Remark,
+	}, nil
+}
+
+func (g *goodsAdminRepo) UpdateSkuPurchaseLimit(ctx context.Context, skuId int64, limit int32) error {
+	_, err := g.data.gc.UpdateSkuPurchaseLimit(ctx, &goodsService.SkuPurchaseLimitRequest{
+		SkuId:         skuId,
+		PurchaseLimit: limit,
+	})
+	return err
+}
+
+func categoryRequest(c *biz.Category) *goodsService.CategoryInfoRequest {
+	return &goodsService.CategoryInfoRequest{
+		Id:             c.ID,
+		Name:           c.Name,
+		ParentCategory: c.ParentCategoryID,
+		Level:          c.Level,
+		IsTab:          c.IsTab,
+		Sort:           c.Sort,
+	}
+}
+
+func brandRequest(b *biz.Brand) *goodsService.BrandRequest {
+	return &goodsService.BrandRequest{
+		Id:    b.ID,
+		Name:  b.Name,
+		Logo:  b.Logo,
+		Desc:  b.Desc,
+		IsTab: b.IsTab,
+		Sort:  b.Sort,
+	}
+}
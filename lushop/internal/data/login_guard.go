@@ -0,0 +1,86 @@
+package data
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// redis 中登录防暴力破解相关的 key，第一个参数为范围 mobile/ip
+const (
+	loginFailKey = "lushop:login:fail:%s:%s" // 滑动窗口内的失败记录，zset 的 score 为失败时间的毫秒时间戳
+	loginLockKey = "lushop:login:lock:%s:%s" // 存在表示已锁定，过期自动解锁
+)
+
+// addFailureScript 清理窗口外的失败记录后记一次失败，返回窗口内的失败次数
+var addFailureScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', tonumber(ARGV[1]) - tonumber(ARGV[2]))
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return redis.call('ZCARD', KEYS[1])
+`)
+
+type loginGuardRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLoginGuardRepo .
+func NewLoginGuardRepo(data *Data, logger log.Logger) biz.LoginGuardRepo {
+	return &loginGuardRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "repo/login_guard")),
+	}
+}
+
+func (r *loginGuardRepo) AddFailure(ctx context.Context, scope, key string, window time.Duration) (int64, error) {
+	now := time.Now().UnixMilli()
+	// 同一毫秒内可能有多次失败，成员加上随机后缀避免被覆盖
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return 0, err
+	}
+	member := fmt.Sprintf("%d-%s", now, hex.EncodeToString(b))
+	return addFailureScript.Run(ctx, r.data.rdb, []string{fmt.Sprintf(loginFailKey, scope, key)},
+		now, window.Milliseconds(), member).Int64()
+}
+
+func (r *loginGuardRepo) Failures(ctx context.Context, scope, key string, window time.Duration) (int64, error) {
+	min := time.Now().Add(-window).UnixMilli()
+	return r.data.rdb.ZCount(ctx, fmt.Sprintf(loginFailKey, scope, key), fmt.Sprint(min), "+inf").Result()
+}
+
+func (r *loginGuardRepo) ClearFailures(ctx context.Context, scope, key string) error {
+	return r.data.rdb.Del(ctx, fmt.Sprintf(loginFailKey, scope, key)).Err()
+}
+
+func (r *loginGuardRepo) Lock(ctx context.Context, scope, key string, ttl time.Duration) (bool, error) {
+	return r.data.rdb.SetNX(ctx, fmt.Sprintf(loginLockKey, scope, key), 1, ttl).Result()
+}
+
+func (r *loginGuardRepo) LockTTL(ctx context.Context, scope, key string) (time.Duration, error) {
+	ttl, err := r.data.rdb.PTTL(ctx, fmt.Sprintf(loginLockKey, scope, key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	// key 不存在时返回负数
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (r *loginGuardRepo) Unlock(ctx context.Context, scope, key string) (bool, error) {
+	n, err := r.data.rdb.Del(ctx, fmt.Sprintf(loginLockKey, scope, key)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,128 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"lushop/internal/biz"
+	"lushop/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// redis 中短信验证码相关的 key
+const (
+	smsCodeKey   = "lushop:sms:code:%s:%s"  // 场景、手机号 -> 验证码哈希、错误次数
+	smsResendKey = "lushop:sms:resend:%s"   // 存在表示还在发送间隔内
+	smsDailyKey  = "lushop:sms:daily:%s:%s" // 日期、手机号 -> 当天发送次数
+	smsIPKey     = "lushop:sms:ip:%s:%s"    // 小时、IP -> 当前小时发送次数
+)
+
+// checkCodeScript 校验验证码，错误时累加错误次数，达到上限后删除验证码
+var checkCodeScript = redis.NewScript(`
+local code = redis.call('HGET', KEYS[1], 'code')
+if not code then
+	return 0
+end
+if code == ARGV[1] then
+	if ARGV[3] == '1' then
+		redis.call('DEL', KEYS[1])
+	end
+	return 1
+end
+local n = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+if n >= tonumber(ARGV[2]) then
+	redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type smsRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSmsRepo .
+func NewSmsRepo(data *Data, logger log.Logger) biz.SmsRepo {
+	return &smsRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "repo/sms")),
+	}
+}
+
+func (r *smsRepo) TakeResend(ctx context.Context, mobile string, interval time.Duration) (bool, error) {
+	return r.data.rdb.SetNX(ctx, fmt.Sprintf(smsResendKey, mobile), 1, interval).Result()
+}
+
+func (r *smsRepo) IncrMobileDaily(ctx context.Context, mobile string) (int64, error) {
+	return r.incr(ctx, fmt.Sprintf(smsDailyKey, time.Now().Format("20060102"), mobile), 24*time.Hour)
+}
+
+func (r *smsRepo) IncrIPHourly(ctx context.Context, ip string) (int64, error) {
+	return r.incr(ctx, fmt.Sprintf(smsIPKey, time.Now().Format("2006010215"), ip), time.Hour)
+}
+
+// incr 固定窗口计数，key 中带有时间所以过期时间只用于清理
+func (r *smsRepo) incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var n *redis.IntCmd
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		n = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return n.Val(), nil
+}
+
+func (r *smsRepo) SaveCode(ctx context.Context, scene, mobile, hash string, ttl time.Duration) error {
+	key := fmt.Sprintf(smsCodeKey, scene, mobile)
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, "code", hash, "attempts", 0)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *smsRepo) CheckCode(ctx context.Context, scene, mobile, hash string, maxAttempts int64, consume bool) (bool, error) {
+	flag := "0"
+	if consume {
+		flag = "1"
+	}
+	n, err := checkCodeScript.Run(ctx, r.data.rdb, []string{fmt.Sprintf(smsCodeKey, scene, mobile)},
+		hash, maxAttempts, flag).Int64()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// logSender 只把验证码打印到日志，用于本地开发和测试
+type logSender struct {
+	log *log.Helper
+}
+
+func (s *logSender) Send(ctx context.Context, mobile, scene, code string, ttl time.Duration) error {
+	s.log.Infof("sms code, mobile: %s, scene: %s, code: %s, ttl: %s", mobile, scene, code, ttl)
+	return nil
+}
+
+// NewSmsSender 按配置选择短信发送渠道，接入短信服务商时在这里增加实现，
+// 没有配置或者配置了不支持的渠道时启动失败，避免线上验证码只打印到日志
+func NewSmsSender(ac *conf.Auth, logger log.Logger) (biz.SmsSender, error) {
+	helper := log.NewHelper(log.With(logger, "module", "sms/sender"))
+	switch sender := ac.GetSms().GetSender(); sender {
+	case "log":
+		helper.Warn("sms sender is log, codes are only written to the log")
+		return &logSender{log: helper}, nil
+	case "":
+		return nil, fmt.Errorf("auth.sms.sender is not configured")
+	default:
+		return nil, fmt.Errorf("auth.sms.sender: unknown sender %q", sender)
+	}
+}
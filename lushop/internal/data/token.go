@@ -0,0 +1,159 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"lushop/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// redis 中 token 相关的 key
+const (
+	refreshKey       = "lushop:refresh:%s"        // refresh token 的哈希 -> 用户ID、family、是否已使用
+	refreshFamilyKey = "lushop:refresh:family:%s" // 存在表示这次登录没有被吊销
+	accessDenyKey    = "lushop:access:deny:%s"    // 已吊销的 access token
+	tokenVersionKey  = "lushop:token:version:%d"  // 用户当前的 token 版本
+)
+
+// tokenVersionTTL 版本缓存的有效期，过期后从用户服务重新加载
+const tokenVersionTTL = 24 * time.Hour
+
+// consumeRefreshScript 原子地取出 refresh token 并标记为已使用，返回 {uid, family, 之前是否已使用, token 版本}
+var consumeRefreshScript = redis.NewScript(`
+local v = redis.call('HMGET', KEYS[1], 'uid', 'family', 'used', 'ver')
+if not v[1] then
+	return false
+end
+local ver = v[4] or '0'
+if v[3] == '1' then
+	return {v[1], v[2], 1, ver}
+end
+redis.call('HSET', KEYS[1], 'used', '1')
+return {v[1], v[2], 0, ver}
+`)
+
+// setTokenVersionScript 只有新版本更大时才写入，避免并发加载时旧版本覆盖新版本
+var setTokenVersionScript = redis.NewScript(`
+local cur = redis.call('GET', KEYS[1])
+if cur and tonumber(cur) > tonumber(ARGV[1]) then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`)
+
+type tokenRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewTokenRepo .
+func NewTokenRepo(data *Data, logger log.Logger) biz.TokenRepo {
+	return &tokenRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "repo/token")),
+	}
+}
+
+func (r *tokenRepo) SaveRefresh(ctx context.Context, hash string, s *biz.RefreshSession, ttl time.Duration) error {
+	key := fmt.Sprintf(refreshKey, hash)
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "uid", s.UserID, "family", s.Family, "used", 0, "ver", s.TokenVersion)
+		pipe.Expire(ctx, key, ttl)
+		pipe.Set(ctx, fmt.Sprintf(refreshFamilyKey, s.Family), 1, ttl)
+		return nil
+	})
+	return err
+}
+
+func (r *tokenRepo) ConsumeRefresh(ctx context.Context, hash string) (*biz.RefreshSession, error) {
+	res, err := consumeRefreshScript.Run(ctx, r.data.rdb, []string{fmt.Sprintf(refreshKey, hash)}).Slice()
+	if err == redis.Nil {
+		return nil, biz.ErrRefreshTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 4 {
+		return nil, biz.ErrRefreshTokenInvalid
+	}
+	uidStr, _ := res[0].(string)
+	family, _ := res[1].(string)
+	used, _ := res[2].(int64)
+	verStr, _ := res[3].(string)
+	uid, err := strconv.ParseInt(uidStr, 10, 64)
+	if err != nil {
+		return nil, biz.ErrRefreshTokenInvalid
+	}
+	ver, err := strconv.ParseInt(verStr, 10, 64)
+	if err != nil {
+		return nil, biz.ErrRefreshTokenInvalid
+	}
+	session := &biz.RefreshSession{UserID: uid, Family: family, TokenVersion: ver}
+	if used == 1 {
+		return session, biz.ErrRefreshTokenReused
+	}
+	return session, nil
+}
+
+func (r *tokenRepo) GetRefresh(ctx context.Context, hash string) (*biz.RefreshSession, error) {
+	res, err := r.data.rdb.HMGet(ctx, fmt.Sprintf(refreshKey, hash), "uid", "family", "ver").Result()
+	if err != nil {
+		return nil, err
+	}
+	uidStr, _ := res[0].(string)
+	family, _ := res[1].(string)
+	verStr, _ := res[2].(string)
+	uid, err := strconv.ParseInt(uidStr, 10, 64)
+	if err != nil {
+		return nil, biz.ErrRefreshTokenInvalid
+	}
+	ver, _ := strconv.ParseInt(verStr, 10, 64)
+	return &biz.RefreshSession{UserID: uid, Family: family, TokenVersion: ver}, nil
+}
+
+func (r *tokenRepo) RevokeFamily(ctx context.Context, family string) error {
+	return r.data.rdb.Del(ctx, fmt.Sprintf(refreshFamilyKey, family)).Err()
+}
+
+func (r *tokenRepo) FamilyActive(ctx context.Context, family string) (bool, error) {
+	n, err := r.data.rdb.Exists(ctx, fmt.Sprintf(refreshFamilyKey, family)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *tokenRepo) DenyAccess(ctx context.Context, jti string, ttl time.Duration) error {
+	return r.data.rdb.Set(ctx, fmt.Sprintf(accessDenyKey, jti), 1, ttl).Err()
+}
+
+func (r *tokenRepo) AccessDenied(ctx context.Context, jti string) (bool, error) {
+	n, err := r.data.rdb.Exists(ctx, fmt.Sprintf(accessDenyKey, jti)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *tokenRepo) TokenVersion(ctx context.Context, userId int64) (int64, bool, error) {
+	v, err := r.data.rdb.Get(ctx, fmt.Sprintf(tokenVersionKey, userId)).Int64()
+	if err == redis.Nil {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return v, true, nil
+}
+
+func (r *tokenRepo) SetTokenVersion(ctx context.Context, userId, version int64) error {
+	return setTokenVersionScript.Run(ctx, r.data.rdb, []string{fmt.Sprintf(tokenVersionKey, userId)},
+		version, tokenVersionTTL.Milliseconds()).Err()
+}
@@ -2,11 +2,13 @@ package data
 
 import (
 	"context"
+	"time"
 
 	userService "lushop/api/service/user/v1"
 	"lushop/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type userRepo struct {
@@ -45,22 +47,18 @@ func (u *userRepo) UserByMobile(ctx context.Context, mobile string) (*biz.User,
 	if err != nil {
 		return nil, err
 	}
-	return &biz.User{
-		Mobile:   byMobile.Mobile,
-		ID:       byMobile.Id,
-		NickName: byMobile.NickName,
-	}, nil
+	return userInfo(byMobile), nil
 }
 
-func (u *userRepo) CheckPassword(ctx context.Context, password, encryptedPassword string) (bool, error) {
-	if byMobile, err := u.data.uc.CheckPassword(ctx, &userService.PasswordCheckInfo{
-		Password:          password,
-		EncryptedPassword: encryptedPassword,
-	}); err != nil {
-		return false, err
-	} else {
-		return byMobile.Success, nil
+func (u *userRepo) VerifyCredentials(ctx context.Context, mobile, password string) (*biz.User, error) {
+	user, err := u.data.uc.VerifyCredentials(ctx, &userService.VerifyCredentialsRequest{
+		Mobile:   mobile,
+		Password: password,
+	})
+	if err != nil {
+		return nil, err
 	}
+	return userInfo(user), nil
 }
 
 func (u *userRepo) UserById(ctx context.Context, id int64) (*biz.User, error) {
@@ -70,11 +68,150 @@ func (u *userRepo) UserById(ctx context.Context, id int64) (*biz.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &biz.User{
-		ID:       user.Id,
-		Mobile:   user.Mobile,
-		NickName: user.NickName,
-		Gender:   user.Gender,
-		Role:     int(user.Role),
-	}, nil
+	return userInfo(user), nil
+}
+
+func (u *userRepo) ListUser(ctx context.Context, f *biz.UserFilter) (int32, []*biz.User, error) {
+	rsp, err := u.data.uc.SearchUser(ctx, &userService.UserSearchRequest{
+		Mobile:    f.Mobile,
+		NickName:  f.NickName,
+		Role:      f.Role,
+		Gender:    f.Gender,
+		StartTime: uint64(f.StartTime),
+		EndTime:   uint64(f.EndTime),
+		Pn:        f.Pn,
+		PSize:     f.PSize,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	var list []*biz.User
+	for _, user := range rsp.Data {
+		list = append(list, userInfo(user))
+	}
+	return rsp.Total, list, nil
+}
+
+func (u *userRepo) ChangePassword(ctx context.Context, id int64, oldPassword, newPassword string) (int64, error) {
+	rsp, err := u.data.uc.ChangePassword(ctx, &userService.ChangePasswordRequest{
+		Id:          id,
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rsp.TokenVersion, nil
+}
+
+func (u *userRepo) ResetPassword(ctx context.Context, mobile, ticket, newPassword string) (int64, int64, error) {
+	rsp, err := u.data.uc.ResetPassword(ctx, &userService.ResetPasswordRequest{
+		Mobile:      mobile,
+		NewPassword: newPassword,
+		Ticket:      ticket,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return rsp.Id, rsp.TokenVersion, nil
+}
+
+func (u *userRepo) SetDisabled(ctx context.Context, id int64, disabled bool) (int64, error) {
+	rsp, err := u.data.uc.SetUserDisabled(ctx, &userService.UserDisabledRequest{
+		Id:       id,
+		Disabled: disabled,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rsp.TokenVersion, nil
+}
+
+func (u *userRepo) ChangeRole(ctx context.Context, id int64, role int32) (int64, error) {
+	rsp, err := u.data.uc.ChangeRole(ctx, &userService.ChangeRoleRequest{
+		Id:   id,
+		Role: role,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return rsp.TokenVersion, nil
+}
+
+func (u *userRepo) DeleteUser(ctx context.Context, id int64) (int64, error) {
+	rsp, err := u.data.uc.DeleteUser(ctx, &userService.IdRequest{Id: id})
+	if err != nil {
+		return 0, err
+	}
+	return rsp.TokenVersion, nil
+}
+
+func (u *userRepo) UpdateProfile(ctx context.Context, user *biz.User, fields []string) error {
+	_, err := u.data.uc.UpdateUser(ctx, &userService.UpdateUserInfo{
+		Id:         user.ID,
+		NickName:   user.NickName,
+		Gender:     user.Gender,
+		Birthday:   user.Birthday,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: fields},
+	})
+	return err
+}
+
+func (u *userRepo) CreateLockEvent(ctx context.Context, e *biz.LockEvent) error {
+	_, err := u.data.uc.CreateLockEvent(ctx, &userService.LockEventInfo{
+		Mobile:   e.Mobile,
+		Ip:       e.IP,
+		Action:   e.Action,
+		Scope:    e.Scope,
+		Reason:   e.Reason,
+		Failures: e.Failures,
+		Until:    uint64(e.Until),
+		Operator: e.Operator,
+	})
+	return err
+}
+
+func (u *userRepo) ListLockEvent(ctx context.Context, mobile, ip string, pn, pSize uint32) (int32, []*biz.LockEvent, error) {
+	rsp, err := u.data.uc.GetLockEventList(ctx, &userService.LockEventListRequest{
+		Mobile: mobile,
+		Ip:     ip,
+		Pn:     pn,
+		PSize:  pSize,
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	var list []*biz.LockEvent
+	for _, e := range rsp.Data {
+		list = append(list, &biz.LockEvent{
+			ID:        e.Id,
+			Mobile:    e.Mobile,
+			IP:        e.Ip,
+			Action:    e.Action,
+			Scope:     e.Scope,
+			Reason:    e.Reason,
+			Failures:  e.Failures,
+			Until:     int64(e.Until),
+			Operator:  e.Operator,
+			CreatedAt: int64(e.CreatedAt),
+		})
+	}
+	return rsp.Total, list, nil
+}
+
+func userInfo(user *userService.UserInfoResponse) *biz.User {
+	rv := &biz.User{
+		ID:           user.Id,
+		Mobile:       user.Mobile,
+		NickName:     user.NickName,
+		Birthday:     user.Birthday,
+		Gender:       user.Gender,
+		Role:         int(user.Role),
+		TokenVersion: user.TokenVersion,
+		Disabled:     user.Disabled,
+	}
+	if user.CreatedAt > 0 {
+		rv.CreatedAt = time.Unix(int64(user.CreatedAt), 0)
+	}
+	return rv
 }
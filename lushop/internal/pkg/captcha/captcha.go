@@ -6,26 +6,45 @@ import (
 	"github.com/mojocn/base64Captcha"
 )
 
-var Store = base64Captcha.DefaultMemStore
-
 type CaptchaInfo struct {
 	CaptchaId string
 	PicPath   string
 	Ans       string
 }
 
+// Captcha 图形验证码，验证码答案保存在 store 中
+type Captcha struct {
+	store        base64Captcha.Store
+	exposeAnswer bool // 开发测试环境返回答案
+}
+
+func NewCaptcha(store base64Captcha.Store, exposeAnswer bool) *Captcha {
+	return &Captcha{store: store, exposeAnswer: exposeAnswer}
+}
+
 // GetCaptcha 生成验证码
-func GetCaptcha(ctx context.Context) (*CaptchaInfo, error) {
+func (c *Captcha) GetCaptcha(ctx context.Context) (*CaptchaInfo, error) {
 	driver := base64Captcha.NewDriverDigit(80, 250, 5, 0.7, 80)
-	cp := base64Captcha.NewCaptcha(driver, Store)
+	cp := base64Captcha.NewCaptcha(driver, c.store)
 	id, b64s, ans, err := cp.Generate()
 	if err != nil {
 		return nil, err
 	}
 
-	return &CaptchaInfo{
+	info := &CaptchaInfo{
 		CaptchaId: id,
 		PicPath:   b64s,
-		Ans:       ans, // 做测试
-	}, nil
+	}
+	if c.exposeAnswer {
+		info.Ans = ans
+	}
+	return info, nil
+}
+
+// Verify 校验验证码，无论是否正确验证码都会失效
+func (c *Captcha) Verify(id, answer string) bool {
+	if id == "" || answer == "" {
+		return false
+	}
+	return c.store.Verify(id, answer, true)
 }
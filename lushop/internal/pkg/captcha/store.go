@@ -0,0 +1,59 @@
+package captcha
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/mojocn/base64Captcha"
+)
+
+const (
+	captchaKey = "lushop:captcha:%s"
+	// base64Captcha.Store 的接口没有 context，每次访问 redis 单独设置超时
+	storeTimeout = time.Second
+)
+
+// redisStore 基于 redis 的验证码存储，多个网关副本共享，重启后不丢失
+type redisStore struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// NewRedisStore .
+func NewRedisStore(rdb *redis.Client, ttl time.Duration) base64Captcha.Store {
+	return &redisStore{rdb: rdb, ttl: ttl}
+}
+
+func (s *redisStore) Set(id string, value string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+	return s.rdb.Set(ctx, fmt.Sprintf(captchaKey, id), value, s.ttl).Err()
+}
+
+// Get clear 为 true 时读取和删除在同一个事务中完成，保证验证码只能使用一次
+func (s *redisStore) Get(id string, clear bool) string {
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+	key := fmt.Sprintf(captchaKey, id)
+	if !clear {
+		v, _ := s.rdb.Get(ctx, key).Result()
+		return v
+	}
+	var get *redis.StringCmd
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, key)
+		pipe.Del(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return ""
+	}
+	return get.Val()
+}
+
+func (s *redisStore) Verify(id, answer string, clear bool) bool {
+	v := s.Get(id, clear)
+	return v != "" && v == answer
+}
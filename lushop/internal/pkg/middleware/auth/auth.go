@@ -7,9 +7,10 @@ import (
 )
 
 type CustomClaims struct {
-	ID          int64
-	NickName    string
-	AuthorityId int
+	ID           int64
+	NickName     string
+	AuthorityId  int
+	TokenVersion int64 // 签发时用户的 token 版本，修改密码后旧版本的 token 失效
 	jwt.RegisteredClaims
 }
 
@@ -0,0 +1,102 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"lushop/internal/pkg/middleware/auth"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwt5 "github.com/golang-jwt/jwt/v5"
+)
+
+type fakeTransport struct {
+	operation string
+}
+
+func (t *fakeTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *fakeTransport) Endpoint() string                { return "" }
+func (t *fakeTransport) Operation() string               { return t.operation }
+func (t *fakeTransport) RequestHeader() transport.Header { return nil }
+func (t *fakeTransport) ReplyHeader() transport.Header   { return nil }
+
+func TestAuthorize(t *testing.T) {
+	policies := map[string][]int32{
+		"/lushop.lushop.v1.Lushop/AdminUserList": {auth.RoleUser, auth.RoleAdmin},
+		"/lushop.lushop.v1.Lushop/Detail":        {auth.RoleAdmin},
+	}
+	admin := func(ctx context.Context, operation string) bool {
+		return strings.HasPrefix(operation, "/lushop.lushop.v1.Lushop/Admin")
+	}
+	tests := []struct {
+		name      string
+		operation string
+		role      int32 // 0 表示没有 token
+		allowed   bool
+	}{
+		{"普通接口不检查角色", "/lushop.lushop.v1.Lushop/GoodsList", 0, true},
+		{"后台接口管理员可以访问", "/lushop.lushop.v1.Lushop/AdminCreateBrand", auth.RoleAdmin, true},
+		{"后台接口普通用户不能访问", "/lushop.lushop.v1.Lushop/AdminCreateBrand", auth.RoleUser, false},
+		{"后台接口没有 token 不能访问", "/lushop.lushop.v1.Lushop/AdminCreateBrand", 0, false},
+		{"配置策略放开后台接口", "/lushop.lushop.v1.Lushop/AdminUserList", auth.RoleUser, true},
+		{"配置策略限制普通接口", "/lushop.lushop.v1.Lushop/Detail", auth.RoleUser, false},
+		{"配置策略的接口管理员可以访问", "/lushop.lushop.v1.Lushop/Detail", auth.RoleAdmin, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := transport.NewServerContext(context.Background(), &fakeTransport{operation: tt.operation})
+			if tt.role != 0 {
+				ctx = jwt.NewContext(ctx, jwt5.MapClaims{"ID": float64(1), "AuthorityId": float64(tt.role)})
+			}
+			var called bool
+			handler := auth.Authorize(policies, admin, log.DefaultLogger)(func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return "ok", nil
+			})
+			_, err := handler(ctx, nil)
+			if tt.allowed {
+				if err != nil || !called {
+					t.Fatalf("should be allowed, err: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, auth.ErrForbidden) || called {
+				t.Fatalf("should be forbidden, err: %v, called: %v", err, called)
+			}
+		})
+	}
+}
+
+func TestRevocation(t *testing.T) {
+	revoked := func(ctx context.Context, claims jwt5.MapClaims) (bool, error) {
+		return claims["jti"] == "revoked", nil
+	}
+	handler := auth.Revocation(revoked)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	tests := []struct {
+		name   string
+		claims jwt5.MapClaims
+		err    error
+	}{
+		{"有效的 token", jwt5.MapClaims{"jti": "a"}, nil},
+		{"已吊销的 token", jwt5.MapClaims{"jti": "revoked"}, auth.ErrTokenRevoked},
+		{"没有 token", nil, auth.ErrTokenRevoked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = jwt.NewContext(ctx, tt.claims)
+			}
+			_, err := handler(ctx, nil)
+			if tt.err == nil && err != nil || tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
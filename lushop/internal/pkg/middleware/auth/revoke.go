@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwt5 "github.com/golang-jwt/jwt/v5"
+)
+
+var ErrTokenRevoked = errors.Unauthorized("TOKEN_REVOKED", "登录已失效，请重新登录")
+
+// RevokedFunc 查询 access token 是否已经吊销
+type RevokedFunc func(ctx context.Context, claims jwt5.MapClaims) (bool, error)
+
+// Revocation 检查 access token 是否已经吊销，需要放在 jwt.Server 之后
+func Revocation(revoked RevokedFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, ErrTokenRevoked
+			}
+			c, ok := claims.(jwt5.MapClaims)
+			if !ok {
+				return nil, ErrTokenRevoked
+			}
+			denied, err := revoked(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+			if denied {
+				return nil, ErrTokenRevoked
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	v1 "lushop/api/lushop/v1"
 	"lushop/internal/conf"
 	"lushop/internal/conf/metrix"
+	"lushop/internal/pkg/middleware/auth"
 	"lushop/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -15,11 +16,12 @@ import (
 )
 
 // NewGRPCServer new a gRPC s.
-func NewGRPCServer(c *conf.Server, s *service.LushopService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, ac *conf.Auth, s *service.LushopService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
 			tracing.Server(),
+			auth.Authorize(NewPolicies(ac), NewAdminMatcher(), logger), // grpc 没有 token，后台接口都会被拒绝
 			logging.Server(logger),
 			metrics.Server(
 				metrics.WithSeconds(metrix.MetricSeconds),
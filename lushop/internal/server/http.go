@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	v1 "lushop/api/lushop/v1"
+	"lushop/internal/biz"
 	"lushop/internal/conf"
 	"lushop/internal/conf/metrix"
+	"lushop/internal/pkg/middleware/auth"
 	"lushop/internal/service"
 
 	http2 "lushop/internal/biz/http"
 	httpNet "net/http"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/encoding"
 	"github.com/go-kratos/kratos/v2/log"
@@ -28,7 +31,8 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, ac *conf.Auth, s *service.LushopService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, ac *conf.Auth, s *service.LushopService, tu *biz.TokenUsecase,
+	logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
@@ -38,7 +42,9 @@ func NewHTTPServer(c *conf.Server, ac *conf.Auth, s *service.LushopService, logg
 				jwt.Server(func(token *jwt5.Token) (interface{}, error) {
 					return []byte(ac.JwtKey), nil
 				}, jwt.WithSigningMethod(jwt5.SigningMethodHS256)),
+				auth.Revocation(tu.IsRevoked), // 已退出登录的 token
 			).Match(NewWhiteListMatcher()).Build(),
+			auth.Authorize(NewPolicies(ac), NewAdminMatcher(), logger), // 后台接口的角色检查
 			logging.Server(logger),
 			metrics.Server(
 				metrics.WithSeconds(metrix.MetricSeconds),
@@ -46,8 +52,8 @@ func NewHTTPServer(c *conf.Server, ac *conf.Auth, s *service.LushopService, logg
 			),
 		),
 		http.Filter(handlers.CORS( // 浏览器跨域
-			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"}),
-			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "HEAD", "OPTIONS"}),
+			handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization", biz.DeviceTokenHeader}),
+			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "DELETE", "HEAD", "OPTIONS"}),
 			handlers.AllowedOrigins([]string{"*"}),
 		)),
 		http.ErrorEncoder(
@@ -100,6 +106,22 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	whiteList["/lushop.lushop.v1.Lushop/Captcha"] = struct{}{}
 	whiteList["/lushop.lushop.v1.Lushop/Login"] = struct{}{}
 	whiteList["/lushop.lushop.v1.Lushop/Register"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/Refresh"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/SmsLogin"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/SendSmsCode"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/VerifySmsCode"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/ResetPassword"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/CategoryTree"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/SubCategory"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/BrandList"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/GoodsSearch"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/GoodsDetail"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/GoodsSkuList"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/GuestToken"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/ListGuestCart"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/CreateGuestCart"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/UpdateGuestCart"] = struct{}{}
+	whiteList["/lushop.lushop.v1.Lushop/DeleteGuestCart"] = struct{}{}
 	return func(ctx context.Context, operation string) bool {
 		if _, ok := whiteList[operation]; ok {
 			return false
@@ -108,6 +130,22 @@ func NewWhiteListMatcher() selector.MatchFunc {
 	}
 }
 
+// NewAdminMatcher 后台接口，方法名以 Admin 开头，路由在 /api/admin 下
+func NewAdminMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		return strings.HasPrefix(operation, "/lushop.lushop.v1.Lushop/Admin")
+	}
+}
+
+// NewPolicies 从配置读取接口的访问策略
+func NewPolicies(ac *conf.Auth) map[string][]int32 {
+	policies := make(map[string][]int32, len(ac.GetPolicies()))
+	for operation, p := range ac.GetPolicies() {
+		policies[operation] = p.GetRoles()
+	}
+	return policies
+}
+
 func extractMessageFromError(err error) string {
 	marshal, err2 := json.Marshal(err)
 	if err2 != nil {
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	v1 "lushop/api/lushop/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *LushopService) ListAddress(ctx context.Context, req *emptypb.Empty) (*v1.AddressListReply, error) {
+	return s.ad.ListAddress(ctx)
+}
+
+func (s *LushopService) CreateAddress(ctx context.Context, req *v1.AddressReq) (*v1.AddressItem, error) {
+	return s.ad.CreateAddress(ctx, req)
+}
+
+func (s *LushopService) UpdateAddress(ctx context.Context, req *v1.AddressReq) (*emptypb.Empty, error) {
+	return s.ad.UpdateAddress(ctx, req)
+}
+
+func (s *LushopService) DeleteAddress(ctx context.Context, req *v1.AddressIdReq) (*emptypb.Empty, error) {
+	return s.ad.DeleteAddress(ctx, req)
+}
+
+func (s *LushopService) SetDefaultAddress(ctx context.Context, req *v1.AddressIdReq) (*emptypb.Empty, error) {
+	return s.ad.SetDefaultAddress(ctx, req)
+}
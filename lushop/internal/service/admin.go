@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	v1 "lushop/api/lushop/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *LushopService) AdminCreateCategory(ctx context.Context, req *v1.AdminCategoryReq) (*v1.CategoryItem, error) {
+	return s.gc.AdminCreateCategory(ctx, req)
+}
+
+func (s *LushopService) AdminUpdateCategory(ctx context.Context, req *v1.AdminCategoryReq) (*emptypb.Empty, error) {
+	return s.gc.AdminUpdateCategory(ctx, req)
+}
+
+func (s *LushopService) AdminDeleteCategory(ctx context.Context, req *v1.AdminIdReq) (*emptypb.Empty, error) {
+	return s.gc.AdminDeleteCategory(ctx, req)
+}
+
+func (s *LushopService) AdminCreateBrand(ctx context.Context, req *v1.AdminBrandReq) (*v1.BrandItem, error) {
+	return s.gc.AdminCreateBrand(ctx, req)
+}
+
+func (s *LushopService) AdminUpdateBrand(ctx context.Context, req *v1.AdminBrandReq) (*emptypb.Empty, error) {
+	return s.gc.AdminUpdateBrand(ctx, req)
+}
+
+func (s *LushopService) AdminDeleteBrand(ctx context.Context, req *v1.AdminIdReq) (*emptypb.Empty, error) {
+	return s.gc.AdminDeleteBrand(ctx, req)
+}
+
+func (s *LushopService) AdminCreateGoods(ctx context.Context, req *v1.AdminGoodsReq) (*v1.AdminIdReply, error) {
+	return s.gc.AdminCreateGoods(ctx, req)
+}
+
+func (s *LushopService) AdminChangeSkuPrice(ctx context.Context, req *v1.AdminSkuPriceReq) (*v1.AdminSkuPriceReply, error) {
+	return s.gc.AdminChangeSkuPrice(ctx, req)
+}
+
+func (s *LushopService) AdminSkuPurchaseLimit(ctx context.Context, req *v1.AdminSkuLimitReq) (*emptypb.Empty, error) {
+	return s.gc.AdminSkuPurchaseLimit(ctx, req)
+}
+
+func (s *LushopService) AdminUserList(ctx context.Context, req *v1.AdminUserListReq) (*v1.AdminUserListReply, error) {
+	return s.uc.AdminUserList(ctx, req)
+}
+
+func (s *LushopService) AdminUserDetail(ctx context.Context, req *v1.AdminIdReq) (*v1.UserDetailResponse, error) {
+	return s.uc.AdminUserDetail(ctx, req)
+}
+
+func (s *LushopService) AdminSetUserDisabled(ctx context.Context, req *v1.AdminUserDisabledReq) (*emptypb.Empty, error) {
+	return s.uc.AdminSetUserDisabled(ctx, req)
+}
+
+func (s *LushopService) AdminChangeUserRole(ctx context.Context, req *v1.AdminUserRoleReq) (*emptypb.Empty, error) {
+	return s.uc.AdminChangeUserRole(ctx, req)
+}
+
+func (s *LushopService) AdminUnlockLogin(ctx context.Context, req *v1.AdminUnlockLoginReq) (*emptypb.Empty, error) {
+	return s.uc.AdminUnlockLogin(ctx, req)
+}
+
+func (s *LushopService) AdminLockEventList(ctx context.Context, req *v1.AdminLockEventListReq) (*v1.AdminLockEventListReply, error) {
+	return s.uc.AdminLockEventList(ctx, req)
+}
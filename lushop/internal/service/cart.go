@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	v1 "lushop/api/lushop/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *LushopService) ListCart(ctx context.Context, req *emptypb.Empty) (*v1.CartListReply, error) {
+	return s.cc.ListCart(ctx)
+}
+
+func (s *LushopService) CreateCart(ctx context.Context, req *v1.CartReq) (*v1.CartItem, error) {
+	return s.cc.CreateCart(ctx, req)
+}
+
+func (s *LushopService) UpdateCart(ctx context.Context, req *v1.CartReq) (*emptypb.Empty, error) {
+	return s.cc.UpdateCart(ctx, req)
+}
+
+func (s *LushopService) DeleteCart(ctx context.Context, req *v1.DeleteCartReq) (*emptypb.Empty, error) {
+	return s.cc.DeleteCart(ctx, req)
+}
+
+func (s *LushopService) SelectCart(ctx context.Context, req *v1.SelectCartReq) (*emptypb.Empty, error) {
+	return s.cc.SelectCart(ctx, req)
+}
+
+func (s *LushopService) GuestToken(ctx context.Context, req *emptypb.Empty) (*v1.GuestTokenReply, error) {
+	return s.cc.GuestToken(ctx)
+}
+
+func (s *LushopService) ListGuestCart(ctx context.Context, req *emptypb.Empty) (*v1.CartListReply, error) {
+	return s.cc.ListGuestCart(ctx)
+}
+
+func (s *LushopService) CreateGuestCart(ctx context.Context, req *v1.GuestCartReq) (*v1.CartItem, error) {
+	return s.cc.CreateGuestCart(ctx, req)
+}
+
+func (s *LushopService) UpdateGuestCart(ctx context.Context, req *v1.GuestCartReq) (*emptypb.Empty, error) {
+	return s.cc.UpdateGuestCart(ctx, req)
+}
+
+func (s *LushopService) DeleteGuestCart(ctx context.Context, req *v1.DeleteCartReq) (*emptypb.Empty, error) {
+	return s.cc.DeleteGuestCart(ctx, req)
+}
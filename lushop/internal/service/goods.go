@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	v1 "lushop/api/lushop/v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *LushopService) CategoryTree(ctx context.Context, req *emptypb.Empty) (*v1.CategoryTreeReply, error) {
+	return s.gc.CategoryTree(ctx)
+}
+
+func (s *LushopService) SubCategory(ctx context.Context, req *v1.SubCategoryReq) (*v1.SubCategoryReply, error) {
+	return s.gc.SubCategory(ctx, req)
+}
+
+func (s *LushopService) BrandList(ctx context.Context, req *v1.BrandListReq) (*v1.BrandListReply, error) {
+	return s.gc.BrandList(ctx, req)
+}
+
+func (s *LushopService) GoodsSearch(ctx context.Context, req *v1.GoodsSearchReq) (*v1.GoodsListReply, error) {
+	return s.gc.GoodsSearch(ctx, req)
+}
+
+func (s *LushopService) GoodsDetail(ctx context.Context, req *v1.GoodsDetailReq) (*v1.GoodsItem, error) {
+	return s.gc.GoodsDetail(ctx, req)
+}
+
+func (s *LushopService) GoodsSkuList(ctx context.Context, req *v1.GoodsDetailReq) (*v1.SkuListReply, error) {
+	return s.gc.GoodsSkuList(ctx, req)
+}
@@ -17,6 +17,12 @@ var ProviderSet = wire.NewSet(NewLushopService)
 type LushopService struct {
 	v1.UnimplementedLushopServer
 	uc  *biz.UserUsecase
+	cc  *biz.CartUsecase
+	gc  *biz.GoodsUsecase
+	tu  *biz.TokenUsecase
+	sms *biz.SmsUsecase
+	ad  *biz.AddressUsecase
+	ac  *biz.AccountUsecase
 	log *log.Helper
 }
 
@@ -24,9 +30,16 @@ type LushopService struct {
 // 遵循了 Wire 要求的依赖注入规范
 // gRPC 服务器启动时，Kratos 的依赖注入系统会调用 NewLushopService
 // 自动创建好 LushopService 实例，并把它注册到 gRPC 服务器上，外部就可以通过 gRPC 调用定义的方法
-func NewLushopService(uc *biz.UserUsecase, logger log.Logger) *LushopService {
+func NewLushopService(uc *biz.UserUsecase, cc *biz.CartUsecase, gc *biz.GoodsUsecase,
+	tu *biz.TokenUsecase, sms *biz.SmsUsecase, ad *biz.AddressUsecase, ac *biz.AccountUsecase, logger log.Logger) *LushopService {
 	return &LushopService{
 		uc:  uc,
+		cc:  cc,
+		gc:  gc,
+		tu:  tu,
+		sms: sms,
+		ad:  ad,
+		ac:  ac,
 		log: log.NewHelper(log.With(logger, "module", "service/lushop")),
 	}
 }
@@ -15,9 +15,49 @@ func (s *LushopService) Login(ctx context.Context, req *v1.LoginReq) (*v1.Regist
 	return s.uc.PasswordLogin(ctx, req)
 }
 
+func (s *LushopService) SmsLogin(ctx context.Context, req *v1.SmsLoginReq) (*v1.RegisterReply, error) {
+	return s.uc.SmsLogin(ctx, req)
+}
+
+func (s *LushopService) SendSmsCode(ctx context.Context, req *v1.SendSmsCodeReq) (*v1.SendSmsCodeReply, error) {
+	return s.sms.SendCode(ctx, req)
+}
+
+func (s *LushopService) VerifySmsCode(ctx context.Context, req *v1.VerifySmsCodeReq) (*emptypb.Empty, error) {
+	return s.sms.VerifyCode(ctx, req)
+}
+
+func (s *LushopService) ChangePassword(ctx context.Context, req *v1.ChangePasswordReq) (*v1.RegisterReply, error) {
+	return s.uc.ChangePassword(ctx, req)
+}
+
+func (s *LushopService) ResetPassword(ctx context.Context, req *v1.ResetPasswordReq) (*emptypb.Empty, error) {
+	return s.uc.ResetPassword(ctx, req)
+}
+
+func (s *LushopService) Refresh(ctx context.Context, req *v1.RefreshReq) (*v1.RegisterReply, error) {
+	return s.tu.Refresh(ctx, req)
+}
+
+func (s *LushopService) Logout(ctx context.Context, req *v1.LogoutReq) (*emptypb.Empty, error) {
+	return s.tu.Logout(ctx, req)
+}
+
 func (s *LushopService) Captcha(ctx context.Context, req *emptypb.Empty) (*v1.CaptchaReply, error) {
 	return s.uc.GetCaptcha(ctx)
 }
 func (s *LushopService) Detail(ctx context.Context, req *emptypb.Empty) (*v1.UserDetailResponse, error) {
 	return s.uc.UserDetailByID(ctx)
 }
+
+func (s *LushopService) UpdateProfile(ctx context.Context, req *v1.UpdateProfileReq) (*v1.UserDetailResponse, error) {
+	return s.uc.UpdateProfile(ctx, req)
+}
+
+func (s *LushopService) DeleteAccount(ctx context.Context, req *v1.DeleteAccountReq) (*emptypb.Empty, error) {
+	return s.ac.DeleteAccount(ctx, req)
+}
+
+func (s *LushopService) ExportData(ctx context.Context, req *emptypb.Empty) (*v1.UserDataExport, error) {
+	return s.ac.ExportData(ctx)
+}
@@ -24,16 +24,24 @@ const (
 )
 
 type CartInfoReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
-	GoodsId       int64                  `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
-	GoodsSn       string                 `protobuf:"bytes,4,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
-	GoodsName     string                 `protobuf:"bytes,5,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
-	SkuId         int64                  `protobuf:"varint,6,opt,name=skuId,proto3" json:"skuId,omitempty"`
-	GoodsPrice    int64                  `protobuf:"varint,7,opt,name=goodsPrice,proto3" json:"goodsPrice,omitempty"`
-	GoodsNum      int32                  `protobuf:"varint,8,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
-	IsSelect      bool                   `protobuf:"varint,9,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	GoodsId    int64                  `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	GoodsSn    string                 `protobuf:"bytes,4,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	GoodsName  string                 `protobuf:"bytes,5,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	SkuId      int64                  `protobuf:"varint,6,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	GoodsPrice int64                  `protobuf:"varint,7,opt,name=goodsPrice,proto3" json:"goodsPrice,omitempty"`
+	GoodsNum   int32                  `protobuf:"varint,8,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	IsSelect   bool                   `protobuf:"varint,9,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	// 以下字段从商品服务实时查询
+	SkuName       string `protobuf:"bytes,10,opt,name=skuName,proto3" json:"skuName,omitempty"`
+	CurrentPrice  int64  `protobuf:"varint,11,opt,name=currentPrice,proto3" json:"currentPrice,omitempty"` // 商品当前价格，goodsPrice 为加入购物车时的价格
+	OnSale        bool   `protobuf:"varint,12,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	Stock         int64  `protobuf:"varint,13,opt,name=stock,proto3" json:"stock,omitempty"`
+	PriceChanged  bool   `protobuf:"varint,14,opt,name=priceChanged,proto3" json:"priceChanged,omitempty"` // 加入购物车后价格发生了变化
+	OutOfStock    bool   `protobuf:"varint,15,opt,name=outOfStock,proto3" json:"outOfStock,omitempty"`     // 商品已下架或库存不足
+	OverLimit     bool   `protobuf:"varint,16,opt,name=overLimit,proto3" json:"overLimit,omitempty"`       // 超过限购数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -131,17 +139,67 @@ func (x *CartInfoReply) GetIsSelect() bool {
 	return false
 }
 
+func (x *CartInfoReply) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *CartInfoReply) GetCurrentPrice() int64 {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return 0
+}
+
+func (x *CartInfoReply) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *CartInfoReply) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CartInfoReply) GetPriceChanged() bool {
+	if x != nil {
+		return x.PriceChanged
+	}
+	return false
+}
+
+func (x *CartInfoReply) GetOutOfStock() bool {
+	if x != nil {
+		return x.OutOfStock
+	}
+	return false
+}
+
+func (x *CartInfoReply) GetOverLimit() bool {
+	if x != nil {
+		return x.OverLimit
+	}
+	return false
+}
+
 type CreateCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
-	GoodsId       int64                  `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
-	GoodsSn       string                 `protobuf:"bytes,4,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
-	GoodsName     string                 `protobuf:"bytes,5,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
-	SkuId         int64                  `protobuf:"varint,6,opt,name=skuId,proto3" json:"skuId,omitempty"`
-	GoodsPrice    int64                  `protobuf:"varint,7,opt,name=goodsPrice,proto3" json:"goodsPrice,omitempty"`
-	GoodsNum      int32                  `protobuf:"varint,8,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
-	IsSelect      bool                   `protobuf:"varint,9,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	// goodsId、goodsSn、goodsName、goodsPrice 已废弃，以商品服务返回的为准
+	GoodsId       int64  `protobuf:"varint,3,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	GoodsSn       string `protobuf:"bytes,4,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	GoodsName     string `protobuf:"bytes,5,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	SkuId         int64  `protobuf:"varint,6,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	GoodsPrice    int64  `protobuf:"varint,7,opt,name=goodsPrice,proto3" json:"goodsPrice,omitempty"`
+	GoodsNum      int32  `protobuf:"varint,8,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	IsSelect      bool   `protobuf:"varint,9,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -241,8 +299,9 @@ func (x *CreateCartRequest) GetIsSelect() bool {
 
 type UpdateCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	GoodsNum      int32                  `protobuf:"varint,2,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	SkuId         int64                  `protobuf:"varint,4,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	GoodsNum      int32                  `protobuf:"varint,5,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -277,9 +336,16 @@ func (*UpdateCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_v1_cart_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *UpdateCartRequest) GetId() int64 {
+func (x *UpdateCartRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCartRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
 	}
 	return 0
 }
@@ -373,6 +439,8 @@ func (x *CheckResponse) GetSuccess() bool {
 
 type DeleteCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=skuIds,proto3" json:"skuIds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -407,6 +475,20 @@ func (*DeleteCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_v1_cart_proto_rawDescGZIP(), []int{5}
 }
 
+func (x *DeleteCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteCartRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
 type DeleteCartReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -515,6 +597,110 @@ func (*GetCartReply) Descriptor() ([]byte, []int) {
 	return file_cart_v1_cart_proto_rawDescGZIP(), []int{8}
 }
 
+type ClearCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearCartRequest) ProtoMessage() {}
+
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClearCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type SelectCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=skuIds,proto3" json:"skuIds,omitempty"` // 为空时选中或取消选中所有商品
+	IsSelect      bool                   `protobuf:"varint,3,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCartRequest) Reset() {
+	*x = SelectCartRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCartRequest) ProtoMessage() {}
+
+func (x *SelectCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCartRequest.ProtoReflect.Descriptor instead.
+func (*SelectCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SelectCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SelectCartRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+func (x *SelectCartRequest) GetIsSelect() bool {
+	if x != nil {
+		return x.IsSelect
+	}
+	return false
+}
+
 type ListCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
@@ -524,7 +710,7 @@ type ListCartRequest struct {
 
 func (x *ListCartRequest) Reset() {
 	*x = ListCartRequest{}
-	mi := &file_cart_v1_cart_proto_msgTypes[9]
+	mi := &file_cart_v1_cart_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +722,7 @@ func (x *ListCartRequest) String() string {
 func (*ListCartRequest) ProtoMessage() {}
 
 func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_v1_cart_proto_msgTypes[9]
+	mi := &file_cart_v1_cart_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +735,7 @@ func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCartRequest.ProtoReflect.Descriptor instead.
 func (*ListCartRequest) Descriptor() ([]byte, []int) {
-	return file_cart_v1_cart_proto_rawDescGZIP(), []int{9}
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListCartRequest) GetUserId() int64 {
@@ -568,7 +754,7 @@ type CartListReply struct {
 
 func (x *CartListReply) Reset() {
 	*x = CartListReply{}
-	mi := &file_cart_v1_cart_proto_msgTypes[10]
+	mi := &file_cart_v1_cart_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -580,7 +766,7 @@ func (x *CartListReply) String() string {
 func (*CartListReply) ProtoMessage() {}
 
 func (x *CartListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_v1_cart_proto_msgTypes[10]
+	mi := &file_cart_v1_cart_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +779,7 @@ func (x *CartListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartListReply.ProtoReflect.Descriptor instead.
 func (*CartListReply) Descriptor() ([]byte, []int) {
-	return file_cart_v1_cart_proto_rawDescGZIP(), []int{10}
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CartListReply) GetResults() []*CartInfoReply {
@@ -603,57 +789,1074 @@ func (x *CartListReply) GetResults() []*CartInfoReply {
 	return nil
 }
 
-var File_cart_v1_cart_proto protoreflect.FileDescriptor
+type GuestTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_cart_v1_cart_proto_rawDesc = "" +
-	"\n" +
-	"\x12cart/v1/cart.proto\x12\acart.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xf7\x01\n" +
-	"\rCartInfoReply\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x18\n" +
-	"\agoodsId\x18\x03 \x01(\x03R\agoodsId\x12\x18\n" +
-	"\agoodsSn\x18\x04 \x01(\tR\agoodsSn\x12\x1c\n" +
-	"\tgoodsName\x18\x05 \x01(\tR\tgoodsName\x12\x14\n" +
-	"\x05skuId\x18\x06 \x01(\x03R\x05skuId\x12\x1e\n" +
-	"\n" +
-	"goodsPrice\x18\a \x01(\x03R\n" +
-	"goodsPrice\x12\x1a\n" +
-	"\bgoodsNum\x18\b \x01(\x05R\bgoodsNum\x12\x1a\n" +
-	"\bisSelect\x18\t \x01(\bR\bisSelect\"\xc3\x02\n" +
-	"\x11CreateCartRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
-	"\x06userId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12!\n" +
-	"\agoodsId\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\agoodsId\x12!\n" +
-	"\agoodsSn\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\agoodsSn\x12%\n" +
-	"\tgoodsName\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tgoodsName\x12\x1d\n" +
-	"\x05skuId\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12'\n" +
-	"\n" +
-	"goodsPrice\x18\a \x01(\x03B\a\xfaB\x04\"\x02 \x00R\n" +
-	"goodsPrice\x12#\n" +
-	"\bgoodsNum\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bgoodsNum\x12#\n" +
-	"\bisSelect\x18\t \x01(\bB\a\xfaB\x04j\x02\b\x01R\bisSelect\"?\n" +
-	"\x11UpdateCartRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
-	"\bgoodsNum\x18\x02 \x01(\x05R\bgoodsNum\"\x11\n" +
-	"\x0fUpdateCartReply\")\n" +
-	"\rCheckResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x13\n" +
-	"\x11DeleteCartRequest\"\x11\n" +
-	"\x0fDeleteCartReply\"\x10\n" +
-	"\x0eGetCartRequest\"\x0e\n" +
-	"\fGetCartReply\")\n" +
-	"\x0fListCartRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\x03R\x06userId\"A\n" +
-	"\rCartListReply\x120\n" +
-	"\aresults\x18\x01 \x03(\v2\x16.cart.v1.CartInfoReplyR\aresults2\x8a\x02\n" +
-	"\x04Cart\x12@\n" +
-	"\n" +
-	"CreateCart\x12\x1a.cart.v1.CreateCartRequest\x1a\x16.cart.v1.CartInfoReply\x12@\n" +
-	"\n" +
-	"UpdateCart\x12\x1a.cart.v1.UpdateCartRequest\x1a\x16.cart.v1.CheckResponse\x12@\n" +
+func (x *GuestTokenRequest) Reset() {
+	*x = GuestTokenRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestTokenRequest) ProtoMessage() {}
+
+func (x *GuestTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestTokenRequest.ProtoReflect.Descriptor instead.
+func (*GuestTokenRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GuestTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SkuId         int64                  `protobuf:"varint,2,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	GoodsNum      int32                  `protobuf:"varint,3,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GuestCartRequest) Reset() {
+	*x = GuestCartRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuestCartRequest) ProtoMessage() {}
+
+func (x *GuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuestCartRequest.ProtoReflect.Descriptor instead.
+func (*GuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GuestCartRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GuestCartRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GuestCartRequest) GetGoodsNum() int32 {
+	if x != nil {
+		return x.GoodsNum
+	}
+	return 0
+}
+
+type DeleteGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=skuIds,proto3" json:"skuIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGuestCartRequest) Reset() {
+	*x = DeleteGuestCartRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGuestCartRequest) ProtoMessage() {}
+
+func (x *DeleteGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteGuestCartRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DeleteGuestCartRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type MergeGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGuestCartRequest) Reset() {
+	*x = MergeGuestCartRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestCartRequest) ProtoMessage() {}
+
+func (x *MergeGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*MergeGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MergeGuestCartRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MergeGuestCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CheckoutPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	CouponCodes   []string               `protobuf:"bytes,2,rep,name=couponCodes,proto3" json:"couponCodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutPreviewRequest) Reset() {
+	*x = CheckoutPreviewRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutPreviewRequest) ProtoMessage() {}
+
+func (x *CheckoutPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutPreviewRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckoutPreviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckoutPreviewRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+// 结算预览，金额单位为分
+type CheckoutLine struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SkuId             int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	GoodsId           int64                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	GoodsName         string                 `protobuf:"bytes,3,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	SkuName           string                 `protobuf:"bytes,4,opt,name=skuName,proto3" json:"skuName,omitempty"`
+	GoodsNum          int32                  `protobuf:"varint,5,opt,name=goodsNum,proto3" json:"goodsNum,omitempty"`
+	Price             int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`                         // 商品售价
+	PromotionPrice    int64                  `protobuf:"varint,7,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`       // 促销价，没有促销时等于售价
+	Subtotal          int64                  `protobuf:"varint,8,opt,name=subtotal,proto3" json:"subtotal,omitempty"`                   // 售价 * 数量
+	PromotionDiscount int64                  `protobuf:"varint,9,opt,name=promotionDiscount,proto3" json:"promotionDiscount,omitempty"` // 促销优惠
+	CouponDiscount    int64                  `protobuf:"varint,10,opt,name=couponDiscount,proto3" json:"couponDiscount,omitempty"`      // 分摊的优惠券优惠
+	Payable           int64                  `protobuf:"varint,11,opt,name=payable,proto3" json:"payable,omitempty"`                    // 应付金额，不包含运费
+	Points            int64                  `protobuf:"varint,12,opt,name=points,proto3" json:"points,omitempty"`                      // 赠送积分
+	ShipFree          bool                   `protobuf:"varint,13,opt,name=shipFree,proto3" json:"shipFree,omitempty"`
+	ShipId            int32                  `protobuf:"varint,14,opt,name=shipId,proto3" json:"shipId,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckoutLine) Reset() {
+	*x = CheckoutLine{}
+	mi := &file_cart_v1_cart_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutLine) ProtoMessage() {}
+
+func (x *CheckoutLine) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutLine.ProtoReflect.Descriptor instead.
+func (*CheckoutLine) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckoutLine) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *CheckoutLine) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *CheckoutLine) GetGoodsNum() int32 {
+	if x != nil {
+		return x.GoodsNum
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetPromotionDiscount() int64 {
+	if x != nil {
+		return x.PromotionDiscount
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetCouponDiscount() int64 {
+	if x != nil {
+		return x.CouponDiscount
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetPayable() int64 {
+	if x != nil {
+		return x.Payable
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CheckoutLine) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *CheckoutLine) GetShipId() int32 {
+	if x != nil {
+		return x.ShipId
+	}
+	return 0
+}
+
+type CouponResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Discount      int64                  `protobuf:"varint,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	Applied       bool                   `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // 没有使用的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CouponResult) Reset() {
+	*x = CouponResult{}
+	mi := &file_cart_v1_cart_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CouponResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CouponResult) ProtoMessage() {}
+
+func (x *CouponResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CouponResult.ProtoReflect.Descriptor instead.
+func (*CouponResult) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CouponResult) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CouponResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CouponResult) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *CouponResult) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *CouponResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CheckoutPreviewReply struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Lines             []*CheckoutLine        `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Coupons           []*CouponResult        `protobuf:"bytes,2,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	InvalidSkuIds     []int64                `protobuf:"varint,3,rep,packed,name=invalidSkuIds,proto3" json:"invalidSkuIds,omitempty"` // 已下架或库存不足，不参与结算的商品
+	Subtotal          int64                  `protobuf:"varint,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	PromotionDiscount int64                  `protobuf:"varint,5,opt,name=promotionDiscount,proto3" json:"promotionDiscount,omitempty"`
+	CouponDiscount    int64                  `protobuf:"varint,6,opt,name=couponDiscount,proto3" json:"couponDiscount,omitempty"`
+	Shipping          int64                  `protobuf:"varint,7,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	Points            int64                  `protobuf:"varint,8,opt,name=points,proto3" json:"points,omitempty"`
+	Payable           int64                  `protobuf:"varint,9,opt,name=payable,proto3" json:"payable,omitempty"`                         // 应付总额，包含运费
+	OverLimitSkuIds   []int64                `protobuf:"varint,10,rep,packed,name=overLimitSkuIds,proto3" json:"overLimitSkuIds,omitempty"` // 超过限购数量，不参与结算的商品
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckoutPreviewReply) Reset() {
+	*x = CheckoutPreviewReply{}
+	mi := &file_cart_v1_cart_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutPreviewReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutPreviewReply) ProtoMessage() {}
+
+func (x *CheckoutPreviewReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutPreviewReply.ProtoReflect.Descriptor instead.
+func (*CheckoutPreviewReply) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckoutPreviewReply) GetLines() []*CheckoutLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CheckoutPreviewReply) GetCoupons() []*CouponResult {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
+func (x *CheckoutPreviewReply) GetInvalidSkuIds() []int64 {
+	if x != nil {
+		return x.InvalidSkuIds
+	}
+	return nil
+}
+
+func (x *CheckoutPreviewReply) GetSubtotal() int64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *CheckoutPreviewReply) GetPromotionDiscount() int64 {
+	if x != nil {
+		return x.PromotionDiscount
+	}
+	return 0
+}
+
+func (x *CheckoutPreviewReply) GetCouponDiscount() int64 {
+	if x != nil {
+		return x.CouponDiscount
+	}
+	return 0
+}
+
+func (x *CheckoutPreviewReply) GetShipping() int64 {
+	if x != nil {
+		return x.Shipping
+	}
+	return 0
+}
+
+func (x *CheckoutPreviewReply) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CheckoutPreviewReply) GetPayable() int64 {
+	if x != nil {
+		return x.Payable
+	}
+	return 0
+}
+
+func (x *CheckoutPreviewReply) GetOverLimitSkuIds() []int64 {
+	if x != nil {
+		return x.OverLimitSkuIds
+	}
+	return nil
+}
+
+type CartSkusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	SkuIds        []int64                `protobuf:"varint,2,rep,packed,name=skuIds,proto3" json:"skuIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartSkusRequest) Reset() {
+	*x = CartSkusRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartSkusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartSkusRequest) ProtoMessage() {}
+
+func (x *CartSkusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartSkusRequest.ProtoReflect.Descriptor instead.
+func (*CartSkusRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CartSkusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartSkusRequest) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type WishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GoodsId       int64                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistRequest) Reset() {
+	*x = WishlistRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistRequest) ProtoMessage() {}
+
+func (x *WishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistRequest.ProtoReflect.Descriptor instead.
+func (*WishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WishlistRequest) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+type RemoveWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	GoodsIds      []int64                `protobuf:"varint,2,rep,packed,name=goodsIds,proto3" json:"goodsIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWishlistRequest) Reset() {
+	*x = RemoveWishlistRequest{}
+	mi := &file_cart_v1_cart_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWishlistRequest) ProtoMessage() {}
+
+func (x *RemoveWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RemoveWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveWishlistRequest) GetGoodsIds() []int64 {
+	if x != nil {
+		return x.GoodsIds
+	}
+	return nil
+}
+
+type WishlistItem struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoodsId int64                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	// 以下字段从商品服务实时查询，商品已删除时为空
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	GoodsSn       string `protobuf:"bytes,4,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	Image         string `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	MarketPrice   int64  `protobuf:"varint,6,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`
+	OnSale        bool   `protobuf:"varint,7,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	FavNum        int64  `protobuf:"varint,8,opt,name=favNum,proto3" json:"favNum,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	mi := &file_cart_v1_cart_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItem) ProtoMessage() {}
+
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WishlistItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetMarketPrice() int64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *WishlistItem) GetFavNum() int64 {
+	if x != nil {
+		return x.FavNum
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type WishlistReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*WishlistItem        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistReply) Reset() {
+	*x = WishlistReply{}
+	mi := &file_cart_v1_cart_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistReply) ProtoMessage() {}
+
+func (x *WishlistReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_v1_cart_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistReply.ProtoReflect.Descriptor instead.
+func (*WishlistReply) Descriptor() ([]byte, []int) {
+	return file_cart_v1_cart_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WishlistReply) GetResults() []*WishlistItem {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_cart_v1_cart_proto protoreflect.FileDescriptor
+
+const file_cart_v1_cart_proto_rawDesc = "" +
+	"\n" +
+	"\x12cart/v1/cart.proto\x12\acart.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xc5\x03\n" +
+	"\rCartInfoReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x18\n" +
+	"\agoodsId\x18\x03 \x01(\x03R\agoodsId\x12\x18\n" +
+	"\agoodsSn\x18\x04 \x01(\tR\agoodsSn\x12\x1c\n" +
+	"\tgoodsName\x18\x05 \x01(\tR\tgoodsName\x12\x14\n" +
+	"\x05skuId\x18\x06 \x01(\x03R\x05skuId\x12\x1e\n" +
+	"\n" +
+	"goodsPrice\x18\a \x01(\x03R\n" +
+	"goodsPrice\x12\x1a\n" +
+	"\bgoodsNum\x18\b \x01(\x05R\bgoodsNum\x12\x1a\n" +
+	"\bisSelect\x18\t \x01(\bR\bisSelect\x12\x18\n" +
+	"\askuName\x18\n" +
+	" \x01(\tR\askuName\x12\"\n" +
+	"\fcurrentPrice\x18\v \x01(\x03R\fcurrentPrice\x12\x16\n" +
+	"\x06onSale\x18\f \x01(\bR\x06onSale\x12\x14\n" +
+	"\x05stock\x18\r \x01(\x03R\x05stock\x12\"\n" +
+	"\fpriceChanged\x18\x0e \x01(\bR\fpriceChanged\x12\x1e\n" +
+	"\n" +
+	"outOfStock\x18\x0f \x01(\bR\n" +
+	"outOfStock\x12\x1c\n" +
+	"\toverLimit\x18\x10 \x01(\bR\toverLimit\"\x9f\x02\n" +
+	"\x11CreateCartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\x06userId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12\x18\n" +
+	"\agoodsId\x18\x03 \x01(\x03R\agoodsId\x12\x18\n" +
+	"\agoodsSn\x18\x04 \x01(\tR\agoodsSn\x12\x1c\n" +
+	"\tgoodsName\x18\x05 \x01(\tR\tgoodsName\x12\x1d\n" +
+	"\x05skuId\x18\x06 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12\x1e\n" +
+	"\n" +
+	"goodsPrice\x18\a \x01(\x03R\n" +
+	"goodsPrice\x12#\n" +
+	"\bgoodsNum\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bgoodsNum\x12#\n" +
+	"\bisSelect\x18\t \x01(\bB\a\xfaB\x04j\x02\b\x01R\bisSelect\"\x88\x01\n" +
+	"\x11UpdateCartRequest\x12\x1f\n" +
+	"\x06userId\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12\x1d\n" +
+	"\x05skuId\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12#\n" +
+	"\bgoodsNum\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bgoodsNumJ\x04\b\x01\x10\x02J\x04\b\x02\x10\x03R\x02id\"\x11\n" +
+	"\x0fUpdateCartReply\")\n" +
+	"\rCheckResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\x11DeleteCartRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12 \n" +
+	"\x06skuIds\x18\x02 \x03(\x03B\b\xfaB\x05\x92\x01\x02\b\x01R\x06skuIds\"\x11\n" +
+	"\x0fDeleteCartReply\"\x10\n" +
+	"\x0eGetCartRequest\"\x0e\n" +
+	"\fGetCartReply\"3\n" +
+	"\x10ClearCartRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\"h\n" +
+	"\x11SelectCartRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12\x16\n" +
+	"\x06skuIds\x18\x02 \x03(\x03R\x06skuIds\x12\x1a\n" +
+	"\bisSelect\x18\x03 \x01(\bR\bisSelect\"2\n" +
+	"\x0fListCartRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\"A\n" +
+	"\rCartListReply\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.cart.v1.CartInfoReplyR\aresults\"4\n" +
+	"\x11GuestTokenRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x10\x18@R\x05token\"w\n" +
+	"\x10GuestCartRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x10\x18@R\x05token\x12\x1d\n" +
+	"\x05skuId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12#\n" +
+	"\bgoodsNum\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\bgoodsNum\"[\n" +
+	"\x16DeleteGuestCartRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x10\x18@R\x05token\x12 \n" +
+	"\x06skuIds\x18\x02 \x03(\x03B\b\xfaB\x05\x92\x01\x02\b\x01R\x06skuIds\"Y\n" +
+	"\x15MergeGuestCartRequest\x12\x1f\n" +
+	"\x05token\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x10\x18@R\x05token\x12\x1f\n" +
+	"\x06userId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\"e\n" +
+	"\x16CheckoutPreviewRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12*\n" +
+	"\vcouponCodes\x18\x02 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\vcouponCodes\"\xa8\x03\n" +
+	"\fCheckoutLine\x12\x14\n" +
+	"\x05skuId\x18\x01 \x01(\x03R\x05skuId\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x03R\agoodsId\x12\x1c\n" +
+	"\tgoodsName\x18\x03 \x01(\tR\tgoodsName\x12\x18\n" +
+	"\askuName\x18\x04 \x01(\tR\askuName\x12\x1a\n" +
+	"\bgoodsNum\x18\x05 \x01(\x05R\bgoodsNum\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12&\n" +
+	"\x0epromotionPrice\x18\a \x01(\x03R\x0epromotionPrice\x12\x1a\n" +
+	"\bsubtotal\x18\b \x01(\x03R\bsubtotal\x12,\n" +
+	"\x11promotionDiscount\x18\t \x01(\x03R\x11promotionDiscount\x12&\n" +
+	"\x0ecouponDiscount\x18\n" +
+	" \x01(\x03R\x0ecouponDiscount\x12\x18\n" +
+	"\apayable\x18\v \x01(\x03R\apayable\x12\x16\n" +
+	"\x06points\x18\f \x01(\x03R\x06points\x12\x1a\n" +
+	"\bshipFree\x18\r \x01(\bR\bshipFree\x12\x16\n" +
+	"\x06shipId\x18\x0e \x01(\x05R\x06shipId\"\x84\x01\n" +
+	"\fCouponResult\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bdiscount\x18\x03 \x01(\x03R\bdiscount\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x84\x03\n" +
+	"\x14CheckoutPreviewReply\x12+\n" +
+	"\x05lines\x18\x01 \x03(\v2\x15.cart.v1.CheckoutLineR\x05lines\x12/\n" +
+	"\acoupons\x18\x02 \x03(\v2\x15.cart.v1.CouponResultR\acoupons\x12$\n" +
+	"\rinvalidSkuIds\x18\x03 \x03(\x03R\rinvalidSkuIds\x12\x1a\n" +
+	"\bsubtotal\x18\x04 \x01(\x03R\bsubtotal\x12,\n" +
+	"\x11promotionDiscount\x18\x05 \x01(\x03R\x11promotionDiscount\x12&\n" +
+	"\x0ecouponDiscount\x18\x06 \x01(\x03R\x0ecouponDiscount\x12\x1a\n" +
+	"\bshipping\x18\a \x01(\x03R\bshipping\x12\x16\n" +
+	"\x06points\x18\b \x01(\x03R\x06points\x12\x18\n" +
+	"\apayable\x18\t \x01(\x03R\apayable\x12(\n" +
+	"\x0foverLimitSkuIds\x18\n" +
+	" \x03(\x03R\x0foverLimitSkuIds\"T\n" +
+	"\x0fCartSkusRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12 \n" +
+	"\x06skuIds\x18\x02 \x03(\x03B\b\xfaB\x05\x92\x01\x02\b\x01R\x06skuIds\"U\n" +
+	"\x0fWishlistRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12!\n" +
+	"\agoodsId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\agoodsId\"^\n" +
+	"\x15RemoveWishlistRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12$\n" +
+	"\bgoodsIds\x18\x02 \x03(\x03B\b\xfaB\x05\x92\x01\x02\b\x01R\bgoodsIds\"\xec\x01\n" +
+	"\fWishlistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x03R\agoodsId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\agoodsSn\x18\x04 \x01(\tR\agoodsSn\x12\x14\n" +
+	"\x05image\x18\x05 \x01(\tR\x05image\x12 \n" +
+	"\vmarketPrice\x18\x06 \x01(\x03R\vmarketPrice\x12\x16\n" +
+	"\x06onSale\x18\a \x01(\bR\x06onSale\x12\x16\n" +
+	"\x06favNum\x18\b \x01(\x03R\x06favNum\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\x03R\tcreatedAt\"@\n" +
+	"\rWishlistReply\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.cart.v1.WishlistItemR\aresults2\xde\n" +
+	"\n" +
+	"\x04Cart\x12@\n" +
+	"\n" +
+	"CreateCart\x12\x1a.cart.v1.CreateCartRequest\x1a\x16.cart.v1.CartInfoReply\x12@\n" +
+	"\n" +
+	"UpdateCart\x12\x1a.cart.v1.UpdateCartRequest\x1a\x16.cart.v1.CheckResponse\x12@\n" +
+	"\n" +
+	"DeleteCart\x12\x1a.cart.v1.DeleteCartRequest\x1a\x16.cart.v1.CheckResponse\x12>\n" +
+	"\tClearCart\x12\x19.cart.v1.ClearCartRequest\x1a\x16.cart.v1.CheckResponse\x12@\n" +
 	"\n" +
-	"DeleteCart\x12\x1a.cart.v1.DeleteCartRequest\x1a\x16.cart.v1.CheckResponse\x12<\n" +
-	"\bListCart\x12\x18.cart.v1.ListCartRequest\x1a\x16.cart.v1.CartListReplyB\x15Z\x13cart/api/cart/v1;v1b\x06proto3"
+	"SelectCart\x12\x1a.cart.v1.SelectCartRequest\x1a\x16.cart.v1.CheckResponse\x12<\n" +
+	"\bListCart\x12\x18.cart.v1.ListCartRequest\x1a\x16.cart.v1.CartListReply\x12Q\n" +
+	"\x0fCheckoutPreview\x12\x1f.cart.v1.CheckoutPreviewRequest\x1a\x1d.cart.v1.CheckoutPreviewReply\x12@\n" +
+	"\fSaveForLater\x12\x18.cart.v1.CartSkusRequest\x1a\x16.cart.v1.CheckResponse\x12=\n" +
+	"\tListSaved\x12\x18.cart.v1.ListCartRequest\x1a\x16.cart.v1.CartListReply\x12C\n" +
+	"\x0fMoveSavedToCart\x12\x18.cart.v1.CartSkusRequest\x1a\x16.cart.v1.CheckResponse\x12?\n" +
+	"\vDeleteSaved\x12\x18.cart.v1.CartSkusRequest\x1a\x16.cart.v1.CheckResponse\x12B\n" +
+	"\x0eMoveToWishlist\x12\x18.cart.v1.CartSkusRequest\x1a\x16.cart.v1.CheckResponse\x12?\n" +
+	"\vAddWishlist\x12\x18.cart.v1.WishlistRequest\x1a\x16.cart.v1.CheckResponse\x12H\n" +
+	"\x0eRemoveWishlist\x12\x1e.cart.v1.RemoveWishlistRequest\x1a\x16.cart.v1.CheckResponse\x12@\n" +
+	"\fListWishlist\x12\x18.cart.v1.ListCartRequest\x1a\x16.cart.v1.WishlistReply\x12D\n" +
+	"\x0fCreateGuestCart\x12\x19.cart.v1.GuestCartRequest\x1a\x16.cart.v1.CartInfoReply\x12D\n" +
+	"\x0fUpdateGuestCart\x12\x19.cart.v1.GuestCartRequest\x1a\x16.cart.v1.CheckResponse\x12J\n" +
+	"\x0fDeleteGuestCart\x12\x1f.cart.v1.DeleteGuestCartRequest\x1a\x16.cart.v1.CheckResponse\x12C\n" +
+	"\rListGuestCart\x12\x1a.cart.v1.GuestTokenRequest\x1a\x16.cart.v1.CartListReply\x12H\n" +
+	"\x0eMergeGuestCart\x12\x1e.cart.v1.MergeGuestCartRequest\x1a\x16.cart.v1.CartListReplyB\x15Z\x13cart/api/cart/v1;v1b\x06proto3"
 
 var (
 	file_cart_v1_cart_proto_rawDescOnce sync.Once
@@ -667,35 +1870,85 @@ func file_cart_v1_cart_proto_rawDescGZIP() []byte {
 	return file_cart_v1_cart_proto_rawDescData
 }
 
-var file_cart_v1_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cart_v1_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_cart_v1_cart_proto_goTypes = []any{
-	(*CartInfoReply)(nil),     // 0: cart.v1.CartInfoReply
-	(*CreateCartRequest)(nil), // 1: cart.v1.CreateCartRequest
-	(*UpdateCartRequest)(nil), // 2: cart.v1.UpdateCartRequest
-	(*UpdateCartReply)(nil),   // 3: cart.v1.UpdateCartReply
-	(*CheckResponse)(nil),     // 4: cart.v1.CheckResponse
-	(*DeleteCartRequest)(nil), // 5: cart.v1.DeleteCartRequest
-	(*DeleteCartReply)(nil),   // 6: cart.v1.DeleteCartReply
-	(*GetCartRequest)(nil),    // 7: cart.v1.GetCartRequest
-	(*GetCartReply)(nil),      // 8: cart.v1.GetCartReply
-	(*ListCartRequest)(nil),   // 9: cart.v1.ListCartRequest
-	(*CartListReply)(nil),     // 10: cart.v1.CartListReply
+	(*CartInfoReply)(nil),          // 0: cart.v1.CartInfoReply
+	(*CreateCartRequest)(nil),      // 1: cart.v1.CreateCartRequest
+	(*UpdateCartRequest)(nil),      // 2: cart.v1.UpdateCartRequest
+	(*UpdateCartReply)(nil),        // 3: cart.v1.UpdateCartReply
+	(*CheckResponse)(nil),          // 4: cart.v1.CheckResponse
+	(*DeleteCartRequest)(nil),      // 5: cart.v1.DeleteCartRequest
+	(*DeleteCartReply)(nil),        // 6: cart.v1.DeleteCartReply
+	(*GetCartRequest)(nil),         // 7: cart.v1.GetCartRequest
+	(*GetCartReply)(nil),           // 8: cart.v1.GetCartReply
+	(*ClearCartRequest)(nil),       // 9: cart.v1.ClearCartRequest
+	(*SelectCartRequest)(nil),      // 10: cart.v1.SelectCartRequest
+	(*ListCartRequest)(nil),        // 11: cart.v1.ListCartRequest
+	(*CartListReply)(nil),          // 12: cart.v1.CartListReply
+	(*GuestTokenRequest)(nil),      // 13: cart.v1.GuestTokenRequest
+	(*GuestCartRequest)(nil),       // 14: cart.v1.GuestCartRequest
+	(*DeleteGuestCartRequest)(nil), // 15: cart.v1.DeleteGuestCartRequest
+	(*MergeGuestCartRequest)(nil),  // 16: cart.v1.MergeGuestCartRequest
+	(*CheckoutPreviewRequest)(nil), // 17: cart.v1.CheckoutPreviewRequest
+	(*CheckoutLine)(nil),           // 18: cart.v1.CheckoutLine
+	(*CouponResult)(nil),           // 19: cart.v1.CouponResult
+	(*CheckoutPreviewReply)(nil),   // 20: cart.v1.CheckoutPreviewReply
+	(*CartSkusRequest)(nil),        // 21: cart.v1.CartSkusRequest
+	(*WishlistRequest)(nil),        // 22: cart.v1.WishlistRequest
+	(*RemoveWishlistRequest)(nil),  // 23: cart.v1.RemoveWishlistRequest
+	(*WishlistItem)(nil),           // 24: cart.v1.WishlistItem
+	(*WishlistReply)(nil),          // 25: cart.v1.WishlistReply
 }
 var file_cart_v1_cart_proto_depIdxs = []int32{
 	0,  // 0: cart.v1.CartListReply.results:type_name -> cart.v1.CartInfoReply
-	1,  // 1: cart.v1.Cart.CreateCart:input_type -> cart.v1.CreateCartRequest
-	2,  // 2: cart.v1.Cart.UpdateCart:input_type -> cart.v1.UpdateCartRequest
-	5,  // 3: cart.v1.Cart.DeleteCart:input_type -> cart.v1.DeleteCartRequest
-	9,  // 4: cart.v1.Cart.ListCart:input_type -> cart.v1.ListCartRequest
-	0,  // 5: cart.v1.Cart.CreateCart:output_type -> cart.v1.CartInfoReply
-	4,  // 6: cart.v1.Cart.UpdateCart:output_type -> cart.v1.CheckResponse
-	4,  // 7: cart.v1.Cart.DeleteCart:output_type -> cart.v1.CheckResponse
-	10, // 8: cart.v1.Cart.ListCart:output_type -> cart.v1.CartListReply
-	5,  // [5:9] is the sub-list for method output_type
-	1,  // [1:5] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	18, // 1: cart.v1.CheckoutPreviewReply.lines:type_name -> cart.v1.CheckoutLine
+	19, // 2: cart.v1.CheckoutPreviewReply.coupons:type_name -> cart.v1.CouponResult
+	24, // 3: cart.v1.WishlistReply.results:type_name -> cart.v1.WishlistItem
+	1,  // 4: cart.v1.Cart.CreateCart:input_type -> cart.v1.CreateCartRequest
+	2,  // 5: cart.v1.Cart.UpdateCart:input_type -> cart.v1.UpdateCartRequest
+	5,  // 6: cart.v1.Cart.DeleteCart:input_type -> cart.v1.DeleteCartRequest
+	9,  // 7: cart.v1.Cart.ClearCart:input_type -> cart.v1.ClearCartRequest
+	10, // 8: cart.v1.Cart.SelectCart:input_type -> cart.v1.SelectCartRequest
+	11, // 9: cart.v1.Cart.ListCart:input_type -> cart.v1.ListCartRequest
+	17, // 10: cart.v1.Cart.CheckoutPreview:input_type -> cart.v1.CheckoutPreviewRequest
+	21, // 11: cart.v1.Cart.SaveForLater:input_type -> cart.v1.CartSkusRequest
+	11, // 12: cart.v1.Cart.ListSaved:input_type -> cart.v1.ListCartRequest
+	21, // 13: cart.v1.Cart.MoveSavedToCart:input_type -> cart.v1.CartSkusRequest
+	21, // 14: cart.v1.Cart.DeleteSaved:input_type -> cart.v1.CartSkusRequest
+	21, // 15: cart.v1.Cart.MoveToWishlist:input_type -> cart.v1.CartSkusRequest
+	22, // 16: cart.v1.Cart.AddWishlist:input_type -> cart.v1.WishlistRequest
+	23, // 17: cart.v1.Cart.RemoveWishlist:input_type -> cart.v1.RemoveWishlistRequest
+	11, // 18: cart.v1.Cart.ListWishlist:input_type -> cart.v1.ListCartRequest
+	14, // 19: cart.v1.Cart.CreateGuestCart:input_type -> cart.v1.GuestCartRequest
+	14, // 20: cart.v1.Cart.UpdateGuestCart:input_type -> cart.v1.GuestCartRequest
+	15, // 21: cart.v1.Cart.DeleteGuestCart:input_type -> cart.v1.DeleteGuestCartRequest
+	13, // 22: cart.v1.Cart.ListGuestCart:input_type -> cart.v1.GuestTokenRequest
+	16, // 23: cart.v1.Cart.MergeGuestCart:input_type -> cart.v1.MergeGuestCartRequest
+	0,  // 24: cart.v1.Cart.CreateCart:output_type -> cart.v1.CartInfoReply
+	4,  // 25: cart.v1.Cart.UpdateCart:output_type -> cart.v1.CheckResponse
+	4,  // 26: cart.v1.Cart.DeleteCart:output_type -> cart.v1.CheckResponse
+	4,  // 27: cart.v1.Cart.ClearCart:output_type -> cart.v1.CheckResponse
+	4,  // 28: cart.v1.Cart.SelectCart:output_type -> cart.v1.CheckResponse
+	12, // 29: cart.v1.Cart.ListCart:output_type -> cart.v1.CartListReply
+	20, // 30: cart.v1.Cart.CheckoutPreview:output_type -> cart.v1.CheckoutPreviewReply
+	4,  // 31: cart.v1.Cart.SaveForLater:output_type -> cart.v1.CheckResponse
+	12, // 32: cart.v1.Cart.ListSaved:output_type -> cart.v1.CartListReply
+	4,  // 33: cart.v1.Cart.MoveSavedToCart:output_type -> cart.v1.CheckResponse
+	4,  // 34: cart.v1.Cart.DeleteSaved:output_type -> cart.v1.CheckResponse
+	4,  // 35: cart.v1.Cart.MoveToWishlist:output_type -> cart.v1.CheckResponse
+	4,  // 36: cart.v1.Cart.AddWishlist:output_type -> cart.v1.CheckResponse
+	4,  // 37: cart.v1.Cart.RemoveWishlist:output_type -> cart.v1.CheckResponse
+	25, // 38: cart.v1.Cart.ListWishlist:output_type -> cart.v1.WishlistReply
+	0,  // 39: cart.v1.Cart.CreateGuestCart:output_type -> cart.v1.CartInfoReply
+	4,  // 40: cart.v1.Cart.UpdateGuestCart:output_type -> cart.v1.CheckResponse
+	4,  // 41: cart.v1.Cart.DeleteGuestCart:output_type -> cart.v1.CheckResponse
+	12, // 42: cart.v1.Cart.ListGuestCart:output_type -> cart.v1.CartListReply
+	12, // 43: cart.v1.Cart.MergeGuestCart:output_type -> cart.v1.CartListReply
+	24, // [24:44] is the sub-list for method output_type
+	4,  // [4:24] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cart_v1_cart_proto_init() }
@@ -709,7 +1962,7 @@ func file_cart_v1_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_v1_cart_proto_rawDesc), len(file_cart_v1_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
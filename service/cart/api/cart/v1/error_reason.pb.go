@@ -24,19 +24,49 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_GREETER_UNSPECIFIED ErrorReason = 0
-	ErrorReason_USER_NOT_FOUND      ErrorReason = 1
+	ErrorReason_CART_UNSPECIFIED            ErrorReason = 0
+	ErrorReason_CART_NOT_FOUND              ErrorReason = 1  // 购物车商品不存在
+	ErrorReason_SKU_NOT_FOUND               ErrorReason = 2  // 商品不存在
+	ErrorReason_GOODS_NOT_ON_SALE           ErrorReason = 3  // 商品已下架
+	ErrorReason_GOODS_STOCK_NOT_ENOUGH      ErrorReason = 4  // 商品库存不足
+	ErrorReason_GOODS_NUM_INVALID           ErrorReason = 5  // 商品数量必须大于0
+	ErrorReason_CART_LINE_LIMIT_EXCEEDED    ErrorReason = 6  // 购物车商品种类超过上限
+	ErrorReason_CART_LINE_QUANTITY_EXCEEDED ErrorReason = 7  // 单个商品数量超过上限
+	ErrorReason_SKU_PURCHASE_LIMIT_EXCEEDED ErrorReason = 8  // 超过商品的限购数量
+	ErrorReason_SKU_IS_EMPTY                ErrorReason = 9  // 没有选择商品
+	ErrorReason_GOODS_NOT_FOUND             ErrorReason = 10 // 商品不存在
+	ErrorReason_WISHLIST_NOT_FOUND          ErrorReason = 11 // 收藏的商品不存在
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "GREETER_UNSPECIFIED",
-		1: "USER_NOT_FOUND",
+		0:  "CART_UNSPECIFIED",
+		1:  "CART_NOT_FOUND",
+		2:  "SKU_NOT_FOUND",
+		3:  "GOODS_NOT_ON_SALE",
+		4:  "GOODS_STOCK_NOT_ENOUGH",
+		5:  "GOODS_NUM_INVALID",
+		6:  "CART_LINE_LIMIT_EXCEEDED",
+		7:  "CART_LINE_QUANTITY_EXCEEDED",
+		8:  "SKU_PURCHASE_LIMIT_EXCEEDED",
+		9:  "SKU_IS_EMPTY",
+		10: "GOODS_NOT_FOUND",
+		11: "WISHLIST_NOT_FOUND",
 	}
 	ErrorReason_value = map[string]int32{
-		"GREETER_UNSPECIFIED": 0,
-		"USER_NOT_FOUND":      1,
+		"CART_UNSPECIFIED":            0,
+		"CART_NOT_FOUND":              1,
+		"SKU_NOT_FOUND":               2,
+		"GOODS_NOT_ON_SALE":           3,
+		"GOODS_STOCK_NOT_ENOUGH":      4,
+		"GOODS_NUM_INVALID":           5,
+		"CART_LINE_LIMIT_EXCEEDED":    6,
+		"CART_LINE_QUANTITY_EXCEEDED": 7,
+		"SKU_PURCHASE_LIMIT_EXCEEDED": 8,
+		"SKU_IS_EMPTY":                9,
+		"GOODS_NOT_FOUND":             10,
+		"WISHLIST_NOT_FOUND":          11,
 	}
 )
 
@@ -71,11 +101,22 @@ var File_cart_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_cart_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1acart/v1/error_reason.proto\x12\rhelloworld.v1*:\n" +
-	"\vErrorReason\x12\x17\n" +
-	"\x13GREETER_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eUSER_NOT_FOUND\x10\x01B>\n" +
-	"\rhelloworld.v1P\x01Z\x19cart/api/helloworld/v1;v1\xa2\x02\x0fAPIHelloworldV1b\x06proto3"
+	"\x1acart/v1/error_reason.proto\x12\acart.v1*\xb3\x02\n" +
+	"\vErrorReason\x12\x14\n" +
+	"\x10CART_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eCART_NOT_FOUND\x10\x01\x12\x11\n" +
+	"\rSKU_NOT_FOUND\x10\x02\x12\x15\n" +
+	"\x11GOODS_NOT_ON_SALE\x10\x03\x12\x1a\n" +
+	"\x16GOODS_STOCK_NOT_ENOUGH\x10\x04\x12\x15\n" +
+	"\x11GOODS_NUM_INVALID\x10\x05\x12\x1c\n" +
+	"\x18CART_LINE_LIMIT_EXCEEDED\x10\x06\x12\x1f\n" +
+	"\x1bCART_LINE_QUANTITY_EXCEEDED\x10\a\x12\x1f\n" +
+	"\x1bSKU_PURCHASE_LIMIT_EXCEEDED\x10\b\x12\x10\n" +
+	"\fSKU_IS_EMPTY\x10\t\x12\x13\n" +
+	"\x0fGOODS_NOT_FOUND\x10\n" +
+	"\x12\x16\n" +
+	"\x12WISHLIST_NOT_FOUND\x10\vB,\n" +
+	"\acart.v1P\x01Z\x13cart/api/cart/v1;v1\xa2\x02\tAPICartV1b\x06proto3"
 
 var (
 	file_cart_v1_error_reason_proto_rawDescOnce sync.Once
@@ -91,7 +132,7 @@ func file_cart_v1_error_reason_proto_rawDescGZIP() []byte {
 
 var file_cart_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_cart_v1_error_reason_proto_goTypes = []any{
-	(ErrorReason)(0), // 0: helloworld.v1.ErrorReason
+	(ErrorReason)(0), // 0: cart.v1.ErrorReason
 }
 var file_cart_v1_error_reason_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
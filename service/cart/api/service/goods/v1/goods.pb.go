@@ -0,0 +1,4876 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: service/goods/v1/goods.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CategoryInfoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentCategory int32                  `protobuf:"varint,3,opt,name=parentCategory,proto3" json:"parentCategory,omitempty"`
+	Level          int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	IsTab          bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Sort           int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryInfoRequest) Reset() {
+	*x = CategoryInfoRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInfoRequest) ProtoMessage() {}
+
+func (x *CategoryInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInfoRequest.ProtoReflect.Descriptor instead.
+func (*CategoryInfoRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CategoryInfoRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryInfoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryInfoRequest) GetParentCategory() int32 {
+	if x != nil {
+		return x.ParentCategory
+	}
+	return 0
+}
+
+func (x *CategoryInfoRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CategoryInfoRequest) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *CategoryInfoRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type CategoryInfoResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentCategory int32                  `protobuf:"varint,3,opt,name=parentCategory,proto3" json:"parentCategory,omitempty"`
+	Level          int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	IsTab          bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Sort           int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CategoryInfoResponse) Reset() {
+	*x = CategoryInfoResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryInfoResponse) ProtoMessage() {}
+
+func (x *CategoryInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryInfoResponse.ProtoReflect.Descriptor instead.
+func (*CategoryInfoResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CategoryInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryInfoResponse) GetParentCategory() int32 {
+	if x != nil {
+		return x.ParentCategory
+	}
+	return 0
+}
+
+func (x *CategoryInfoResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *CategoryInfoResponse) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *CategoryInfoResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type SubCategoryListResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Info          *CategoryInfoResponse   `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	SubCategory   []*CategoryInfoResponse `protobuf:"bytes,2,rep,name=subCategory,proto3" json:"subCategory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubCategoryListResponse) Reset() {
+	*x = SubCategoryListResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubCategoryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubCategoryListResponse) ProtoMessage() {}
+
+func (x *SubCategoryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubCategoryListResponse.ProtoReflect.Descriptor instead.
+func (*SubCategoryListResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubCategoryListResponse) GetInfo() *CategoryInfoResponse {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *SubCategoryListResponse) GetSubCategory() []*CategoryInfoResponse {
+	if x != nil {
+		return x.SubCategory
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CategoryListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JsonData      string                 `protobuf:"bytes,1,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryListResponse) Reset() {
+	*x = CategoryListResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryListResponse) ProtoMessage() {}
+
+func (x *CategoryListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryListResponse.ProtoReflect.Descriptor instead.
+func (*CategoryListResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CategoryListResponse) GetJsonData() string {
+	if x != nil {
+		return x.JsonData
+	}
+	return ""
+}
+
+type CategoryListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryListRequest) Reset() {
+	*x = CategoryListRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryListRequest) ProtoMessage() {}
+
+func (x *CategoryListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryListRequest.ProtoReflect.Descriptor instead.
+func (*CategoryListRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CategoryListRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CategoryListRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type SpecificationValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttrId        int64                  `protobuf:"varint,2,opt,name=attrId,proto3" json:"attrId,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecificationValue) Reset() {
+	*x = SpecificationValue{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecificationValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecificationValue) ProtoMessage() {}
+
+func (x *SpecificationValue) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecificationValue.ProtoReflect.Descriptor instead.
+func (*SpecificationValue) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SpecificationValue) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpecificationValue) GetAttrId() int64 {
+	if x != nil {
+		return x.AttrId
+	}
+	return 0
+}
+
+func (x *SpecificationValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SpecificationValue) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type SpecificationValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttrId        int64                  `protobuf:"varint,2,opt,name=attrId,proto3" json:"attrId,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecificationValueResponse) Reset() {
+	*x = SpecificationValueResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecificationValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecificationValueResponse) ProtoMessage() {}
+
+func (x *SpecificationValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecificationValueResponse.ProtoReflect.Descriptor instead.
+func (*SpecificationValueResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SpecificationValueResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpecificationValueResponse) GetAttrId() int64 {
+	if x != nil {
+		return x.AttrId
+	}
+	return 0
+}
+
+func (x *SpecificationValueResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SpecificationValueResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type SpecificationRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId             int64                  `protobuf:"varint,2,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Sort               int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Status             bool                   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	IsSku              bool                   `protobuf:"varint,6,opt,name=isSku,proto3" json:"isSku,omitempty"`
+	IsSelect           bool                   `protobuf:"varint,7,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	SpecificationValue []*SpecificationValue  `protobuf:"bytes,8,rep,name=specificationValue,proto3" json:"specificationValue,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SpecificationRequest) Reset() {
+	*x = SpecificationRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecificationRequest) ProtoMessage() {}
+
+func (x *SpecificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecificationRequest.ProtoReflect.Descriptor instead.
+func (*SpecificationRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SpecificationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpecificationRequest) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *SpecificationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpecificationRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *SpecificationRequest) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *SpecificationRequest) GetIsSku() bool {
+	if x != nil {
+		return x.IsSku
+	}
+	return false
+}
+
+func (x *SpecificationRequest) GetIsSelect() bool {
+	if x != nil {
+		return x.IsSelect
+	}
+	return false
+}
+
+func (x *SpecificationRequest) GetSpecificationValue() []*SpecificationValue {
+	if x != nil {
+		return x.SpecificationValue
+	}
+	return nil
+}
+
+type SpecificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecificationResponse) Reset() {
+	*x = SpecificationResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecificationResponse) ProtoMessage() {}
+
+func (x *SpecificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecificationResponse.ProtoReflect.Descriptor instead.
+func (*SpecificationResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SpecificationResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AttrGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId        int64                  `protobuf:"varint,2,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status        bool                   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttrGroupRequest) Reset() {
+	*x = AttrGroupRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrGroupRequest) ProtoMessage() {}
+
+func (x *AttrGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrGroupRequest.ProtoReflect.Descriptor instead.
+func (*AttrGroupRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AttrGroupRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttrGroupRequest) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *AttrGroupRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AttrGroupRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *AttrGroupRequest) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *AttrGroupRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type AttrGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId        int64                  `protobuf:"varint,2,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status        bool                   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttrGroupResponse) Reset() {
+	*x = AttrGroupResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrGroupResponse) ProtoMessage() {}
+
+func (x *AttrGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrGroupResponse.ProtoReflect.Descriptor instead.
+func (*AttrGroupResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AttrGroupResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttrGroupResponse) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *AttrGroupResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AttrGroupResponse) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *AttrGroupResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *AttrGroupResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type AttrValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttrId        int64                  `protobuf:"varint,2,opt,name=attrId,proto3" json:"attrId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttrValueRequest) Reset() {
+	*x = AttrValueRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrValueRequest) ProtoMessage() {}
+
+func (x *AttrValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrValueRequest.ProtoReflect.Descriptor instead.
+func (*AttrValueRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AttrValueRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttrValueRequest) GetAttrId() int64 {
+	if x != nil {
+		return x.AttrId
+	}
+	return 0
+}
+
+func (x *AttrValueRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AttrValueRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AttrRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId        int64                  `protobuf:"varint,2,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Desc          string                 `protobuf:"bytes,5,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status        bool                   `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Sort          int32                  `protobuf:"varint,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	AttrValue     []*AttrValueRequest    `protobuf:"bytes,8,rep,name=attrValue,proto3" json:"attrValue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttrRequest) Reset() {
+	*x = AttrRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrRequest) ProtoMessage() {}
+
+func (x *AttrRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrRequest.ProtoReflect.Descriptor instead.
+func (*AttrRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AttrRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttrRequest) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *AttrRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AttrRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AttrRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *AttrRequest) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *AttrRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *AttrRequest) GetAttrValue() []*AttrValueRequest {
+	if x != nil {
+		return x.AttrValue
+	}
+	return nil
+}
+
+type AttrValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttrId        int64                  `protobuf:"varint,2,opt,name=attrId,proto3" json:"attrId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttrValueResponse) Reset() {
+	*x = AttrValueResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrValueResponse) ProtoMessage() {}
+
+func (x *AttrValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrValueResponse.ProtoReflect.Descriptor instead.
+func (*AttrValueResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AttrValueResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttrValueResponse) GetAttrId() int64 {
+	if x != nil {
+		return x.AttrId
+	}
+	return 0
+}
+
+func (x *AttrValueResponse) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AttrValueResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AttrResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId        int64                  `protobuf:"varint,2,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	GroupId       int64                  `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Desc          string                 `protobuf:"bytes,5,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status        bool                   `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Sort          int32                  `protobuf:"varint,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	AttrValue     []*AttrValueResponse   `protobuf:"bytes,8,rep,name=attrValue,proto3" json:"attrValue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttrResponse) Reset() {
+	*x = AttrResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrResponse) ProtoMessage() {}
+
+func (x *AttrResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrResponse.ProtoReflect.Descriptor instead.
+func (*AttrResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AttrResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttrResponse) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *AttrResponse) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AttrResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AttrResponse) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *AttrResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *AttrResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *AttrResponse) GetAttrValue() []*AttrValueResponse {
+	if x != nil {
+		return x.AttrValue
+	}
+	return nil
+}
+
+type CreateGoodsRequest struct {
+	state           protoimpl.MessageState        `protogen:"open.v1"`
+	Id              int64                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId      int32                         `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId         int32                         `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	TypeId          int64                         `protobuf:"varint,4,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	Name            string                        `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	NameAlias       string                        `protobuf:"bytes,6,opt,name=nameAlias,proto3" json:"nameAlias,omitempty"`
+	GoodsTags       string                        `protobuf:"bytes,7,opt,name=goodsTags,proto3" json:"goodsTags,omitempty"`
+	GoodsSn         string                        `protobuf:"bytes,8,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	ShopPrice       int64                         `protobuf:"varint,9,opt,name=shopPrice,proto3" json:"shopPrice,omitempty"`
+	MarketPrice     int64                         `protobuf:"varint,10,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`
+	Inventory       int64                         `protobuf:"varint,11,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	GoodsBrief      string                        `protobuf:"bytes,12,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`
+	GoodsFrontImage string                        `protobuf:"bytes,13,opt,name=goodsFrontImage,proto3" json:"goodsFrontImage,omitempty"`
+	GoodsImages     []string                      `protobuf:"bytes,14,rep,name=goodsImages,proto3" json:"goodsImages,omitempty"`
+	ShipFree        bool                          `protobuf:"varint,15,opt,name=shipFree,proto3" json:"shipFree,omitempty"`
+	ShipId          int32                         `protobuf:"varint,16,opt,name=shipId,proto3" json:"shipId,omitempty"`
+	IsNew           bool                          `protobuf:"varint,17,opt,name=isNew,proto3" json:"isNew,omitempty"`
+	IsHot           bool                          `protobuf:"varint,18,opt,name=isHot,proto3" json:"isHot,omitempty"`
+	OnSale          bool                          `protobuf:"varint,19,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	Sku             []*CreateGoodsRequestGoodsSku `protobuf:"bytes,20,rep,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateGoodsRequest) Reset() {
+	*x = CreateGoodsRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoodsRequest) ProtoMessage() {}
+
+func (x *CreateGoodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoodsRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoodsRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateGoodsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequest) GetNameAlias() string {
+	if x != nil {
+		return x.NameAlias
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequest) GetGoodsTags() string {
+	if x != nil {
+		return x.GoodsTags
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequest) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequest) GetShopPrice() int64 {
+	if x != nil {
+		return x.ShopPrice
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetMarketPrice() int64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetInventory() int64 {
+	if x != nil {
+		return x.Inventory
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetGoodsBrief() string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequest) GetGoodsFrontImage() string {
+	if x != nil {
+		return x.GoodsFrontImage
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequest) GetGoodsImages() []string {
+	if x != nil {
+		return x.GoodsImages
+	}
+	return nil
+}
+
+func (x *CreateGoodsRequest) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *CreateGoodsRequest) GetShipId() int32 {
+	if x != nil {
+		return x.ShipId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequest) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *CreateGoodsRequest) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *CreateGoodsRequest) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *CreateGoodsRequest) GetSku() []*CreateGoodsRequestGoodsSku {
+	if x != nil {
+		return x.Sku
+	}
+	return nil
+}
+
+type CreateGoodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ID            int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoodsResponse) Reset() {
+	*x = CreateGoodsResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoodsResponse) ProtoMessage() {}
+
+func (x *CreateGoodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoodsResponse.ProtoReflect.Descriptor instead.
+func (*CreateGoodsResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateGoodsResponse) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type BrandListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         int32                  `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int32                  `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandListRequest) Reset() {
+	*x = BrandListRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandListRequest) ProtoMessage() {}
+
+func (x *BrandListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandListRequest.ProtoReflect.Descriptor instead.
+func (*BrandListRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BrandListRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *BrandListRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type BrandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	IsTab         bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandRequest) Reset() {
+	*x = BrandRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandRequest) ProtoMessage() {}
+
+func (x *BrandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandRequest.ProtoReflect.Descriptor instead.
+func (*BrandRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BrandRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BrandRequest) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BrandRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *BrandRequest) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *BrandRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type BrandInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Logo          string                 `protobuf:"bytes,3,opt,name=logo,proto3" json:"logo,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	IsTab         bool                   `protobuf:"varint,5,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandInfoResponse) Reset() {
+	*x = BrandInfoResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandInfoResponse) ProtoMessage() {}
+
+func (x *BrandInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandInfoResponse.ProtoReflect.Descriptor instead.
+func (*BrandInfoResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BrandInfoResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrandInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BrandInfoResponse) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *BrandInfoResponse) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *BrandInfoResponse) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *BrandInfoResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type BrandListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Data          []*BrandInfoResponse   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrandListResponse) Reset() {
+	*x = BrandListResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrandListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrandListResponse) ProtoMessage() {}
+
+func (x *BrandListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrandListResponse.ProtoReflect.Descriptor instead.
+func (*BrandListResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BrandListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BrandListResponse) GetData() []*BrandInfoResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SkuListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []int64                `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuListRequest) Reset() {
+	*x = SkuListRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuListRequest) ProtoMessage() {}
+
+func (x *SkuListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuListRequest.ProtoReflect.Descriptor instead.
+func (*SkuListRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SkuListRequest) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type SkuInfoResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoodsId        int64                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	GoodsSn        string                 `protobuf:"bytes,3,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	GoodsName      string                 `protobuf:"bytes,4,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	SkuName        string                 `protobuf:"bytes,5,opt,name=skuName,proto3" json:"skuName,omitempty"`
+	SkuCode        string                 `protobuf:"bytes,6,opt,name=skuCode,proto3" json:"skuCode,omitempty"`
+	Price          int64                  `protobuf:"varint,7,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice int64                  `protobuf:"varint,8,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	Points         int64                  `protobuf:"varint,9,opt,name=points,proto3" json:"points,omitempty"`
+	Pic            string                 `protobuf:"bytes,10,opt,name=pic,proto3" json:"pic,omitempty"`
+	Inventory      int64                  `protobuf:"varint,11,opt,name=inventory,proto3" json:"inventory,omitempty"`         // 库存，以库存表为准
+	OnSale         bool                   `protobuf:"varint,12,opt,name=onSale,proto3" json:"onSale,omitempty"`               // 商品和 sku 都上架时为 true
+	ShipFree       bool                   `protobuf:"varint,13,opt,name=shipFree,proto3" json:"shipFree,omitempty"`           // 商品是否免运费
+	ShipId         int32                  `protobuf:"varint,14,opt,name=shipId,proto3" json:"shipId,omitempty"`               // 商品的运费模版
+	PurchaseLimit  int32                  `protobuf:"varint,15,opt,name=purchaseLimit,proto3" json:"purchaseLimit,omitempty"` // 每个用户限购数量，0 为不限购
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SkuInfoResponse) Reset() {
+	*x = SkuInfoResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuInfoResponse) ProtoMessage() {}
+
+func (x *SkuInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuInfoResponse.ProtoReflect.Descriptor instead.
+func (*SkuInfoResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SkuInfoResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SkuInfoResponse) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *SkuInfoResponse) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *SkuInfoResponse) GetGoodsName() string {
+	if x != nil {
+		return x.GoodsName
+	}
+	return ""
+}
+
+func (x *SkuInfoResponse) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *SkuInfoResponse) GetSkuCode() string {
+	if x != nil {
+		return x.SkuCode
+	}
+	return ""
+}
+
+func (x *SkuInfoResponse) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuInfoResponse) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *SkuInfoResponse) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *SkuInfoResponse) GetPic() string {
+	if x != nil {
+		return x.Pic
+	}
+	return ""
+}
+
+func (x *SkuInfoResponse) GetInventory() int64 {
+	if x != nil {
+		return x.Inventory
+	}
+	return 0
+}
+
+func (x *SkuInfoResponse) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *SkuInfoResponse) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *SkuInfoResponse) GetShipId() int32 {
+	if x != nil {
+		return x.ShipId
+	}
+	return 0
+}
+
+func (x *SkuInfoResponse) GetPurchaseLimit() int32 {
+	if x != nil {
+		return x.PurchaseLimit
+	}
+	return 0
+}
+
+type SkuPurchaseLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	PurchaseLimit int32                  `protobuf:"varint,2,opt,name=purchaseLimit,proto3" json:"purchaseLimit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuPurchaseLimitRequest) Reset() {
+	*x = SkuPurchaseLimitRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuPurchaseLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuPurchaseLimitRequest) ProtoMessage() {}
+
+func (x *SkuPurchaseLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuPurchaseLimitRequest.ProtoReflect.Descriptor instead.
+func (*SkuPurchaseLimitRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SkuPurchaseLimitRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuPurchaseLimitRequest) GetPurchaseLimit() int32 {
+	if x != nil {
+		return x.PurchaseLimit
+	}
+	return 0
+}
+
+type SkuListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*SkuInfoResponse     `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuListResponse) Reset() {
+	*x = SkuListResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuListResponse) ProtoMessage() {}
+
+func (x *SkuListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuListResponse.ProtoReflect.Descriptor instead.
+func (*SkuListResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SkuListResponse) GetList() []*SkuInfoResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GoodsTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TypeCode      string                 `protobuf:"bytes,3,opt,name=typeCode,proto3" json:"typeCode,omitempty"`
+	NameAlias     string                 `protobuf:"bytes,4,opt,name=nameAlias,proto3" json:"nameAlias,omitempty"`
+	IsVirtual     bool                   `protobuf:"varint,5,opt,name=isVirtual,proto3" json:"isVirtual,omitempty"`
+	Desc          string                 `protobuf:"bytes,6,opt,name=desc,proto3" json:"desc,omitempty"`
+	Sort          int32                  `protobuf:"varint,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	BrandIds      string                 `protobuf:"bytes,8,opt,name=brandIds,proto3" json:"brandIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsTypeRequest) Reset() {
+	*x = GoodsTypeRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsTypeRequest) ProtoMessage() {}
+
+func (x *GoodsTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsTypeRequest.ProtoReflect.Descriptor instead.
+func (*GoodsTypeRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GoodsTypeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsTypeRequest) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *GoodsTypeRequest) GetNameAlias() string {
+	if x != nil {
+		return x.NameAlias
+	}
+	return ""
+}
+
+func (x *GoodsTypeRequest) GetIsVirtual() bool {
+	if x != nil {
+		return x.IsVirtual
+	}
+	return false
+}
+
+func (x *GoodsTypeRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *GoodsTypeRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *GoodsTypeRequest) GetBrandIds() string {
+	if x != nil {
+		return x.BrandIds
+	}
+	return ""
+}
+
+type GoodsTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsTypeResponse) Reset() {
+	*x = GoodsTypeResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsTypeResponse) ProtoMessage() {}
+
+func (x *GoodsTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsTypeResponse.ProtoReflect.Descriptor instead.
+func (*GoodsTypeResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GoodsTypeResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 修改商品类型，没有传入的字段保持不变，传入 brandIds 时重新绑定品牌
+type UpdateGoodsTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	TypeCode      *string                `protobuf:"bytes,3,opt,name=typeCode,proto3,oneof" json:"typeCode,omitempty"`
+	NameAlias     *string                `protobuf:"bytes,4,opt,name=nameAlias,proto3,oneof" json:"nameAlias,omitempty"`
+	IsVirtual     *bool                  `protobuf:"varint,5,opt,name=isVirtual,proto3,oneof" json:"isVirtual,omitempty"`
+	Desc          *string                `protobuf:"bytes,6,opt,name=desc,proto3,oneof" json:"desc,omitempty"`
+	Sort          *int32                 `protobuf:"varint,7,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	BrandIds      *string                `protobuf:"bytes,8,opt,name=brandIds,proto3,oneof" json:"brandIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGoodsTypeRequest) Reset() {
+	*x = UpdateGoodsTypeRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGoodsTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGoodsTypeRequest) ProtoMessage() {}
+
+func (x *UpdateGoodsTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGoodsTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGoodsTypeRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateGoodsTypeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGoodsTypeRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGoodsTypeRequest) GetTypeCode() string {
+	if x != nil && x.TypeCode != nil {
+		return *x.TypeCode
+	}
+	return ""
+}
+
+func (x *UpdateGoodsTypeRequest) GetNameAlias() string {
+	if x != nil && x.NameAlias != nil {
+		return *x.NameAlias
+	}
+	return ""
+}
+
+func (x *UpdateGoodsTypeRequest) GetIsVirtual() bool {
+	if x != nil && x.IsVirtual != nil {
+		return *x.IsVirtual
+	}
+	return false
+}
+
+func (x *UpdateGoodsTypeRequest) GetDesc() string {
+	if x != nil && x.Desc != nil {
+		return *x.Desc
+	}
+	return ""
+}
+
+func (x *UpdateGoodsTypeRequest) GetSort() int32 {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return 0
+}
+
+func (x *UpdateGoodsTypeRequest) GetBrandIds() string {
+	if x != nil && x.BrandIds != nil {
+		return *x.BrandIds
+	}
+	return ""
+}
+
+type GoodsTypeInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TypeCode      string                 `protobuf:"bytes,3,opt,name=typeCode,proto3" json:"typeCode,omitempty"`
+	NameAlias     string                 `protobuf:"bytes,4,opt,name=nameAlias,proto3" json:"nameAlias,omitempty"`
+	IsVirtual     bool                   `protobuf:"varint,5,opt,name=isVirtual,proto3" json:"isVirtual,omitempty"`
+	Desc          string                 `protobuf:"bytes,6,opt,name=desc,proto3" json:"desc,omitempty"`
+	Sort          int32                  `protobuf:"varint,7,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsTypeInfoResponse) Reset() {
+	*x = GoodsTypeInfoResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsTypeInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsTypeInfoResponse) ProtoMessage() {}
+
+func (x *GoodsTypeInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsTypeInfoResponse.ProtoReflect.Descriptor instead.
+func (*GoodsTypeInfoResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GoodsTypeInfoResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsTypeInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsTypeInfoResponse) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *GoodsTypeInfoResponse) GetNameAlias() string {
+	if x != nil {
+		return x.NameAlias
+	}
+	return ""
+}
+
+func (x *GoodsTypeInfoResponse) GetIsVirtual() bool {
+	if x != nil {
+		return x.IsVirtual
+	}
+	return false
+}
+
+func (x *GoodsTypeInfoResponse) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *GoodsTypeInfoResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type GoodsTypeListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         int32                  `protobuf:"varint,1,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int32                  `protobuf:"varint,2,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsTypeListRequest) Reset() {
+	*x = GoodsTypeListRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsTypeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsTypeListRequest) ProtoMessage() {}
+
+func (x *GoodsTypeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsTypeListRequest.ProtoReflect.Descriptor instead.
+func (*GoodsTypeListRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GoodsTypeListRequest) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *GoodsTypeListRequest) GetPagePerNums() int32 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+type GoodsTypeListResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Total         int64                    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List          []*GoodsTypeInfoResponse `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsTypeListResponse) Reset() {
+	*x = GoodsTypeListResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsTypeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsTypeListResponse) ProtoMessage() {}
+
+func (x *GoodsTypeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsTypeListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsTypeListResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GoodsTypeListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GoodsTypeListResponse) GetList() []*GoodsTypeInfoResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DeleteGoodsTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGoodsTypeRequest) Reset() {
+	*x = DeleteGoodsTypeRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGoodsTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGoodsTypeRequest) ProtoMessage() {}
+
+func (x *DeleteGoodsTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGoodsTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGoodsTypeRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteGoodsTypeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GoodsTypeTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsTypeTemplateRequest) Reset() {
+	*x = GoodsTypeTemplateRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsTypeTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsTypeTemplateRequest) ProtoMessage() {}
+
+func (x *GoodsTypeTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsTypeTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GoodsTypeTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GoodsTypeTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SpecificationInfoResponse struct {
+	state              protoimpl.MessageState        `protogen:"open.v1"`
+	Id                 int64                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId             int64                         `protobuf:"varint,2,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	Name               string                        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Sort               int32                         `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Status             bool                          `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	IsSku              bool                          `protobuf:"varint,6,opt,name=isSku,proto3" json:"isSku,omitempty"`
+	IsSelect           bool                          `protobuf:"varint,7,opt,name=isSelect,proto3" json:"isSelect,omitempty"`
+	SpecificationValue []*SpecificationValueResponse `protobuf:"bytes,8,rep,name=specificationValue,proto3" json:"specificationValue,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SpecificationInfoResponse) Reset() {
+	*x = SpecificationInfoResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecificationInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecificationInfoResponse) ProtoMessage() {}
+
+func (x *SpecificationInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecificationInfoResponse.ProtoReflect.Descriptor instead.
+func (*SpecificationInfoResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SpecificationInfoResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SpecificationInfoResponse) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *SpecificationInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpecificationInfoResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *SpecificationInfoResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *SpecificationInfoResponse) GetIsSku() bool {
+	if x != nil {
+		return x.IsSku
+	}
+	return false
+}
+
+func (x *SpecificationInfoResponse) GetIsSelect() bool {
+	if x != nil {
+		return x.IsSelect
+	}
+	return false
+}
+
+func (x *SpecificationInfoResponse) GetSpecificationValue() []*SpecificationValueResponse {
+	if x != nil {
+		return x.SpecificationValue
+	}
+	return nil
+}
+
+type AttrGroupInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeId        int64                  `protobuf:"varint,2,opt,name=typeId,proto3" json:"typeId,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Desc          string                 `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status        bool                   `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	Attr          []*AttrResponse        `protobuf:"bytes,7,rep,name=attr,proto3" json:"attr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttrGroupInfoResponse) Reset() {
+	*x = AttrGroupInfoResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttrGroupInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttrGroupInfoResponse) ProtoMessage() {}
+
+func (x *AttrGroupInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttrGroupInfoResponse.ProtoReflect.Descriptor instead.
+func (*AttrGroupInfoResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AttrGroupInfoResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttrGroupInfoResponse) GetTypeId() int64 {
+	if x != nil {
+		return x.TypeId
+	}
+	return 0
+}
+
+func (x *AttrGroupInfoResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AttrGroupInfoResponse) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *AttrGroupInfoResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+func (x *AttrGroupInfoResponse) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *AttrGroupInfoResponse) GetAttr() []*AttrResponse {
+	if x != nil {
+		return x.Attr
+	}
+	return nil
+}
+
+// 商品类型模板，后台编辑商品时根据选择的商品类型加载
+type GoodsTypeTemplateResponse struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Info           *GoodsTypeInfoResponse       `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Brands         []*BrandInfoResponse         `protobuf:"bytes,2,rep,name=brands,proto3" json:"brands,omitempty"`
+	Specifications []*SpecificationInfoResponse `protobuf:"bytes,3,rep,name=specifications,proto3" json:"specifications,omitempty"`
+	AttrGroups     []*AttrGroupInfoResponse     `protobuf:"bytes,4,rep,name=attrGroups,proto3" json:"attrGroups,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GoodsTypeTemplateResponse) Reset() {
+	*x = GoodsTypeTemplateResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsTypeTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsTypeTemplateResponse) ProtoMessage() {}
+
+func (x *GoodsTypeTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsTypeTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GoodsTypeTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GoodsTypeTemplateResponse) GetInfo() *GoodsTypeInfoResponse {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *GoodsTypeTemplateResponse) GetBrands() []*BrandInfoResponse {
+	if x != nil {
+		return x.Brands
+	}
+	return nil
+}
+
+func (x *GoodsTypeTemplateResponse) GetSpecifications() []*SpecificationInfoResponse {
+	if x != nil {
+		return x.Specifications
+	}
+	return nil
+}
+
+func (x *GoodsTypeTemplateResponse) GetAttrGroups() []*AttrGroupInfoResponse {
+	if x != nil {
+		return x.AttrGroups
+	}
+	return nil
+}
+
+// 根据设计的 mapping 结构，来构建查询的时候所需的字段
+type GoodsFilterRequest struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Keywords      string                          `protobuf:"bytes,1,opt,name=keywords,proto3" json:"keywords,omitempty"`
+	CategoryId    int32                           `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId       int32                           `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	MinPrice      int64                           `protobuf:"varint,4,opt,name=minPrice,proto3" json:"minPrice,omitempty"`
+	MaxPrice      int64                           `protobuf:"varint,5,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	IsHot         bool                            `protobuf:"varint,6,opt,name=isHot,proto3" json:"isHot,omitempty"`
+	IsNew         bool                            `protobuf:"varint,7,opt,name=isNew,proto3" json:"isNew,omitempty"`
+	IsTab         bool                            `protobuf:"varint,8,opt,name=isTab,proto3" json:"isTab,omitempty"`
+	ClickNum      int64                           `protobuf:"varint,9,opt,name=clickNum,proto3" json:"clickNum,omitempty"`
+	SoldNum       int64                           `protobuf:"varint,10,opt,name=soldNum,proto3" json:"soldNum,omitempty"`
+	FavNum        int64                           `protobuf:"varint,11,opt,name=favNum,proto3" json:"favNum,omitempty"`
+	Pages         int64                           `protobuf:"varint,12,opt,name=pages,proto3" json:"pages,omitempty"`
+	PagePerNums   int64                           `protobuf:"varint,13,opt,name=pagePerNums,proto3" json:"pagePerNums,omitempty"`
+	Id            int64                           `protobuf:"varint,14,opt,name=id,proto3" json:"id,omitempty"`
+	Attrs         []*GoodsFilterRequestAttrFilter `protobuf:"bytes,15,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsFilterRequest) Reset() {
+	*x = GoodsFilterRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsFilterRequest) ProtoMessage() {}
+
+func (x *GoodsFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsFilterRequest.ProtoReflect.Descriptor instead.
+func (*GoodsFilterRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GoodsFilterRequest) GetKeywords() string {
+	if x != nil {
+		return x.Keywords
+	}
+	return ""
+}
+
+func (x *GoodsFilterRequest) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetMinPrice() int64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetMaxPrice() int64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *GoodsFilterRequest) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *GoodsFilterRequest) GetIsTab() bool {
+	if x != nil {
+		return x.IsTab
+	}
+	return false
+}
+
+func (x *GoodsFilterRequest) GetClickNum() int64 {
+	if x != nil {
+		return x.ClickNum
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetSoldNum() int64 {
+	if x != nil {
+		return x.SoldNum
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetFavNum() int64 {
+	if x != nil {
+		return x.FavNum
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetPages() int64 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetPagePerNums() int64 {
+	if x != nil {
+		return x.PagePerNums
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequest) GetAttrs() []*GoodsFilterRequestAttrFilter {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+type GoodsInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int32                  `protobuf:"varint,2,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	BrandId       int32                  `protobuf:"varint,3,opt,name=brandId,proto3" json:"brandId,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	GoodsSn       string                 `protobuf:"bytes,5,opt,name=goodsSn,proto3" json:"goodsSn,omitempty"`
+	ClickNum      int64                  `protobuf:"varint,6,opt,name=clickNum,proto3" json:"clickNum,omitempty"`
+	SoldNum       int64                  `protobuf:"varint,7,opt,name=soldNum,proto3" json:"soldNum,omitempty"`
+	FavNum        int64                  `protobuf:"varint,8,opt,name=favNum,proto3" json:"favNum,omitempty"`
+	MarketPrice   int64                  `protobuf:"varint,9,opt,name=marketPrice,proto3" json:"marketPrice,omitempty"`
+	GoodsBrief    string                 `protobuf:"bytes,10,opt,name=goodsBrief,proto3" json:"goodsBrief,omitempty"`
+	GoodsDesc     string                 `protobuf:"bytes,11,opt,name=goodsDesc,proto3" json:"goodsDesc,omitempty"`
+	ShipFree      bool                   `protobuf:"varint,12,opt,name=shipFree,proto3" json:"shipFree,omitempty"`
+	Images        string                 `protobuf:"bytes,13,opt,name=images,proto3" json:"images,omitempty"`
+	GoodsImages   []string               `protobuf:"bytes,14,rep,name=goodsImages,proto3" json:"goodsImages,omitempty"`
+	IsNew         bool                   `protobuf:"varint,15,opt,name=isNew,proto3" json:"isNew,omitempty"`
+	IsHot         bool                   `protobuf:"varint,16,opt,name=isHot,proto3" json:"isHot,omitempty"`
+	OnSale        bool                   `protobuf:"varint,17,opt,name=onSale,proto3" json:"onSale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsInfoResponse) Reset() {
+	*x = GoodsInfoResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsInfoResponse) ProtoMessage() {}
+
+func (x *GoodsInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsInfoResponse.ProtoReflect.Descriptor instead.
+func (*GoodsInfoResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GoodsInfoResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetBrandId() int32 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetGoodsSn() string {
+	if x != nil {
+		return x.GoodsSn
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetClickNum() int64 {
+	if x != nil {
+		return x.ClickNum
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetSoldNum() int64 {
+	if x != nil {
+		return x.SoldNum
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetFavNum() int64 {
+	if x != nil {
+		return x.FavNum
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetMarketPrice() int64 {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return 0
+}
+
+func (x *GoodsInfoResponse) GetGoodsBrief() string {
+	if x != nil {
+		return x.GoodsBrief
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetGoodsDesc() string {
+	if x != nil {
+		return x.GoodsDesc
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetShipFree() bool {
+	if x != nil {
+		return x.ShipFree
+	}
+	return false
+}
+
+func (x *GoodsInfoResponse) GetImages() string {
+	if x != nil {
+		return x.Images
+	}
+	return ""
+}
+
+func (x *GoodsInfoResponse) GetGoodsImages() []string {
+	if x != nil {
+		return x.GoodsImages
+	}
+	return nil
+}
+
+func (x *GoodsInfoResponse) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *GoodsInfoResponse) GetIsHot() bool {
+	if x != nil {
+		return x.IsHot
+	}
+	return false
+}
+
+func (x *GoodsInfoResponse) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+type GoodsListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List          []*GoodsInfoResponse   `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsListResponse) Reset() {
+	*x = GoodsListResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsListResponse) ProtoMessage() {}
+
+func (x *GoodsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsListResponse.ProtoReflect.Descriptor instead.
+func (*GoodsListResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GoodsListResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GoodsListResponse) GetList() []*GoodsInfoResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type GoodInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodInfoRequest) Reset() {
+	*x = GoodInfoRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodInfoRequest) ProtoMessage() {}
+
+func (x *GoodInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodInfoRequest.ProtoReflect.Descriptor instead.
+func (*GoodInfoRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GoodInfoRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BatchGoodsIdInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []int64                `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGoodsIdInfo) Reset() {
+	*x = BatchGoodsIdInfo{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGoodsIdInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGoodsIdInfo) ProtoMessage() {}
+
+func (x *BatchGoodsIdInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGoodsIdInfo.ProtoReflect.Descriptor instead.
+func (*BatchGoodsIdInfo) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchGoodsIdInfo) GetId() []int64 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// 收藏数变化的事件，由购物车服务的收藏夹产生
+type FavEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	GoodsId       int64                  `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavEvent) Reset() {
+	*x = FavEvent{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavEvent) ProtoMessage() {}
+
+func (x *FavEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavEvent.ProtoReflect.Descriptor instead.
+func (*FavEvent) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FavEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *FavEvent) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *FavEvent) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type FavEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*FavEvent            `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavEventsRequest) Reset() {
+	*x = FavEventsRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavEventsRequest) ProtoMessage() {}
+
+func (x *FavEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavEventsRequest.ProtoReflect.Descriptor instead.
+func (*FavEventsRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FavEventsRequest) GetEvents() []*FavEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type UploadMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadMediaRequest) Reset() {
+	*x = UploadMediaRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadMediaRequest) ProtoMessage() {}
+
+func (x *UploadMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadMediaRequest.ProtoReflect.Descriptor instead.
+func (*UploadMediaRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UploadMediaRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type MediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbUrl      string                 `protobuf:"bytes,3,opt,name=thumbUrl,proto3" json:"thumbUrl,omitempty"`
+	Hash          string                 `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	ContentType   string                 `protobuf:"bytes,5,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Width         int32                  `protobuf:"varint,7,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaResponse) Reset() {
+	*x = MediaResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaResponse) ProtoMessage() {}
+
+func (x *MediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaResponse.ProtoReflect.Descriptor instead.
+func (*MediaResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MediaResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MediaResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MediaResponse) GetThumbUrl() string {
+	if x != nil {
+		return x.ThumbUrl
+	}
+	return ""
+}
+
+func (x *MediaResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *MediaResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MediaResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MediaResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MediaResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type MediaGCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GraceSeconds  int64                  `protobuf:"varint,1,opt,name=graceSeconds,proto3" json:"graceSeconds,omitempty"` // 上传后多长时间内的图片不清理，默认 24 小时
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaGCRequest) Reset() {
+	*x = MediaGCRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaGCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaGCRequest) ProtoMessage() {}
+
+func (x *MediaGCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaGCRequest.ProtoReflect.Descriptor instead.
+func (*MediaGCRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MediaGCRequest) GetGraceSeconds() int64 {
+	if x != nil {
+		return x.GraceSeconds
+	}
+	return 0
+}
+
+type MediaGCResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int64                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaGCResponse) Reset() {
+	*x = MediaGCResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaGCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaGCResponse) ProtoMessage() {}
+
+func (x *MediaGCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaGCResponse.ProtoReflect.Descriptor instead.
+func (*MediaGCResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MediaGCResponse) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type ChangeSkuPriceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkuId          int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	Price          int64                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice int64                  `protobuf:"varint,3,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	EffectiveFrom  int64                  `protobuf:"varint,4,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`   // 开始生效的时间戳，为 0 或早于当前时间时立即生效
+	EffectiveUntil int64                  `protobuf:"varint,5,opt,name=effectiveUntil,proto3" json:"effectiveUntil,omitempty"` // 结束生效的时间戳，为 0 时一直有效
+	Remark         string                 `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeSkuPriceRequest) Reset() {
+	*x = ChangeSkuPriceRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSkuPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSkuPriceRequest) ProtoMessage() {}
+
+func (x *ChangeSkuPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSkuPriceRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSkuPriceRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ChangeSkuPriceRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ChangeSkuPriceRequest) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ChangeSkuPriceRequest) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *ChangeSkuPriceRequest) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *ChangeSkuPriceRequest) GetEffectiveUntil() int64 {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return 0
+}
+
+func (x *ChangeSkuPriceRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type SkuPriceResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SkuId          int64                  `protobuf:"varint,2,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	Price          int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice int64                  `protobuf:"varint,4,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	EffectiveFrom  int64                  `protobuf:"varint,5,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`
+	EffectiveUntil int64                  `protobuf:"varint,6,opt,name=effectiveUntil,proto3" json:"effectiveUntil,omitempty"`
+	Status         int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"` // 0 等待生效 1 已生效 2 已结束
+	Remark         string                 `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SkuPriceResponse) Reset() {
+	*x = SkuPriceResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuPriceResponse) ProtoMessage() {}
+
+func (x *SkuPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuPriceResponse.ProtoReflect.Descriptor instead.
+func (*SkuPriceResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SkuPriceResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SkuPriceResponse) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuPriceResponse) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SkuPriceResponse) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *SkuPriceResponse) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *SkuPriceResponse) GetEffectiveUntil() int64 {
+	if x != nil {
+		return x.EffectiveUntil
+	}
+	return 0
+}
+
+func (x *SkuPriceResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SkuPriceResponse) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *SkuPriceResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SkuPriceAtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuPriceAtRequest) Reset() {
+	*x = SkuPriceAtRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuPriceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuPriceAtRequest) ProtoMessage() {}
+
+func (x *SkuPriceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuPriceAtRequest.ProtoReflect.Descriptor instead.
+func (*SkuPriceAtRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SkuPriceAtRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuPriceAtRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SkuPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         int64                  `protobuf:"varint,1,opt,name=skuId,proto3" json:"skuId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuPriceHistoryRequest) Reset() {
+	*x = SkuPriceHistoryRequest{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuPriceHistoryRequest) ProtoMessage() {}
+
+func (x *SkuPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*SkuPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SkuPriceHistoryRequest) GetSkuId() int64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+type SkuPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*SkuPriceResponse    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkuPriceHistoryResponse) Reset() {
+	*x = SkuPriceHistoryResponse{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuPriceHistoryResponse) ProtoMessage() {}
+
+func (x *SkuPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*SkuPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SkuPriceHistoryResponse) GetList() []*SkuPriceResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 根据商品类型 选择商品规格信息并选择
+// 商品 sku 属性值 里面有规格的ID和属性的ID，分别是几组信息
+type CreateGoodsRequestGoodsSku struct {
+	state             protoimpl.MessageState                     `protogen:"open.v1"`
+	Id                int64                                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoodsId           int64                                      `protobuf:"varint,2,opt,name=goodsId,proto3" json:"goodsId,omitempty"`
+	SkuName           string                                     `protobuf:"bytes,3,opt,name=skuName,proto3" json:"skuName,omitempty"`
+	Code              string                                     `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	BarCode           string                                     `protobuf:"bytes,5,opt,name=barCode,proto3" json:"barCode,omitempty"`
+	Price             int64                                      `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	PromotionPrice    int64                                      `protobuf:"varint,7,opt,name=promotionPrice,proto3" json:"promotionPrice,omitempty"`
+	Points            int64                                      `protobuf:"varint,8,opt,name=points,proto3" json:"points,omitempty"`
+	Image             string                                     `protobuf:"bytes,9,opt,name=image,proto3" json:"image,omitempty"`
+	Sort              int32                                      `protobuf:"varint,10,opt,name=sort,proto3" json:"sort,omitempty"`
+	Inventory         int64                                      `protobuf:"varint,11,opt,name=inventory,proto3" json:"inventory,omitempty"` // sku 库存
+	SpecificationInfo []*CreateGoodsRequestGoodsSkuSpecification `protobuf:"bytes,12,rep,name=specificationInfo,proto3" json:"specificationInfo,omitempty"`
+	GroupAttrInfo     []*CreateGoodsRequestGoodsSkuGroupAttr     `protobuf:"bytes,13,rep,name=groupAttrInfo,proto3" json:"groupAttrInfo,omitempty"`
+	PurchaseLimit     int32                                      `protobuf:"varint,14,opt,name=purchaseLimit,proto3" json:"purchaseLimit,omitempty"` // 每个用户限购数量，0 为不限购
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateGoodsRequestGoodsSku) Reset() {
+	*x = CreateGoodsRequestGoodsSku{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoodsRequestGoodsSku) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoodsRequestGoodsSku) ProtoMessage() {}
+
+func (x *CreateGoodsRequestGoodsSku) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoodsRequestGoodsSku.ProtoReflect.Descriptor instead.
+func (*CreateGoodsRequestGoodsSku) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetGoodsId() int64 {
+	if x != nil {
+		return x.GoodsId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetBarCode() string {
+	if x != nil {
+		return x.BarCode
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetPromotionPrice() int64 {
+	if x != nil {
+		return x.PromotionPrice
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetInventory() int64 {
+	if x != nil {
+		return x.Inventory
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetSpecificationInfo() []*CreateGoodsRequestGoodsSkuSpecification {
+	if x != nil {
+		return x.SpecificationInfo
+	}
+	return nil
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetGroupAttrInfo() []*CreateGoodsRequestGoodsSkuGroupAttr {
+	if x != nil {
+		return x.GroupAttrInfo
+	}
+	return nil
+}
+
+func (x *CreateGoodsRequestGoodsSku) GetPurchaseLimit() int32 {
+	if x != nil {
+		return x.PurchaseLimit
+	}
+	return 0
+}
+
+// 规格
+type CreateGoodsRequestGoodsSkuSpecification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SId           int64                  `protobuf:"varint,1,opt,name=sId,proto3" json:"sId,omitempty"`
+	VId           int64                  `protobuf:"varint,2,opt,name=vId,proto3" json:"vId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoodsRequestGoodsSkuSpecification) Reset() {
+	*x = CreateGoodsRequestGoodsSkuSpecification{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoodsRequestGoodsSkuSpecification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoodsRequestGoodsSkuSpecification) ProtoMessage() {}
+
+func (x *CreateGoodsRequestGoodsSkuSpecification) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoodsRequestGoodsSkuSpecification.ProtoReflect.Descriptor instead.
+func (*CreateGoodsRequestGoodsSkuSpecification) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{16, 0, 0}
+}
+
+func (x *CreateGoodsRequestGoodsSkuSpecification) GetSId() int64 {
+	if x != nil {
+		return x.SId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSkuSpecification) GetVId() int64 {
+	if x != nil {
+		return x.VId
+	}
+	return 0
+}
+
+// 属性组
+type CreateGoodsRequestGoodsSkuGroupAttr struct {
+	state         protoimpl.MessageState                     `protogen:"open.v1"`
+	GroupId       int64                                      `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName     string                                     `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	AttrInfo      []*CreateGoodsRequestGoodsSkuGroupAttrAttr `protobuf:"bytes,3,rep,name=attrInfo,proto3" json:"attrInfo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttr) Reset() {
+	*x = CreateGoodsRequestGoodsSkuGroupAttr{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoodsRequestGoodsSkuGroupAttr) ProtoMessage() {}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoodsRequestGoodsSkuGroupAttr.ProtoReflect.Descriptor instead.
+func (*CreateGoodsRequestGoodsSkuGroupAttr) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{16, 0, 1}
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttr) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttr) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttr) GetAttrInfo() []*CreateGoodsRequestGoodsSkuGroupAttrAttr {
+	if x != nil {
+		return x.AttrInfo
+	}
+	return nil
+}
+
+type CreateGoodsRequestGoodsSkuGroupAttrAttr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttrId        int64                  `protobuf:"varint,1,opt,name=attrId,proto3" json:"attrId,omitempty"`
+	AttrName      string                 `protobuf:"bytes,2,opt,name=attrName,proto3" json:"attrName,omitempty"`
+	AttrValueId   int64                  `protobuf:"varint,3,opt,name=attrValueId,proto3" json:"attrValueId,omitempty"`
+	AttrValueName string                 `protobuf:"bytes,4,opt,name=attrValueName,proto3" json:"attrValueName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttrAttr) Reset() {
+	*x = CreateGoodsRequestGoodsSkuGroupAttrAttr{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttrAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoodsRequestGoodsSkuGroupAttrAttr) ProtoMessage() {}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttrAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoodsRequestGoodsSkuGroupAttrAttr.ProtoReflect.Descriptor instead.
+func (*CreateGoodsRequestGoodsSkuGroupAttrAttr) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{16, 0, 1, 0}
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttrAttr) GetAttrId() int64 {
+	if x != nil {
+		return x.AttrId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttrAttr) GetAttrName() string {
+	if x != nil {
+		return x.AttrName
+	}
+	return ""
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttrAttr) GetAttrValueId() int64 {
+	if x != nil {
+		return x.AttrValueId
+	}
+	return 0
+}
+
+func (x *CreateGoodsRequestGoodsSkuGroupAttrAttr) GetAttrValueName() string {
+	if x != nil {
+		return x.AttrValueName
+	}
+	return ""
+}
+
+// 属性筛选，同一属性下的多个值为或关系，不同属性之间为且关系
+type GoodsFilterRequestAttrFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttrId        int64                  `protobuf:"varint,1,opt,name=attrId,proto3" json:"attrId,omitempty"`
+	ValueIds      []int64                `protobuf:"varint,2,rep,packed,name=valueIds,proto3" json:"valueIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoodsFilterRequestAttrFilter) Reset() {
+	*x = GoodsFilterRequestAttrFilter{}
+	mi := &file_service_goods_v1_goods_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoodsFilterRequestAttrFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoodsFilterRequestAttrFilter) ProtoMessage() {}
+
+func (x *GoodsFilterRequestAttrFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_service_goods_v1_goods_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoodsFilterRequestAttrFilter.ProtoReflect.Descriptor instead.
+func (*GoodsFilterRequestAttrFilter) Descriptor() ([]byte, []int) {
+	return file_service_goods_v1_goods_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *GoodsFilterRequestAttrFilter) GetAttrId() int64 {
+	if x != nil {
+		return x.AttrId
+	}
+	return 0
+}
+
+func (x *GoodsFilterRequestAttrFilter) GetValueIds() []int64 {
+	if x != nil {
+		return x.ValueIds
+	}
+	return nil
+}
+
+var File_service_goods_v1_goods_proto protoreflect.FileDescriptor
+
+const file_service_goods_v1_goods_proto_rawDesc = "" +
+	"\n" +
+	"\x1cservice/goods/v1/goods.proto\x12\bgoods.v1\x1a\x17validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xa1\x01\n" +
+	"\x13CategoryInfoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x0eparentCategory\x18\x03 \x01(\x05R\x0eparentCategory\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x14\n" +
+	"\x05isTab\x18\x05 \x01(\bR\x05isTab\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"\xa2\x01\n" +
+	"\x14CategoryInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12&\n" +
+	"\x0eparentCategory\x18\x03 \x01(\x05R\x0eparentCategory\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x14\n" +
+	"\x05isTab\x18\x05 \x01(\bR\x05isTab\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"\x8f\x01\n" +
+	"\x17SubCategoryListResponse\x122\n" +
+	"\x04info\x18\x01 \x01(\v2\x1e.goods.v1.CategoryInfoResponseR\x04info\x12@\n" +
+	"\vsubCategory\x18\x02 \x03(\v2\x1e.goods.v1.CategoryInfoResponseR\vsubCategory\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"2\n" +
+	"\x14CategoryListResponse\x12\x1a\n" +
+	"\bjsonData\x18\x01 \x01(\tR\bjsonData\";\n" +
+	"\x13CategoryListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\"x\n" +
+	"\x12SpecificationValue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06attrId\x18\x02 \x01(\x03R\x06attrId\x12\x1d\n" +
+	"\x05value\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05value\x12\x1b\n" +
+	"\x04sort\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04sort\"n\n" +
+	"\x1aSpecificationValueResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06attrId\x18\x02 \x01(\x03R\x06attrId\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\"\x99\x02\n" +
+	"\x14SpecificationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\x06typeId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x06typeId\x12\x1b\n" +
+	"\x04name\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x02R\x04name\x12\x1b\n" +
+	"\x04sort\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04sort\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\bR\x06status\x12\x14\n" +
+	"\x05isSku\x18\x06 \x01(\bR\x05isSku\x12\x1a\n" +
+	"\bisSelect\x18\a \x01(\bR\bisSelect\x12L\n" +
+	"\x12specificationValue\x18\b \x03(\v2\x1c.goods.v1.SpecificationValueR\x12specificationValue\"'\n" +
+	"\x15SpecificationResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xab\x01\n" +
+	"\x10AttrGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\x06typeId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x06typeId\x12\x1d\n" +
+	"\x05title\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05title\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\bR\x06status\x12\x1b\n" +
+	"\x04sort\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04sort\"\x91\x01\n" +
+	"\x11AttrGroupResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06typeId\x18\x02 \x01(\x03R\x06typeId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\bR\x06status\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"|\n" +
+	"\x10AttrValueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06attrId\x18\x02 \x01(\x03R\x06attrId\x12!\n" +
+	"\agroupId\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\agroupId\x12\x1d\n" +
+	"\x05value\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x05value\"\x83\x02\n" +
+	"\vAttrRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\x06typeId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x06typeId\x12!\n" +
+	"\agroupId\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\agroupId\x12\x1d\n" +
+	"\x05title\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05title\x12\x12\n" +
+	"\x04desc\x18\x05 \x01(\tR\x04desc\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\bR\x06status\x12\x1b\n" +
+	"\x04sort\x18\a \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\x04sort\x128\n" +
+	"\tattrValue\x18\b \x03(\v2\x1a.goods.v1.AttrValueRequestR\tattrValue\"k\n" +
+	"\x11AttrValueResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06attrId\x18\x02 \x01(\x03R\x06attrId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\x03R\agroupId\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\tR\x05value\"\xe1\x01\n" +
+	"\fAttrResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06typeId\x18\x02 \x01(\x03R\x06typeId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\x03R\agroupId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04desc\x18\x05 \x01(\tR\x04desc\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\bR\x06status\x12\x12\n" +
+	"\x04sort\x18\a \x01(\x05R\x04sort\x129\n" +
+	"\tattrValue\x18\b \x03(\v2\x1b.goods.v1.AttrValueResponseR\tattrValue\"\x9f\f\n" +
+	"\x12CreateGoodsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\n" +
+	"categoryId\x12!\n" +
+	"\abrandId\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x01R\abrandId\x12\x1f\n" +
+	"\x06typeId\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x06typeId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnameAlias\x18\x06 \x01(\tR\tnameAlias\x12\x1c\n" +
+	"\tgoodsTags\x18\a \x01(\tR\tgoodsTags\x12\x18\n" +
+	"\agoodsSn\x18\b \x01(\tR\agoodsSn\x12\x1c\n" +
+	"\tshopPrice\x18\t \x01(\x03R\tshopPrice\x12 \n" +
+	"\vmarketPrice\x18\n" +
+	" \x01(\x03R\vmarketPrice\x12\x1c\n" +
+	"\tinventory\x18\v \x01(\x03R\tinventory\x12\x1e\n" +
+	"\n" +
+	"goodsBrief\x18\f \x01(\tR\n" +
+	"goodsBrief\x12(\n" +
+	"\x0fgoodsFrontImage\x18\r \x01(\tR\x0fgoodsFrontImage\x12 \n" +
+	"\vgoodsImages\x18\x0e \x03(\tR\vgoodsImages\x12\x1a\n" +
+	"\bshipFree\x18\x0f \x01(\bR\bshipFree\x12\x16\n" +
+	"\x06shipId\x18\x10 \x01(\x05R\x06shipId\x12\x14\n" +
+	"\x05isNew\x18\x11 \x01(\bR\x05isNew\x12\x14\n" +
+	"\x05isHot\x18\x12 \x01(\bR\x05isHot\x12\x16\n" +
+	"\x06onSale\x18\x13 \x01(\bR\x06onSale\x127\n" +
+	"\x03sku\x18\x14 \x03(\v2%.goods.v1.CreateGoodsRequest.goodsSkuR\x03sku\x1a\xa6\a\n" +
+	"\bgoodsSku\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x03R\agoodsId\x12!\n" +
+	"\askuName\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\askuName\x12\x1b\n" +
+	"\x04code\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\x12!\n" +
+	"\abarCode\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\abarCode\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x03R\x05price\x12&\n" +
+	"\x0epromotionPrice\x18\a \x01(\x03R\x0epromotionPrice\x12\x16\n" +
+	"\x06points\x18\b \x01(\x03R\x06points\x12\x14\n" +
+	"\x05image\x18\t \x01(\tR\x05image\x12\x12\n" +
+	"\x04sort\x18\n" +
+	" \x01(\x05R\x04sort\x12\x1c\n" +
+	"\tinventory\x18\v \x01(\x03R\tinventory\x12a\n" +
+	"\x11specificationInfo\x18\f \x03(\v23.goods.v1.CreateGoodsRequest.goodsSku.specificationR\x11specificationInfo\x12U\n" +
+	"\rgroupAttrInfo\x18\r \x03(\v2/.goods.v1.CreateGoodsRequest.goodsSku.groupAttrR\rgroupAttrInfo\x12-\n" +
+	"\rpurchaseLimit\x18\x0e \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rpurchaseLimit\x1aE\n" +
+	"\rspecification\x12\x19\n" +
+	"\x03sId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x03sId\x12\x19\n" +
+	"\x03vId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x03vId\x1a\xbe\x02\n" +
+	"\tgroupAttr\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12P\n" +
+	"\battrInfo\x18\x03 \x03(\v24.goods.v1.CreateGoodsRequest.goodsSku.groupAttr.attrR\battrInfo\x1a\xa6\x01\n" +
+	"\x04attr\x12\x1f\n" +
+	"\x06attrId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x06attrId\x12#\n" +
+	"\battrName\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\battrName\x12)\n" +
+	"\vattrValueId\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\vattrValueId\x12-\n" +
+	"\rattrValueName\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\rattrValueName\"%\n" +
+	"\x13CreateGoodsResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\x03R\x02ID\"J\n" +
+	"\x10BrandListRequest\x12\x14\n" +
+	"\x05pages\x18\x01 \x01(\x05R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\x02 \x01(\x05R\vpagePerNums\"\x84\x01\n" +
+	"\fBrandRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04logo\x18\x03 \x01(\tR\x04logo\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x14\n" +
+	"\x05isTab\x18\x05 \x01(\bR\x05isTab\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"\x89\x01\n" +
+	"\x11BrandInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04logo\x18\x03 \x01(\tR\x04logo\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x14\n" +
+	"\x05isTab\x18\x05 \x01(\bR\x05isTab\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"Z\n" +
+	"\x11BrandListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12/\n" +
+	"\x04data\x18\x02 \x03(\v2\x1b.goods.v1.BrandInfoResponseR\x04data\" \n" +
+	"\x0eSkuListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x03(\x03R\x02id\"\x9f\x03\n" +
+	"\x0fSkuInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\agoodsId\x18\x02 \x01(\x03R\agoodsId\x12\x18\n" +
+	"\agoodsSn\x18\x03 \x01(\tR\agoodsSn\x12\x1c\n" +
+	"\tgoodsName\x18\x04 \x01(\tR\tgoodsName\x12\x18\n" +
+	"\askuName\x18\x05 \x01(\tR\askuName\x12\x18\n" +
+	"\askuCode\x18\x06 \x01(\tR\askuCode\x12\x14\n" +
+	"\x05price\x18\a \x01(\x03R\x05price\x12&\n" +
+	"\x0epromotionPrice\x18\b \x01(\x03R\x0epromotionPrice\x12\x16\n" +
+	"\x06points\x18\t \x01(\x03R\x06points\x12\x10\n" +
+	"\x03pic\x18\n" +
+	" \x01(\tR\x03pic\x12\x1c\n" +
+	"\tinventory\x18\v \x01(\x03R\tinventory\x12\x16\n" +
+	"\x06onSale\x18\f \x01(\bR\x06onSale\x12\x1a\n" +
+	"\bshipFree\x18\r \x01(\bR\bshipFree\x12\x16\n" +
+	"\x06shipId\x18\x0e \x01(\x05R\x06shipId\x12$\n" +
+	"\rpurchaseLimit\x18\x0f \x01(\x05R\rpurchaseLimit\"g\n" +
+	"\x17SkuPurchaseLimitRequest\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05skuId\x12-\n" +
+	"\rpurchaseLimit\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rpurchaseLimit\"@\n" +
+	"\x0fSkuListResponse\x12-\n" +
+	"\x04list\x18\x01 \x03(\v2\x19.goods.v1.SkuInfoResponseR\x04list\"\xed\x01\n" +
+	"\x10GoodsTypeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\x04name\x12#\n" +
+	"\btypeCode\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\btypeCode\x12\x1c\n" +
+	"\tnameAlias\x18\x04 \x01(\tR\tnameAlias\x12\x1c\n" +
+	"\tisVirtual\x18\x05 \x01(\bR\tisVirtual\x12\x12\n" +
+	"\x04desc\x18\x06 \x01(\tR\x04desc\x12\x12\n" +
+	"\x04sort\x18\a \x01(\x05R\x04sort\x12#\n" +
+	"\bbrandIds\x18\b \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbrandIds\"#\n" +
+	"\x11GoodsTypeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xf0\x02\n" +
+	"\x16UpdateGoodsTypeRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12 \n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x03H\x00R\x04name\x88\x01\x01\x12(\n" +
+	"\btypeCode\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x03H\x01R\btypeCode\x88\x01\x01\x12!\n" +
+	"\tnameAlias\x18\x04 \x01(\tH\x02R\tnameAlias\x88\x01\x01\x12!\n" +
+	"\tisVirtual\x18\x05 \x01(\bH\x03R\tisVirtual\x88\x01\x01\x12\x17\n" +
+	"\x04desc\x18\x06 \x01(\tH\x04R\x04desc\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\a \x01(\x05H\x05R\x04sort\x88\x01\x01\x12(\n" +
+	"\bbrandIds\x18\b \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x06R\bbrandIds\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_typeCodeB\f\n" +
+	"\n" +
+	"_nameAliasB\f\n" +
+	"\n" +
+	"_isVirtualB\a\n" +
+	"\x05_descB\a\n" +
+	"\x05_sortB\v\n" +
+	"\t_brandIds\"\xbb\x01\n" +
+	"\x15GoodsTypeInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\btypeCode\x18\x03 \x01(\tR\btypeCode\x12\x1c\n" +
+	"\tnameAlias\x18\x04 \x01(\tR\tnameAlias\x12\x1c\n" +
+	"\tisVirtual\x18\x05 \x01(\bR\tisVirtual\x12\x12\n" +
+	"\x04desc\x18\x06 \x01(\tR\x04desc\x12\x12\n" +
+	"\x04sort\x18\a \x01(\x05R\x04sort\"N\n" +
+	"\x14GoodsTypeListRequest\x12\x14\n" +
+	"\x05pages\x18\x01 \x01(\x05R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\x02 \x01(\x05R\vpagePerNums\"b\n" +
+	"\x15GoodsTypeListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x123\n" +
+	"\x04list\x18\x02 \x03(\v2\x1f.goods.v1.GoodsTypeInfoResponseR\x04list\"1\n" +
+	"\x16DeleteGoodsTypeRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x02id\"3\n" +
+	"\x18GoodsTypeTemplateRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x02id\"\x8b\x02\n" +
+	"\x19SpecificationInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06typeId\x18\x02 \x01(\x03R\x06typeId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\bR\x06status\x12\x14\n" +
+	"\x05isSku\x18\x06 \x01(\bR\x05isSku\x12\x1a\n" +
+	"\bisSelect\x18\a \x01(\bR\bisSelect\x12T\n" +
+	"\x12specificationValue\x18\b \x03(\v2$.goods.v1.SpecificationValueResponseR\x12specificationValue\"\xc1\x01\n" +
+	"\x15AttrGroupInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06typeId\x18\x02 \x01(\x03R\x06typeId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\tR\x04desc\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\bR\x06status\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\x12*\n" +
+	"\x04attr\x18\a \x03(\v2\x16.goods.v1.AttrResponseR\x04attr\"\x93\x02\n" +
+	"\x19GoodsTypeTemplateResponse\x123\n" +
+	"\x04info\x18\x01 \x01(\v2\x1f.goods.v1.GoodsTypeInfoResponseR\x04info\x123\n" +
+	"\x06brands\x18\x02 \x03(\v2\x1b.goods.v1.BrandInfoResponseR\x06brands\x12K\n" +
+	"\x0especifications\x18\x03 \x03(\v2#.goods.v1.SpecificationInfoResponseR\x0especifications\x12?\n" +
+	"\n" +
+	"attrGroups\x18\x04 \x03(\v2\x1f.goods.v1.AttrGroupInfoResponseR\n" +
+	"attrGroups\"\x8e\x04\n" +
+	"\x12GoodsFilterRequest\x12\x1a\n" +
+	"\bkeywords\x18\x01 \x01(\tR\bkeywords\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x18\n" +
+	"\abrandId\x18\x03 \x01(\x05R\abrandId\x12\x1a\n" +
+	"\bminPrice\x18\x04 \x01(\x03R\bminPrice\x12\x1a\n" +
+	"\bmaxPrice\x18\x05 \x01(\x03R\bmaxPrice\x12\x14\n" +
+	"\x05isHot\x18\x06 \x01(\bR\x05isHot\x12\x14\n" +
+	"\x05isNew\x18\a \x01(\bR\x05isNew\x12\x14\n" +
+	"\x05isTab\x18\b \x01(\bR\x05isTab\x12\x1a\n" +
+	"\bclickNum\x18\t \x01(\x03R\bclickNum\x12\x18\n" +
+	"\asoldNum\x18\n" +
+	" \x01(\x03R\asoldNum\x12\x16\n" +
+	"\x06favNum\x18\v \x01(\x03R\x06favNum\x12\x14\n" +
+	"\x05pages\x18\f \x01(\x03R\x05pages\x12 \n" +
+	"\vpagePerNums\x18\r \x01(\x03R\vpagePerNums\x12\x0e\n" +
+	"\x02id\x18\x0e \x01(\x03R\x02id\x12=\n" +
+	"\x05attrs\x18\x0f \x03(\v2'.goods.v1.GoodsFilterRequest.attrFilterR\x05attrs\x1aS\n" +
+	"\n" +
+	"attrFilter\x12\x1f\n" +
+	"\x06attrId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x06attrId\x12$\n" +
+	"\bvalueIds\x18\x02 \x03(\x03B\b\xfaB\x05\x92\x01\x02\b\x01R\bvalueIds\"\xd3\x03\n" +
+	"\x11GoodsInfoResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x02 \x01(\x05R\n" +
+	"categoryId\x12\x18\n" +
+	"\abrandId\x18\x03 \x01(\x05R\abrandId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\agoodsSn\x18\x05 \x01(\tR\agoodsSn\x12\x1a\n" +
+	"\bclickNum\x18\x06 \x01(\x03R\bclickNum\x12\x18\n" +
+	"\asoldNum\x18\a \x01(\x03R\asoldNum\x12\x16\n" +
+	"\x06favNum\x18\b \x01(\x03R\x06favNum\x12 \n" +
+	"\vmarketPrice\x18\t \x01(\x03R\vmarketPrice\x12\x1e\n" +
+	"\n" +
+	"goodsBrief\x18\n" +
+	" \x01(\tR\n" +
+	"goodsBrief\x12\x1c\n" +
+	"\tgoodsDesc\x18\v \x01(\tR\tgoodsDesc\x12\x1a\n" +
+	"\bshipFree\x18\f \x01(\bR\bshipFree\x12\x16\n" +
+	"\x06images\x18\r \x01(\tR\x06images\x12 \n" +
+	"\vgoodsImages\x18\x0e \x03(\tR\vgoodsImages\x12\x14\n" +
+	"\x05isNew\x18\x0f \x01(\bR\x05isNew\x12\x14\n" +
+	"\x05isHot\x18\x10 \x01(\bR\x05isHot\x12\x16\n" +
+	"\x06onSale\x18\x11 \x01(\bR\x06onSale\"Z\n" +
+	"\x11GoodsListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12/\n" +
+	"\x04list\x18\x02 \x03(\v2\x1b.goods.v1.GoodsInfoResponseR\x04list\"*\n" +
+	"\x0fGoodInfoRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x02id\".\n" +
+	"\x10BatchGoodsIdInfo\x12\x1a\n" +
+	"\x02id\x18\x01 \x03(\x03B\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x10dR\x02id\"|\n" +
+	"\bFavEvent\x12#\n" +
+	"\aeventId\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\aeventId\x12!\n" +
+	"\agoodsId\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\agoodsId\x12(\n" +
+	"\x05delta\x18\x03 \x01(\x05B\x12\xfaB\x0f\x1a\r0\x010\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01R\x05delta\"K\n" +
+	"\x10FavEventsRequest\x127\n" +
+	"\x06events\x18\x01 \x03(\v2\x12.goods.v1.FavEventB\v\xfaB\b\x92\x01\x05\b\x01\x10\xf4\x03R\x06events\"7\n" +
+	"\x12UploadMediaRequest\x12!\n" +
+	"\acontent\x18\x01 \x01(\fB\a\xfaB\x04z\x02\x10\x01R\acontent\"\xc5\x01\n" +
+	"\rMediaResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bthumbUrl\x18\x03 \x01(\tR\bthumbUrl\x12\x12\n" +
+	"\x04hash\x18\x04 \x01(\tR\x04hash\x12 \n" +
+	"\vcontentType\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05width\x18\a \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\b \x01(\x05R\x06height\"4\n" +
+	"\x0eMediaGCRequest\x12\"\n" +
+	"\fgraceSeconds\x18\x01 \x01(\x03R\fgraceSeconds\"+\n" +
+	"\x0fMediaGCResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x03R\aremoved\"\xec\x01\n" +
+	"\x15ChangeSkuPriceRequest\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x05skuId\x12\x1d\n" +
+	"\x05price\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x05price\x12/\n" +
+	"\x0epromotionPrice\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x0epromotionPrice\x12$\n" +
+	"\reffectiveFrom\x18\x04 \x01(\x03R\reffectiveFrom\x12&\n" +
+	"\x0eeffectiveUntil\x18\x05 \x01(\x03R\x0eeffectiveUntil\x12\x16\n" +
+	"\x06remark\x18\x06 \x01(\tR\x06remark\"\x92\x02\n" +
+	"\x10SkuPriceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05skuId\x18\x02 \x01(\x03R\x05skuId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12&\n" +
+	"\x0epromotionPrice\x18\x04 \x01(\x03R\x0epromotionPrice\x12$\n" +
+	"\reffectiveFrom\x18\x05 \x01(\x03R\reffectiveFrom\x12&\n" +
+	"\x0eeffectiveUntil\x18\x06 \x01(\x03R\x0eeffectiveUntil\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x16\n" +
+	"\x06remark\x18\b \x01(\tR\x06remark\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\x03R\tcreatedAt\"Y\n" +
+	"\x11SkuPriceAtRequest\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x05skuId\x12%\n" +
+	"\ttimestamp\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\ttimestamp\"7\n" +
+	"\x16SkuPriceHistoryRequest\x12\x1d\n" +
+	"\x05skuId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02(\x01R\x05skuId\"I\n" +
+	"\x17SkuPriceHistoryResponse\x12.\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.goods.v1.SkuPriceResponseR\x04list2\x9c\x12\n" +
+	"\x05Goods\x12L\n" +
+	"\x12GetAllCategoryList\x12\x16.google.protobuf.Empty\x1a\x1e.goods.v1.CategoryListResponse\x12O\n" +
+	"\x0eCreateCategory\x12\x1d.goods.v1.CategoryInfoRequest\x1a\x1e.goods.v1.CategoryInfoResponse\x12R\n" +
+	"\x0eGetSubCategory\x12\x1d.goods.v1.CategoryListRequest\x1a!.goods.v1.SubCategoryListResponse\x12I\n" +
+	"\x0eDeleteCategory\x12\x1f.goods.v1.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x0eUpdateCategory\x12\x1d.goods.v1.CategoryInfoRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\tBrandList\x12\x1a.goods.v1.BrandListRequest\x1a\x1b.goods.v1.BrandListResponse\x12B\n" +
+	"\vCreateBrand\x12\x16.goods.v1.BrandRequest\x1a\x1b.goods.v1.BrandInfoResponse\x12=\n" +
+	"\vDeleteBrand\x12\x16.goods.v1.BrandRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\vUpdateBrand\x12\x16.goods.v1.BrandRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x18CreateGoodsSpecification\x12\x1e.goods.v1.SpecificationRequest\x1a\x1f.goods.v1.SpecificationResponse\x12J\n" +
+	"\x0fCreateGoodsType\x12\x1a.goods.v1.GoodsTypeRequest\x1a\x1b.goods.v1.GoodsTypeResponse\x12P\n" +
+	"\rGoodsTypeList\x12\x1e.goods.v1.GoodsTypeListRequest\x1a\x1f.goods.v1.GoodsTypeListResponse\x12K\n" +
+	"\x0fUpdateGoodsType\x12 .goods.v1.UpdateGoodsTypeRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0fDeleteGoodsType\x12 .goods.v1.DeleteGoodsTypeRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
+	"\x14GetGoodsTypeTemplate\x12\".goods.v1.GoodsTypeTemplateRequest\x1a#.goods.v1.GoodsTypeTemplateResponse\x12J\n" +
+	"\x0fCreateAttrGroup\x12\x1a.goods.v1.AttrGroupRequest\x1a\x1b.goods.v1.AttrGroupResponse\x12@\n" +
+	"\x0fCreateAttrValue\x12\x15.goods.v1.AttrRequest\x1a\x16.goods.v1.AttrResponse\x12J\n" +
+	"\vCreateGoods\x12\x1c.goods.v1.CreateGoodsRequest\x1a\x1d.goods.v1.CreateGoodsResponse\x12C\n" +
+	"\vUpdateGoods\x12\x1c.goods.v1.CreateGoodsRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\tGoodsList\x12\x1c.goods.v1.GoodsFilterRequest\x1a\x1b.goods.v1.GoodsListResponse\x12H\n" +
+	"\x0eGetGoodsDetail\x12\x19.goods.v1.GoodInfoRequest\x1a\x1b.goods.v1.GoodsInfoResponse\x12H\n" +
+	"\rBatchGetGoods\x12\x1a.goods.v1.BatchGoodsIdInfo\x1a\x1b.goods.v1.GoodsListResponse\x12D\n" +
+	"\x0eApplyFavEvents\x12\x1a.goods.v1.FavEventsRequest\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\aSkuList\x12\x18.goods.v1.SkuListRequest\x1a\x19.goods.v1.SkuListResponse\x12D\n" +
+	"\fGoodsSkuList\x12\x19.goods.v1.GoodInfoRequest\x1a\x19.goods.v1.SkuListResponse\x12S\n" +
+	"\x16UpdateSkuPurchaseLimit\x12!.goods.v1.SkuPurchaseLimitRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x0eChangeSkuPrice\x12\x1f.goods.v1.ChangeSkuPriceRequest\x1a\x1a.goods.v1.SkuPriceResponse\x12E\n" +
+	"\n" +
+	"SkuPriceAt\x12\x1b.goods.v1.SkuPriceAtRequest\x1a\x1a.goods.v1.SkuPriceResponse\x12V\n" +
+	"\x0fSkuPriceHistory\x12 .goods.v1.SkuPriceHistoryRequest\x1a!.goods.v1.SkuPriceHistoryResponse\x12D\n" +
+	"\vUploadMedia\x12\x1c.goods.v1.UploadMediaRequest\x1a\x17.goods.v1.MediaResponse\x12>\n" +
+	"\aMediaGC\x12\x18.goods.v1.MediaGCRequest\x1a\x19.goods.v1.MediaGCResponseB\x17Z\x15goods/api/goods/v1;v1b\x06proto3"
+
+var (
+	file_service_goods_v1_goods_proto_rawDescOnce sync.Once
+	file_service_goods_v1_goods_proto_rawDescData []byte
+)
+
+func file_service_goods_v1_goods_proto_rawDescGZIP() []byte {
+	file_service_goods_v1_goods_proto_rawDescOnce.Do(func() {
+		file_service_goods_v1_goods_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_service_goods_v1_goods_proto_rawDesc), len(file_service_goods_v1_goods_proto_rawDesc)))
+	})
+	return file_service_goods_v1_goods_proto_rawDescData
+}
+
+var file_service_goods_v1_goods_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_service_goods_v1_goods_proto_goTypes = []any{
+	(*CategoryInfoRequest)(nil),                     // 0: goods.v1.CategoryInfoRequest
+	(*CategoryInfoResponse)(nil),                    // 1: goods.v1.CategoryInfoResponse
+	(*SubCategoryListResponse)(nil),                 // 2: goods.v1.SubCategoryListResponse
+	(*DeleteCategoryRequest)(nil),                   // 3: goods.v1.DeleteCategoryRequest
+	(*CategoryListResponse)(nil),                    // 4: goods.v1.CategoryListResponse
+	(*CategoryListRequest)(nil),                     // 5: goods.v1.CategoryListRequest
+	(*SpecificationValue)(nil),                      // 6: goods.v1.SpecificationValue
+	(*SpecificationValueResponse)(nil),              // 7: goods.v1.SpecificationValueResponse
+	(*SpecificationRequest)(nil),                    // 8: goods.v1.SpecificationRequest
+	(*SpecificationResponse)(nil),                   // 9: goods.v1.SpecificationResponse
+	(*AttrGroupRequest)(nil),                        // 10: goods.v1.AttrGroupRequest
+	(*AttrGroupResponse)(nil),                       // 11: goods.v1.AttrGroupResponse
+	(*AttrValueRequest)(nil),                        // 12: goods.v1.AttrValueRequest
+	(*AttrRequest)(nil),                             // 13: goods.v1.AttrRequest
+	(*AttrValueResponse)(nil),                       // 14: goods.v1.AttrValueResponse
+	(*AttrResponse)(nil),                            // 15: goods.v1.AttrResponse
+	(*CreateGoodsRequest)(nil),                      // 16: goods.v1.CreateGoodsRequest
+	(*CreateGoodsResponse)(nil),                     // 17: goods.v1.CreateGoodsResponse
+	(*BrandListRequest)(nil),                        // 18: goods.v1.BrandListRequest
+	(*BrandRequest)(nil),                            // 19: goods.v1.BrandRequest
+	(*BrandInfoResponse)(nil),                       // 20: goods.v1.BrandInfoResponse
+	(*BrandListResponse)(nil),                       // 21: goods.v1.BrandListResponse
+	(*SkuListRequest)(nil),                          // 22: goods.v1.SkuListRequest
+	(*SkuInfoResponse)(nil),                         // 23: goods.v1.SkuInfoResponse
+	(*SkuPurchaseLimitRequest)(nil),                 // 24: goods.v1.SkuPurchaseLimitRequest
+	(*SkuListResponse)(nil),                         // 25: goods.v1.SkuListResponse
+	(*GoodsTypeRequest)(nil),                        // 26: goods.v1.GoodsTypeRequest
+	(*GoodsTypeResponse)(nil),                       // 27: goods.v1.GoodsTypeResponse
+	(*UpdateGoodsTypeRequest)(nil),                  // 28: goods.v1.UpdateGoodsTypeRequest
+	(*GoodsTypeInfoResponse)(nil),                   // 29: goods.v1.GoodsTypeInfoResponse
+	(*GoodsTypeListRequest)(nil),                    // 30: goods.v1.GoodsTypeListRequest
+	(*GoodsTypeListResponse)(nil),                   // 31: goods.v1.GoodsTypeListResponse
+	(*DeleteGoodsTypeRequest)(nil),                  // 32: goods.v1.DeleteGoodsTypeRequest
+	(*GoodsTypeTemplateRequest)(nil),                // 33: goods.v1.GoodsTypeTemplateRequest
+	(*SpecificationInfoResponse)(nil),               // 34: goods.v1.SpecificationInfoResponse
+	(*AttrGroupInfoResponse)(nil),                   // 35: goods.v1.AttrGroupInfoResponse
+	(*GoodsTypeTemplateResponse)(nil),               // 36: goods.v1.GoodsTypeTemplateResponse
+	(*GoodsFilterRequest)(nil),                      // 37: goods.v1.GoodsFilterRequest
+	(*GoodsInfoResponse)(nil),                       // 38: goods.v1.GoodsInfoResponse
+	(*GoodsListResponse)(nil),                       // 39: goods.v1.GoodsListResponse
+	(*GoodInfoRequest)(nil),                         // 40: goods.v1.GoodInfoRequest
+	(*BatchGoodsIdInfo)(nil),                        // 41: goods.v1.BatchGoodsIdInfo
+	(*FavEvent)(nil),                                // 42: goods.v1.FavEvent
+	(*FavEventsRequest)(nil),                        // 43: goods.v1.FavEventsRequest
+	(*UploadMediaRequest)(nil),                      // 44: goods.v1.UploadMediaRequest
+	(*MediaResponse)(nil),                           // 45: goods.v1.MediaResponse
+	(*MediaGCRequest)(nil),                          // 46: goods.v1.MediaGCRequest
+	(*MediaGCResponse)(nil),                         // 47: goods.v1.MediaGCResponse
+	(*ChangeSkuPriceRequest)(nil),                   // 48: goods.v1.ChangeSkuPriceRequest
+	(*SkuPriceResponse)(nil),                        // 49: goods.v1.SkuPriceResponse
+	(*SkuPriceAtRequest)(nil),                       // 50: goods.v1.SkuPriceAtRequest
+	(*SkuPriceHistoryRequest)(nil),                  // 51: goods.v1.SkuPriceHistoryRequest
+	(*SkuPriceHistoryResponse)(nil),                 // 52: goods.v1.SkuPriceHistoryResponse
+	(*CreateGoodsRequestGoodsSku)(nil),              // 53: goods.v1.CreateGoodsRequest.goodsSku
+	(*CreateGoodsRequestGoodsSkuSpecification)(nil), // 54: goods.v1.CreateGoodsRequest.goodsSku.specification
+	(*CreateGoodsRequestGoodsSkuGroupAttr)(nil),     // 55: goods.v1.CreateGoodsRequest.goodsSku.groupAttr
+	(*CreateGoodsRequestGoodsSkuGroupAttrAttr)(nil), // 56: goods.v1.CreateGoodsRequest.goodsSku.groupAttr.attr
+	(*GoodsFilterRequestAttrFilter)(nil),            // 57: goods.v1.GoodsFilterRequest.attrFilter
+	(*emptypb.Empty)(nil),                           // 58: google.protobuf.Empty
+}
+var file_service_goods_v1_goods_proto_depIdxs = []int32{
+	1,  // 0: goods.v1.SubCategoryListResponse.info:type_name -> goods.v1.CategoryInfoResponse
+	1,  // 1: goods.v1.SubCategoryListResponse.subCategory:type_name -> goods.v1.CategoryInfoResponse
+	6,  // 2: goods.v1.SpecificationRequest.specificationValue:type_name -> goods.v1.SpecificationValue
+	12, // 3: goods.v1.AttrRequest.attrValue:type_name -> goods.v1.AttrValueRequest
+	14, // 4: goods.v1.AttrResponse.attrValue:type_name -> goods.v1.AttrValueResponse
+	53, // 5: goods.v1.CreateGoodsRequest.sku:type_name -> goods.v1.CreateGoodsRequest.goodsSku
+	20, // 6: goods.v1.BrandListResponse.data:type_name -> goods.v1.BrandInfoResponse
+	23, // 7: goods.v1.SkuListResponse.list:type_name -> goods.v1.SkuInfoResponse
+	29, // 8: goods.v1.GoodsTypeListResponse.list:type_name -> goods.v1.GoodsTypeInfoResponse
+	7,  // 9: goods.v1.SpecificationInfoResponse.specificationValue:type_name -> goods.v1.SpecificationValueResponse
+	15, // 10: goods.v1.AttrGroupInfoResponse.attr:type_name -> goods.v1.AttrResponse
+	29, // 11: goods.v1.GoodsTypeTemplateResponse.info:type_name -> goods.v1.GoodsTypeInfoResponse
+	20, // 12: goods.v1.GoodsTypeTemplateResponse.brands:type_name -> goods.v1.BrandInfoResponse
+	34, // 13: goods.v1.GoodsTypeTemplateResponse.specifications:type_name -> goods.v1.SpecificationInfoResponse
+	35, // 14: goods.v1.GoodsTypeTemplateResponse.attrGroups:type_name -> goods.v1.AttrGroupInfoResponse
+	57, // 15: goods.v1.GoodsFilterRequest.attrs:type_name -> goods.v1.GoodsFilterRequest.attrFilter
+	38, // 16: goods.v1.GoodsListResponse.list:type_name -> goods.v1.GoodsInfoResponse
+	42, // 17: goods.v1.FavEventsRequest.events:type_name -> goods.v1.FavEvent
+	49, // 18: goods.v1.SkuPriceHistoryResponse.list:type_name -> goods.v1.SkuPriceResponse
+	54, // 19: goods.v1.CreateGoodsRequest.goodsSku.specificationInfo:type_name -> goods.v1.CreateGoodsRequest.goodsSku.specification
+	55, // 20: goods.v1.CreateGoodsRequest.goodsSku.groupAttrInfo:type_name -> goods.v1.CreateGoodsRequest.goodsSku.groupAttr
+	56, // 21: goods.v1.CreateGoodsRequest.goodsSku.groupAttr.attrInfo:type_name -> goods.v1.CreateGoodsRequest.goodsSku.groupAttr.attr
+	58, // 22: goods.v1.Goods.GetAllCategoryList:input_type -> google.protobuf.Empty
+	0,  // 23: goods.v1.Goods.CreateCategory:input_type -> goods.v1.CategoryInfoRequest
+	5,  // 24: goods.v1.Goods.GetSubCategory:input_type -> goods.v1.CategoryListRequest
+	3,  // 25: goods.v1.Goods.DeleteCategory:input_type -> goods.v1.DeleteCategoryRequest
+	0,  // 26: goods.v1.Goods.UpdateCategory:input_type -> goods.v1.CategoryInfoRequest
+	18, // 27: goods.v1.Goods.BrandList:input_type -> goods.v1.BrandListRequest
+	19, // 28: goods.v1.Goods.CreateBrand:input_type -> goods.v1.BrandRequest
+	19, // 29: goods.v1.Goods.DeleteBrand:input_type -> goods.v1.BrandRequest
+	19, // 30: goods.v1.Goods.UpdateBrand:input_type -> goods.v1.BrandRequest
+	8,  // 31: goods.v1.Goods.CreateGoodsSpecification:input_type -> goods.v1.SpecificationRequest
+	26, // 32: goods.v1.Goods.CreateGoodsType:input_type -> goods.v1.GoodsTypeRequest
+	30, // 33: goods.v1.Goods.GoodsTypeList:input_type -> goods.v1.GoodsTypeListRequest
+	28, // 34: goods.v1.Goods.UpdateGoodsType:input_type -> goods.v1.UpdateGoodsTypeRequest
+	32, // 35: goods.v1.Goods.DeleteGoodsType:input_type -> goods.v1.DeleteGoodsTypeRequest
+	33, // 36: goods.v1.Goods.GetGoodsTypeTemplate:input_type -> goods.v1.GoodsTypeTemplateRequest
+	10, // 37: goods.v1.Goods.CreateAttrGroup:input_type -> goods.v1.AttrGroupRequest
+	13, // 38: goods.v1.Goods.CreateAttrValue:input_type -> goods.v1.AttrRequest
+	16, // 39: goods.v1.Goods.CreateGoods:input_type -> goods.v1.CreateGoodsRequest
+	16, // 40: goods.v1.Goods.UpdateGoods:input_type -> goods.v1.CreateGoodsRequest
+	37, // 41: goods.v1.Goods.GoodsList:input_type -> goods.v1.GoodsFilterRequest
+	40, // 42: goods.v1.Goods.GetGoodsDetail:input_type -> goods.v1.GoodInfoRequest
+	41, // 43: goods.v1.Goods.BatchGetGoods:input_type -> goods.v1.BatchGoodsIdInfo
+	43, // 44: goods.v1.Goods.ApplyFavEvents:input_type -> goods.v1.FavEventsRequest
+	22, // 45: goods.v1.Goods.SkuList:input_type -> goods.v1.SkuListRequest
+	40, // 46: goods.v1.Goods.GoodsSkuList:input_type -> goods.v1.GoodInfoRequest
+	24, // 47: goods.v1.Goods.UpdateSkuPurchaseLimit:input_type -> goods.v1.SkuPurchaseLimitRequest
+	48, // 48: goods.v1.Goods.ChangeSkuPrice:input_type -> goods.v1.ChangeSkuPriceRequest
+	50, // 49: goods.v1.Goods.SkuPriceAt:input_type -> goods.v1.SkuPriceAtRequest
+	51, // 50: goods.v1.Goods.SkuPriceHistory:input_type -> goods.v1.SkuPriceHistoryRequest
+	44, // 51: goods.v1.Goods.UploadMedia:input_type -> goods.v1.UploadMediaRequest
+	46, // 52: goods.v1.Goods.MediaGC:input_type -> goods.v1.MediaGCRequest
+	4,  // 53: goods.v1.Goods.GetAllCategoryList:output_type -> goods.v1.CategoryListResponse
+	1,  // 54: goods.v1.Goods.CreateCategory:output_type -> goods.v1.CategoryInfoResponse
+	2,  // 55: goods.v1.Goods.GetSubCategory:output_type -> goods.v1.SubCategoryListResponse
+	58, // 56: goods.v1.Goods.DeleteCategory:output_type -> google.protobuf.Empty
+	58, // 57: goods.v1.Goods.UpdateCategory:output_type -> google.protobuf.Empty
+	21, // 58: goods.v1.Goods.BrandList:output_type -> goods.v1.BrandListResponse
+	20, // 59: goods.v1.Goods.CreateBrand:output_type -> goods.v1.BrandInfoResponse
+	58, // 60: goods.v1.Goods.DeleteBrand:output_type -> google.protobuf.Empty
+	58, // 61: goods.v1.Goods.UpdateBrand:output_type -> google.protobuf.Empty
+	9,  // 62: goods.v1.Goods.CreateGoodsSpecification:output_type -> goods.v1.SpecificationResponse
+	27, // 63: goods.v1.Goods.CreateGoodsType:output_type -> goods.v1.GoodsTypeResponse
+	31, // 64: goods.v1.Goods.GoodsTypeList:output_type -> goods.v1.GoodsTypeListResponse
+	58, // 65: goods.v1.Goods.UpdateGoodsType:output_type -> google.protobuf.Empty
+	58, // 66: goods.v1.Goods.DeleteGoodsType:output_type -> google.protobuf.Empty
+	36, // 67: goods.v1.Goods.GetGoodsTypeTemplate:output_type -> goods.v1.GoodsTypeTemplateResponse
+	11, // 68: goods.v1.Goods.CreateAttrGroup:output_type -> goods.v1.AttrGroupResponse
+	15, // 69: goods.v1.Goods.CreateAttrValue:output_type -> goods.v1.AttrResponse
+	17, // 70: goods.v1.Goods.CreateGoods:output_type -> goods.v1.CreateGoodsResponse
+	58, // 71: goods.v1.Goods.UpdateGoods:output_type -> google.protobuf.Empty
+	39, // 72: goods.v1.Goods.GoodsList:output_type -> goods.v1.GoodsListResponse
+	38, // 73: goods.v1.Goods.GetGoodsDetail:output_type -> goods.v1.GoodsInfoResponse
+	39, // 74: goods.v1.Goods.BatchGetGoods:output_type -> goods.v1.GoodsListResponse
+	58, // 75: goods.v1.Goods.ApplyFavEvents:output_type -> google.protobuf.Empty
+	25, // 76: goods.v1.Goods.SkuList:output_type -> goods.v1.SkuListResponse
+	25, // 77: goods.v1.Goods.GoodsSkuList:output_type -> goods.v1.SkuListResponse
+	58, // 78: goods.v1.Goods.UpdateSkuPurchaseLimit:output_type -> google.protobuf.Empty
+	49, // 79: goods.v1.Goods.ChangeSkuPrice:output_type -> goods.v1.SkuPriceResponse
+	49, // 80: goods.v1.Goods.SkuPriceAt:output_type -> goods.v1.SkuPriceResponse
+	52, // 81: goods.v1.Goods.SkuPriceHistory:output_type -> goods.v1.SkuPriceHistoryResponse
+	45, // 82: goods.v1.Goods.UploadMedia:output_type -> goods.v1.MediaResponse
+	47, // 83: goods.v1.Goods.MediaGC:output_type -> goods.v1.MediaGCResponse
+	53, // [53:84] is the sub-list for method output_type
+	22, // [22:53] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_service_goods_v1_goods_proto_init() }
+func file_service_goods_v1_goods_proto_init() {
+	if File_service_goods_v1_goods_proto != nil {
+		return
+	}
+	file_service_goods_v1_goods_proto_msgTypes[28].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_goods_v1_goods_proto_rawDesc), len(file_service_goods_v1_goods_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   58,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_goods_v1_goods_proto_goTypes,
+		DependencyIndexes: file_service_goods_v1_goods_proto_depIdxs,
+		MessageInfos:      file_service_goods_v1_goods_proto_msgTypes,
+	}.Build()
+	File_service_goods_v1_goods_proto = out.File
+	file_service_goods_v1_goods_proto_goTypes = nil
+	file_service_goods_v1_goods_proto_depIdxs = nil
+}
@@ -0,0 +1,7658 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: service/goods/v1/goods.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CategoryInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryInfoRequestMultiError, or nil if none found.
+func (m *CategoryInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ParentCategory
+
+	// no validation rules for Level
+
+	// no validation rules for IsTab
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return CategoryInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryInfoRequestMultiError is an error wrapping multiple validation
+// errors returned by CategoryInfoRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CategoryInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryInfoRequestMultiError) AllErrors() []error { return m }
+
+// CategoryInfoRequestValidationError is the validation error returned by
+// CategoryInfoRequest.Validate if the designated constraints aren't met.
+type CategoryInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryInfoRequestValidationError) ErrorName() string {
+	return "CategoryInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryInfoRequestValidationError{}
+
+// Validate checks the field values on CategoryInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryInfoResponseMultiError, or nil if none found.
+func (m *CategoryInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for ParentCategory
+
+	// no validation rules for Level
+
+	// no validation rules for IsTab
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return CategoryInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by CategoryInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CategoryInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryInfoResponseMultiError) AllErrors() []error { return m }
+
+// CategoryInfoResponseValidationError is the validation error returned by
+// CategoryInfoResponse.Validate if the designated constraints aren't met.
+type CategoryInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryInfoResponseValidationError) ErrorName() string {
+	return "CategoryInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryInfoResponseValidationError{}
+
+// Validate checks the field values on SubCategoryListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubCategoryListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubCategoryListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubCategoryListResponseMultiError, or nil if none found.
+func (m *SubCategoryListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubCategoryListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubCategoryListResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubCategoryListResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubCategoryListResponseValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSubCategory() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubCategoryListResponseValidationError{
+						field:  fmt.Sprintf("SubCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubCategoryListResponseValidationError{
+						field:  fmt.Sprintf("SubCategory[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubCategoryListResponseValidationError{
+					field:  fmt.Sprintf("SubCategory[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubCategoryListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubCategoryListResponseMultiError is an error wrapping multiple validation
+// errors returned by SubCategoryListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SubCategoryListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubCategoryListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubCategoryListResponseMultiError) AllErrors() []error { return m }
+
+// SubCategoryListResponseValidationError is the validation error returned by
+// SubCategoryListResponse.Validate if the designated constraints aren't met.
+type SubCategoryListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubCategoryListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubCategoryListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubCategoryListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubCategoryListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubCategoryListResponseValidationError) ErrorName() string {
+	return "SubCategoryListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubCategoryListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubCategoryListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubCategoryListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubCategoryListResponseValidationError{}
+
+// Validate checks the field values on DeleteCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCategoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCategoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCategoryRequestMultiError, or nil if none found.
+func (m *DeleteCategoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCategoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteCategoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCategoryRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCategoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCategoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCategoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCategoryRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCategoryRequestValidationError is the validation error returned by
+// DeleteCategoryRequest.Validate if the designated constraints aren't met.
+type DeleteCategoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCategoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCategoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCategoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCategoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCategoryRequestValidationError) ErrorName() string {
+	return "DeleteCategoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCategoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCategoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCategoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCategoryRequestValidationError{}
+
+// Validate checks the field values on CategoryListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryListResponseMultiError, or nil if none found.
+func (m *CategoryListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JsonData
+
+	if len(errors) > 0 {
+		return CategoryListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryListResponseMultiError is an error wrapping multiple validation
+// errors returned by CategoryListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CategoryListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryListResponseMultiError) AllErrors() []error { return m }
+
+// CategoryListResponseValidationError is the validation error returned by
+// CategoryListResponse.Validate if the designated constraints aren't met.
+type CategoryListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryListResponseValidationError) ErrorName() string {
+	return "CategoryListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryListResponseValidationError{}
+
+// Validate checks the field values on CategoryListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CategoryListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CategoryListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CategoryListRequestMultiError, or nil if none found.
+func (m *CategoryListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CategoryListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return CategoryListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CategoryListRequestMultiError is an error wrapping multiple validation
+// errors returned by CategoryListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CategoryListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CategoryListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CategoryListRequestMultiError) AllErrors() []error { return m }
+
+// CategoryListRequestValidationError is the validation error returned by
+// CategoryListRequest.Validate if the designated constraints aren't met.
+type CategoryListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryListRequestValidationError) ErrorName() string {
+	return "CategoryListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryListRequestValidationError{}
+
+// Validate checks the field values on SpecificationValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpecificationValue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecificationValue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpecificationValueMultiError, or nil if none found.
+func (m *SpecificationValue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecificationValue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AttrId
+
+	if utf8.RuneCountInString(m.GetValue()) < 3 {
+		err := SpecificationValueValidationError{
+			field:  "Value",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSort() < 1 {
+		err := SpecificationValueValidationError{
+			field:  "Sort",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SpecificationValueMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecificationValueMultiError is an error wrapping multiple validation errors
+// returned by SpecificationValue.ValidateAll() if the designated constraints
+// aren't met.
+type SpecificationValueMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecificationValueMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecificationValueMultiError) AllErrors() []error { return m }
+
+// SpecificationValueValidationError is the validation error returned by
+// SpecificationValue.Validate if the designated constraints aren't met.
+type SpecificationValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecificationValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecificationValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecificationValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecificationValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecificationValueValidationError) ErrorName() string {
+	return "SpecificationValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SpecificationValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecificationValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecificationValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecificationValueValidationError{}
+
+// Validate checks the field values on SpecificationValueResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpecificationValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecificationValueResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpecificationValueResponseMultiError, or nil if none found.
+func (m *SpecificationValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecificationValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AttrId
+
+	// no validation rules for Value
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return SpecificationValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecificationValueResponseMultiError is an error wrapping multiple
+// validation errors returned by SpecificationValueResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SpecificationValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecificationValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecificationValueResponseMultiError) AllErrors() []error { return m }
+
+// SpecificationValueResponseValidationError is the validation error returned
+// by SpecificationValueResponse.Validate if the designated constraints aren't met.
+type SpecificationValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecificationValueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecificationValueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecificationValueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecificationValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecificationValueResponseValidationError) ErrorName() string {
+	return "SpecificationValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SpecificationValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecificationValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecificationValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecificationValueResponseValidationError{}
+
+// Validate checks the field values on SpecificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpecificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecificationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpecificationRequestMultiError, or nil if none found.
+func (m *SpecificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetTypeId() < 1 {
+		err := SpecificationRequestValidationError{
+			field:  "TypeId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 2 {
+		err := SpecificationRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSort() < 1 {
+		err := SpecificationRequestValidationError{
+			field:  "Sort",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for IsSku
+
+	// no validation rules for IsSelect
+
+	for idx, item := range m.GetSpecificationValue() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SpecificationRequestValidationError{
+						field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SpecificationRequestValidationError{
+						field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SpecificationRequestValidationError{
+					field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SpecificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecificationRequestMultiError is an error wrapping multiple validation
+// errors returned by SpecificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SpecificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecificationRequestMultiError) AllErrors() []error { return m }
+
+// SpecificationRequestValidationError is the validation error returned by
+// SpecificationRequest.Validate if the designated constraints aren't met.
+type SpecificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecificationRequestValidationError) ErrorName() string {
+	return "SpecificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SpecificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecificationRequestValidationError{}
+
+// Validate checks the field values on SpecificationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpecificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecificationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpecificationResponseMultiError, or nil if none found.
+func (m *SpecificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return SpecificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecificationResponseMultiError is an error wrapping multiple validation
+// errors returned by SpecificationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SpecificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecificationResponseMultiError) AllErrors() []error { return m }
+
+// SpecificationResponseValidationError is the validation error returned by
+// SpecificationResponse.Validate if the designated constraints aren't met.
+type SpecificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecificationResponseValidationError) ErrorName() string {
+	return "SpecificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SpecificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecificationResponseValidationError{}
+
+// Validate checks the field values on AttrGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttrGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttrGroupRequestMultiError, or nil if none found.
+func (m *AttrGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetTypeId() < 1 {
+		err := AttrGroupRequestValidationError{
+			field:  "TypeId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 3 {
+		err := AttrGroupRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Desc
+
+	// no validation rules for Status
+
+	if m.GetSort() < 1 {
+		err := AttrGroupRequestValidationError{
+			field:  "Sort",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttrGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by AttrGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AttrGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrGroupRequestMultiError) AllErrors() []error { return m }
+
+// AttrGroupRequestValidationError is the validation error returned by
+// AttrGroupRequest.Validate if the designated constraints aren't met.
+type AttrGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrGroupRequestValidationError) ErrorName() string { return "AttrGroupRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttrGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrGroupRequestValidationError{}
+
+// Validate checks the field values on AttrGroupResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttrGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttrGroupResponseMultiError, or nil if none found.
+func (m *AttrGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TypeId
+
+	// no validation rules for Title
+
+	// no validation rules for Desc
+
+	// no validation rules for Status
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return AttrGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrGroupResponseMultiError is an error wrapping multiple validation errors
+// returned by AttrGroupResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AttrGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrGroupResponseMultiError) AllErrors() []error { return m }
+
+// AttrGroupResponseValidationError is the validation error returned by
+// AttrGroupResponse.Validate if the designated constraints aren't met.
+type AttrGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrGroupResponseValidationError) ErrorName() string {
+	return "AttrGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttrGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrGroupResponseValidationError{}
+
+// Validate checks the field values on AttrValueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttrValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrValueRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttrValueRequestMultiError, or nil if none found.
+func (m *AttrValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AttrId
+
+	if m.GetGroupId() < 1 {
+		err := AttrValueRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetValue()) < 3 {
+		err := AttrValueRequestValidationError{
+			field:  "Value",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttrValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrValueRequestMultiError is an error wrapping multiple validation errors
+// returned by AttrValueRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AttrValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrValueRequestMultiError) AllErrors() []error { return m }
+
+// AttrValueRequestValidationError is the validation error returned by
+// AttrValueRequest.Validate if the designated constraints aren't met.
+type AttrValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrValueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrValueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrValueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrValueRequestValidationError) ErrorName() string { return "AttrValueRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttrValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrValueRequestValidationError{}
+
+// Validate checks the field values on AttrRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttrRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttrRequestMultiError, or
+// nil if none found.
+func (m *AttrRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetTypeId() < 1 {
+		err := AttrRequestValidationError{
+			field:  "TypeId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() < 1 {
+		err := AttrRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) < 1 {
+		err := AttrRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Desc
+
+	// no validation rules for Status
+
+	if m.GetSort() < 1 {
+		err := AttrRequestValidationError{
+			field:  "Sort",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAttrValue() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttrRequestValidationError{
+						field:  fmt.Sprintf("AttrValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttrRequestValidationError{
+						field:  fmt.Sprintf("AttrValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttrRequestValidationError{
+					field:  fmt.Sprintf("AttrValue[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttrRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrRequestMultiError is an error wrapping multiple validation errors
+// returned by AttrRequest.ValidateAll() if the designated constraints aren't met.
+type AttrRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrRequestMultiError) AllErrors() []error { return m }
+
+// AttrRequestValidationError is the validation error returned by
+// AttrRequest.Validate if the designated constraints aren't met.
+type AttrRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrRequestValidationError) ErrorName() string { return "AttrRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttrRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrRequestValidationError{}
+
+// Validate checks the field values on AttrValueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttrValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrValueResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttrValueResponseMultiError, or nil if none found.
+func (m *AttrValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AttrId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return AttrValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrValueResponseMultiError is an error wrapping multiple validation errors
+// returned by AttrValueResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AttrValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrValueResponseMultiError) AllErrors() []error { return m }
+
+// AttrValueResponseValidationError is the validation error returned by
+// AttrValueResponse.Validate if the designated constraints aren't met.
+type AttrValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrValueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrValueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrValueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrValueResponseValidationError) ErrorName() string {
+	return "AttrValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttrValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrValueResponseValidationError{}
+
+// Validate checks the field values on AttrResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttrResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttrResponseMultiError, or
+// nil if none found.
+func (m *AttrResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TypeId
+
+	// no validation rules for GroupId
+
+	// no validation rules for Title
+
+	// no validation rules for Desc
+
+	// no validation rules for Status
+
+	// no validation rules for Sort
+
+	for idx, item := range m.GetAttrValue() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttrResponseValidationError{
+						field:  fmt.Sprintf("AttrValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttrResponseValidationError{
+						field:  fmt.Sprintf("AttrValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttrResponseValidationError{
+					field:  fmt.Sprintf("AttrValue[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttrResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrResponseMultiError is an error wrapping multiple validation errors
+// returned by AttrResponse.ValidateAll() if the designated constraints aren't met.
+type AttrResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrResponseMultiError) AllErrors() []error { return m }
+
+// AttrResponseValidationError is the validation error returned by
+// AttrResponse.Validate if the designated constraints aren't met.
+type AttrResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrResponseValidationError) ErrorName() string { return "AttrResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttrResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrResponseValidationError{}
+
+// Validate checks the field values on CreateGoodsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGoodsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGoodsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGoodsRequestMultiError, or nil if none found.
+func (m *CreateGoodsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGoodsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.GetCategoryId() < 1 {
+		err := CreateGoodsRequestValidationError{
+			field:  "CategoryId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBrandId() < 1 {
+		err := CreateGoodsRequestValidationError{
+			field:  "BrandId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTypeId() < 1 {
+		err := CreateGoodsRequestValidationError{
+			field:  "TypeId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for NameAlias
+
+	// no validation rules for GoodsTags
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for ShopPrice
+
+	// no validation rules for MarketPrice
+
+	// no validation rules for Inventory
+
+	// no validation rules for GoodsBrief
+
+	// no validation rules for GoodsFrontImage
+
+	// no validation rules for ShipFree
+
+	// no validation rules for ShipId
+
+	// no validation rules for IsNew
+
+	// no validation rules for IsHot
+
+	// no validation rules for OnSale
+
+	for idx, item := range m.GetSku() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateGoodsRequestValidationError{
+						field:  fmt.Sprintf("Sku[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateGoodsRequestValidationError{
+						field:  fmt.Sprintf("Sku[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateGoodsRequestValidationError{
+					field:  fmt.Sprintf("Sku[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateGoodsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGoodsRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGoodsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGoodsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGoodsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGoodsRequestMultiError) AllErrors() []error { return m }
+
+// CreateGoodsRequestValidationError is the validation error returned by
+// CreateGoodsRequest.Validate if the designated constraints aren't met.
+type CreateGoodsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGoodsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGoodsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGoodsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGoodsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGoodsRequestValidationError) ErrorName() string {
+	return "CreateGoodsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGoodsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGoodsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGoodsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGoodsRequestValidationError{}
+
+// Validate checks the field values on CreateGoodsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGoodsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGoodsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGoodsResponseMultiError, or nil if none found.
+func (m *CreateGoodsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGoodsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ID
+
+	if len(errors) > 0 {
+		return CreateGoodsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGoodsResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateGoodsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateGoodsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGoodsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGoodsResponseMultiError) AllErrors() []error { return m }
+
+// CreateGoodsResponseValidationError is the validation error returned by
+// CreateGoodsResponse.Validate if the designated constraints aren't met.
+type CreateGoodsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGoodsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGoodsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGoodsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGoodsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGoodsResponseValidationError) ErrorName() string {
+	return "CreateGoodsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGoodsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGoodsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGoodsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGoodsResponseValidationError{}
+
+// Validate checks the field values on BrandListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BrandListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrandListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BrandListRequestMultiError, or nil if none found.
+func (m *BrandListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrandListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pages
+
+	// no validation rules for PagePerNums
+
+	if len(errors) > 0 {
+		return BrandListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrandListRequestMultiError is an error wrapping multiple validation errors
+// returned by BrandListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BrandListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrandListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrandListRequestMultiError) AllErrors() []error { return m }
+
+// BrandListRequestValidationError is the validation error returned by
+// BrandListRequest.Validate if the designated constraints aren't met.
+type BrandListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandListRequestValidationError) ErrorName() string { return "BrandListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrandListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrandListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandListRequestValidationError{}
+
+// Validate checks the field values on BrandRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BrandRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrandRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BrandRequestMultiError, or
+// nil if none found.
+func (m *BrandRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrandRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Logo
+
+	// no validation rules for Desc
+
+	// no validation rules for IsTab
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return BrandRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrandRequestMultiError is an error wrapping multiple validation errors
+// returned by BrandRequest.ValidateAll() if the designated constraints aren't met.
+type BrandRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrandRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrandRequestMultiError) AllErrors() []error { return m }
+
+// BrandRequestValidationError is the validation error returned by
+// BrandRequest.Validate if the designated constraints aren't met.
+type BrandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandRequestValidationError) ErrorName() string { return "BrandRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandRequestValidationError{}
+
+// Validate checks the field values on BrandInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BrandInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrandInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BrandInfoResponseMultiError, or nil if none found.
+func (m *BrandInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrandInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Logo
+
+	// no validation rules for Desc
+
+	// no validation rules for IsTab
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return BrandInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrandInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by BrandInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BrandInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrandInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrandInfoResponseMultiError) AllErrors() []error { return m }
+
+// BrandInfoResponseValidationError is the validation error returned by
+// BrandInfoResponse.Validate if the designated constraints aren't met.
+type BrandInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandInfoResponseValidationError) ErrorName() string {
+	return "BrandInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BrandInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrandInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandInfoResponseValidationError{}
+
+// Validate checks the field values on BrandListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BrandListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrandListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BrandListResponseMultiError, or nil if none found.
+func (m *BrandListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrandListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BrandListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BrandListResponseValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BrandListResponseValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BrandListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrandListResponseMultiError is an error wrapping multiple validation errors
+// returned by BrandListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BrandListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrandListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrandListResponseMultiError) AllErrors() []error { return m }
+
+// BrandListResponseValidationError is the validation error returned by
+// BrandListResponse.Validate if the designated constraints aren't met.
+type BrandListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandListResponseValidationError) ErrorName() string {
+	return "BrandListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BrandListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrandListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandListResponseValidationError{}
+
+// Validate checks the field values on SkuListRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SkuListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SkuListRequestMultiError,
+// or nil if none found.
+func (m *SkuListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SkuListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuListRequestMultiError is an error wrapping multiple validation errors
+// returned by SkuListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SkuListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuListRequestMultiError) AllErrors() []error { return m }
+
+// SkuListRequestValidationError is the validation error returned by
+// SkuListRequest.Validate if the designated constraints aren't met.
+type SkuListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuListRequestValidationError) ErrorName() string { return "SkuListRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuListRequestValidationError{}
+
+// Validate checks the field values on SkuInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkuInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuInfoResponseMultiError, or nil if none found.
+func (m *SkuInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GoodsId
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for GoodsName
+
+	// no validation rules for SkuName
+
+	// no validation rules for SkuCode
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for Points
+
+	// no validation rules for Pic
+
+	// no validation rules for Inventory
+
+	// no validation rules for OnSale
+
+	// no validation rules for ShipFree
+
+	// no validation rules for ShipId
+
+	// no validation rules for PurchaseLimit
+
+	if len(errors) > 0 {
+		return SkuInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by SkuInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SkuInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuInfoResponseMultiError) AllErrors() []error { return m }
+
+// SkuInfoResponseValidationError is the validation error returned by
+// SkuInfoResponse.Validate if the designated constraints aren't met.
+type SkuInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuInfoResponseValidationError) ErrorName() string { return "SkuInfoResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuInfoResponseValidationError{}
+
+// Validate checks the field values on SkuPurchaseLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SkuPurchaseLimitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuPurchaseLimitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPurchaseLimitRequestMultiError, or nil if none found.
+func (m *SkuPurchaseLimitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuPurchaseLimitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() <= 0 {
+		err := SkuPurchaseLimitRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPurchaseLimit() < 0 {
+		err := SkuPurchaseLimitRequestValidationError{
+			field:  "PurchaseLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SkuPurchaseLimitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuPurchaseLimitRequestMultiError is an error wrapping multiple validation
+// errors returned by SkuPurchaseLimitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SkuPurchaseLimitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuPurchaseLimitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuPurchaseLimitRequestMultiError) AllErrors() []error { return m }
+
+// SkuPurchaseLimitRequestValidationError is the validation error returned by
+// SkuPurchaseLimitRequest.Validate if the designated constraints aren't met.
+type SkuPurchaseLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuPurchaseLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuPurchaseLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuPurchaseLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuPurchaseLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuPurchaseLimitRequestValidationError) ErrorName() string {
+	return "SkuPurchaseLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkuPurchaseLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuPurchaseLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuPurchaseLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuPurchaseLimitRequestValidationError{}
+
+// Validate checks the field values on SkuListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkuListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuListResponseMultiError, or nil if none found.
+func (m *SkuListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SkuListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SkuListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SkuListResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SkuListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuListResponseMultiError is an error wrapping multiple validation errors
+// returned by SkuListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SkuListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuListResponseMultiError) AllErrors() []error { return m }
+
+// SkuListResponseValidationError is the validation error returned by
+// SkuListResponse.Validate if the designated constraints aren't met.
+type SkuListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuListResponseValidationError) ErrorName() string { return "SkuListResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuListResponseValidationError{}
+
+// Validate checks the field values on GoodsTypeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeRequestMultiError, or nil if none found.
+func (m *GoodsTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) < 3 {
+		err := GoodsTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTypeCode()) < 3 {
+		err := GoodsTypeRequestValidationError{
+			field:  "TypeCode",
+			reason: "value length must be at least 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for NameAlias
+
+	// no validation rules for IsVirtual
+
+	// no validation rules for Desc
+
+	// no validation rules for Sort
+
+	if utf8.RuneCountInString(m.GetBrandIds()) < 1 {
+		err := GoodsTypeRequestValidationError{
+			field:  "BrandIds",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeRequestMultiError is an error wrapping multiple validation errors
+// returned by GoodsTypeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeRequestMultiError) AllErrors() []error { return m }
+
+// GoodsTypeRequestValidationError is the validation error returned by
+// GoodsTypeRequest.Validate if the designated constraints aren't met.
+type GoodsTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeRequestValidationError) ErrorName() string { return "GoodsTypeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeRequestValidationError{}
+
+// Validate checks the field values on GoodsTypeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeResponseMultiError, or nil if none found.
+func (m *GoodsTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GoodsTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeResponseMultiError is an error wrapping multiple validation errors
+// returned by GoodsTypeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeResponseMultiError) AllErrors() []error { return m }
+
+// GoodsTypeResponseValidationError is the validation error returned by
+// GoodsTypeResponse.Validate if the designated constraints aren't met.
+type GoodsTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeResponseValidationError) ErrorName() string {
+	return "GoodsTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeResponseValidationError{}
+
+// Validate checks the field values on UpdateGoodsTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateGoodsTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateGoodsTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateGoodsTypeRequestMultiError, or nil if none found.
+func (m *UpdateGoodsTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateGoodsTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateGoodsTypeRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Name != nil {
+
+		if utf8.RuneCountInString(m.GetName()) < 3 {
+			err := UpdateGoodsTypeRequestValidationError{
+				field:  "Name",
+				reason: "value length must be at least 3 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.TypeCode != nil {
+
+		if utf8.RuneCountInString(m.GetTypeCode()) < 3 {
+			err := UpdateGoodsTypeRequestValidationError{
+				field:  "TypeCode",
+				reason: "value length must be at least 3 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.NameAlias != nil {
+		// no validation rules for NameAlias
+	}
+
+	if m.IsVirtual != nil {
+		// no validation rules for IsVirtual
+	}
+
+	if m.Desc != nil {
+		// no validation rules for Desc
+	}
+
+	if m.Sort != nil {
+		// no validation rules for Sort
+	}
+
+	if m.BrandIds != nil {
+
+		if utf8.RuneCountInString(m.GetBrandIds()) < 1 {
+			err := UpdateGoodsTypeRequestValidationError{
+				field:  "BrandIds",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateGoodsTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateGoodsTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateGoodsTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateGoodsTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateGoodsTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateGoodsTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpdateGoodsTypeRequestValidationError is the validation error returned by
+// UpdateGoodsTypeRequest.Validate if the designated constraints aren't met.
+type UpdateGoodsTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateGoodsTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateGoodsTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateGoodsTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateGoodsTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateGoodsTypeRequestValidationError) ErrorName() string {
+	return "UpdateGoodsTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateGoodsTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateGoodsTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateGoodsTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateGoodsTypeRequestValidationError{}
+
+// Validate checks the field values on GoodsTypeInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeInfoResponseMultiError, or nil if none found.
+func (m *GoodsTypeInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for TypeCode
+
+	// no validation rules for NameAlias
+
+	// no validation rules for IsVirtual
+
+	// no validation rules for Desc
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return GoodsTypeInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeInfoResponseMultiError) AllErrors() []error { return m }
+
+// GoodsTypeInfoResponseValidationError is the validation error returned by
+// GoodsTypeInfoResponse.Validate if the designated constraints aren't met.
+type GoodsTypeInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeInfoResponseValidationError) ErrorName() string {
+	return "GoodsTypeInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeInfoResponseValidationError{}
+
+// Validate checks the field values on GoodsTypeListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeListRequestMultiError, or nil if none found.
+func (m *GoodsTypeListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pages
+
+	// no validation rules for PagePerNums
+
+	if len(errors) > 0 {
+		return GoodsTypeListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeListRequestMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeListRequestMultiError) AllErrors() []error { return m }
+
+// GoodsTypeListRequestValidationError is the validation error returned by
+// GoodsTypeListRequest.Validate if the designated constraints aren't met.
+type GoodsTypeListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeListRequestValidationError) ErrorName() string {
+	return "GoodsTypeListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeListRequestValidationError{}
+
+// Validate checks the field values on GoodsTypeListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeListResponseMultiError, or nil if none found.
+func (m *GoodsTypeListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeListResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsTypeListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeListResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeListResponseMultiError) AllErrors() []error { return m }
+
+// GoodsTypeListResponseValidationError is the validation error returned by
+// GoodsTypeListResponse.Validate if the designated constraints aren't met.
+type GoodsTypeListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeListResponseValidationError) ErrorName() string {
+	return "GoodsTypeListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeListResponseValidationError{}
+
+// Validate checks the field values on DeleteGoodsTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteGoodsTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteGoodsTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteGoodsTypeRequestMultiError, or nil if none found.
+func (m *DeleteGoodsTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteGoodsTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := DeleteGoodsTypeRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteGoodsTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteGoodsTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteGoodsTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteGoodsTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteGoodsTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteGoodsTypeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteGoodsTypeRequestValidationError is the validation error returned by
+// DeleteGoodsTypeRequest.Validate if the designated constraints aren't met.
+type DeleteGoodsTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGoodsTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGoodsTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGoodsTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGoodsTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGoodsTypeRequestValidationError) ErrorName() string {
+	return "DeleteGoodsTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGoodsTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGoodsTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGoodsTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGoodsTypeRequestValidationError{}
+
+// Validate checks the field values on GoodsTypeTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeTemplateRequestMultiError, or nil if none found.
+func (m *GoodsTypeTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := GoodsTypeTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsTypeTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GoodsTypeTemplateRequestValidationError is the validation error returned by
+// GoodsTypeTemplateRequest.Validate if the designated constraints aren't met.
+type GoodsTypeTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeTemplateRequestValidationError) ErrorName() string {
+	return "GoodsTypeTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeTemplateRequestValidationError{}
+
+// Validate checks the field values on SpecificationInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpecificationInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecificationInfoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpecificationInfoResponseMultiError, or nil if none found.
+func (m *SpecificationInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecificationInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TypeId
+
+	// no validation rules for Name
+
+	// no validation rules for Sort
+
+	// no validation rules for Status
+
+	// no validation rules for IsSku
+
+	// no validation rules for IsSelect
+
+	for idx, item := range m.GetSpecificationValue() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SpecificationInfoResponseValidationError{
+						field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SpecificationInfoResponseValidationError{
+						field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SpecificationInfoResponseValidationError{
+					field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SpecificationInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecificationInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by SpecificationInfoResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SpecificationInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecificationInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecificationInfoResponseMultiError) AllErrors() []error { return m }
+
+// SpecificationInfoResponseValidationError is the validation error returned by
+// SpecificationInfoResponse.Validate if the designated constraints aren't met.
+type SpecificationInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecificationInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecificationInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecificationInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecificationInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecificationInfoResponseValidationError) ErrorName() string {
+	return "SpecificationInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SpecificationInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecificationInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecificationInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecificationInfoResponseValidationError{}
+
+// Validate checks the field values on AttrGroupInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttrGroupInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrGroupInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttrGroupInfoResponseMultiError, or nil if none found.
+func (m *AttrGroupInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrGroupInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TypeId
+
+	// no validation rules for Title
+
+	// no validation rules for Desc
+
+	// no validation rules for Status
+
+	// no validation rules for Sort
+
+	for idx, item := range m.GetAttr() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttrGroupInfoResponseValidationError{
+						field:  fmt.Sprintf("Attr[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttrGroupInfoResponseValidationError{
+						field:  fmt.Sprintf("Attr[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttrGroupInfoResponseValidationError{
+					field:  fmt.Sprintf("Attr[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttrGroupInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrGroupInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by AttrGroupInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttrGroupInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrGroupInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrGroupInfoResponseMultiError) AllErrors() []error { return m }
+
+// AttrGroupInfoResponseValidationError is the validation error returned by
+// AttrGroupInfoResponse.Validate if the designated constraints aren't met.
+type AttrGroupInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrGroupInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrGroupInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrGroupInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrGroupInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrGroupInfoResponseValidationError) ErrorName() string {
+	return "AttrGroupInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttrGroupInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrGroupInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrGroupInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrGroupInfoResponseValidationError{}
+
+// Validate checks the field values on GoodsTypeTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeTemplateResponseMultiError, or nil if none found.
+func (m *GoodsTypeTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GoodsTypeTemplateResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GoodsTypeTemplateResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GoodsTypeTemplateResponseValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBrands() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Brands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Brands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeTemplateResponseValidationError{
+					field:  fmt.Sprintf("Brands[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSpecifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Specifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Specifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeTemplateResponseValidationError{
+					field:  fmt.Sprintf("Specifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAttrGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("AttrGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("AttrGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeTemplateResponseValidationError{
+					field:  fmt.Sprintf("AttrGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsTypeTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeTemplateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GoodsTypeTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GoodsTypeTemplateResponseValidationError is the validation error returned by
+// GoodsTypeTemplateResponse.Validate if the designated constraints aren't met.
+type GoodsTypeTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeTemplateResponseValidationError) ErrorName() string {
+	return "GoodsTypeTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeTemplateResponseValidationError{}
+
+// Validate checks the field values on GoodsFilterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsFilterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsFilterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsFilterRequestMultiError, or nil if none found.
+func (m *GoodsFilterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsFilterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Keywords
+
+	// no validation rules for CategoryId
+
+	// no validation rules for BrandId
+
+	// no validation rules for MinPrice
+
+	// no validation rules for MaxPrice
+
+	// no validation rules for IsHot
+
+	// no validation rules for IsNew
+
+	// no validation rules for IsTab
+
+	// no validation rules for ClickNum
+
+	// no validation rules for SoldNum
+
+	// no validation rules for FavNum
+
+	// no validation rules for Pages
+
+	// no validation rules for PagePerNums
+
+	// no validation rules for Id
+
+	for idx, item := range m.GetAttrs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsFilterRequestValidationError{
+						field:  fmt.Sprintf("Attrs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsFilterRequestValidationError{
+						field:  fmt.Sprintf("Attrs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsFilterRequestValidationError{
+					field:  fmt.Sprintf("Attrs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsFilterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsFilterRequestMultiError is an error wrapping multiple validation errors
+// returned by GoodsFilterRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsFilterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsFilterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsFilterRequestMultiError) AllErrors() []error { return m }
+
+// GoodsFilterRequestValidationError is the validation error returned by
+// GoodsFilterRequest.Validate if the designated constraints aren't met.
+type GoodsFilterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsFilterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsFilterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsFilterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsFilterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsFilterRequestValidationError) ErrorName() string {
+	return "GoodsFilterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsFilterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsFilterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsFilterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsFilterRequestValidationError{}
+
+// Validate checks the field values on GoodsInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodsInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsInfoResponseMultiError, or nil if none found.
+func (m *GoodsInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CategoryId
+
+	// no validation rules for BrandId
+
+	// no validation rules for Name
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for ClickNum
+
+	// no validation rules for SoldNum
+
+	// no validation rules for FavNum
+
+	// no validation rules for MarketPrice
+
+	// no validation rules for GoodsBrief
+
+	// no validation rules for GoodsDesc
+
+	// no validation rules for ShipFree
+
+	// no validation rules for Images
+
+	// no validation rules for IsNew
+
+	// no validation rules for IsHot
+
+	// no validation rules for OnSale
+
+	if len(errors) > 0 {
+		return GoodsInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by GoodsInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsInfoResponseMultiError) AllErrors() []error { return m }
+
+// GoodsInfoResponseValidationError is the validation error returned by
+// GoodsInfoResponse.Validate if the designated constraints aren't met.
+type GoodsInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsInfoResponseValidationError) ErrorName() string {
+	return "GoodsInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsInfoResponseValidationError{}
+
+// Validate checks the field values on GoodsListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodsListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsListResponseMultiError, or nil if none found.
+func (m *GoodsListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsListResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsListResponseMultiError is an error wrapping multiple validation errors
+// returned by GoodsListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsListResponseMultiError) AllErrors() []error { return m }
+
+// GoodsListResponseValidationError is the validation error returned by
+// GoodsListResponse.Validate if the designated constraints aren't met.
+type GoodsListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsListResponseValidationError) ErrorName() string {
+	return "GoodsListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsListResponseValidationError{}
+
+// Validate checks the field values on GoodInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodInfoRequestMultiError, or nil if none found.
+func (m *GoodInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := GoodInfoRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by GoodInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GoodInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodInfoRequestMultiError) AllErrors() []error { return m }
+
+// GoodInfoRequestValidationError is the validation error returned by
+// GoodInfoRequest.Validate if the designated constraints aren't met.
+type GoodInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodInfoRequestValidationError) ErrorName() string { return "GoodInfoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoodInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodInfoRequestValidationError{}
+
+// Validate checks the field values on BatchGoodsIdInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchGoodsIdInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGoodsIdInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGoodsIdInfoMultiError, or nil if none found.
+func (m *BatchGoodsIdInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGoodsIdInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetId()); l < 1 || l > 100 {
+		err := BatchGoodsIdInfoValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchGoodsIdInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGoodsIdInfoMultiError is an error wrapping multiple validation errors
+// returned by BatchGoodsIdInfo.ValidateAll() if the designated constraints
+// aren't met.
+type BatchGoodsIdInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGoodsIdInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGoodsIdInfoMultiError) AllErrors() []error { return m }
+
+// BatchGoodsIdInfoValidationError is the validation error returned by
+// BatchGoodsIdInfo.Validate if the designated constraints aren't met.
+type BatchGoodsIdInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGoodsIdInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGoodsIdInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGoodsIdInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGoodsIdInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGoodsIdInfoValidationError) ErrorName() string { return "BatchGoodsIdInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchGoodsIdInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGoodsIdInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGoodsIdInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGoodsIdInfoValidationError{}
+
+// Validate checks the field values on FavEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FavEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FavEventMultiError, or nil
+// if none found.
+func (m *FavEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEventId()); l < 1 || l > 64 {
+		err := FavEventValidationError{
+			field:  "EventId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsId() <= 0 {
+		err := FavEventValidationError{
+			field:  "GoodsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _FavEvent_Delta_InLookup[m.GetDelta()]; !ok {
+		err := FavEventValidationError{
+			field:  "Delta",
+			reason: "value must be in list [1 -1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FavEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavEventMultiError is an error wrapping multiple validation errors returned
+// by FavEvent.ValidateAll() if the designated constraints aren't met.
+type FavEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavEventMultiError) AllErrors() []error { return m }
+
+// FavEventValidationError is the validation error returned by
+// FavEvent.Validate if the designated constraints aren't met.
+type FavEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavEventValidationError) ErrorName() string { return "FavEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavEventValidationError{}
+
+var _FavEvent_Delta_InLookup = map[int32]struct{}{
+	1:  {},
+	-1: {},
+}
+
+// Validate checks the field values on FavEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FavEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FavEventsRequestMultiError, or nil if none found.
+func (m *FavEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetEvents()); l < 1 || l > 500 {
+		err := FavEventsRequestValidationError{
+			field:  "Events",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FavEventsRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FavEventsRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FavEventsRequestValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FavEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by FavEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FavEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavEventsRequestMultiError) AllErrors() []error { return m }
+
+// FavEventsRequestValidationError is the validation error returned by
+// FavEventsRequest.Validate if the designated constraints aren't met.
+type FavEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavEventsRequestValidationError) ErrorName() string { return "FavEventsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavEventsRequestValidationError{}
+
+// Validate checks the field values on UploadMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadMediaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadMediaRequestMultiError, or nil if none found.
+func (m *UploadMediaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadMediaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContent()) < 1 {
+		err := UploadMediaRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadMediaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadMediaRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadMediaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadMediaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadMediaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadMediaRequestMultiError) AllErrors() []error { return m }
+
+// UploadMediaRequestValidationError is the validation error returned by
+// UploadMediaRequest.Validate if the designated constraints aren't met.
+type UploadMediaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadMediaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadMediaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadMediaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadMediaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadMediaRequestValidationError) ErrorName() string {
+	return "UploadMediaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadMediaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadMediaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadMediaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadMediaRequestValidationError{}
+
+// Validate checks the field values on MediaResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaResponseMultiError, or
+// nil if none found.
+func (m *MediaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for ThumbUrl
+
+	// no validation rules for Hash
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if len(errors) > 0 {
+		return MediaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaResponseMultiError is an error wrapping multiple validation errors
+// returned by MediaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MediaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaResponseMultiError) AllErrors() []error { return m }
+
+// MediaResponseValidationError is the validation error returned by
+// MediaResponse.Validate if the designated constraints aren't met.
+type MediaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaResponseValidationError) ErrorName() string { return "MediaResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaResponseValidationError{}
+
+// Validate checks the field values on MediaGCRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaGCRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaGCRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaGCRequestMultiError,
+// or nil if none found.
+func (m *MediaGCRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaGCRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GraceSeconds
+
+	if len(errors) > 0 {
+		return MediaGCRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaGCRequestMultiError is an error wrapping multiple validation errors
+// returned by MediaGCRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MediaGCRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaGCRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaGCRequestMultiError) AllErrors() []error { return m }
+
+// MediaGCRequestValidationError is the validation error returned by
+// MediaGCRequest.Validate if the designated constraints aren't met.
+type MediaGCRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaGCRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaGCRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaGCRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaGCRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaGCRequestValidationError) ErrorName() string { return "MediaGCRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaGCRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaGCRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaGCRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaGCRequestValidationError{}
+
+// Validate checks the field values on MediaGCResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MediaGCResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaGCResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MediaGCResponseMultiError, or nil if none found.
+func (m *MediaGCResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaGCResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return MediaGCResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaGCResponseMultiError is an error wrapping multiple validation errors
+// returned by MediaGCResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MediaGCResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaGCResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaGCResponseMultiError) AllErrors() []error { return m }
+
+// MediaGCResponseValidationError is the validation error returned by
+// MediaGCResponse.Validate if the designated constraints aren't met.
+type MediaGCResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaGCResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaGCResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaGCResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaGCResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaGCResponseValidationError) ErrorName() string { return "MediaGCResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaGCResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaGCResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaGCResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaGCResponseValidationError{}
+
+// Validate checks the field values on ChangeSkuPriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeSkuPriceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeSkuPriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeSkuPriceRequestMultiError, or nil if none found.
+func (m *ChangeSkuPriceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeSkuPriceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() < 1 {
+		err := ChangeSkuPriceRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := ChangeSkuPriceRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPromotionPrice() < 0 {
+		err := ChangeSkuPriceRequestValidationError{
+			field:  "PromotionPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for EffectiveUntil
+
+	// no validation rules for Remark
+
+	if len(errors) > 0 {
+		return ChangeSkuPriceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeSkuPriceRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangeSkuPriceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeSkuPriceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeSkuPriceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeSkuPriceRequestMultiError) AllErrors() []error { return m }
+
+// ChangeSkuPriceRequestValidationError is the validation error returned by
+// ChangeSkuPriceRequest.Validate if the designated constraints aren't met.
+type ChangeSkuPriceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeSkuPriceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeSkuPriceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeSkuPriceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeSkuPriceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeSkuPriceRequestValidationError) ErrorName() string {
+	return "ChangeSkuPriceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeSkuPriceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeSkuPriceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeSkuPriceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeSkuPriceRequestValidationError{}
+
+// Validate checks the field values on SkuPriceResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuPriceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPriceResponseMultiError, or nil if none found.
+func (m *SkuPriceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuPriceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SkuId
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for EffectiveUntil
+
+	// no validation rules for Status
+
+	// no validation rules for Remark
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return SkuPriceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuPriceResponseMultiError is an error wrapping multiple validation errors
+// returned by SkuPriceResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SkuPriceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuPriceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuPriceResponseMultiError) AllErrors() []error { return m }
+
+// SkuPriceResponseValidationError is the validation error returned by
+// SkuPriceResponse.Validate if the designated constraints aren't met.
+type SkuPriceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuPriceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuPriceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuPriceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuPriceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuPriceResponseValidationError) ErrorName() string { return "SkuPriceResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuPriceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuPriceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuPriceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuPriceResponseValidationError{}
+
+// Validate checks the field values on SkuPriceAtRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceAtRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuPriceAtRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPriceAtRequestMultiError, or nil if none found.
+func (m *SkuPriceAtRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuPriceAtRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() < 1 {
+		err := SkuPriceAtRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTimestamp() < 1 {
+		err := SkuPriceAtRequestValidationError{
+			field:  "Timestamp",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SkuPriceAtRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuPriceAtRequestMultiError is an error wrapping multiple validation errors
+// returned by SkuPriceAtRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SkuPriceAtRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuPriceAtRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuPriceAtRequestMultiError) AllErrors() []error { return m }
+
+// SkuPriceAtRequestValidationError is the validation error returned by
+// SkuPriceAtRequest.Validate if the designated constraints aren't met.
+type SkuPriceAtRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuPriceAtRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuPriceAtRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuPriceAtRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuPriceAtRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuPriceAtRequestValidationError) ErrorName() string {
+	return "SkuPriceAtRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkuPriceAtRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuPriceAtRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuPriceAtRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuPriceAtRequestValidationError{}
+
+// Validate checks the field values on SkuPriceHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuPriceHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPriceHistoryRequestMultiError, or nil if none found.
+func (m *SkuPriceHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuPriceHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() < 1 {
+		err := SkuPriceHistoryRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SkuPriceHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuPriceHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by SkuPriceHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SkuPriceHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuPriceHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuPriceHistoryRequestMultiError) AllErrors() []error { return m }
+
+// SkuPriceHistoryRequestValidationError is the validation error returned by
+// SkuPriceHistoryRequest.Validate if the designated constraints aren't met.
+type SkuPriceHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuPriceHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuPriceHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuPriceHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuPriceHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuPriceHistoryRequestValidationError) ErrorName() string {
+	return "SkuPriceHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkuPriceHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuPriceHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuPriceHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuPriceHistoryRequestValidationError{}
+
+// Validate checks the field values on SkuPriceHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuPriceHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPriceHistoryResponseMultiError, or nil if none found.
+func (m *SkuPriceHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuPriceHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SkuPriceHistoryResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SkuPriceHistoryResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SkuPriceHistoryResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SkuPriceHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuPriceHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by SkuPriceHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SkuPriceHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuPriceHistoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuPriceHistoryResponseMultiError) AllErrors() []error { return m }
+
+// SkuPriceHistoryResponseValidationError is the validation error returned by
+// SkuPriceHistoryResponse.Validate if the designated constraints aren't met.
+type SkuPriceHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuPriceHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuPriceHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuPriceHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuPriceHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuPriceHistoryResponseValidationError) ErrorName() string {
+	return "SkuPriceHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkuPriceHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuPriceHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuPriceHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuPriceHistoryResponseValidationError{}
+
+// Validate checks the field values on CreateGoodsRequestGoodsSku with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGoodsRequestGoodsSku) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGoodsRequestGoodsSku with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGoodsRequestGoodsSkuMultiError, or nil if none found.
+func (m *CreateGoodsRequestGoodsSku) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGoodsRequestGoodsSku) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GoodsId
+
+	if utf8.RuneCountInString(m.GetSkuName()) < 1 {
+		err := CreateGoodsRequestGoodsSkuValidationError{
+			field:  "SkuName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := CreateGoodsRequestGoodsSkuValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBarCode()) < 1 {
+		err := CreateGoodsRequestGoodsSkuValidationError{
+			field:  "BarCode",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for Points
+
+	// no validation rules for Image
+
+	// no validation rules for Sort
+
+	// no validation rules for Inventory
+
+	for idx, item := range m.GetSpecificationInfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateGoodsRequestGoodsSkuValidationError{
+						field:  fmt.Sprintf("SpecificationInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateGoodsRequestGoodsSkuValidationError{
+						field:  fmt.Sprintf("SpecificationInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateGoodsRequestGoodsSkuValidationError{
+					field:  fmt.Sprintf("SpecificationInfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetGroupAttrInfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateGoodsRequestGoodsSkuValidationError{
+						field:  fmt.Sprintf("GroupAttrInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateGoodsRequestGoodsSkuValidationError{
+						field:  fmt.Sprintf("GroupAttrInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateGoodsRequestGoodsSkuValidationError{
+					field:  fmt.Sprintf("GroupAttrInfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetPurchaseLimit() < 0 {
+		err := CreateGoodsRequestGoodsSkuValidationError{
+			field:  "PurchaseLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateGoodsRequestGoodsSkuMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGoodsRequestGoodsSkuMultiError is an error wrapping multiple
+// validation errors returned by CreateGoodsRequestGoodsSku.ValidateAll() if
+// the designated constraints aren't met.
+type CreateGoodsRequestGoodsSkuMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGoodsRequestGoodsSkuMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGoodsRequestGoodsSkuMultiError) AllErrors() []error { return m }
+
+// CreateGoodsRequestGoodsSkuValidationError is the validation error returned
+// by CreateGoodsRequestGoodsSku.Validate if the designated constraints aren't met.
+type CreateGoodsRequestGoodsSkuValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGoodsRequestGoodsSkuValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGoodsRequestGoodsSkuValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGoodsRequestGoodsSkuValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGoodsRequestGoodsSkuValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGoodsRequestGoodsSkuValidationError) ErrorName() string {
+	return "CreateGoodsRequestGoodsSkuValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGoodsRequestGoodsSkuValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGoodsRequestGoodsSku.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGoodsRequestGoodsSkuValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGoodsRequestGoodsSkuValidationError{}
+
+// Validate checks the field values on CreateGoodsRequestGoodsSkuSpecification
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateGoodsRequestGoodsSkuSpecification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateGoodsRequestGoodsSkuSpecification with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateGoodsRequestGoodsSkuSpecificationMultiError, or nil if none found.
+func (m *CreateGoodsRequestGoodsSkuSpecification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGoodsRequestGoodsSkuSpecification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSId() < 1 {
+		err := CreateGoodsRequestGoodsSkuSpecificationValidationError{
+			field:  "SId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVId() < 1 {
+		err := CreateGoodsRequestGoodsSkuSpecificationValidationError{
+			field:  "VId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateGoodsRequestGoodsSkuSpecificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGoodsRequestGoodsSkuSpecificationMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateGoodsRequestGoodsSkuSpecification.ValidateAll() if the designated
+// constraints aren't met.
+type CreateGoodsRequestGoodsSkuSpecificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGoodsRequestGoodsSkuSpecificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGoodsRequestGoodsSkuSpecificationMultiError) AllErrors() []error { return m }
+
+// CreateGoodsRequestGoodsSkuSpecificationValidationError is the validation
+// error returned by CreateGoodsRequestGoodsSkuSpecification.Validate if the
+// designated constraints aren't met.
+type CreateGoodsRequestGoodsSkuSpecificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGoodsRequestGoodsSkuSpecificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGoodsRequestGoodsSkuSpecificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGoodsRequestGoodsSkuSpecificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGoodsRequestGoodsSkuSpecificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGoodsRequestGoodsSkuSpecificationValidationError) ErrorName() string {
+	return "CreateGoodsRequestGoodsSkuSpecificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGoodsRequestGoodsSkuSpecificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGoodsRequestGoodsSkuSpecification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGoodsRequestGoodsSkuSpecificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGoodsRequestGoodsSkuSpecificationValidationError{}
+
+// Validate checks the field values on CreateGoodsRequestGoodsSkuGroupAttr with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateGoodsRequestGoodsSkuGroupAttr) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGoodsRequestGoodsSkuGroupAttr
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateGoodsRequestGoodsSkuGroupAttrMultiError, or nil if none found.
+func (m *CreateGoodsRequestGoodsSkuGroupAttr) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGoodsRequestGoodsSkuGroupAttr) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for GroupName
+
+	for idx, item := range m.GetAttrInfo() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateGoodsRequestGoodsSkuGroupAttrValidationError{
+						field:  fmt.Sprintf("AttrInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateGoodsRequestGoodsSkuGroupAttrValidationError{
+						field:  fmt.Sprintf("AttrInfo[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateGoodsRequestGoodsSkuGroupAttrValidationError{
+					field:  fmt.Sprintf("AttrInfo[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateGoodsRequestGoodsSkuGroupAttrMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGoodsRequestGoodsSkuGroupAttrMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateGoodsRequestGoodsSkuGroupAttr.ValidateAll() if the designated
+// constraints aren't met.
+type CreateGoodsRequestGoodsSkuGroupAttrMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGoodsRequestGoodsSkuGroupAttrMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGoodsRequestGoodsSkuGroupAttrMultiError) AllErrors() []error { return m }
+
+// CreateGoodsRequestGoodsSkuGroupAttrValidationError is the validation error
+// returned by CreateGoodsRequestGoodsSkuGroupAttr.Validate if the designated
+// constraints aren't met.
+type CreateGoodsRequestGoodsSkuGroupAttrValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGoodsRequestGoodsSkuGroupAttrValidationError) ErrorName() string {
+	return "CreateGoodsRequestGoodsSkuGroupAttrValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGoodsRequestGoodsSkuGroupAttrValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGoodsRequestGoodsSkuGroupAttr.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGoodsRequestGoodsSkuGroupAttrValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGoodsRequestGoodsSkuGroupAttrValidationError{}
+
+// Validate checks the field values on CreateGoodsRequestGoodsSkuGroupAttrAttr
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateGoodsRequestGoodsSkuGroupAttrAttr) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateGoodsRequestGoodsSkuGroupAttrAttr with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// CreateGoodsRequestGoodsSkuGroupAttrAttrMultiError, or nil if none found.
+func (m *CreateGoodsRequestGoodsSkuGroupAttrAttr) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGoodsRequestGoodsSkuGroupAttrAttr) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAttrId() < 1 {
+		err := CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError{
+			field:  "AttrId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAttrName()) < 1 {
+		err := CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError{
+			field:  "AttrName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAttrValueId() < 1 {
+		err := CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError{
+			field:  "AttrValueId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAttrValueName()) < 1 {
+		err := CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError{
+			field:  "AttrValueName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateGoodsRequestGoodsSkuGroupAttrAttrMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGoodsRequestGoodsSkuGroupAttrAttrMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateGoodsRequestGoodsSkuGroupAttrAttr.ValidateAll() if the designated
+// constraints aren't met.
+type CreateGoodsRequestGoodsSkuGroupAttrAttrMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGoodsRequestGoodsSkuGroupAttrAttrMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGoodsRequestGoodsSkuGroupAttrAttrMultiError) AllErrors() []error { return m }
+
+// CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError is the validation
+// error returned by CreateGoodsRequestGoodsSkuGroupAttrAttr.Validate if the
+// designated constraints aren't met.
+type CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError) ErrorName() string {
+	return "CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGoodsRequestGoodsSkuGroupAttrAttr.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError{}
+
+// Validate checks the field values on GoodsFilterRequestAttrFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsFilterRequestAttrFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsFilterRequestAttrFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsFilterRequestAttrFilterMultiError, or nil if none found.
+func (m *GoodsFilterRequestAttrFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsFilterRequestAttrFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAttrId() < 1 {
+		err := GoodsFilterRequestAttrFilterValidationError{
+			field:  "AttrId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetValueIds()) < 1 {
+		err := GoodsFilterRequestAttrFilterValidationError{
+			field:  "ValueIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsFilterRequestAttrFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsFilterRequestAttrFilterMultiError is an error wrapping multiple
+// validation errors returned by GoodsFilterRequestAttrFilter.ValidateAll() if
+// the designated constraints aren't met.
+type GoodsFilterRequestAttrFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsFilterRequestAttrFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsFilterRequestAttrFilterMultiError) AllErrors() []error { return m }
+
+// GoodsFilterRequestAttrFilterValidationError is the validation error returned
+// by GoodsFilterRequestAttrFilter.Validate if the designated constraints
+// aren't met.
+type GoodsFilterRequestAttrFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsFilterRequestAttrFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsFilterRequestAttrFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsFilterRequestAttrFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsFilterRequestAttrFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsFilterRequestAttrFilterValidationError) ErrorName() string {
+	return "GoodsFilterRequestAttrFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsFilterRequestAttrFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsFilterRequestAttrFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsFilterRequestAttrFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsFilterRequestAttrFilterValidationError{}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"cart/internal/conf"
+	"cart/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -38,7 +39,8 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, rr registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, cf *server.CartFlusher,
+	fd *server.FavEventDispatcher, rr registry.Registrar) *kratos.App {
 	return kratos.New(
 		kratos.ID(id+"cart service"),
 		kratos.Name(Name),
@@ -47,6 +49,8 @@ func newApp(logger log.Logger, gs *grpc.Server, rr registry.Registrar) *kratos.A
 		kratos.Logger(logger),
 		kratos.Server(
 			gs,
+			cf,
+			fd,
 		),
 		kratos.Registrar(rr), // consul 的引入 服务发现和注册
 	)
@@ -109,7 +113,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	app, cleanup, err := wireApp(bc.Server, bc.Data, &rc, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Service, bc.Checkout, &rc, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -23,19 +23,33 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, confService *conf.Service, checkout *conf.Checkout, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client)
 	if err != nil {
 		return nil, nil, err
 	}
-	cartRepo := data.NewCartRepo(dataData, logger)
-	cartUsecase := biz.NewCartUsecase(cartRepo, logger)
-	cartService := service.NewCartService(cartUsecase)
+	cartRepo := data.NewCartStore(confData, dataData, logger)
+	discovery := data.NewDiscovery(registry)
+	goodsClient := data.NewGoodsServiceClient(confService, discovery)
+	goodsRepo := data.NewGoodsRepo(goodsClient, logger)
+	cartRule := data.NewCartRule(confData)
+	cartUsecase := biz.NewCartUsecase(cartRepo, goodsRepo, cartRule, logger)
+	guestCartRepo := data.NewGuestCartRepo(confData, dataData, logger)
+	guestCartUsecase := biz.NewGuestCartUsecase(guestCartRepo, cartUsecase, goodsRepo, logger)
+	couponRepo := data.NewCouponRepo(checkout)
+	shipTemplateRepo := data.NewShipTemplateRepo(checkout)
+	checkoutUsecase := biz.NewCheckoutUsecase(cartRepo, goodsRepo, couponRepo, shipTemplateRepo, logger)
+	savedCartRepo := data.NewSavedCartRepo(dataData, logger)
+	wishlistRepo := data.NewWishlistRepo(dataData, logger)
+	listUsecase := biz.NewListUsecase(cartUsecase, savedCartRepo, wishlistRepo, goodsRepo, logger)
+	cartService := service.NewCartService(cartUsecase, guestCartUsecase, checkoutUsecase, listUsecase)
 	grpcServer := server.NewGRPCServer(confServer, cartService, logger)
+	cartFlusher := server.NewCartFlusher(confData, cartUsecase, logger)
+	favEventDispatcher := server.NewFavEventDispatcher(listUsecase, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, registrar)
+	app := newApp(logger, grpcServer, cartFlusher, favEventDispatcher, registrar)
 	return app, func() {
 		cleanup()
 	}, nil
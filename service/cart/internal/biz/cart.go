@@ -1,44 +1,314 @@
 package biz
 
 import (
+	v1 "cart/api/cart/v1"
 	"cart/internal/domain"
 	"context"
+	"fmt"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
-// var (
-//
-//	// ErrUserNotFound is user not found.
-//	ErrUserNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
-//
-// )
+var (
+	// ErrCartLinesExceeded 存储中检查到超过商品种类上限，并发加入购物车时提前检查可能通过
+	ErrCartLinesExceeded = errors.BadRequest(v1.ErrorReason_CART_LINE_LIMIT_EXCEEDED.String(), "购物车商品种类已达上限")
+	// ErrCartNumExceeded 存储中检查到超过可以购买的数量
+	ErrCartNumExceeded = errors.BadRequest(v1.ErrorReason_CART_LINE_QUANTITY_EXCEEDED.String(), "商品数量超过可购买数量")
+)
+
 type CartRepo interface {
-	Create(ctx context.Context, c *domain.ShopCart) (*domain.ShopCart, error)
+	// Create 加入购物车，已经存在的商品累加数量，在同一个原子操作中检查 limit
+	Create(ctx context.Context, c *domain.ShopCart, limit *domain.CartLimit) (*domain.ShopCart, error)
 	List(ctx context.Context, userId int64) (domain.ShopCartList, error)
+	UpdateNum(ctx context.Context, userId, skuId int64, num int32) error
+	Select(ctx context.Context, userId int64, isSelect bool, skuIds ...int64) error
+	Delete(ctx context.Context, userId int64, skuIds ...int64) error
+	Clear(ctx context.Context, userId int64) error
+	Flush(ctx context.Context) (int, error) // 将缓存中修改过的购物车写回数据库，返回写回的用户数
+}
+
+// GoodsRepo 商品服务，购物车中商品的价格和库存以商品服务为准
+type GoodsRepo interface {
+	ListSku(ctx context.Context, ids ...int64) (domain.SkuList, error)
+	ListGoods(ctx context.Context, ids ...int64) (domain.GoodsList, error)
+	ApplyFavEvents(ctx context.Context, events ...*domain.FavEvent) error
 }
 
 type CartUsecase struct {
-	repo CartRepo
-	log  *log.Helper
+	repo      CartRepo
+	goodsRepo GoodsRepo
+	rule      *domain.CartRule
+	log       *log.Helper
 }
 
-func NewCartUsecase(repo CartRepo, logger log.Logger) *CartUsecase {
-	return &CartUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewCartUsecase(repo CartRepo, goodsRepo GoodsRepo, rule *domain.CartRule, logger log.Logger) *CartUsecase {
+	return &CartUsecase{repo: repo, goodsRepo: goodsRepo, rule: rule, log: log.NewHelper(logger)}
 }
 
+// CreateCart 加入购物车，商品名称、编号和价格从商品服务获取，不使用客户端传入的值
 func (uc *CartUsecase) CreateCart(ctx context.Context, c *domain.ShopCart) (*domain.ShopCart, error) {
-	return uc.repo.Create(ctx, c)
+	sku, err := uc.findSku(ctx, c.SkuId)
+	if err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.List(ctx, c.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkAdd(list, sku, c.GoodsNum); err != nil {
+		return nil, err
+	}
+
+	c.GoodsId = sku.GoodsId
+	c.GoodsSn = sku.GoodsSn
+	c.GoodsName = sku.GoodsName
+	c.GoodsPrice = sku.Price
+	res, err := uc.repo.Create(ctx, c, uc.rule.Limit(sku))
+	if err != nil {
+		return nil, err
+	}
+	res.Check(sku)
+	return res, nil
 }
+
+// CreateCarts 批量加入购物车，先检查所有商品，有一个商品不能加入时返回错误，不修改购物车
+func (uc *CartUsecase) CreateCarts(ctx context.Context, userId int64, items domain.ShopCartList) error {
+	carts, skus, err := uc.checkCarts(ctx, userId, items)
+	if err != nil {
+		return err
+	}
+	return uc.createCarts(ctx, carts, skus)
+}
+
+// checkCarts 检查批量加入的商品，返回需要写入的购物车和商品服务的 sku
+func (uc *CartUsecase) checkCarts(ctx context.Context, userId int64, items domain.ShopCartList) (domain.ShopCartList, domain.SkuList, error) {
+	list, err := uc.repo.List(ctx, userId)
+	if err != nil {
+		return nil, nil, err
+	}
+	skus, err := uc.goodsRepo.ListSku(ctx, items.SkuIds()...)
+	if err != nil {
+		return nil, nil, err
+	}
+	var carts domain.ShopCartList
+	for _, item := range items {
+		sku := skus.FindById(item.SkuId)
+		if sku == nil {
+			return nil, nil, errors.NotFound(v1.ErrorReason_SKU_NOT_FOUND.String(), "商品不存在")
+		}
+		if !sku.OnSale {
+			return nil, nil, errors.BadRequest(v1.ErrorReason_GOODS_NOT_ON_SALE.String(), "商品已下架")
+		}
+		if err := uc.checkAdd(list, sku, item.GoodsNum); err != nil {
+			return nil, nil, err
+		}
+		// 前面的商品计入购物车后再检查后面的商品种类上限
+		if exist := list.FindBySkuId(sku.ID); exist != nil {
+			exist.GoodsNum += item.GoodsNum
+		} else {
+			list = append(list, &domain.ShopCart{SkuId: sku.ID, GoodsNum: item.GoodsNum})
+		}
+		carts = append(carts, &domain.ShopCart{
+			UserId:     userId,
+			GoodsId:    sku.GoodsId,
+			SkuId:      sku.ID,
+			GoodsPrice: sku.Price,
+			GoodsNum:   item.GoodsNum,
+			GoodsSn:    sku.GoodsSn,
+			GoodsName:  sku.GoodsName,
+			IsSelect:   true,
+		})
+	}
+	return carts, skus, nil
+}
+
+func (uc *CartUsecase) createCarts(ctx context.Context, carts domain.ShopCartList, skus domain.SkuList) error {
+	for _, cart := range carts {
+		if _, err := uc.repo.Create(ctx, cart, uc.rule.Limit(skus.FindById(cart.SkuId))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge 将其他购物车中的商品合并到用户购物车，相同 sku 的数量相加且不超过库存、数量上限和限购数量，
+// 已下架、没有库存或超过商品种类上限的商品不合并
+func (uc *CartUsecase) Merge(ctx context.Context, userId int64, items domain.ShopCartList) error {
+	carts, err := uc.repo.List(ctx, userId)
+	if err != nil {
+		return err
+	}
+	skus, err := uc.goodsRepo.ListSku(ctx, items.SkuIds()...)
+	if err != nil {
+		return err
+	}
+
+	lines := len(carts)
+	for _, item := range items {
+		sku := skus.FindById(item.SkuId)
+		if sku == nil || !sku.OnSale {
+			continue
+		}
+		var num int32
+		if exist := carts.FindBySkuId(item.SkuId); exist != nil {
+			num = exist.GoodsNum
+		} else if uc.rule.LinesExceeded(lines) {
+			continue
+		}
+		target := num + item.GoodsNum
+		if max := uc.rule.MaxNum(sku); target > max {
+			target = max
+		}
+		if target <= num {
+			continue
+		}
+
+		if num > 0 {
+			err = uc.repo.UpdateNum(ctx, userId, item.SkuId, target)
+		} else {
+			lines++
+			_, err = uc.repo.Create(ctx, &domain.ShopCart{
+				UserId:     userId,
+				GoodsId:    sku.GoodsId,
+				SkuId:      sku.ID,
+				GoodsPrice: sku.Price,
+				GoodsNum:   target,
+				GoodsSn:    sku.GoodsSn,
+				GoodsName:  sku.GoodsName,
+				IsSelect:   item.IsSelect,
+			}, uc.rule.Limit(sku))
+			// 并发加入购物车后超过限制的商品不合并
+			if errors.Is(err, ErrCartLinesExceeded) || errors.Is(err, ErrCartNumExceeded) {
+				err = nil
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List 购物车列表，查询商品服务标记价格变化和缺货的商品
 func (uc *CartUsecase) List(ctx context.Context, userId int64) (domain.ShopCartList, error) {
-	return uc.repo.List(ctx, userId)
+	list, err := uc.repo.List(ctx, userId)
+	if err != nil || len(list) == 0 {
+		return list, err
+	}
+
+	skus, err := uc.goodsRepo.ListSku(ctx, list.SkuIds()...)
+	if err != nil {
+		return nil, err
+	}
+	for _, cart := range list {
+		cart.Check(skus.FindById(cart.SkuId))
+	}
+	return list, nil
 }
 
 func (uc *CartUsecase) ListSelected(ctx context.Context, userId int64) (domain.ShopCartList, error) {
-	res, err := uc.repo.List(ctx, userId)
+	res, err := uc.List(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
 	res = res.ListSelected()
 	return res, nil
 }
+
+// UpdateNum 修改购物车商品数量，数量必须大于0，删除商品使用 Delete
+func (uc *CartUsecase) UpdateNum(ctx context.Context, userId, skuId int64, num int32) error {
+	if num <= 0 {
+		return errors.BadRequest(v1.ErrorReason_GOODS_NUM_INVALID.String(), "商品数量必须大于0")
+	}
+	sku, err := uc.getSku(ctx, skuId)
+	if err != nil {
+		return err
+	}
+	if err := uc.checkUpdate(sku, num); err != nil {
+		return err
+	}
+	return uc.repo.UpdateNum(ctx, userId, skuId, num)
+}
+
+// Select 选中或取消选中购物车商品，skuIds 为空时操作所有商品
+func (uc *CartUsecase) Select(ctx context.Context, userId int64, isSelect bool, skuIds ...int64) error {
+	return uc.repo.Select(ctx, userId, isSelect, skuIds...)
+}
+
+func (uc *CartUsecase) Delete(ctx context.Context, userId int64, skuIds ...int64) error {
+	if len(skuIds) == 0 {
+		return errors.BadRequest(v1.ErrorReason_SKU_IS_EMPTY.String(), "请选择要删除的商品")
+	}
+	return uc.repo.Delete(ctx, userId, skuIds...)
+}
+
+func (uc *CartUsecase) Clear(ctx context.Context, userId int64) error {
+	return uc.repo.Clear(ctx, userId)
+}
+
+// Flush 将缓存中修改过的购物车写回数据库
+func (uc *CartUsecase) Flush(ctx context.Context) (int, error) {
+	return uc.repo.Flush(ctx)
+}
+
+// getSku 从商品服务查询 sku，商品服务没有返回时为商品不存在
+func (uc *CartUsecase) getSku(ctx context.Context, skuId int64) (*domain.Sku, error) {
+	skus, err := uc.goodsRepo.ListSku(ctx, skuId)
+	if err != nil {
+		return nil, err
+	}
+	sku := skus.FindById(skuId)
+	if sku == nil {
+		return nil, errors.NotFound(v1.ErrorReason_SKU_NOT_FOUND.String(), "商品不存在")
+	}
+	return sku, nil
+}
+
+// findSku 查询可以加入购物车的 sku
+func (uc *CartUsecase) findSku(ctx context.Context, skuId int64) (*domain.Sku, error) {
+	sku, err := uc.getSku(ctx, skuId)
+	if err != nil {
+		return nil, err
+	}
+	if !sku.OnSale {
+		return nil, errors.BadRequest(v1.ErrorReason_GOODS_NOT_ON_SALE.String(), "商品已下架")
+	}
+	return sku, nil
+}
+
+// checkAdd 检查加入购物车后的商品种类、数量和库存，已经在购物车中的商品数量累加计算。
+// 提前检查用于返回具体的错误信息，存储写入时还会在原子操作中再次检查
+func (uc *CartUsecase) checkAdd(list domain.ShopCartList, sku *domain.Sku, num int32) error {
+	if exist := list.FindBySkuId(sku.ID); exist != nil {
+		num += exist.GoodsNum
+	} else if uc.rule.LinesExceeded(len(list)) {
+		return errors.BadRequest(v1.ErrorReason_CART_LINE_LIMIT_EXCEEDED.String(),
+			fmt.Sprintf("购物车最多添加%d种商品", uc.rule.MaxLines))
+	}
+	return uc.checkUpdate(sku, num)
+}
+
+// checkUpdate 检查修改后的商品数量是否超过数量上限、限购数量和库存
+func (uc *CartUsecase) checkUpdate(sku *domain.Sku, num int32) error {
+	if err := uc.checkNum(sku, num); err != nil {
+		return err
+	}
+	if sku.Inventory < int64(num) {
+		return errors.BadRequest(v1.ErrorReason_GOODS_STOCK_NOT_ENOUGH.String(), "商品库存不足")
+	}
+	return nil
+}
+
+// checkNum 检查单个商品的数量上限和限购数量
+func (uc *CartUsecase) checkNum(sku *domain.Sku, num int32) error {
+	if uc.rule.QuantityExceeded(num) {
+		return errors.BadRequest(v1.ErrorReason_CART_LINE_QUANTITY_EXCEEDED.String(),
+			fmt.Sprintf("单个商品最多购买%d件", uc.rule.MaxQuantity))
+	}
+	if sku.PurchaseLimitExceeded(num) {
+		return errors.BadRequest(v1.ErrorReason_SKU_PURCHASE_LIMIT_EXCEEDED.String(),
+			fmt.Sprintf("该商品每人限购%d件", sku.PurchaseLimit))
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package biz
+
+import (
+	"cart/internal/domain"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CouponRepo 优惠券
+type CouponRepo interface {
+	ListByCodes(ctx context.Context, codes ...string) ([]*domain.Coupon, error)
+}
+
+// ShipTemplateRepo 运费模版，没有配置的模版使用默认运费
+type ShipTemplateRepo interface {
+	ListByIds(ctx context.Context, ids ...int32) (map[int32]*domain.ShipTemplate, error)
+}
+
+type CheckoutUsecase struct {
+	repo       CartRepo
+	goodsRepo  GoodsRepo
+	couponRepo CouponRepo
+	shipRepo   ShipTemplateRepo
+	log        *log.Helper
+}
+
+func NewCheckoutUsecase(repo CartRepo, goodsRepo GoodsRepo, couponRepo CouponRepo, shipRepo ShipTemplateRepo,
+	logger log.Logger) *CheckoutUsecase {
+	return &CheckoutUsecase{
+		repo:       repo,
+		goodsRepo:  goodsRepo,
+		couponRepo: couponRepo,
+		shipRepo:   shipRepo,
+		log:        log.NewHelper(logger),
+	}
+}
+
+// Preview 计算选中商品的结算金额，依次计算促销价、优惠券和运费，
+// 已下架、库存不足或超过限购的商品不参与结算，每个订单只能使用一张优惠券
+func (uc *CheckoutUsecase) Preview(ctx context.Context, userId int64, couponCodes ...string) (*domain.Checkout, error) {
+	list, err := uc.repo.List(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	list = list.ListSelected()
+
+	res := &domain.Checkout{}
+	if len(list) > 0 {
+		skus, err := uc.goodsRepo.ListSku(ctx, list.SkuIds()...)
+		if err != nil {
+			return nil, err
+		}
+		for _, cart := range list {
+			sku := skus.FindById(cart.SkuId)
+			if cart.Check(sku); cart.OutOfStock {
+				res.InvalidSkuIds = append(res.InvalidSkuIds, cart.SkuId)
+				continue
+			}
+			if cart.OverLimit {
+				res.OverLimitSkuIds = append(res.OverLimitSkuIds, cart.SkuId)
+				continue
+			}
+			res.Lines = append(res.Lines, domain.NewCheckoutLine(cart, sku))
+		}
+	}
+
+	// 同一张优惠券只能使用一次
+	couponCodes = uniqueCodes(couponCodes)
+	if len(couponCodes) > 0 {
+		coupons, err := uc.couponRepo.ListByCodes(ctx, couponCodes...)
+		if err != nil {
+			return nil, err
+		}
+		var applied bool
+		for _, code := range couponCodes {
+			coupon := findCoupon(coupons, code)
+			if coupon == nil {
+				res.Coupons = append(res.Coupons, &domain.CouponResult{Code: code, Reason: "优惠券不存在"})
+				continue
+			}
+			// 已经使用了一张优惠券，其余的不再叠加
+			if applied {
+				res.Coupons = append(res.Coupons, &domain.CouponResult{Code: code, Name: coupon.Name, Reason: "每个订单只能使用一张优惠券"})
+				continue
+			}
+			result := res.ApplyCoupon(coupon)
+			applied = result.Applied
+			res.Coupons = append(res.Coupons, result)
+		}
+	}
+
+	if ids := res.ShipTemplateIds(); len(ids) > 0 {
+		templates, err := uc.shipRepo.ListByIds(ctx, ids...)
+		if err != nil {
+			return nil, err
+		}
+		res.ApplyShipping(templates)
+	}
+	res.Sum()
+	return res, nil
+}
+
+func uniqueCodes(codes []string) []string {
+	seen := make(map[string]bool, len(codes))
+	res := make([]string, 0, len(codes))
+	for _, code := range codes {
+		if !seen[code] {
+			seen[code] = true
+			res = append(res, code)
+		}
+	}
+	return res
+}
+
+func findCoupon(coupons []*domain.Coupon, code string) *domain.Coupon {
+	for _, coupon := range coupons {
+		if coupon.Code == code {
+			return coupon
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package biz
+
+import (
+	v1 "cart/api/cart/v1"
+	"cart/internal/domain"
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// GuestCartRepo 游客购物车，使用网关签发的设备 token 区分，一段时间没有访问后过期
+type GuestCartRepo interface {
+	Create(ctx context.Context, token string, c *domain.ShopCart, limit *domain.CartLimit) (*domain.ShopCart, error)
+	List(ctx context.Context, token string) (domain.ShopCartList, error)
+	UpdateNum(ctx context.Context, token string, skuId int64, num int32) error
+	Delete(ctx context.Context, token string, skuIds ...int64) error
+	// Claim 原子取走游客购物车，并发合并时只有一个请求能取到，返回取走后的 key
+	Claim(ctx context.Context, token string) (string, domain.ShopCartList, error)
+	Release(ctx context.Context, claim string) error               // 删除已经合并的游客购物车
+	Restore(ctx context.Context, token string, claim string) error // 合并失败时放回游客购物车
+}
+
+type GuestCartUsecase struct {
+	repo      GuestCartRepo
+	cart      *CartUsecase
+	goodsRepo GoodsRepo
+	log       *log.Helper
+}
+
+func NewGuestCartUsecase(repo GuestCartRepo, cart *CartUsecase, goodsRepo GoodsRepo, logger log.Logger) *GuestCartUsecase {
+	return &GuestCartUsecase{repo: repo, cart: cart, goodsRepo: goodsRepo, log: log.NewHelper(logger)}
+}
+
+// CreateCart 游客加入购物车，与用户购物车一样从商品服务获取商品信息
+func (uc *GuestCartUsecase) CreateCart(ctx context.Context, token string, c *domain.ShopCart) (*domain.ShopCart, error) {
+	sku, err := uc.cart.findSku(ctx, c.SkuId)
+	if err != nil {
+		return nil, err
+	}
+	list, err := uc.repo.List(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.cart.checkAdd(list, sku, c.GoodsNum); err != nil {
+		return nil, err
+	}
+
+	c.GoodsId = sku.GoodsId
+	c.GoodsSn = sku.GoodsSn
+	c.GoodsName = sku.GoodsName
+	c.GoodsPrice = sku.Price
+	c.IsSelect = true
+	res, err := uc.repo.Create(ctx, token, c, uc.cart.rule.Limit(sku))
+	if err != nil {
+		return nil, err
+	}
+	res.Check(sku)
+	return res, nil
+}
+
+// List 游客购物车列表，标记价格变化和缺货的商品
+func (uc *GuestCartUsecase) List(ctx context.Context, token string) (domain.ShopCartList, error) {
+	list, err := uc.repo.List(ctx, token)
+	if err != nil || len(list) == 0 {
+		return list, err
+	}
+	skus, err := uc.goodsRepo.ListSku(ctx, list.SkuIds()...)
+	if err != nil {
+		return nil, err
+	}
+	for _, cart := range list {
+		cart.Check(skus.FindById(cart.SkuId))
+	}
+	return list, nil
+}
+
+func (uc *GuestCartUsecase) UpdateNum(ctx context.Context, token string, skuId int64, num int32) error {
+	if num <= 0 {
+		return errors.BadRequest(v1.ErrorReason_GOODS_NUM_INVALID.String(), "商品数量必须大于0")
+	}
+	sku, err := uc.cart.getSku(ctx, skuId)
+	if err != nil {
+		return err
+	}
+	if err := uc.cart.checkUpdate(sku, num); err != nil {
+		return err
+	}
+	return uc.repo.UpdateNum(ctx, token, skuId, num)
+}
+
+func (uc *GuestCartUsecase) Delete(ctx context.Context, token string, skuIds ...int64) error {
+	if len(skuIds) == 0 {
+		return errors.BadRequest(v1.ErrorReason_SKU_IS_EMPTY.String(), "请选择要删除的商品")
+	}
+	return uc.repo.Delete(ctx, token, skuIds...)
+}
+
+// Merge 将游客购物车合并到用户购物车，先取走游客购物车再合并，同一个游客购物车不会被重复合并
+func (uc *GuestCartUsecase) Merge(ctx context.Context, token string, userId int64) (domain.ShopCartList, error) {
+	claim, guest, err := uc.repo.Claim(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if len(guest) == 0 {
+		return uc.cart.List(ctx, userId)
+	}
+	if err := uc.cart.Merge(ctx, userId, guest); err != nil {
+		if err := uc.repo.Restore(ctx, token, claim); err != nil {
+			uc.log.Errorf("restore guest cart error: %v", err)
+		}
+		return nil, err
+	}
+
+	if err := uc.repo.Release(ctx, claim); err != nil {
+		// 合并已经完成，清理失败只记录日志，取走的游客购物车会自然过期
+		uc.log.Errorf("release guest cart error: %v", err)
+	}
+	return uc.cart.List(ctx, userId)
+}
@@ -0,0 +1,240 @@
+package biz
+
+import (
+	v1 "cart/api/cart/v1"
+	"cart/internal/domain"
+	"context"
+	"math"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SavedCartRepo 稍后购买的商品
+type SavedCartRepo interface {
+	Save(ctx context.Context, list domain.ShopCartList) error
+	List(ctx context.Context, userId int64) (domain.ShopCartList, error)
+	Delete(ctx context.Context, userId int64, skuIds ...int64) error
+}
+
+// WishlistRepo 收藏夹，收藏和取消收藏时同时写入收藏数变化的事件
+type WishlistRepo interface {
+	Add(ctx context.Context, userId, goodsId int64) (bool, error)
+	Remove(ctx context.Context, userId int64, goodsIds ...int64) (int, error)
+	List(ctx context.Context, userId int64) ([]*domain.Wishlist, error)
+	PendingFavEvents(ctx context.Context, limit int) ([]*domain.FavEvent, error)
+	MarkFavEventsSent(ctx context.Context, ids ...int64) error
+}
+
+// favEventBatch 每次发送到商品服务的事件数量
+const favEventBatch = 100
+
+// ListUsecase 购物车、稍后购买和收藏夹之间的商品移动
+type ListUsecase struct {
+	cart         *CartUsecase
+	savedRepo    SavedCartRepo
+	wishlistRepo WishlistRepo
+	goodsRepo    GoodsRepo
+	log          *log.Helper
+}
+
+func NewListUsecase(cart *CartUsecase, savedRepo SavedCartRepo, wishlistRepo WishlistRepo, goodsRepo GoodsRepo,
+	logger log.Logger) *ListUsecase {
+	return &ListUsecase{
+		cart:         cart,
+		savedRepo:    savedRepo,
+		wishlistRepo: wishlistRepo,
+		goodsRepo:    goodsRepo,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// SaveForLater 将购物车商品移到稍后购买，先从购物车删除再保存，保存失败时放回购物车，
+// 删除失败时商品不会同时出现在两个地方
+func (uc *ListUsecase) SaveForLater(ctx context.Context, userId int64, skuIds ...int64) error {
+	lines, err := uc.cartLines(ctx, userId, skuIds...)
+	if err != nil {
+		return err
+	}
+	if err := uc.cart.repo.Delete(ctx, userId, lines.SkuIds()...); err != nil {
+		return err
+	}
+	if err := uc.savedRepo.Save(ctx, lines); err != nil {
+		uc.restoreCart(ctx, lines)
+		return err
+	}
+	return nil
+}
+
+// ListSaved 稍后购买的商品，与购物车一样标记价格变化和缺货的商品
+func (uc *ListUsecase) ListSaved(ctx context.Context, userId int64) (domain.ShopCartList, error) {
+	list, err := uc.savedRepo.List(ctx, userId)
+	if err != nil || len(list) == 0 {
+		return list, err
+	}
+	skus, err := uc.goodsRepo.ListSku(ctx, list.SkuIds()...)
+	if err != nil {
+		return nil, err
+	}
+	for _, cart := range list {
+		cart.Check(skus.FindById(cart.SkuId))
+	}
+	return list, nil
+}
+
+// MoveToCart 将稍后购买的商品移回购物车，所有商品都可以加入购物车时才移动，有一个不满足时都不移动
+func (uc *ListUsecase) MoveToCart(ctx context.Context, userId int64, skuIds ...int64) error {
+	if len(skuIds) == 0 {
+		return errors.BadRequest(v1.ErrorReason_SKU_IS_EMPTY.String(), "请选择商品")
+	}
+	list, err := uc.savedRepo.List(ctx, userId)
+	if err != nil {
+		return err
+	}
+	var lines domain.ShopCartList
+	for _, skuId := range skuIds {
+		saved := list.FindBySkuId(skuId)
+		if saved == nil {
+			return errors.NotFound(v1.ErrorReason_CART_NOT_FOUND.String(), "稍后购买的商品不存在")
+		}
+		if lines.FindBySkuId(skuId) == nil {
+			lines = append(lines, saved)
+		}
+	}
+	carts, skus, err := uc.cart.checkCarts(ctx, userId, lines)
+	if err != nil {
+		return err
+	}
+	// 先从稍后购买删除再加入购物车，加入失败时放回稍后购买
+	if err := uc.savedRepo.Delete(ctx, userId, lines.SkuIds()...); err != nil {
+		return err
+	}
+	if err := uc.cart.createCarts(ctx, carts, skus); err != nil {
+		if err := uc.savedRepo.Save(ctx, lines); err != nil {
+			uc.log.Errorf("restore saved cart error: %v", err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (uc *ListUsecase) DeleteSaved(ctx context.Context, userId int64, skuIds ...int64) error {
+	if len(skuIds) == 0 {
+		return errors.BadRequest(v1.ErrorReason_SKU_IS_EMPTY.String(), "请选择要删除的商品")
+	}
+	return uc.savedRepo.Delete(ctx, userId, skuIds...)
+}
+
+// MoveToWishlist 将购物车商品移到收藏夹，收藏的是商品而不是 sku。先从购物车删除再收藏，
+// 收藏失败时放回购物车，重复收藏不会重复计数
+func (uc *ListUsecase) MoveToWishlist(ctx context.Context, userId int64, skuIds ...int64) error {
+	lines, err := uc.cartLines(ctx, userId, skuIds...)
+	if err != nil {
+		return err
+	}
+	if err := uc.cart.repo.Delete(ctx, userId, lines.SkuIds()...); err != nil {
+		return err
+	}
+	for _, line := range lines {
+		if _, err := uc.wishlistRepo.Add(ctx, userId, line.GoodsId); err != nil {
+			uc.restoreCart(ctx, lines)
+			return err
+		}
+	}
+	return nil
+}
+
+// AddWishlist 收藏商品，重复收藏不会重复增加商品的收藏数
+func (uc *ListUsecase) AddWishlist(ctx context.Context, userId, goodsId int64) error {
+	goods, err := uc.goodsRepo.ListGoods(ctx, goodsId)
+	if err != nil {
+		return err
+	}
+	if goods.FindById(goodsId) == nil {
+		return errors.NotFound(v1.ErrorReason_GOODS_NOT_FOUND.String(), "商品不存在")
+	}
+	_, err = uc.wishlistRepo.Add(ctx, userId, goodsId)
+	return err
+}
+
+func (uc *ListUsecase) RemoveWishlist(ctx context.Context, userId int64, goodsIds ...int64) error {
+	count, err := uc.wishlistRepo.Remove(ctx, userId, goodsIds...)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound(v1.ErrorReason_WISHLIST_NOT_FOUND.String(), "收藏的商品不存在")
+	}
+	return nil
+}
+
+// ListWishlist 收藏夹列表，商品信息从商品服务查询
+func (uc *ListUsecase) ListWishlist(ctx context.Context, userId int64) ([]*domain.Wishlist, error) {
+	list, err := uc.wishlistRepo.List(ctx, userId)
+	if err != nil || len(list) == 0 {
+		return list, err
+	}
+	ids := make([]int64, 0, len(list))
+	for _, item := range list {
+		ids = append(ids, item.GoodsId)
+	}
+	goods, err := uc.goodsRepo.ListGoods(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range list {
+		item.Goods = goods.FindById(item.GoodsId)
+	}
+	return list, nil
+}
+
+// DispatchFavEvents 将收藏数变化的事件发送到商品服务，返回发送的数量，
+// 商品服务根据事件ID去重，发送成功后标记失败时重复发送不会重复计数
+func (uc *ListUsecase) DispatchFavEvents(ctx context.Context) (int, error) {
+	events, err := uc.wishlistRepo.PendingFavEvents(ctx, favEventBatch)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	if err := uc.goodsRepo.ApplyFavEvents(ctx, events...); err != nil {
+		return 0, err
+	}
+	ids := make([]int64, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+	}
+	if err := uc.wishlistRepo.MarkFavEventsSent(ctx, ids...); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+// restoreCart 移动失败时将删除的商品放回购物车，放回的是原来的数量，不检查限制
+func (uc *ListUsecase) restoreCart(ctx context.Context, lines domain.ShopCartList) {
+	for _, line := range lines {
+		if _, err := uc.cart.repo.Create(ctx, line, &domain.CartLimit{MaxNum: math.MaxInt32}); err != nil {
+			uc.log.Errorf("restore cart user %d sku %d error: %v", line.UserId, line.SkuId, err)
+		}
+	}
+}
+
+// cartLines 查询购物车中指定的商品，重复的 sku 只返回一次，有商品不在购物车中时返回错误
+func (uc *ListUsecase) cartLines(ctx context.Context, userId int64, skuIds ...int64) (domain.ShopCartList, error) {
+	if len(skuIds) == 0 {
+		return nil, errors.BadRequest(v1.ErrorReason_SKU_IS_EMPTY.String(), "请选择商品")
+	}
+	list, err := uc.cart.repo.List(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	var lines domain.ShopCartList
+	for _, skuId := range skuIds {
+		line := list.FindBySkuId(skuId)
+		if line == nil {
+			return nil, errors.NotFound(v1.ErrorReason_CART_NOT_FOUND.String(), "购物车商品不存在")
+		}
+		if lines.FindBySkuId(skuId) == nil {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
@@ -29,6 +29,7 @@ type Bootstrap struct {
 	Trace         *Trace                 `protobuf:"bytes,3,opt,name=trace,proto3" json:"trace,omitempty"`
 	Auth          *Auth                  `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
 	Service       *Service               `protobuf:"bytes,5,opt,name=service,proto3" json:"service,omitempty"`
+	Checkout      *Checkout              `protobuf:"bytes,6,opt,name=checkout,proto3" json:"checkout,omitempty"` // 结算预览使用的运费模版和优惠券
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +99,13 @@ func (x *Bootstrap) GetService() *Service {
 	return nil
 }
 
+func (x *Bootstrap) GetCheckout() *Checkout {
+	if x != nil {
+		return x.Checkout
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -155,6 +163,7 @@ type Data struct {
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
 	Elastic       *Data_Elastic          `protobuf:"bytes,3,opt,name=elastic,proto3" json:"elastic,omitempty"`
+	Cart          *Data_Cart             `protobuf:"bytes,4,opt,name=cart,proto3" json:"cart,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +219,13 @@ func (x *Data) GetElastic() *Data_Elastic {
 	return nil
 }
 
+func (x *Data) GetCart() *Data_Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
 type Service struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *Service_User          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -394,6 +410,67 @@ func (x *Auth) GetJwtKey() string {
 	return ""
 }
 
+// 金额单位为分
+type Checkout struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	DefaultShipFee int64                    `protobuf:"varint,1,opt,name=default_ship_fee,json=defaultShipFee,proto3" json:"default_ship_fee,omitempty"` // 没有配置运费模版的商品使用的运费
+	ShipTemplates  []*Checkout_ShipTemplate `protobuf:"bytes,2,rep,name=ship_templates,json=shipTemplates,proto3" json:"ship_templates,omitempty"`
+	Coupons        []*Checkout_Coupon       `protobuf:"bytes,3,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Checkout) Reset() {
+	*x = Checkout{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Checkout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checkout) ProtoMessage() {}
+
+func (x *Checkout) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checkout.ProtoReflect.Descriptor instead.
+func (*Checkout) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Checkout) GetDefaultShipFee() int64 {
+	if x != nil {
+		return x.DefaultShipFee
+	}
+	return 0
+}
+
+func (x *Checkout) GetShipTemplates() []*Checkout_ShipTemplate {
+	if x != nil {
+		return x.ShipTemplates
+	}
+	return nil
+}
+
+func (x *Checkout) GetCoupons() []*Checkout_Coupon {
+	if x != nil {
+		return x.Coupons
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -405,7 +482,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -417,7 +494,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +542,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -477,7 +554,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +601,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +613,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +658,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +670,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +744,7 @@ type Data_Elastic struct {
 
 func (x *Data_Elastic) Reset() {
 	*x = Data_Elastic{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -679,7 +756,7 @@ func (x *Data_Elastic) String() string {
 func (*Data_Elastic) ProtoMessage() {}
 
 func (x *Data_Elastic) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,6 +779,91 @@ func (x *Data_Elastic) GetAddr() string {
 	return ""
 }
 
+// 购物车存储，store 为 redis 时使用 redis 缓存并异步写回 mysql，否则直接读写 mysql
+type Data_Cart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Store         string                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`                                          // redis 中购物车的过期时间
+	FlushInterval *durationpb.Duration   `protobuf:"bytes,3,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"` // 写回 mysql 的时间间隔
+	GuestTtl      *durationpb.Duration   `protobuf:"bytes,4,opt,name=guest_ttl,json=guestTtl,proto3" json:"guest_ttl,omitempty"`                // 游客购物车没有访问后的过期时间
+	MaxLines      int32                  `protobuf:"varint,5,opt,name=max_lines,json=maxLines,proto3" json:"max_lines,omitempty"`               // 购物车最多的商品种类，0 为不限制
+	MaxQuantity   int32                  `protobuf:"varint,6,opt,name=max_quantity,json=maxQuantity,proto3" json:"max_quantity,omitempty"`      // 单个商品最多的数量，0 为不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Cart) Reset() {
+	*x = Data_Cart{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Cart) ProtoMessage() {}
+
+func (x *Data_Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Cart.ProtoReflect.Descriptor instead.
+func (*Data_Cart) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Cart) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *Data_Cart) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Data_Cart) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
+	}
+	return nil
+}
+
+func (x *Data_Cart) GetGuestTtl() *durationpb.Duration {
+	if x != nil {
+		return x.GuestTtl
+	}
+	return nil
+}
+
+func (x *Data_Cart) GetMaxLines() int32 {
+	if x != nil {
+		return x.MaxLines
+	}
+	return 0
+}
+
+func (x *Data_Cart) GetMaxQuantity() int32 {
+	if x != nil {
+		return x.MaxQuantity
+	}
+	return 0
+}
+
 type Service_User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
@@ -711,7 +873,7 @@ type Service_User struct {
 
 func (x *Service_User) Reset() {
 	*x = Service_User{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +885,7 @@ func (x *Service_User) String() string {
 func (*Service_User) ProtoMessage() {}
 
 func (x *Service_User) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +917,7 @@ type Service_Goods struct {
 
 func (x *Service_Goods) Reset() {
 	*x = Service_Goods{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -767,7 +929,7 @@ func (x *Service_Goods) String() string {
 func (*Service_Goods) ProtoMessage() {}
 
 func (x *Service_Goods) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +962,7 @@ type Registry_Consul struct {
 
 func (x *Registry_Consul) Reset() {
 	*x = Registry_Consul{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -812,7 +974,7 @@ func (x *Registry_Consul) String() string {
 func (*Registry_Consul) ProtoMessage() {}
 
 func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,18 +1004,155 @@ func (x *Registry_Consul) GetScheme() string {
 	return ""
 }
 
+type Checkout_ShipTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fee           int64                  `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`                                 // 运费
+	FreeAmount    int64                  `protobuf:"varint,3,opt,name=free_amount,json=freeAmount,proto3" json:"free_amount,omitempty"` // 满额包邮，0 表示不包邮
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Checkout_ShipTemplate) Reset() {
+	*x = Checkout_ShipTemplate{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Checkout_ShipTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checkout_ShipTemplate) ProtoMessage() {}
+
+func (x *Checkout_ShipTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checkout_ShipTemplate.ProtoReflect.Descriptor instead.
+func (*Checkout_ShipTemplate) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *Checkout_ShipTemplate) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Checkout_ShipTemplate) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Checkout_ShipTemplate) GetFreeAmount() int64 {
+	if x != nil {
+		return x.FreeAmount
+	}
+	return 0
+}
+
+type Checkout_Coupon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Threshold     int64                  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`                // 使用门槛
+	Discount      int64                  `protobuf:"varint,4,opt,name=discount,proto3" json:"discount,omitempty"`                  // 优惠金额
+	SkuIds        []int64                `protobuf:"varint,5,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"` // 可以使用的 sku，为空时全部商品可用
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Checkout_Coupon) Reset() {
+	*x = Checkout_Coupon{}
+	mi := &file_conf_conf_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Checkout_Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Checkout_Coupon) ProtoMessage() {}
+
+func (x *Checkout_Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Checkout_Coupon.ProtoReflect.Descriptor instead.
+func (*Checkout_Coupon) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *Checkout_Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Checkout_Coupon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Checkout_Coupon) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Checkout_Coupon) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Checkout_Coupon) GetSkuIds() []int64 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xdb\x01\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\x8d\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12'\n" +
 	"\x05trace\x18\x03 \x01(\v2\x11.kratos.api.TraceR\x05trace\x12$\n" +
 	"\x04auth\x18\x04 \x01(\v2\x10.kratos.api.AuthR\x04auth\x12-\n" +
-	"\aservice\x18\x05 \x01(\v2\x13.kratos.api.ServiceR\aservice\"\xb8\x02\n" +
+	"\aservice\x18\x05 \x01(\v2\x13.kratos.api.ServiceR\aservice\x120\n" +
+	"\bcheckout\x18\x06 \x01(\v2\x14.kratos.api.CheckoutR\bcheckout\"\xb8\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1ai\n" +
@@ -864,11 +1163,12 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x9a\x04\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xcb\x06\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x122\n" +
-	"\aelastic\x18\x03 \x01(\v2\x18.kratos.api.Data.ElasticR\aelastic\x1a:\n" +
+	"\aelastic\x18\x03 \x01(\v2\x18.kratos.api.Data.ElasticR\aelastic\x12)\n" +
+	"\x04cart\x18\x04 \x01(\v2\x15.kratos.api.Data.CartR\x04cart\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\x9d\x02\n" +
@@ -881,7 +1181,14 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\fread_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\x1d\n" +
 	"\aElastic\x12\x12\n" +
-	"\x04addr\x18\x01 \x01(\tR\x04addr\"\xb1\x01\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x1a\x83\x02\n" +
+	"\x04Cart\x12\x14\n" +
+	"\x05store\x18\x01 \x01(\tR\x05store\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x12@\n" +
+	"\x0eflush_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rflushInterval\x126\n" +
+	"\tguest_ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bguestTtl\x12\x1b\n" +
+	"\tmax_lines\x18\x05 \x01(\x05R\bmaxLines\x12!\n" +
+	"\fmax_quantity\x18\x06 \x01(\x05R\vmaxQuantity\"\xb1\x01\n" +
 	"\aService\x12,\n" +
 	"\x04user\x18\x01 \x01(\v2\x18.kratos.api.Service.UserR\x04user\x12/\n" +
 	"\x05goods\x18\x02 \x01(\v2\x19.kratos.api.Service.GoodsR\x05goods\x1a\"\n" +
@@ -897,7 +1204,22 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
 	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"\x1f\n" +
 	"\x04Auth\x12\x17\n" +
-	"\ajwt_key\x18\x01 \x01(\tR\x06jwtKeyB\x19Z\x17cart/internal/conf;confb\x06proto3"
+	"\ajwt_key\x18\x01 \x01(\tR\x06jwtKey\"\x8e\x03\n" +
+	"\bCheckout\x12(\n" +
+	"\x10default_ship_fee\x18\x01 \x01(\x03R\x0edefaultShipFee\x12H\n" +
+	"\x0eship_templates\x18\x02 \x03(\v2!.kratos.api.Checkout.ShipTemplateR\rshipTemplates\x125\n" +
+	"\acoupons\x18\x03 \x03(\v2\x1b.kratos.api.Checkout.CouponR\acoupons\x1aQ\n" +
+	"\fShipTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03fee\x18\x02 \x01(\x03R\x03fee\x12\x1f\n" +
+	"\vfree_amount\x18\x03 \x01(\x03R\n" +
+	"freeAmount\x1a\x83\x01\n" +
+	"\x06Coupon\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\x03R\tthreshold\x12\x1a\n" +
+	"\bdiscount\x18\x04 \x01(\x03R\bdiscount\x12\x17\n" +
+	"\asku_ids\x18\x05 \x03(\x03R\x06skuIdsB\x19Z\x17cart/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -911,24 +1233,28 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*Data)(nil),                // 2: kratos.api.Data
-	(*Service)(nil),             // 3: kratos.api.Service
-	(*Trace)(nil),               // 4: kratos.api.Trace
-	(*Registry)(nil),            // 5: kratos.api.Registry
-	(*Auth)(nil),                // 6: kratos.api.Auth
-	(*Server_HTTP)(nil),         // 7: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 8: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 9: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 10: kratos.api.Data.Redis
-	(*Data_Elastic)(nil),        // 11: kratos.api.Data.Elastic
-	(*Service_User)(nil),        // 12: kratos.api.Service.User
-	(*Service_Goods)(nil),       // 13: kratos.api.Service.Goods
-	(*Registry_Consul)(nil),     // 14: kratos.api.Registry.Consul
-	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
+	(*Bootstrap)(nil),             // 0: kratos.api.Bootstrap
+	(*Server)(nil),                // 1: kratos.api.Server
+	(*Data)(nil),                  // 2: kratos.api.Data
+	(*Service)(nil),               // 3: kratos.api.Service
+	(*Trace)(nil),                 // 4: kratos.api.Trace
+	(*Registry)(nil),              // 5: kratos.api.Registry
+	(*Auth)(nil),                  // 6: kratos.api.Auth
+	(*Checkout)(nil),              // 7: kratos.api.Checkout
+	(*Server_HTTP)(nil),           // 8: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),           // 9: kratos.api.Server.GRPC
+	(*Data_Database)(nil),         // 10: kratos.api.Data.Database
+	(*Data_Redis)(nil),            // 11: kratos.api.Data.Redis
+	(*Data_Elastic)(nil),          // 12: kratos.api.Data.Elastic
+	(*Data_Cart)(nil),             // 13: kratos.api.Data.Cart
+	(*Service_User)(nil),          // 14: kratos.api.Service.User
+	(*Service_Goods)(nil),         // 15: kratos.api.Service.Goods
+	(*Registry_Consul)(nil),       // 16: kratos.api.Registry.Consul
+	(*Checkout_ShipTemplate)(nil), // 17: kratos.api.Checkout.ShipTemplate
+	(*Checkout_Coupon)(nil),       // 18: kratos.api.Checkout.Coupon
+	(*durationpb.Duration)(nil),   // 19: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -936,24 +1262,31 @@ var file_conf_conf_proto_depIdxs = []int32{
 	4,  // 2: kratos.api.Bootstrap.trace:type_name -> kratos.api.Trace
 	6,  // 3: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
 	3,  // 4: kratos.api.Bootstrap.service:type_name -> kratos.api.Service
-	7,  // 5: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	8,  // 6: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	9,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	10, // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	11, // 9: kratos.api.Data.elastic:type_name -> kratos.api.Data.Elastic
-	12, // 10: kratos.api.Service.user:type_name -> kratos.api.Service.User
-	13, // 11: kratos.api.Service.goods:type_name -> kratos.api.Service.Goods
-	14, // 12: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
-	15, // 13: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	15, // 14: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	15, // 15: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	15, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	15, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	7,  // 5: kratos.api.Bootstrap.checkout:type_name -> kratos.api.Checkout
+	8,  // 6: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	9,  // 7: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	10, // 8: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	11, // 9: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	12, // 10: kratos.api.Data.elastic:type_name -> kratos.api.Data.Elastic
+	13, // 11: kratos.api.Data.cart:type_name -> kratos.api.Data.Cart
+	14, // 12: kratos.api.Service.user:type_name -> kratos.api.Service.User
+	15, // 13: kratos.api.Service.goods:type_name -> kratos.api.Service.Goods
+	16, // 14: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
+	17, // 15: kratos.api.Checkout.ship_templates:type_name -> kratos.api.Checkout.ShipTemplate
+	18, // 16: kratos.api.Checkout.coupons:type_name -> kratos.api.Checkout.Coupon
+	19, // 17: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	19, // 18: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	19, // 19: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	19, // 20: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	19, // 21: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	19, // 22: kratos.api.Data.Cart.ttl:type_name -> google.protobuf.Duration
+	19, // 23: kratos.api.Data.Cart.flush_interval:type_name -> google.protobuf.Duration
+	19, // 24: kratos.api.Data.Cart.guest_ttl:type_name -> google.protobuf.Duration
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -967,7 +1300,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -10,13 +10,14 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ShopCart struct {
 	ID         int64          `gorm:"primarykey;type:int" json:"id"`
-	UserId     int64          `gorm:"type:int;not null;comment:用户id" json:"user_id"`
+	UserId     int64          `gorm:"type:int;not null;uniqueIndex:user_sku,priority:1;comment:用户id" json:"user_id"`
 	GoodsId    int64          `gorm:"type:int;not null;comment:商品id" json:"goods_id"`
-	SkuId      int64          `gorm:"type:int;not null;comment:sku_id" json:"sku_id"`
+	SkuId      int64          `gorm:"type:int;not null;uniqueIndex:user_sku,priority:2;comment:sku_id" json:"sku_id"`
 	GoodsPrice int64          `gorm:"type:int;not null;comment:商品价格" json:"goods_price"`
 	GoodsNum   int32          `gorm:"type:int;not null;comment:商品数量" json:"goods_num"`
 	GoodsSn    string         `gorm:"type:varchar(500);default:;comment:商品编号"`
@@ -27,6 +28,18 @@ type ShopCart struct {
 	DeletedAt  gorm.DeletedAt `json:"deleted_at"`
 }
 
+// cartConflict 同一个用户的同一个 sku 只有一条记录，软删除的记录会被重新使用
+var cartConflict = []clause.Column{{Name: "user_id"}, {Name: "sku_id"}}
+
+// cartAddAssignments 商品已经在购物车中时累加数量，已经删除的记录按新加入处理，
+// mysql 按顺序执行赋值，deleted_at 必须放在最后
+var cartAddAssignments = []clause.Assignment{
+	{Column: clause.Column{Name: "goods_num"}, Value: gorm.Expr("IF(deleted_at IS NULL, goods_num + VALUES(goods_num), VALUES(goods_num))")},
+	{Column: clause.Column{Name: "is_select"}, Value: gorm.Expr("IF(deleted_at IS NULL, is_select, VALUES(is_select))")},
+	{Column: clause.Column{Name: "update_time"}, Value: gorm.Expr("VALUES(update_time)")},
+	{Column: clause.Column{Name: "deleted_at"}, Value: nil},
+}
+
 type cartRepo struct {
 	data *Data
 	log  *log.Helper
@@ -52,25 +65,58 @@ func (p *ShopCart) ToDomain() *domain.ShopCart {
 		IsSelect:   p.IsSelect,
 	}
 }
-func (r *cartRepo) Create(ctx context.Context, c *domain.ShopCart) (*domain.ShopCart, error) {
-	var shopCart ShopCart
-	if result := r.data.db.Where(&ShopCart{UserId: c.UserId, SkuId: c.SkuId}).First(&shopCart); result.RowsAffected == 1 {
-		shopCart.GoodsNum += c.GoodsNum
-	} else {
-		shopCart.UserId = c.UserId
-		shopCart.GoodsId = c.GoodsId
-		shopCart.SkuId = c.SkuId
-		shopCart.GoodsPrice = c.GoodsPrice
-		shopCart.GoodsNum = c.GoodsNum
-		shopCart.GoodsSn = c.GoodsSn
-		shopCart.GoodsName = c.GoodsName
-		shopCart.IsSelect = c.IsSelect
+// Create 锁定用户的购物车记录后检查限制再累加数量，唯一索引上的锁会阻塞同一个用户的并发加入，
+// 避免先查询再保存时并发丢失数量或超过限制
+func (r *cartRepo) Create(ctx context.Context, c *domain.ShopCart, limit *domain.CartLimit) (*domain.ShopCart, error) {
+	shopCart := ShopCart{
+		UserId:     c.UserId,
+		GoodsId:    c.GoodsId,
+		SkuId:      c.SkuId,
+		GoodsPrice: c.GoodsPrice,
+		GoodsNum:   c.GoodsNum,
+		GoodsSn:    c.GoodsSn,
+		GoodsName:  c.GoodsName,
+		IsSelect:   c.IsSelect,
 	}
-
-	if result := r.data.db.Save(&shopCart); result.Error != nil {
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var carts []ShopCart
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", c.UserId).Find(&carts).Error; err != nil {
+			return err
+		}
+		var lines int
+		var num int32
+		for _, cart := range carts {
+			if cart.DeletedAt.Valid {
+				continue
+			}
+			lines++
+			if cart.SkuId == c.SkuId {
+				num = cart.GoodsNum
+			}
+		}
+		if num == 0 && limit.MaxLines > 0 && int32(lines) >= limit.MaxLines {
+			return biz.ErrCartLinesExceeded
+		}
+		if num+c.GoodsNum > limit.MaxNum {
+			return biz.ErrCartNumExceeded
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   cartConflict,
+			DoUpdates: cartAddAssignments,
+		}).Create(&shopCart).Error
+	})
+	if errors.Is(err, biz.ErrCartLinesExceeded) || errors.Is(err, biz.ErrCartNumExceeded) {
+		return nil, err
+	}
+	if err != nil {
 		return nil, errors.InternalServer("CREATE_CART_NOT_FOUND", "创建购物车失败")
 	}
 
+	shopCart = ShopCart{}
+	if result := r.data.db.Where(&ShopCart{UserId: c.UserId, SkuId: c.SkuId}).First(&shopCart); result.Error != nil {
+		return nil, errors.InternalServer("CREATE_CART_NOT_FOUND", "创建购物车失败")
+	}
 	return shopCart.ToDomain(), nil
 }
 
@@ -87,3 +133,54 @@ func (r *cartRepo) List(ctx context.Context, userId int64) (domain.ShopCartList,
 		return rsp, nil
 	}
 }
+
+// UpdateNum 修改用户购物车中商品的数量
+func (r *cartRepo) UpdateNum(ctx context.Context, userId, skuId int64, num int32) error {
+	var shopCart ShopCart
+	if result := r.data.db.Where(&ShopCart{UserId: userId, SkuId: skuId}).First(&shopCart); result.RowsAffected == 0 {
+		return errors.NotFound("CART_NOT_FOUND", "购物车商品不存在")
+	}
+
+	shopCart.GoodsNum = num
+	if result := r.data.db.Save(&shopCart); result.Error != nil {
+		return errors.InternalServer("UPDATE_CART_ERROR", "修改购物车失败")
+	}
+	return nil
+}
+
+// Select 选中或取消选中用户购物车中的商品，skuIds 为空时操作所有商品
+func (r *cartRepo) Select(ctx context.Context, userId int64, isSelect bool, skuIds ...int64) error {
+	db := r.data.db.Model(&ShopCart{}).Where("user_id = ?", userId)
+	if len(skuIds) > 0 {
+		db = db.Where("sku_id IN (?)", skuIds)
+	}
+	if result := db.Update("is_select", isSelect); result.Error != nil {
+		return errors.InternalServer("UPDATE_CART_ERROR", "修改购物车失败")
+	}
+	return nil
+}
+
+// Delete 删除用户购物车中的商品
+func (r *cartRepo) Delete(ctx context.Context, userId int64, skuIds ...int64) error {
+	result := r.data.db.Where("user_id = ? AND sku_id IN (?)", userId, skuIds).Delete(&ShopCart{})
+	if result.Error != nil {
+		return errors.InternalServer("DELETE_CART_ERROR", "删除购物车商品失败")
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("CART_NOT_FOUND", "购物车商品不存在")
+	}
+	return nil
+}
+
+// Clear 清空用户的购物车
+func (r *cartRepo) Clear(ctx context.Context, userId int64) error {
+	if result := r.data.db.Where("user_id = ?", userId).Delete(&ShopCart{}); result.Error != nil {
+		return errors.InternalServer("DELETE_CART_ERROR", "清空购物车失败")
+	}
+	return nil
+}
+
+// Flush mysql 存储没有需要写回的数据
+func (r *cartRepo) Flush(ctx context.Context) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,450 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cart/internal/biz"
+	"cart/internal/conf"
+	"cart/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	cartDirtyKey    = "cart:dirty" // 购物车有修改、需要写回 mysql 的用户
+	cartLoadedField = "_loaded"    // 标记用户购物车已经从 mysql 加载到 redis
+	cartFlushBatch  = 100          // 每次最多写回的用户数
+	cartFlushLock   = 30 * time.Second
+)
+
+// loadCartScript 购物车还没有加载时才写入从 mysql 查询的数据，避免覆盖并发写入的数量
+var loadCartScript = redis.NewScript(`
+local ttl = table.remove(ARGV, 1)
+if redis.call('HEXISTS', KEYS[1], '_loaded') == 0 then
+	redis.call('HSET', KEYS[1], unpack(ARGV))
+end
+redis.call('PEXPIRE', KEYS[1], ttl)
+return 1
+`)
+
+// addCartScript 检查商品种类和数量限制后加入购物车，已经存在的商品累加数量。
+// KEYS[2] 存在时标记需要写回 mysql，ttl 大于 0 时刷新过期时间。返回 -1 超过商品种类上限，-2 超过数量上限
+var addCartScript = redis.NewScript(`
+local num = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+local maxLines = tonumber(ARGV[7])
+if num == 0 and maxLines > 0 then
+	local lines = 0
+	for _, field in ipairs(redis.call('HKEYS', KEYS[1])) do
+		if string.sub(field, -4) == ':num' then
+			lines = lines + 1
+		end
+	end
+	if lines >= maxLines then
+		return -1
+	end
+end
+if num + tonumber(ARGV[4]) > tonumber(ARGV[8]) then
+	return -2
+end
+redis.call('HSETNX', KEYS[1], ARGV[3], ARGV[6])
+redis.call('HSETNX', KEYS[1], ARGV[2], ARGV[5])
+redis.call('HINCRBY', KEYS[1], ARGV[1], ARGV[4])
+if tonumber(ARGV[9]) > 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[9])
+end
+if #KEYS > 1 then
+	redis.call('SADD', KEYS[2], ARGV[10])
+end
+return 1
+`)
+
+// addCart 执行 addCartScript，超过限制时返回对应的错误
+func addCart(ctx context.Context, rdb *redis.Client, keys []string, c *domain.ShopCart, limit *domain.CartLimit, ttl time.Duration) error {
+	info, _ := json.Marshal(cartInfo{
+		GoodsId:    c.GoodsId,
+		GoodsPrice: c.GoodsPrice,
+		GoodsSn:    c.GoodsSn,
+		GoodsName:  c.GoodsName,
+	})
+	n, err := addCartScript.Run(ctx, rdb, keys,
+		cartNumField(c.SkuId), cartSelectField(c.SkuId), cartInfoField(c.SkuId),
+		c.GoodsNum, c.IsSelect, info, limit.MaxLines, limit.MaxNum, ttl.Milliseconds(), c.UserId).Int()
+	if err != nil {
+		return errors.InternalServer("CREATE_CART_NOT_FOUND", "创建购物车失败")
+	}
+	switch n {
+	case -1:
+		return biz.ErrCartLinesExceeded
+	case -2:
+		return biz.ErrCartNumExceeded
+	}
+	return nil
+}
+
+// updateNumScript 商品还在购物车中时才修改数量，检查和写入在一个脚本中完成，
+// 避免并发删除后又写回一条只有数量的记录
+var updateNumScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[2], ARGV[3])
+redis.call('SADD', KEYS[2], ARGV[4])
+return 1
+`)
+
+// unlockScript 只释放自己持有的锁，锁过期后被其他实例获取时不会误删
+var unlockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// cartInfo 购物车商品不会变化的信息，以 json 保存在 hash 中
+type cartInfo struct {
+	ID         int64  `json:"id"`
+	GoodsId    int64  `json:"goods_id"`
+	GoodsPrice int64  `json:"goods_price"`
+	GoodsSn    string `json:"goods_sn"`
+	GoodsName  string `json:"goods_name"`
+}
+
+// cacheCartRepo 每个用户的购物车保存在一个 redis hash 中，数量使用 HINCRBY 原子修改，
+// 修改过的购物车由定时任务写回 mysql，redis 中没有时从 mysql 加载
+type cacheCartRepo struct {
+	data *Data
+	db   *cartRepo
+	ttl  time.Duration
+	log  *log.Helper
+}
+
+// NewCartStore 根据配置选择购物车的存储
+func NewCartStore(c *conf.Data, data *Data, logger log.Logger) biz.CartRepo {
+	db := &cartRepo{data: data, log: log.NewHelper(logger)}
+	if c.Cart == nil || c.Cart.Store != "redis" || data.rdb == nil {
+		return db
+	}
+
+	ttl := 7 * 24 * time.Hour
+	if c.Cart.Ttl != nil && c.Cart.Ttl.AsDuration() > 0 {
+		ttl = c.Cart.Ttl.AsDuration()
+	}
+	return &cacheCartRepo{
+		data: data,
+		db:   db,
+		ttl:  ttl,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func cartKey(userId int64) string {
+	return fmt.Sprintf("cart:%d", userId)
+}
+
+func cartNumField(skuId int64) string {
+	return fmt.Sprintf("%d:num", skuId)
+}
+
+func cartSelectField(skuId int64) string {
+	return fmt.Sprintf("%d:sel", skuId)
+}
+
+func cartInfoField(skuId int64) string {
+	return fmt.Sprintf("%d:info", skuId)
+}
+
+// load 确保用户的购物车已经加载到 redis
+func (r *cacheCartRepo) load(ctx context.Context, userId int64) error {
+	key := cartKey(userId)
+	loaded, err := r.data.rdb.HExists(ctx, key, cartLoadedField).Result()
+	if err != nil {
+		return errors.InternalServer("CART_CACHE_ERROR", err.Error())
+	}
+	if loaded {
+		return r.data.rdb.Expire(ctx, key, r.ttl).Err()
+	}
+
+	list, err := r.db.List(ctx, userId)
+	if err != nil {
+		return err
+	}
+	args := []interface{}{r.ttl.Milliseconds(), cartLoadedField, 1}
+	for _, cart := range list {
+		info, _ := json.Marshal(cartInfo{
+			ID:         cart.ID,
+			GoodsId:    cart.GoodsId,
+			GoodsPrice: cart.GoodsPrice,
+			GoodsSn:    cart.GoodsSn,
+			GoodsName:  cart.GoodsName,
+		})
+		args = append(args,
+			cartNumField(cart.SkuId), cart.GoodsNum,
+			cartSelectField(cart.SkuId), cart.IsSelect,
+			cartInfoField(cart.SkuId), info,
+		)
+	}
+	if err := loadCartScript.Run(ctx, r.data.rdb, []string{key}, args...).Err(); err != nil {
+		return errors.InternalServer("CART_CACHE_ERROR", err.Error())
+	}
+	return nil
+}
+
+func (r *cacheCartRepo) Create(ctx context.Context, c *domain.ShopCart, limit *domain.CartLimit) (*domain.ShopCart, error) {
+	if err := r.load(ctx, c.UserId); err != nil {
+		return nil, err
+	}
+	if err := addCart(ctx, r.data.rdb, []string{cartKey(c.UserId), cartDirtyKey}, c, limit, 0); err != nil {
+		return nil, err
+	}
+
+	list, err := r.List(ctx, c.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if cart := list.FindBySkuId(c.SkuId); cart != nil {
+		return cart, nil
+	}
+	return nil, errors.InternalServer("CREATE_CART_NOT_FOUND", "创建购物车失败")
+}
+
+func (r *cacheCartRepo) List(ctx context.Context, userId int64) (domain.ShopCartList, error) {
+	if err := r.load(ctx, userId); err != nil {
+		return nil, err
+	}
+	fields, err := r.data.rdb.HGetAll(ctx, cartKey(userId)).Result()
+	if err != nil {
+		return nil, errors.InternalServer("SELECT_CART_ERROR", "用户购物车列表查询失败")
+	}
+	return parseCart(userId, fields), nil
+}
+
+func (r *cacheCartRepo) UpdateNum(ctx context.Context, userId, skuId int64, num int32) error {
+	if err := r.load(ctx, userId); err != nil {
+		return err
+	}
+	n, err := updateNumScript.Run(ctx, r.data.rdb, []string{cartKey(userId), cartDirtyKey},
+		cartInfoField(skuId), cartNumField(skuId), num, userId).Int()
+	if err != nil {
+		return errors.InternalServer("UPDATE_CART_ERROR", "修改购物车失败")
+	}
+	if n == 0 {
+		return errors.NotFound("CART_NOT_FOUND", "购物车商品不存在")
+	}
+	return nil
+}
+
+func (r *cacheCartRepo) Select(ctx context.Context, userId int64, isSelect bool, skuIds ...int64) error {
+	list, err := r.List(ctx, userId)
+	if err != nil {
+		return err
+	}
+	key := cartKey(userId)
+	return r.write(ctx, userId, func(pipe redis.Pipeliner) {
+		for _, cart := range list {
+			if len(skuIds) == 0 || containsSku(skuIds, cart.SkuId) {
+				pipe.HSet(ctx, key, cartSelectField(cart.SkuId), isSelect)
+			}
+		}
+	})
+}
+
+func (r *cacheCartRepo) Delete(ctx context.Context, userId int64, skuIds ...int64) error {
+	if err := r.load(ctx, userId); err != nil {
+		return err
+	}
+	var fields []string
+	for _, skuId := range skuIds {
+		fields = append(fields, cartNumField(skuId), cartSelectField(skuId), cartInfoField(skuId))
+	}
+	n, err := r.data.rdb.HDel(ctx, cartKey(userId), fields...).Result()
+	if err != nil {
+		return errors.InternalServer("DELETE_CART_ERROR", "删除购物车商品失败")
+	}
+	if n == 0 {
+		return errors.NotFound("CART_NOT_FOUND", "购物车商品不存在")
+	}
+	return r.write(ctx, userId, func(pipe redis.Pipeliner) {})
+}
+
+func (r *cacheCartRepo) Clear(ctx context.Context, userId int64) error {
+	key := cartKey(userId)
+	return r.write(ctx, userId, func(pipe redis.Pipeliner) {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, cartLoadedField, 1)
+		pipe.Expire(ctx, key, r.ttl)
+	})
+}
+
+// write 修改购物车并标记需要写回 mysql
+func (r *cacheCartRepo) write(ctx context.Context, userId int64, fn func(pipe redis.Pipeliner)) error {
+	_, err := r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		fn(pipe)
+		pipe.SAdd(ctx, cartDirtyKey, userId)
+		return nil
+	})
+	if err != nil {
+		return errors.InternalServer("UPDATE_CART_ERROR", "修改购物车失败")
+	}
+	return nil
+}
+
+// Flush 将修改过的购物车写回 mysql，写回失败的用户重新标记等待下次写回
+func (r *cacheCartRepo) Flush(ctx context.Context) (int, error) {
+	var count int
+	for i := 0; i < cartFlushBatch; i++ {
+		userId, err := r.data.rdb.SPop(ctx, cartDirtyKey).Int64()
+		if err == redis.Nil {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		if err := r.flushUser(ctx, userId); err != nil {
+			r.data.rdb.SAdd(ctx, cartDirtyKey, userId)
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+func cartFlushKey(userId int64) string {
+	return fmt.Sprintf("cart:flush:%d", userId)
+}
+
+// flushUser 同一个用户同时只有一个实例写回，读取快照在加锁之后，先获取锁的实例提交后
+// 后面的实例才能读取更新的快照，旧快照不会覆盖新快照。没有获取到锁时重新标记等待下次写回
+func (r *cacheCartRepo) flushUser(ctx context.Context, userId int64) error {
+	lockKey := cartFlushKey(userId)
+	token := strconv.FormatInt(time.Now().UnixNano(), 10)
+	ok, err := r.data.rdb.SetNX(ctx, lockKey, token, cartFlushLock).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return r.data.rdb.SAdd(ctx, cartDirtyKey, userId).Err()
+	}
+	defer unlockScript.Run(context.Background(), r.data.rdb, []string{lockKey}, token)
+
+	fields, err := r.data.rdb.HGetAll(ctx, cartKey(userId)).Result()
+	if err != nil {
+		return err
+	}
+	if _, ok := fields[cartLoadedField]; !ok {
+		// 缓存已经过期，没有可以写回的数据
+		return nil
+	}
+	list := parseCart(userId, fields)
+
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 删除 redis 中已经不存在的商品
+		query := tx.Where("user_id = ?", userId)
+		if len(list) > 0 {
+			var skuIds []int64
+			for _, cart := range list {
+				skuIds = append(skuIds, cart.SkuId)
+			}
+			query = query.Where("sku_id NOT IN (?)", skuIds)
+		}
+		if err := query.Delete(&ShopCart{}).Error; err != nil {
+			return err
+		}
+
+		var carts []*ShopCart
+		for _, cart := range list {
+			if cart.GoodsId == 0 {
+				// 缺少商品信息的记录不能写回
+				r.log.Warnf("cart flush skip user %d sku %d without info", userId, cart.SkuId)
+				continue
+			}
+			carts = append(carts, &ShopCart{
+				UserId:     userId,
+				GoodsId:    cart.GoodsId,
+				SkuId:      cart.SkuId,
+				GoodsPrice: cart.GoodsPrice,
+				GoodsNum:   cart.GoodsNum,
+				GoodsSn:    cart.GoodsSn,
+				GoodsName:  cart.GoodsName,
+				IsSelect:   cart.IsSelect,
+			})
+		}
+		if len(carts) == 0 {
+			return nil
+		}
+		// redis 中的数量是最终结果，直接覆盖 mysql 中的记录
+		return tx.Clauses(clause.OnConflict{
+			Columns: cartConflict,
+			DoUpdates: []clause.Assignment{
+				{Column: clause.Column{Name: "goods_num"}, Value: gorm.Expr("VALUES(goods_num)")},
+				{Column: clause.Column{Name: "is_select"}, Value: gorm.Expr("VALUES(is_select)")},
+				{Column: clause.Column{Name: "update_time"}, Value: gorm.Expr("VALUES(update_time)")},
+				{Column: clause.Column{Name: "deleted_at"}, Value: nil},
+			},
+		}).Create(&carts).Error
+	})
+}
+
+// parseCart 将 hash 中的字段还原为购物车列表，按 sku 排序
+func parseCart(userId int64, fields map[string]string) domain.ShopCartList {
+	carts := make(map[int64]*domain.ShopCart)
+	for field, value := range fields {
+		i := strings.LastIndex(field, ":")
+		if i < 0 {
+			continue
+		}
+		skuId, err := strconv.ParseInt(field[:i], 10, 64)
+		if err != nil {
+			continue
+		}
+		cart, ok := carts[skuId]
+		if !ok {
+			cart = &domain.ShopCart{UserId: userId, SkuId: skuId}
+			carts[skuId] = cart
+		}
+		switch field[i+1:] {
+		case "num":
+			num, _ := strconv.ParseInt(value, 10, 32)
+			cart.GoodsNum = int32(num)
+		case "sel":
+			cart.IsSelect = value == "1" || value == "true"
+		case "info":
+			var info cartInfo
+			if err := json.Unmarshal([]byte(value), &info); err == nil {
+				cart.ID = info.ID
+				cart.GoodsId = info.GoodsId
+				cart.GoodsPrice = info.GoodsPrice
+				cart.GoodsSn = info.GoodsSn
+				cart.GoodsName = info.GoodsName
+			}
+		}
+	}
+
+	var list domain.ShopCartList
+	for _, cart := range carts {
+		// 没有数量的字段说明商品已经被删除
+		if cart.GoodsNum > 0 {
+			list = append(list, cart)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].SkuId < list[j].SkuId })
+	return list
+}
+
+func containsSku(skuIds []int64, skuId int64) bool {
+	for _, id := range skuIds {
+		if id == skuId {
+			return true
+		}
+	}
+	return false
+}
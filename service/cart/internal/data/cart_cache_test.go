@@ -0,0 +1,111 @@
+package data_test
+
+import (
+	"cart/internal/biz"
+	"cart/internal/conf"
+	"cart/internal/data"
+	"cart/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CartCache", func() {
+	var ro biz.CartRepo
+	var db biz.CartRepo
+	BeforeEach(func() {
+		ro = data.NewCartStore(&conf.Data{Cart: &conf.Data_Cart{Store: "redis"}}, CacheDb, nil)
+		db = data.NewCartRepo(Db, nil)
+	})
+
+	It("Load", func() {
+		// mysql 中已有的购物车在第一次访问时加载到 redis
+		_, err := db.Create(ctx, &domain.ShopCart{UserId: 101, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 2, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		list, err := ro.List(ctx, 101)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(HaveLen(1))
+		Ω(list[0].GoodsNum).Should(Equal(int32(2)))
+		Ω(list[0].GoodsPrice).Should(Equal(int64(1000)))
+
+		c, err := ro.Create(ctx, &domain.ShopCart{UserId: 101, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 3, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(c.GoodsNum).Should(Equal(int32(5)))
+	})
+
+	It("UpdateNum", func() {
+		_, err := ro.Create(ctx, &domain.ShopCart{UserId: 102, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		err = ro.UpdateNum(ctx, 102, 1, 5)
+		Ω(err).ShouldNot(HaveOccurred())
+		list, err := ro.List(ctx, 102)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list[0].GoodsNum).Should(Equal(int32(5)))
+
+		// 不在购物车中的商品不会写入只有数量的记录
+		err = ro.UpdateNum(ctx, 102, 2, 5)
+		Ω(err).Should(HaveOccurred())
+		list, err = ro.List(ctx, 102)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(HaveLen(1))
+	})
+
+	It("Delete", func() {
+		_, err := ro.Create(ctx, &domain.ShopCart{UserId: 103, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+		_, err = ro.Create(ctx, &domain.ShopCart{UserId: 103, GoodsId: 1, SkuId: 2, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		err = ro.Delete(ctx, 103, 1)
+		Ω(err).ShouldNot(HaveOccurred())
+		err = ro.Delete(ctx, 103, 1)
+		Ω(err).Should(HaveOccurred())
+		err = ro.UpdateNum(ctx, 103, 1, 5)
+		Ω(err).Should(HaveOccurred())
+
+		list, err := ro.List(ctx, 103)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(HaveLen(1))
+		Ω(list[0].SkuId).Should(Equal(int64(2)))
+	})
+
+	It("Flush", func() {
+		_, err := db.Create(ctx, &domain.ShopCart{UserId: 104, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+		_, err = ro.Create(ctx, &domain.ShopCart{UserId: 104, GoodsId: 1, SkuId: 2, GoodsPrice: 1000, GoodsNum: 3, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+		err = ro.Delete(ctx, 104, 1)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		_, err = ro.Flush(ctx)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		// 写回后 mysql 和 redis 一致
+		list, err := db.List(ctx, 104)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(HaveLen(1))
+		Ω(list[0].SkuId).Should(Equal(int64(2)))
+		Ω(list[0].GoodsNum).Should(Equal(int32(3)))
+	})
+
+	It("CreateLimit", func() {
+		limit := &domain.CartLimit{MaxLines: 1, MaxNum: 3}
+		_, err := ro.Create(ctx, &domain.ShopCart{UserId: 105, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 2, IsSelect: true}, limit)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		// 累加后超过可购买数量
+		_, err = ro.Create(ctx, &domain.ShopCart{UserId: 105, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 2, IsSelect: true}, limit)
+		Ω(errors.Is(err, biz.ErrCartNumExceeded)).Should(BeTrue())
+		// 超过商品种类上限
+		_, err = ro.Create(ctx, &domain.ShopCart{UserId: 105, GoodsId: 1, SkuId: 2, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, limit)
+		Ω(errors.Is(err, biz.ErrCartLinesExceeded)).Should(BeTrue())
+
+		list, err := ro.List(ctx, 105)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(HaveLen(1))
+		Ω(list[0].GoodsNum).Should(Equal(int32(2)))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"cart/internal/data"
 	"cart/internal/domain"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -26,7 +27,7 @@ var _ = Describe("Cart", func() {
 			GoodsName:  "Mate 40 Pro",
 			IsSelect:   true,
 		}
-		c, err := ro.Create(ctx, &cartData)
+		c, err := ro.Create(ctx, &cartData, unlimited)
 		Ω(err).ShouldNot(HaveOccurred())
 		Ω(c.UserId).Should(Equal(int64(1)))
 		Ω(c.GoodsNum).Should(Equal(int32(10)))
@@ -42,10 +43,77 @@ var _ = Describe("Cart", func() {
 			GoodsName:  "Mate 40 Pro",
 			IsSelect:   true,
 		}
-		c2, err := ro.Create(ctx, &cartData2)
+		c2, err := ro.Create(ctx, &cartData2, unlimited)
 		Ω(err).ShouldNot(HaveOccurred())
 		Ω(c2.UserId).Should(Equal(int64(1)))
 		Ω(c2.GoodsNum).Should(Equal(int32(20)))
 	})
 
+	It("UpdateNum", func() {
+		_, err := ro.Create(ctx, &domain.ShopCart{UserId: 2, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		err = ro.UpdateNum(ctx, 2, 1, 5)
+		Ω(err).ShouldNot(HaveOccurred())
+		list, err := ro.List(ctx, 2)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list[0].GoodsNum).Should(Equal(int32(5)))
+
+		// 不能修改其他用户的购物车
+		err = ro.UpdateNum(ctx, 3, 1, 5)
+		Ω(err).Should(HaveOccurred())
+	})
+
+	It("SelectAndDelete", func() {
+		_, err := ro.Create(ctx, &domain.ShopCart{UserId: 4, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+		_, err = ro.Create(ctx, &domain.ShopCart{UserId: 4, GoodsId: 1, SkuId: 2, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, unlimited)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		err = ro.Select(ctx, 4, false, 1)
+		Ω(err).ShouldNot(HaveOccurred())
+		list, err := ro.List(ctx, 4)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list.ListSelected()).Should(HaveLen(1))
+
+		err = ro.Select(ctx, 4, true)
+		Ω(err).ShouldNot(HaveOccurred())
+		list, err = ro.List(ctx, 4)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list.ListSelected()).Should(HaveLen(2))
+
+		// 不能删除其他用户的购物车
+		err = ro.Delete(ctx, 5, 1)
+		Ω(err).Should(HaveOccurred())
+
+		err = ro.Delete(ctx, 4, 1)
+		Ω(err).ShouldNot(HaveOccurred())
+		list, err = ro.List(ctx, 4)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(HaveLen(1))
+
+		err = ro.Clear(ctx, 4)
+		Ω(err).ShouldNot(HaveOccurred())
+		list, err = ro.List(ctx, 4)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(BeEmpty())
+	})
+
+	It("CreateLimit", func() {
+		limit := &domain.CartLimit{MaxLines: 1, MaxNum: 3}
+		_, err := ro.Create(ctx, &domain.ShopCart{UserId: 6, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 2, IsSelect: true}, limit)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		// 累加后超过可购买数量
+		_, err = ro.Create(ctx, &domain.ShopCart{UserId: 6, GoodsId: 1, SkuId: 1, GoodsPrice: 1000, GoodsNum: 2, IsSelect: true}, limit)
+		Ω(errors.Is(err, biz.ErrCartNumExceeded)).Should(BeTrue())
+		// 超过商品种类上限
+		_, err = ro.Create(ctx, &domain.ShopCart{UserId: 6, GoodsId: 1, SkuId: 2, GoodsPrice: 1000, GoodsNum: 1, IsSelect: true}, limit)
+		Ω(errors.Is(err, biz.ErrCartLinesExceeded)).Should(BeTrue())
+
+		list, err := ro.List(ctx, 6)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(list).Should(HaveLen(1))
+		Ω(list[0].GoodsNum).Should(Equal(int32(2)))
+	})
 })
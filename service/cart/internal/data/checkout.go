@@ -0,0 +1,81 @@
+package data
+
+import (
+	"context"
+
+	"cart/internal/biz"
+	"cart/internal/conf"
+	"cart/internal/domain"
+)
+
+// NewCartRule 从配置读取购物车的限制
+func NewCartRule(c *conf.Data) *domain.CartRule {
+	return &domain.CartRule{
+		MaxLines:    c.GetCart().GetMaxLines(),
+		MaxQuantity: c.GetCart().GetMaxQuantity(),
+	}
+}
+
+// couponRepo 从配置读取优惠券
+type couponRepo struct {
+	coupons map[string]*domain.Coupon
+}
+
+// NewCouponRepo .
+func NewCouponRepo(c *conf.Checkout) biz.CouponRepo {
+	r := &couponRepo{coupons: make(map[string]*domain.Coupon)}
+	for _, coupon := range c.GetCoupons() {
+		r.coupons[coupon.Code] = &domain.Coupon{
+			Code:      coupon.Code,
+			Name:      coupon.Name,
+			Threshold: coupon.Threshold,
+			Discount:  coupon.Discount,
+			SkuIds:    coupon.SkuIds,
+		}
+	}
+	return r
+}
+
+func (r *couponRepo) ListByCodes(ctx context.Context, codes ...string) ([]*domain.Coupon, error) {
+	var res []*domain.Coupon
+	for _, code := range codes {
+		if coupon, ok := r.coupons[code]; ok {
+			res = append(res, coupon)
+		}
+	}
+	return res, nil
+}
+
+// shipTemplateRepo 从配置读取运费模版
+type shipTemplateRepo struct {
+	defaultFee int64
+	templates  map[int32]*domain.ShipTemplate
+}
+
+// NewShipTemplateRepo .
+func NewShipTemplateRepo(c *conf.Checkout) biz.ShipTemplateRepo {
+	r := &shipTemplateRepo{
+		defaultFee: c.GetDefaultShipFee(),
+		templates:  make(map[int32]*domain.ShipTemplate),
+	}
+	for _, tpl := range c.GetShipTemplates() {
+		r.templates[tpl.Id] = &domain.ShipTemplate{
+			ID:         tpl.Id,
+			Fee:        tpl.Fee,
+			FreeAmount: tpl.FreeAmount,
+		}
+	}
+	return r
+}
+
+func (r *shipTemplateRepo) ListByIds(ctx context.Context, ids ...int32) (map[int32]*domain.ShipTemplate, error) {
+	res := make(map[int32]*domain.ShipTemplate, len(ids))
+	for _, id := range ids {
+		if tpl, ok := r.templates[id]; ok {
+			res[id] = tpl
+		} else {
+			res[id] = &domain.ShipTemplate{ID: id, Fee: r.defaultFee}
+		}
+	}
+	return res, nil
+}
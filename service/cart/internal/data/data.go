@@ -18,7 +18,8 @@ import (
 
 // ProviderSet is data providers.
 var ProviderSet = wire.NewSet(NewData,
-	NewDB, NewRedis, NewCartRepo)
+	NewDB, NewRedis, NewCartStore, NewGuestCartRepo, NewGoodsRepo, NewGoodsServiceClient, NewDiscovery,
+	NewCouponRepo, NewShipTemplateRepo, NewCartRule, NewSavedCartRepo, NewWishlistRepo)
 
 // Data .
 type Data struct {
@@ -30,6 +31,9 @@ type Data struct {
 func NewData(c *conf.Data, logger log.Logger, db *gorm.DB, rdb *redis.Client) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
+		if rdb != nil {
+			_ = rdb.Close()
+		}
 	}
 	return &Data{
 		db:  db,
@@ -62,7 +66,14 @@ func NewDB(c *conf.Data) *gorm.DB {
 		log.Errorf("failed opening connection to sqlite: %v", err)
 		panic("failed to connect database")
 	}
-	_ = db.AutoMigrate(&ShopCart{})
+	// 已有重复购物车记录时唯一索引会创建失败，需要先运行 internal/data/entity 迁移工具合并
+	if err := db.AutoMigrate(&ShopCart{}, &SavedCart{}, &Wishlist{}, &FavEventOutbox{}); err != nil {
+		log.Errorf("failed migrating database: %v", err)
+		panic("failed to migrate database")
+	}
+	if !db.Migrator().HasIndex(&ShopCart{}, "user_sku") {
+		panic("shop_cart missing unique index user_sku")
+	}
 	return db
 }
 
@@ -76,8 +87,5 @@ func NewRedis(c *conf.Data) *redis.Client {
 		ReadTimeout:  c.Redis.ReadTimeout.AsDuration(),
 	})
 	rdb.AddHook(redisotel.TracingHook{})
-	if err := rdb.Close(); err != nil {
-		log.Error(err)
-	}
 	return rdb
 }
@@ -3,9 +3,11 @@ package data_test
 import (
 	"cart/internal/conf"
 	"cart/internal/data"
+	"cart/internal/domain"
 	"context"
 	"testing"
 
+	"github.com/go-redis/redis/v8"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
@@ -22,18 +24,28 @@ func TestData(t *testing.T) {
 }
 
 var cleaner func()      // 定义删除 mysql 容器的回调函数
+var redisCleaner func() // 定义删除 redis 容器的回调函数
 var Db *data.Data       // 用于测试的 data
+var CacheDb *data.Data  // 带 redis 的 data，用于测试 redis 购物车
 var ctx context.Context // 上下文
+
+// unlimited 测试中加入购物车不限制商品种类和数量
+var unlimited = &domain.CartLimit{MaxNum: 1000}
+
 // initialize  AutoMigrate gorm自动建表
 func initialize(db *gorm.DB) error {
 	err := db.AutoMigrate(
 		&data.ShopCart{},
+		&data.SavedCart{},
+		&data.Wishlist{},
+		&data.FavEventOutbox{},
 	)
 	return errors.WithStack(err)
 }
 
 // ginkgo 使用 BeforeEach 为您的 Specs 设置状态
 var _ = BeforeSuite(func() {
+	ctx = context.Background()
 	// 执行测试数据库操作之前，链接之前 docker 容器创建的 mysql
 	con, f := data.DockerMysql("mysql", "8.0")
 	// con, f := data.DockerMysql("mariadb", "latest")
@@ -48,6 +60,13 @@ var _ = BeforeSuite(func() {
 		return
 	}
 	Db = mySQLDb
+
+	addr, rf := data.DockerRedis("redis", "7")
+	redisCleaner = rf
+	rdb := redis.NewClient(&redis.Options{Addr: addr})
+	CacheDb, _, err = data.NewData(config, nil, db, rdb)
+	Expect(err).NotTo(HaveOccurred())
+
 	err = initialize(db)
 	if err != nil {
 		return
@@ -58,4 +77,5 @@ var _ = BeforeSuite(func() {
 // 测试结束后 通过回调函数，关闭并删除 docker 创建的容器
 var _ = AfterSuite(func() {
 	cleaner()
+	redisCleaner()
 })
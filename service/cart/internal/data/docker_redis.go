@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/ory/dockertest/v3"
+)
+
+// DockerRedis 启动一个临时的 Redis Docker 容器用于测试
+func DockerRedis(img, version string) (string, func()) {
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		log.Fatalf("Could not connect to docker: %s", err)
+	}
+	pool.MaxWait = time.Minute * 2
+	resource, err := pool.Run(img, version, nil)
+	if err != nil {
+		log.Fatalf("Could not start resource: %s", err)
+	}
+	addr := fmt.Sprintf("localhost:%s", resource.GetPort("6379/tcp"))
+	if err := pool.Retry(func() error {
+		rdb := redis.NewClient(&redis.Options{Addr: addr})
+		defer rdb.Close()
+		return rdb.Ping(context.Background()).Err()
+	}); err != nil {
+		log.Fatalf("Could not connect to docker: %s", err)
+	}
+	// 回调函数关闭容器
+	return addr, func() {
+		if err = pool.Purge(resource); err != nil {
+			log.Fatalf("Could not purge resource: %s", err)
+		}
+	}
+}
@@ -34,7 +34,37 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	_ = db.AutoMigrate(
+	if err := dedupeShopCart(db); err != nil {
+		panic(err)
+	}
+	if err := db.AutoMigrate(
 		&data.ShopCart{},
-	)
+		&data.SavedCart{},
+		&data.Wishlist{},
+		&data.FavEventOutbox{},
+	); err != nil {
+		panic(err)
+	}
+}
+
+// dedupeShopCart 添加 user_sku 唯一索引之前合并重复的购物车记录，每个 sku 优先保留没有删除的、
+// 其次保留最新的一条，没有删除的记录数量累加到保留的记录上，已经存在索引时跳过
+func dedupeShopCart(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&data.ShopCart{}) || m.HasIndex(&data.ShopCart{}, "user_sku") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`UPDATE shop_cart c JOIN (
+			SELECT MAX(id) AS id, SUM(goods_num) AS goods_num FROM shop_cart
+			WHERE deleted_at IS NULL GROUP BY user_id, sku_id HAVING COUNT(*) > 1
+		) d ON c.id = d.id SET c.goods_num = d.goods_num`).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(`DELETE c1 FROM shop_cart c1 JOIN shop_cart c2
+			ON c1.user_id = c2.user_id AND c1.sku_id = c2.sku_id AND c1.id <> c2.id
+			WHERE (c1.deleted_at IS NOT NULL AND c2.deleted_at IS NULL)
+			OR ((c1.deleted_at IS NULL) = (c2.deleted_at IS NULL) AND c1.id < c2.id)`).Error
+	})
 }
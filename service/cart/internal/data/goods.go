@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	goodsV1 "cart/api/service/goods/v1"
+	"cart/internal/biz"
+	"cart/internal/conf"
+	"cart/internal/domain"
+
+	consul "github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	consulAPI "github.com/hashicorp/consul/api"
+)
+
+type goodsRepo struct {
+	gc  goodsV1.GoodsClient // 商品服务的客户端
+	log *log.Helper
+}
+
+func NewGoodsRepo(gc goodsV1.GoodsClient, logger log.Logger) biz.GoodsRepo {
+	return &goodsRepo{
+		gc:  gc,
+		log: log.NewHelper(logger),
+	}
+}
+
+// ListSku 从商品服务批量查询 sku 的名称、价格、上架状态、库存和运费信息
+func (r *goodsRepo) ListSku(ctx context.Context, ids ...int64) (domain.SkuList, error) {
+	rsp, err := r.gc.SkuList(ctx, &goodsV1.SkuListRequest{Id: ids})
+	if err != nil {
+		return nil, err
+	}
+	var res domain.SkuList
+	for _, sku := range rsp.List {
+		res = append(res, &domain.Sku{
+			ID:             sku.Id,
+			GoodsId:        sku.GoodsId,
+			GoodsSn:        sku.GoodsSn,
+			GoodsName:      sku.GoodsName,
+			SkuName:        sku.SkuName,
+			Price:          sku.Price,
+			PromotionPrice: sku.PromotionPrice,
+			Points:         sku.Points,
+			OnSale:         sku.OnSale,
+			Inventory:      sku.Inventory,
+			ShipFree:       sku.ShipFree,
+			ShipID:         sku.ShipId,
+			PurchaseLimit:  sku.PurchaseLimit,
+		})
+	}
+	return res, nil
+}
+
+// ListGoods 从商品服务批量查询商品信息
+func (r *goodsRepo) ListGoods(ctx context.Context, ids ...int64) (domain.GoodsList, error) {
+	rsp, err := r.gc.BatchGetGoods(ctx, &goodsV1.BatchGoodsIdInfo{Id: ids})
+	if err != nil {
+		return nil, err
+	}
+	var res domain.GoodsList
+	for _, goods := range rsp.List {
+		res = append(res, &domain.Goods{
+			ID:          goods.Id,
+			Name:        goods.Name,
+			GoodsSn:     goods.GoodsSn,
+			Image:       goods.Images,
+			MarketPrice: goods.MarketPrice,
+			OnSale:      goods.OnSale,
+			FavNum:      goods.FavNum,
+		})
+	}
+	return res, nil
+}
+
+// ApplyFavEvents 将收藏数变化的事件发送到商品服务
+func (r *goodsRepo) ApplyFavEvents(ctx context.Context, events ...*domain.FavEvent) error {
+	req := &goodsV1.FavEventsRequest{}
+	for _, e := range events {
+		req.Events = append(req.Events, &goodsV1.FavEvent{
+			EventId: e.EventID(),
+			GoodsId: e.GoodsId,
+			Delta:   e.Delta,
+		})
+	}
+	_, err := r.gc.ApplyFavEvents(ctx, req)
+	return err
+}
+
+// NewGoodsServiceClient 链接商品grpc服务
+func NewGoodsServiceClient(sr *conf.Service, rr registry.Discovery) goodsV1.GoodsClient {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint(sr.Goods.Endpoint), // consul
+		grpc.WithDiscovery(rr),               // consul
+		grpc.WithMiddleware(
+			tracing.Client(), // 链路追踪
+			recovery.Recovery(),
+		),
+		grpc.WithTimeout(2*time.Second),
+	)
+	if err != nil {
+		panic(err)
+	}
+	return goodsV1.NewGoodsClient(conn)
+}
+
+// NewDiscovery consul 服务发现
+func NewDiscovery(conf *conf.Registry) registry.Discovery {
+	c := consulAPI.DefaultConfig()
+	c.Address = conf.Consul.Address
+	c.Scheme = conf.Consul.Scheme
+	cli, err := consulAPI.NewClient(c)
+	if err != nil {
+		panic(err)
+	}
+	return consul.New(cli, consul.WithHealthCheck(false))
+}
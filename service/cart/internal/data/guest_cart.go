@@ -0,0 +1,135 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"cart/internal/biz"
+	"cart/internal/conf"
+	"cart/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis/v8"
+)
+
+// guestCartRepo 游客购物车只保存在 redis，与用户购物车使用相同的 hash 结构，
+// 每次访问都会刷新过期时间，超过 ttl 没有访问的购物车自动删除
+type guestCartRepo struct {
+	data *Data
+	ttl  time.Duration
+	log  *log.Helper
+}
+
+// NewGuestCartRepo .
+func NewGuestCartRepo(c *conf.Data, data *Data, logger log.Logger) biz.GuestCartRepo {
+	ttl := 72 * time.Hour
+	if c.Cart != nil && c.Cart.GuestTtl != nil && c.Cart.GuestTtl.AsDuration() > 0 {
+		ttl = c.Cart.GuestTtl.AsDuration()
+	}
+	return &guestCartRepo{
+		data: data,
+		ttl:  ttl,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func guestCartKey(token string) string {
+	return "cart:guest:" + token
+}
+
+func (r *guestCartRepo) Create(ctx context.Context, token string, c *domain.ShopCart, limit *domain.CartLimit) (*domain.ShopCart, error) {
+	if err := addCart(ctx, r.data.rdb, []string{guestCartKey(token)}, c, limit, r.ttl); err != nil {
+		return nil, err
+	}
+
+	list, err := r.List(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if cart := list.FindBySkuId(c.SkuId); cart != nil {
+		return cart, nil
+	}
+	return nil, errors.InternalServer("CREATE_CART_NOT_FOUND", "创建购物车失败")
+}
+
+func (r *guestCartRepo) List(ctx context.Context, token string) (domain.ShopCartList, error) {
+	key := guestCartKey(token)
+	fields, err := r.data.rdb.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, errors.InternalServer("SELECT_CART_ERROR", "游客购物车列表查询失败")
+	}
+	if len(fields) > 0 {
+		r.data.rdb.Expire(ctx, key, r.ttl)
+	}
+	return parseCart(0, fields), nil
+}
+
+func (r *guestCartRepo) UpdateNum(ctx context.Context, token string, skuId int64, num int32) error {
+	key := guestCartKey(token)
+	exists, err := r.data.rdb.HExists(ctx, key, cartNumField(skuId)).Result()
+	if err != nil {
+		return errors.InternalServer("UPDATE_CART_ERROR", "修改购物车失败")
+	}
+	if !exists {
+		return errors.NotFound("CART_NOT_FOUND", "购物车商品不存在")
+	}
+	_, err = r.data.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, cartNumField(skuId), num)
+		pipe.Expire(ctx, key, r.ttl)
+		return nil
+	})
+	if err != nil {
+		return errors.InternalServer("UPDATE_CART_ERROR", "修改购物车失败")
+	}
+	return nil
+}
+
+func (r *guestCartRepo) Delete(ctx context.Context, token string, skuIds ...int64) error {
+	var fields []string
+	for _, skuId := range skuIds {
+		fields = append(fields, cartNumField(skuId), cartSelectField(skuId), cartInfoField(skuId))
+	}
+	n, err := r.data.rdb.HDel(ctx, guestCartKey(token), fields...).Result()
+	if err != nil {
+		return errors.InternalServer("DELETE_CART_ERROR", "删除购物车商品失败")
+	}
+	if n == 0 {
+		return errors.NotFound("CART_NOT_FOUND", "购物车商品不存在")
+	}
+	return nil
+}
+
+// Claim 将游客购物车重命名为本次合并独有的 key，重命名是原子的，并发合并时只有一个请求能取到
+func (r *guestCartRepo) Claim(ctx context.Context, token string) (string, domain.ShopCartList, error) {
+	claim := guestCartKey(token) + ":merge:" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	err := r.data.rdb.Rename(ctx, guestCartKey(token), claim).Err()
+	if err != nil {
+		if strings.Contains(err.Error(), "no such key") {
+			return claim, nil, nil
+		}
+		return "", nil, errors.InternalServer("SELECT_CART_ERROR", "游客购物车列表查询失败")
+	}
+	fields, err := r.data.rdb.HGetAll(ctx, claim).Result()
+	if err != nil {
+		return "", nil, errors.InternalServer("SELECT_CART_ERROR", "游客购物车列表查询失败")
+	}
+	return claim, parseCart(0, fields), nil
+}
+
+func (r *guestCartRepo) Release(ctx context.Context, claim string) error {
+	if err := r.data.rdb.Del(ctx, claim).Err(); err != nil {
+		return errors.InternalServer("DELETE_CART_ERROR", "清空购物车失败")
+	}
+	return nil
+}
+
+// Restore 游客购物车在合并期间又有新的商品时不覆盖，取走的购物车等待过期
+func (r *guestCartRepo) Restore(ctx context.Context, token string, claim string) error {
+	if err := r.data.rdb.RenameNX(ctx, claim, guestCartKey(token)).Err(); err != nil {
+		return errors.InternalServer("UPDATE_CART_ERROR", "恢复游客购物车失败")
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"cart/internal/biz"
+	"cart/internal/domain"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SavedCart 稍后购买的商品，从购物车移出后保存
+type SavedCart struct {
+	ID         int64     `gorm:"primarykey;type:int" json:"id"`
+	UserId     int64     `gorm:"type:int;not null;uniqueIndex:user_sku,priority:1;comment:用户id" json:"user_id"`
+	GoodsId    int64     `gorm:"type:int;not null;comment:商品id" json:"goods_id"`
+	SkuId      int64     `gorm:"type:int;not null;uniqueIndex:user_sku,priority:2;comment:sku_id" json:"sku_id"`
+	GoodsPrice int64     `gorm:"type:int;not null;comment:商品价格" json:"goods_price"`
+	GoodsNum   int32     `gorm:"type:int;not null;comment:商品数量" json:"goods_num"`
+	GoodsSn    string    `gorm:"type:varchar(500);default:;comment:商品编号"`
+	GoodsName  string    `gorm:"type:varchar(500);default:;comment:商品名称"`
+	CreatedAt  time.Time `gorm:"column:add_time" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"column:update_time" json:"updated_at"`
+}
+
+// Wishlist 收藏夹，同一个商品只收藏一次
+type Wishlist struct {
+	ID        int64     `gorm:"primarykey;type:int" json:"id"`
+	UserId    int64     `gorm:"type:int;not null;uniqueIndex:user_goods,priority:1;comment:用户id" json:"user_id"`
+	GoodsId   int64     `gorm:"type:int;not null;uniqueIndex:user_goods,priority:2;comment:商品id" json:"goods_id"`
+	CreatedAt time.Time `gorm:"column:add_time" json:"created_at"`
+}
+
+// FavEventOutbox 待发送到商品服务的收藏数变化事件
+type FavEventOutbox struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	GoodsId   int64     `gorm:"type:int;not null;comment:商品id"`
+	Delta     int32     `gorm:"type:int;not null;comment:收藏数变化"`
+	Sent      bool      `gorm:"index:sent;default:false;not null;comment:是否已经发送"`
+	CreatedAt time.Time `gorm:"column:add_time"`
+}
+
+func (p *SavedCart) ToDomain() *domain.ShopCart {
+	return &domain.ShopCart{
+		ID:         p.ID,
+		UserId:     p.UserId,
+		GoodsId:    p.GoodsId,
+		SkuId:      p.SkuId,
+		GoodsPrice: p.GoodsPrice,
+		GoodsNum:   p.GoodsNum,
+		GoodsSn:    p.GoodsSn,
+		GoodsName:  p.GoodsName,
+	}
+}
+
+func (p *Wishlist) ToDomain() *domain.Wishlist {
+	return &domain.Wishlist{
+		ID:        p.ID,
+		UserId:    p.UserId,
+		GoodsId:   p.GoodsId,
+		CreatedAt: p.CreatedAt,
+	}
+}
+
+type savedCartRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSavedCartRepo .
+func NewSavedCartRepo(data *Data, logger log.Logger) biz.SavedCartRepo {
+	return &savedCartRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Save 保存稍后购买的商品，已经存在的商品累加数量
+func (r *savedCartRepo) Save(ctx context.Context, list domain.ShopCartList) error {
+	if len(list) == 0 {
+		return nil
+	}
+	var saved []*SavedCart
+	for _, c := range list {
+		saved = append(saved, &SavedCart{
+			UserId:     c.UserId,
+			GoodsId:    c.GoodsId,
+			SkuId:      c.SkuId,
+			GoodsPrice: c.GoodsPrice,
+			GoodsNum:   c.GoodsNum,
+			GoodsSn:    c.GoodsSn,
+			GoodsName:  c.GoodsName,
+		})
+	}
+	err := r.data.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "sku_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"goods_num": gorm.Expr("goods_num + VALUES(goods_num)")}),
+	}).Create(&saved).Error
+	if err != nil {
+		return errors.InternalServer("SAVE_CART_ERROR", "保存稍后购买的商品失败")
+	}
+	return nil
+}
+
+func (r *savedCartRepo) List(ctx context.Context, userId int64) (domain.ShopCartList, error) {
+	var saved []*SavedCart
+	if err := r.data.db.WithContext(ctx).Where("user_id = ?", userId).Order("id desc").Find(&saved).Error; err != nil {
+		return nil, errors.InternalServer("SELECT_CART_ERROR", "稍后购买的商品查询失败")
+	}
+	var res domain.ShopCartList
+	for _, item := range saved {
+		res = append(res, item.ToDomain())
+	}
+	return res, nil
+}
+
+func (r *savedCartRepo) Delete(ctx context.Context, userId int64, skuIds ...int64) error {
+	result := r.data.db.WithContext(ctx).Where("user_id = ? AND sku_id IN (?)", userId, skuIds).Delete(&SavedCart{})
+	if result.Error != nil {
+		return errors.InternalServer("DELETE_CART_ERROR", "删除稍后购买的商品失败")
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("CART_NOT_FOUND", "稍后购买的商品不存在")
+	}
+	return nil
+}
+
+type wishlistRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewWishlistRepo .
+func NewWishlistRepo(data *Data, logger log.Logger) biz.WishlistRepo {
+	return &wishlistRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Add 收藏商品，新收藏的商品在同一个事务中写入收藏数加一的事件，返回是否新收藏
+func (r *wishlistRepo) Add(ctx context.Context, userId, goodsId int64) (bool, error) {
+	var added bool
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&Wishlist{UserId: userId, GoodsId: goodsId})
+		if res.Error != nil {
+			return res.Error
+		}
+		if added = res.RowsAffected > 0; !added {
+			return nil
+		}
+		return tx.Create(&FavEventOutbox{GoodsId: goodsId, Delta: 1}).Error
+	})
+	if err != nil {
+		return false, errors.InternalServer("WISHLIST_SAVE_ERROR", "收藏商品失败")
+	}
+	return added, nil
+}
+
+// Remove 取消收藏，每个取消的商品写入收藏数减一的事件，返回取消的数量
+func (r *wishlistRepo) Remove(ctx context.Context, userId int64, goodsIds ...int64) (int, error) {
+	var count int
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var list []*Wishlist
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND goods_id IN (?)", userId, goodsIds).Find(&list).Error; err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		var ids []int64
+		var events []*FavEventOutbox
+		for _, item := range list {
+			ids = append(ids, item.ID)
+			events = append(events, &FavEventOutbox{GoodsId: item.GoodsId, Delta: -1})
+		}
+		if err := tx.Delete(&Wishlist{}, ids).Error; err != nil {
+			return err
+		}
+		count = len(list)
+		return tx.Create(&events).Error
+	})
+	if err != nil {
+		return 0, errors.InternalServer("WISHLIST_DELETE_ERROR", "取消收藏失败")
+	}
+	return count, nil
+}
+
+func (r *wishlistRepo) List(ctx context.Context, userId int64) ([]*domain.Wishlist, error) {
+	var list []*Wishlist
+	if err := r.data.db.WithContext(ctx).Where("user_id = ?", userId).Order("id desc").Find(&list).Error; err != nil {
+		return nil, errors.InternalServer("WISHLIST_SELECT_ERROR", "收藏夹查询失败")
+	}
+	var res []*domain.Wishlist
+	for _, item := range list {
+		res = append(res, item.ToDomain())
+	}
+	return res, nil
+}
+
+// PendingFavEvents 按写入顺序查询还没有发送的收藏事件
+func (r *wishlistRepo) PendingFavEvents(ctx context.Context, limit int) ([]*domain.FavEvent, error) {
+	var list []*FavEventOutbox
+	if err := r.data.db.WithContext(ctx).Where("sent = ?", false).Order("id").Limit(limit).Find(&list).Error; err != nil {
+		return nil, errors.InternalServer("FAV_EVENT_SELECT_ERROR", err.Error())
+	}
+	var res []*domain.FavEvent
+	for _, item := range list {
+		res = append(res, &domain.FavEvent{ID: item.ID, GoodsId: item.GoodsId, Delta: item.Delta})
+	}
+	return res, nil
+}
+
+func (r *wishlistRepo) MarkFavEventsSent(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	err := r.data.db.WithContext(ctx).Model(&FavEventOutbox{}).Where("id IN (?)", ids).Update("sent", true).Error
+	if err != nil {
+		return errors.InternalServer("FAV_EVENT_UPDATE_ERROR", err.Error())
+	}
+	return nil
+}
@@ -10,6 +10,15 @@ type ShopCart struct {
 	GoodsSn    string
 	GoodsName  string
 	IsSelect   bool
+
+	// 以下字段从商品服务实时查询，不保存到数据库
+	SkuName      string
+	CurrentPrice int64 // 商品当前价格
+	OnSale       bool
+	Stock        int64
+	PriceChanged bool // 加入购物车后商品价格发生了变化
+	OutOfStock   bool // 商品已下架或库存不足
+	OverLimit    bool // 超过限购数量
 }
 
 type ShopCartList []*ShopCart
@@ -23,3 +32,110 @@ func (p ShopCartList) ListSelected() ShopCartList {
 	}
 	return list
 }
+
+func (p ShopCartList) SkuIds() []int64 {
+	ids := make([]int64, 0, len(p))
+	for _, cart := range p {
+		ids = append(ids, cart.SkuId)
+	}
+	return ids
+}
+
+func (p ShopCartList) FindBySkuId(skuId int64) *ShopCart {
+	for _, cart := range p {
+		if cart.SkuId == skuId {
+			return cart
+		}
+	}
+	return nil
+}
+
+// Sku 商品服务返回的 sku 信息
+type Sku struct {
+	ID             int64
+	GoodsId        int64
+	GoodsSn        string
+	GoodsName      string
+	SkuName        string
+	Price          int64
+	PromotionPrice int64
+	Points         int64
+	OnSale         bool
+	Inventory      int64
+	ShipFree       bool
+	ShipID         int32
+	PurchaseLimit  int32 // 每个用户限购数量，0 为不限购
+}
+
+type SkuList []*Sku
+
+func (p SkuList) FindById(id int64) *Sku {
+	for _, sku := range p {
+		if sku.ID == id {
+			return sku
+		}
+	}
+	return nil
+}
+
+// Check 根据商品服务的 sku 信息标记价格变化、缺货和超过限购，sku 不存在时视为缺货
+func (p *ShopCart) Check(sku *Sku) {
+	if sku == nil {
+		p.OutOfStock = true
+		return
+	}
+	p.SkuName = sku.SkuName
+	p.CurrentPrice = sku.Price
+	p.OnSale = sku.OnSale
+	p.Stock = sku.Inventory
+	p.PriceChanged = sku.Price != p.GoodsPrice
+	p.OutOfStock = !sku.OnSale || sku.Inventory < int64(p.GoodsNum)
+	p.OverLimit = sku.PurchaseLimitExceeded(p.GoodsNum)
+}
+
+// CartRule 购物车的限制，0 为不限制
+type CartRule struct {
+	MaxLines    int32 // 购物车最多的商品种类
+	MaxQuantity int32 // 单个商品最多的数量
+}
+
+// LinesExceeded 购物车中再加入一种商品是否超过商品种类上限
+func (r *CartRule) LinesExceeded(lines int) bool {
+	return r.MaxLines > 0 && int32(lines) >= r.MaxLines
+}
+
+// QuantityExceeded 单个商品数量是否超过上限
+func (r *CartRule) QuantityExceeded(num int32) bool {
+	return r.MaxQuantity > 0 && num > r.MaxQuantity
+}
+
+// PurchaseLimitExceeded 是否超过 sku 的限购数量
+func (p *Sku) PurchaseLimitExceeded(num int32) bool {
+	return p.PurchaseLimit > 0 && num > p.PurchaseLimit
+}
+
+// CartLimit 加入购物车时由存储在同一个原子操作中检查的限制
+type CartLimit struct {
+	MaxLines int32 // 购物车最多的商品种类，0 为不限制
+	MaxNum   int32 // 单个商品最多的数量，包含库存、数量上限和限购数量
+}
+
+// Limit 加入 sku 时的限制
+func (r *CartRule) Limit(sku *Sku) *CartLimit {
+	return &CartLimit{MaxLines: r.MaxLines, MaxNum: r.MaxNum(sku)}
+}
+
+// MaxNum 在库存、数量上限和限购数量内可以购买的最大数量
+func (r *CartRule) MaxNum(sku *Sku) int32 {
+	max := sku.Inventory
+	if r.MaxQuantity > 0 && int64(r.MaxQuantity) < max {
+		max = int64(r.MaxQuantity)
+	}
+	if sku.PurchaseLimit > 0 && int64(sku.PurchaseLimit) < max {
+		max = int64(sku.PurchaseLimit)
+	}
+	if max < 0 {
+		return 0
+	}
+	return int32(max)
+}
@@ -0,0 +1,25 @@
+package domain_test
+
+import (
+	"cart/internal/domain"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShopCart", func() {
+	table.DescribeTable("Check 缺货和限购",
+		func(sku *domain.Sku, num int32, outOfStock, overLimit bool) {
+			cart := &domain.ShopCart{SkuId: 1, GoodsNum: num}
+			cart.Check(sku)
+			Ω(cart.OutOfStock).Should(Equal(outOfStock))
+			Ω(cart.OverLimit).Should(Equal(overLimit))
+		},
+		table.Entry("正常", &domain.Sku{ID: 1, OnSale: true, Inventory: 10, PurchaseLimit: 5}, int32(5), false, false),
+		table.Entry("超过限购", &domain.Sku{ID: 1, OnSale: true, Inventory: 10, PurchaseLimit: 5}, int32(6), false, true),
+		table.Entry("不限购", &domain.Sku{ID: 1, OnSale: true, Inventory: 10}, int32(10), false, false),
+		table.Entry("库存不足", &domain.Sku{ID: 1, OnSale: true, Inventory: 1}, int32(2), true, false),
+		table.Entry("sku 不存在", nil, int32(1), true, false),
+	)
+})
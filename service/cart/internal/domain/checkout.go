@@ -0,0 +1,197 @@
+package domain
+
+// 结算预览，金额单位为分
+
+// Coupon 优惠券，满 Threshold 减 Discount
+type Coupon struct {
+	Code      string
+	Name      string
+	Threshold int64
+	Discount  int64
+	SkuIds    []int64 // 可以使用的 sku，为空时全部商品可用
+}
+
+func (p *Coupon) Applicable(skuId int64) bool {
+	if len(p.SkuIds) == 0 {
+		return true
+	}
+	for _, id := range p.SkuIds {
+		if id == skuId {
+			return true
+		}
+	}
+	return false
+}
+
+// ShipTemplate 运费模版，同一个模版的商品合并计算运费，满 FreeAmount 包邮
+type ShipTemplate struct {
+	ID         int32
+	Fee        int64
+	FreeAmount int64
+}
+
+// CheckoutLine 结算的商品行
+type CheckoutLine struct {
+	SkuId             int64
+	GoodsId           int64
+	GoodsName         string
+	SkuName           string
+	GoodsNum          int32
+	Price             int64 // 商品售价
+	PromotionPrice    int64 // 促销价，没有促销时等于售价
+	Subtotal          int64 // 售价 * 数量
+	PromotionDiscount int64 // 促销优惠
+	CouponDiscount    int64 // 分摊的优惠券优惠
+	Payable           int64 // 应付金额，不包含运费
+	Points            int64 // 赠送积分
+	ShipFree          bool
+	ShipID            int32
+}
+
+// CouponResult 优惠券的使用结果
+type CouponResult struct {
+	Code     string
+	Name     string
+	Discount int64
+	Applied  bool
+	Reason   string // 没有使用的原因
+}
+
+type Checkout struct {
+	Lines             []*CheckoutLine
+	Coupons           []*CouponResult
+	InvalidSkuIds     []int64 // 已下架或库存不足，不参与结算的商品
+	OverLimitSkuIds   []int64 // 超过限购数量，不参与结算的商品
+	Subtotal          int64
+	PromotionDiscount int64
+	CouponDiscount    int64
+	Shipping          int64
+	Points            int64
+	Payable           int64 // 应付总额，包含运费
+}
+
+// NewCheckoutLine 使用商品服务的当前价格生成结算行，促销价有效时按促销价计算
+func NewCheckoutLine(cart *ShopCart, sku *Sku) *CheckoutLine {
+	line := &CheckoutLine{
+		SkuId:          cart.SkuId,
+		GoodsId:        sku.GoodsId,
+		GoodsName:      sku.GoodsName,
+		SkuName:        sku.SkuName,
+		GoodsNum:       cart.GoodsNum,
+		Price:          sku.Price,
+		PromotionPrice: sku.Price,
+		ShipFree:       sku.ShipFree,
+		ShipID:         sku.ShipID,
+	}
+	if sku.PromotionPrice > 0 && sku.PromotionPrice < sku.Price {
+		line.PromotionPrice = sku.PromotionPrice
+	}
+	num := int64(cart.GoodsNum)
+	line.Subtotal = line.Price * num
+	line.PromotionDiscount = (line.Price - line.PromotionPrice) * num
+	line.Payable = line.Subtotal - line.PromotionDiscount
+	line.Points = sku.Points * num
+	return line
+}
+
+// ApplyCoupon 在促销价的基础上使用优惠券，优惠金额按应付金额分摊到可用的商品行
+func (p *Checkout) ApplyCoupon(coupon *Coupon) *CouponResult {
+	res := &CouponResult{Code: coupon.Code, Name: coupon.Name}
+	var lines []*CheckoutLine
+	var base int64
+	for _, line := range p.Lines {
+		if coupon.Applicable(line.SkuId) && line.Payable > 0 {
+			lines = append(lines, line)
+			base += line.Payable
+		}
+	}
+	if len(lines) == 0 {
+		res.Reason = "没有可以使用优惠券的商品"
+		return res
+	}
+	if base < coupon.Threshold {
+		res.Reason = "未满足优惠券使用门槛"
+		return res
+	}
+
+	discount := coupon.Discount
+	if discount > base {
+		discount = base
+	}
+	// 按比例分摊，每行最多分摊到应付金额，分不完的部分顺延给后面的商品行
+	remain := discount
+	for i, line := range lines {
+		share := discount * line.Payable / base
+		if i == len(lines)-1 {
+			share = remain
+		}
+		remain -= line.deductCoupon(share)
+	}
+	// 最后一行不够分摊时，剩余的优惠从前面还有应付金额的商品行扣除
+	for _, line := range lines {
+		if remain == 0 {
+			break
+		}
+		remain -= line.deductCoupon(remain)
+	}
+	res.Discount = discount
+	res.Applied = true
+	return res
+}
+
+// deductCoupon 从应付金额中扣除优惠券优惠，不超过应付金额，返回实际扣除的金额
+func (p *CheckoutLine) deductCoupon(amount int64) int64 {
+	if amount > p.Payable {
+		amount = p.Payable
+	}
+	p.CouponDiscount += amount
+	p.Payable -= amount
+	return amount
+}
+
+// ShipTemplateIds 需要计算运费的运费模版，免运费的商品不计算
+func (p *Checkout) ShipTemplateIds() []int32 {
+	var ids []int32
+	seen := make(map[int32]bool)
+	for _, line := range p.Lines {
+		if !line.ShipFree && !seen[line.ShipID] {
+			seen[line.ShipID] = true
+			ids = append(ids, line.ShipID)
+		}
+	}
+	return ids
+}
+
+// ApplyShipping 同一个运费模版的商品应付金额满包邮金额时免运费
+func (p *Checkout) ApplyShipping(templates map[int32]*ShipTemplate) {
+	amount := make(map[int32]int64)
+	for _, line := range p.Lines {
+		if !line.ShipFree {
+			amount[line.ShipID] += line.Payable
+		}
+	}
+	p.Shipping = 0
+	for id, total := range amount {
+		tpl, ok := templates[id]
+		if !ok {
+			continue
+		}
+		if tpl.FreeAmount > 0 && total >= tpl.FreeAmount {
+			continue
+		}
+		p.Shipping += tpl.Fee
+	}
+}
+
+// Sum 汇总商品行的金额
+func (p *Checkout) Sum() {
+	p.Subtotal, p.PromotionDiscount, p.CouponDiscount, p.Points, p.Payable = 0, 0, 0, 0, 0
+	for _, line := range p.Lines {
+		p.Subtotal += line.Subtotal
+		p.PromotionDiscount += line.PromotionDiscount
+		p.CouponDiscount += line.CouponDiscount
+		p.Points += line.Points
+		p.Payable += line.Payable
+	}
+	p.Payable += p.Shipping
+}
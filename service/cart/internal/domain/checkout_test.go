@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"cart/internal/domain"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// newLines 按应付金额生成结算行，sku id 从 1 开始
+func newLines(payables ...int64) []*domain.CheckoutLine {
+	var lines []*domain.CheckoutLine
+	for i, payable := range payables {
+		lines = append(lines, &domain.CheckoutLine{SkuId: int64(i + 1), Subtotal: payable, Payable: payable})
+	}
+	return lines
+}
+
+var _ = Describe("Checkout", func() {
+	table.DescribeTable("NewCheckoutLine 促销价",
+		func(price, promotionPrice int64, num int32, subtotal, promotion, payable int64) {
+			line := domain.NewCheckoutLine(
+				&domain.ShopCart{SkuId: 1, GoodsNum: num},
+				&domain.Sku{ID: 1, Price: price, PromotionPrice: promotionPrice, Points: 10},
+			)
+			Ω(line.Subtotal).Should(Equal(subtotal))
+			Ω(line.PromotionDiscount).Should(Equal(promotion))
+			Ω(line.Payable).Should(Equal(payable))
+			Ω(line.Points).Should(Equal(10 * int64(num)))
+		},
+		table.Entry("没有促销价", int64(1000), int64(0), int32(2), int64(2000), int64(0), int64(2000)),
+		table.Entry("促销价低于售价", int64(1000), int64(800), int32(3), int64(3000), int64(600), int64(2400)),
+		table.Entry("促销价不低于售价时不生效", int64(1000), int64(1200), int32(1), int64(1000), int64(0), int64(1000)),
+	)
+
+	table.DescribeTable("ApplyCoupon 优惠分摊",
+		func(payables []int64, coupon *domain.Coupon, applied bool, discount int64, shares []int64) {
+			checkout := &domain.Checkout{Lines: newLines(payables...)}
+			res := checkout.ApplyCoupon(coupon)
+			Ω(res.Applied).Should(Equal(applied))
+			Ω(res.Discount).Should(Equal(discount))
+			var total int64
+			for i, line := range checkout.Lines {
+				Ω(line.CouponDiscount).Should(Equal(shares[i]))
+				Ω(line.Payable).Should(Equal(payables[i] - shares[i]))
+				Ω(line.Payable).Should(BeNumerically(">=", 0))
+				total += line.CouponDiscount
+			}
+			Ω(total).Should(Equal(discount))
+		},
+		table.Entry("按应付金额比例分摊", []int64{3000, 1000},
+			&domain.Coupon{Code: "A", Discount: 400}, true, int64(400), []int64{300, 100}),
+		table.Entry("除不尽的部分分摊给最后一行", []int64{1000, 1000, 1000},
+			&domain.Coupon{Code: "A", Discount: 100}, true, int64(100), []int64{33, 33, 34}),
+		table.Entry("最后一行不够分摊时顺延到前面的商品行", []int64{5, 5, 1},
+			&domain.Coupon{Code: "A", Discount: 10}, true, int64(10), []int64{5, 4, 1}),
+		table.Entry("优惠金额不超过应付金额", []int64{500},
+			&domain.Coupon{Code: "A", Discount: 800}, true, int64(500), []int64{500}),
+		table.Entry("只分摊到可以使用的商品", []int64{1000, 1000},
+			&domain.Coupon{Code: "A", Discount: 100, SkuIds: []int64{2}}, true, int64(100), []int64{0, 100}),
+		table.Entry("未满足使用门槛", []int64{1000, 1000},
+			&domain.Coupon{Code: "A", Threshold: 3000, Discount: 100}, false, int64(0), []int64{0, 0}),
+		table.Entry("没有可以使用的商品", []int64{1000},
+			&domain.Coupon{Code: "A", Discount: 100, SkuIds: []int64{9}}, false, int64(0), []int64{0}),
+	)
+
+	templates := map[int32]*domain.ShipTemplate{
+		1: {ID: 1, Fee: 800, FreeAmount: 9900},
+		2: {ID: 2, Fee: 500},
+	}
+	table.DescribeTable("ApplyShipping 运费",
+		func(lines []*domain.CheckoutLine, shipping int64) {
+			checkout := &domain.Checkout{Lines: lines}
+			checkout.ApplyShipping(templates)
+			Ω(checkout.Shipping).Should(Equal(shipping))
+		},
+		table.Entry("未满包邮金额", []*domain.CheckoutLine{
+			{SkuId: 1, Payable: 5000, ShipID: 1},
+		}, int64(800)),
+		table.Entry("同一个模版合并计算满包邮金额", []*domain.CheckoutLine{
+			{SkuId: 1, Payable: 5000, ShipID: 1},
+			{SkuId: 2, Payable: 4900, ShipID: 1},
+		}, int64(0)),
+		table.Entry("包邮商品不计算运费", []*domain.CheckoutLine{
+			{SkuId: 1, Payable: 5000, ShipID: 1, ShipFree: true},
+		}, int64(0)),
+		table.Entry("不同模版分别计算", []*domain.CheckoutLine{
+			{SkuId: 1, Payable: 5000, ShipID: 1},
+			{SkuId: 2, Payable: 99999, ShipID: 2},
+		}, int64(1300)),
+		table.Entry("模版不存在时不收运费", []*domain.CheckoutLine{
+			{SkuId: 1, Payable: 5000, ShipID: 3},
+		}, int64(0)),
+	)
+
+	It("Sum", func() {
+		checkout := &domain.Checkout{
+			Lines: []*domain.CheckoutLine{
+				{Subtotal: 3000, PromotionDiscount: 600, CouponDiscount: 100, Payable: 2300, Points: 30},
+				{Subtotal: 1000, CouponDiscount: 50, Payable: 950, Points: 10},
+			},
+			Shipping: 800,
+			Payable:  1, // 重复汇总时先清零
+		}
+		checkout.Sum()
+		Ω(checkout.Subtotal).Should(Equal(int64(4000)))
+		Ω(checkout.PromotionDiscount).Should(Equal(int64(600)))
+		Ω(checkout.CouponDiscount).Should(Equal(int64(150)))
+		Ω(checkout.Points).Should(Equal(int64(40)))
+		Ω(checkout.Payable).Should(Equal(int64(4050)))
+	})
+})
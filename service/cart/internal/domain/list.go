@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Goods 商品服务返回的商品信息
+type Goods struct {
+	ID          int64
+	Name        string
+	GoodsSn     string
+	Image       string
+	MarketPrice int64
+	OnSale      bool
+	FavNum      int64
+}
+
+type GoodsList []*Goods
+
+func (p GoodsList) FindById(id int64) *Goods {
+	for _, goods := range p {
+		if goods.ID == id {
+			return goods
+		}
+	}
+	return nil
+}
+
+// Wishlist 收藏夹中的商品
+type Wishlist struct {
+	ID        int64
+	UserId    int64
+	GoodsId   int64
+	CreatedAt time.Time
+
+	// 以下字段从商品服务实时查询
+	Goods *Goods
+}
+
+// FavEvent 收藏数变化的事件，收藏夹变化时与收藏记录在同一个事务中写入，再异步发送到商品服务
+type FavEvent struct {
+	ID      int64
+	GoodsId int64
+	Delta   int32
+}
+
+// EventID 事件的唯一标识，商品服务使用它去重
+func (p *FavEvent) EventID() string {
+	return fmt.Sprintf("cart-fav-%d", p.ID)
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"cart/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// dispatchInterval 发送收藏事件的时间间隔
+const dispatchInterval = 5 * time.Second
+
+// FavEventDispatcher 定时将收藏数变化的事件发送到商品服务，作为 kratos 的 server 随服务启动和停止
+type FavEventDispatcher struct {
+	uc   *biz.ListUsecase
+	stop chan struct{}
+	log  *log.Helper
+}
+
+// NewFavEventDispatcher .
+func NewFavEventDispatcher(uc *biz.ListUsecase, logger log.Logger) *FavEventDispatcher {
+	return &FavEventDispatcher{
+		uc:   uc,
+		stop: make(chan struct{}),
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (s *FavEventDispatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+func (s *FavEventDispatcher) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
+
+func (s *FavEventDispatcher) run(ctx context.Context) {
+	for {
+		count, err := s.uc.DispatchFavEvents(ctx)
+		if err != nil {
+			s.log.Errorf("dispatch fav events error: %v", err)
+			return
+		}
+		if count == 0 {
+			return
+		}
+		s.log.Debugf("dispatched %d fav events", count)
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"cart/internal/biz"
+	"cart/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CartFlusher 定时将 redis 中修改过的购物车写回 mysql，作为 kratos 的 server 随服务启动和停止
+type CartFlusher struct {
+	uc       *biz.CartUsecase
+	interval time.Duration
+	stop     chan struct{}
+	log      *log.Helper
+}
+
+// NewCartFlusher .
+func NewCartFlusher(c *conf.Data, uc *biz.CartUsecase, logger log.Logger) *CartFlusher {
+	interval := 5 * time.Second
+	if c.Cart != nil && c.Cart.FlushInterval != nil && c.Cart.FlushInterval.AsDuration() > 0 {
+		interval = c.Cart.FlushInterval.AsDuration()
+	}
+	return &CartFlusher{
+		uc:       uc,
+		interval: interval,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+}
+
+func (s *CartFlusher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+// Stop 停止前再写回一次，减少重启时丢失的修改
+func (s *CartFlusher) Stop(ctx context.Context) error {
+	close(s.stop)
+	s.run(ctx)
+	return nil
+}
+
+func (s *CartFlusher) run(ctx context.Context) {
+	for {
+		count, err := s.uc.Flush(ctx)
+		if err != nil {
+			s.log.Errorf("flush cart error: %v", err)
+			return
+		}
+		if count == 0 {
+			return
+		}
+		s.log.Debugf("flushed %d carts", count)
+	}
+}
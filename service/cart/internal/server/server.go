@@ -13,7 +13,7 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewRegistrar)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewRegistrar, NewCartFlusher, NewFavEventDispatcher)
 
 // NewRegistrar 引入 consul
 func NewRegistrar(conf *conf.Registry) registry.Registrar {
@@ -10,42 +10,32 @@ import (
 // NewCartService is a cart service.
 type CartService struct {
 	v1.UnimplementedCartServer
-	cart *biz.CartUsecase
+	cart     *biz.CartUsecase
+	guest    *biz.GuestCartUsecase
+	checkout *biz.CheckoutUsecase
+	list     *biz.ListUsecase
 }
 
 // NewCartService new a cart service.
-func NewCartService(cart *biz.CartUsecase) *CartService {
-	return &CartService{cart: cart}
+func NewCartService(cart *biz.CartUsecase, guest *biz.GuestCartUsecase, checkout *biz.CheckoutUsecase,
+	list *biz.ListUsecase) *CartService {
+	return &CartService{cart: cart, guest: guest, checkout: checkout, list: list}
 }
 
 func (s *CartService) CreateCart(ctx context.Context, req *v1.CreateCartRequest) (*v1.CartInfoReply, error) {
 	// 调用
 	rv, err := s.cart.CreateCart(ctx, &domain.ShopCart{
-		UserId:     req.UserId,
-		GoodsId:    req.GoodsId,
-		SkuId:      req.SkuId,
-		GoodsPrice: req.GoodsPrice,
-		GoodsNum:   req.GoodsNum,
-		GoodsSn:    req.GoodsSn,
-		GoodsName:  req.GoodsName,
-		IsSelect:   req.IsSelect,
+		UserId:   req.UserId,
+		SkuId:    req.SkuId,
+		GoodsNum: req.GoodsNum,
+		IsSelect: req.IsSelect,
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return &v1.CartInfoReply{
-		Id:         rv.ID,
-		UserId:     rv.UserId,
-		GoodsId:    rv.GoodsId,
-		GoodsSn:    rv.GoodsSn,
-		GoodsName:  rv.GoodsName,
-		SkuId:      rv.SkuId,
-		GoodsPrice: rv.GoodsPrice,
-		GoodsNum:   rv.GoodsNum,
-		IsSelect:   rv.IsSelect,
-	}, nil
+	return cartInfoReply(rv), nil
 }
 
 func (s *CartService) ListCart(ctx context.Context, req *v1.ListCartRequest) (*v1.CartListReply, error) {
@@ -53,31 +43,66 @@ func (s *CartService) ListCart(ctx context.Context, req *v1.ListCartRequest) (*v
 	if err != nil {
 		return nil, err
 	}
-	var rsp v1.CartListReply
+	return cartListReply(res), nil
+}
 
-	for _, cart := range res {
-		rsp.Results = append(rsp.Results, &v1.CartInfoReply{
-			Id:         cart.ID,
-			UserId:     cart.UserId,
-			GoodsId:    cart.GoodsId,
-			GoodsSn:    cart.GoodsSn,
-			GoodsName:  cart.GoodsName,
-			SkuId:      cart.SkuId,
-			GoodsPrice: cart.GoodsPrice,
-			GoodsNum:   cart.GoodsNum,
-			IsSelect:   cart.IsSelect,
-		})
+func (s *CartService) UpdateCart(ctx context.Context, req *v1.UpdateCartRequest) (*v1.CheckResponse, error) {
+	if err := s.cart.UpdateNum(ctx, req.UserId, req.SkuId, req.GoodsNum); err != nil {
+		return nil, err
 	}
+	return &v1.CheckResponse{Success: true}, nil
+}
 
-	return &rsp, nil
+func (s *CartService) DeleteCart(ctx context.Context, req *v1.DeleteCartRequest) (*v1.CheckResponse, error) {
+	if err := s.cart.Delete(ctx, req.UserId, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) ClearCart(ctx context.Context, req *v1.ClearCartRequest) (*v1.CheckResponse, error) {
+	if err := s.cart.Clear(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) SelectCart(ctx context.Context, req *v1.SelectCartRequest) (*v1.CheckResponse, error) {
+	if err := s.cart.Select(ctx, req.UserId, req.IsSelect, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
 }
 
-//func (s *CartService) UpdateCart(ctx context.Context, req *pb.UpdateCartRequest) (*pb.UpdateCartReply, error) {
-//	return &pb.UpdateCartReply{}, nil
-//}
-//func (s *CartService) DeleteCart(ctx context.Context, req *pb.DeleteCartRequest) (*pb.DeleteCartReply, error) {
-//	return &pb.DeleteCartReply{}, nil
-//}
 //func (s *CartService) GetCart(ctx context.Context, req *pb.GetCartRequest) (*pb.GetCartReply, error) {
 //	return &pb.GetCartReply{}, nil
 //}
+
+func cartInfoReply(cart *domain.ShopCart) *v1.CartInfoReply {
+	return &v1.CartInfoReply{
+		Id:           cart.ID,
+		UserId:       cart.UserId,
+		GoodsId:      cart.GoodsId,
+		GoodsSn:      cart.GoodsSn,
+		GoodsName:    cart.GoodsName,
+		SkuId:        cart.SkuId,
+		GoodsPrice:   cart.GoodsPrice,
+		GoodsNum:     cart.GoodsNum,
+		IsSelect:     cart.IsSelect,
+		SkuName:      cart.SkuName,
+		CurrentPrice: cart.CurrentPrice,
+		OnSale:       cart.OnSale,
+		Stock:        cart.Stock,
+		PriceChanged: cart.PriceChanged,
+		OutOfStock:   cart.OutOfStock,
+		OverLimit:    cart.OverLimit,
+	}
+}
+
+func cartListReply(list domain.ShopCartList) *v1.CartListReply {
+	var rsp v1.CartListReply
+	for _, cart := range list {
+		rsp.Results = append(rsp.Results, cartInfoReply(cart))
+	}
+	return &rsp
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	v1 "cart/api/cart/v1"
+	"context"
+)
+
+func (s *CartService) CheckoutPreview(ctx context.Context, req *v1.CheckoutPreviewRequest) (*v1.CheckoutPreviewReply, error) {
+	res, err := s.checkout.Preview(ctx, req.UserId, req.CouponCodes...)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &v1.CheckoutPreviewReply{
+		InvalidSkuIds:     res.InvalidSkuIds,
+		OverLimitSkuIds:   res.OverLimitSkuIds,
+		Subtotal:          res.Subtotal,
+		PromotionDiscount: res.PromotionDiscount,
+		CouponDiscount:    res.CouponDiscount,
+		Shipping:          res.Shipping,
+		Points:            res.Points,
+		Payable:           res.Payable,
+	}
+	for _, line := range res.Lines {
+		rsp.Lines = append(rsp.Lines, &v1.CheckoutLine{
+			SkuId:             line.SkuId,
+			GoodsId:           line.GoodsId,
+			GoodsName:         line.GoodsName,
+			SkuName:           line.SkuName,
+			GoodsNum:          line.GoodsNum,
+			Price:             line.Price,
+			PromotionPrice:    line.PromotionPrice,
+			Subtotal:          line.Subtotal,
+			PromotionDiscount: line.PromotionDiscount,
+			CouponDiscount:    line.CouponDiscount,
+			Payable:           line.Payable,
+			Points:            line.Points,
+			ShipFree:          line.ShipFree,
+			ShipId:            line.ShipID,
+		})
+	}
+	for _, coupon := range res.Coupons {
+		rsp.Coupons = append(rsp.Coupons, &v1.CouponResult{
+			Code:     coupon.Code,
+			Name:     coupon.Name,
+			Discount: coupon.Discount,
+			Applied:  coupon.Applied,
+			Reason:   coupon.Reason,
+		})
+	}
+	return rsp, nil
+}
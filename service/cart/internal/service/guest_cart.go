@@ -0,0 +1,48 @@
+package service
+
+import (
+	v1 "cart/api/cart/v1"
+	"cart/internal/domain"
+	"context"
+)
+
+func (s *CartService) CreateGuestCart(ctx context.Context, req *v1.GuestCartRequest) (*v1.CartInfoReply, error) {
+	rv, err := s.guest.CreateCart(ctx, req.Token, &domain.ShopCart{
+		SkuId:    req.SkuId,
+		GoodsNum: req.GoodsNum,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cartInfoReply(rv), nil
+}
+
+func (s *CartService) UpdateGuestCart(ctx context.Context, req *v1.GuestCartRequest) (*v1.CheckResponse, error) {
+	if err := s.guest.UpdateNum(ctx, req.Token, req.SkuId, req.GoodsNum); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) DeleteGuestCart(ctx context.Context, req *v1.DeleteGuestCartRequest) (*v1.CheckResponse, error) {
+	if err := s.guest.Delete(ctx, req.Token, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) ListGuestCart(ctx context.Context, req *v1.GuestTokenRequest) (*v1.CartListReply, error) {
+	res, err := s.guest.List(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return cartListReply(res), nil
+}
+
+func (s *CartService) MergeGuestCart(ctx context.Context, req *v1.MergeGuestCartRequest) (*v1.CartListReply, error) {
+	res, err := s.guest.Merge(ctx, req.Token, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return cartListReply(res), nil
+}
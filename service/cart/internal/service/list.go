@@ -0,0 +1,81 @@
+package service
+
+import (
+	v1 "cart/api/cart/v1"
+	"context"
+)
+
+func (s *CartService) SaveForLater(ctx context.Context, req *v1.CartSkusRequest) (*v1.CheckResponse, error) {
+	if err := s.list.SaveForLater(ctx, req.UserId, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) ListSaved(ctx context.Context, req *v1.ListCartRequest) (*v1.CartListReply, error) {
+	res, err := s.list.ListSaved(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return cartListReply(res), nil
+}
+
+func (s *CartService) MoveSavedToCart(ctx context.Context, req *v1.CartSkusRequest) (*v1.CheckResponse, error) {
+	if err := s.list.MoveToCart(ctx, req.UserId, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) DeleteSaved(ctx context.Context, req *v1.CartSkusRequest) (*v1.CheckResponse, error) {
+	if err := s.list.DeleteSaved(ctx, req.UserId, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) MoveToWishlist(ctx context.Context, req *v1.CartSkusRequest) (*v1.CheckResponse, error) {
+	if err := s.list.MoveToWishlist(ctx, req.UserId, req.SkuIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) AddWishlist(ctx context.Context, req *v1.WishlistRequest) (*v1.CheckResponse, error) {
+	if err := s.list.AddWishlist(ctx, req.UserId, req.GoodsId); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) RemoveWishlist(ctx context.Context, req *v1.RemoveWishlistRequest) (*v1.CheckResponse, error) {
+	if err := s.list.RemoveWishlist(ctx, req.UserId, req.GoodsIds...); err != nil {
+		return nil, err
+	}
+	return &v1.CheckResponse{Success: true}, nil
+}
+
+func (s *CartService) ListWishlist(ctx context.Context, req *v1.ListCartRequest) (*v1.WishlistReply, error) {
+	res, err := s.list.ListWishlist(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.WishlistReply{}
+	for _, item := range res {
+		reply := &v1.WishlistItem{
+			Id:        item.ID,
+			GoodsId:   item.GoodsId,
+			CreatedAt: item.CreatedAt.Unix(),
+		}
+		if goods := item.Goods; goods != nil {
+			reply.Name = goods.Name
+			reply.GoodsSn = goods.GoodsSn
+			reply.Image = goods.Image
+			reply.MarketPrice = goods.MarketPrice
+			reply.OnSale = goods.OnSale
+			reply.FavNum = goods.FavNum
+		}
+		rsp.Results = append(rsp.Results, reply)
+	}
+	return rsp, nil
+}
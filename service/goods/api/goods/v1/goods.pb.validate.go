@@ -2950,6 +2950,260 @@ var _ interface {
 	ErrorName() string
 } = SkuListRequestValidationError{}
 
+// Validate checks the field values on SkuInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkuInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuInfoResponseMultiError, or nil if none found.
+func (m *SkuInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GoodsId
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for GoodsName
+
+	// no validation rules for SkuName
+
+	// no validation rules for SkuCode
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for Points
+
+	// no validation rules for Pic
+
+	// no validation rules for Inventory
+
+	// no validation rules for OnSale
+
+	// no validation rules for ShipFree
+
+	// no validation rules for ShipId
+
+	// no validation rules for PurchaseLimit
+
+	if len(errors) > 0 {
+		return SkuInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by SkuInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SkuInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuInfoResponseMultiError) AllErrors() []error { return m }
+
+// SkuInfoResponseValidationError is the validation error returned by
+// SkuInfoResponse.Validate if the designated constraints aren't met.
+type SkuInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuInfoResponseValidationError) ErrorName() string { return "SkuInfoResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuInfoResponseValidationError{}
+
+// Validate checks the field values on SkuPurchaseLimitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SkuPurchaseLimitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuPurchaseLimitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPurchaseLimitRequestMultiError, or nil if none found.
+func (m *SkuPurchaseLimitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuPurchaseLimitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() <= 0 {
+		err := SkuPurchaseLimitRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPurchaseLimit() < 0 {
+		err := SkuPurchaseLimitRequestValidationError{
+			field:  "PurchaseLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SkuPurchaseLimitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuPurchaseLimitRequestMultiError is an error wrapping multiple validation
+// errors returned by SkuPurchaseLimitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SkuPurchaseLimitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuPurchaseLimitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuPurchaseLimitRequestMultiError) AllErrors() []error { return m }
+
+// SkuPurchaseLimitRequestValidationError is the validation error returned by
+// SkuPurchaseLimitRequest.Validate if the designated constraints aren't met.
+type SkuPurchaseLimitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuPurchaseLimitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuPurchaseLimitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuPurchaseLimitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuPurchaseLimitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuPurchaseLimitRequestValidationError) ErrorName() string {
+	return "SkuPurchaseLimitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SkuPurchaseLimitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuPurchaseLimitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuPurchaseLimitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuPurchaseLimitRequestValidationError{}
+
 // Validate checks the field values on SkuListResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2972,6 +3226,40 @@ func (m *SkuListResponse) validate(all bool) error {
 
 	var errors []error
 
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SkuListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SkuListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SkuListResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SkuListResponseMultiError(errors)
 	}
@@ -3297,70 +3585,2987 @@ var _ interface {
 	ErrorName() string
 } = GoodsTypeResponseValidationError{}
 
-// Validate checks the field values on GoodsFilterRequest with the rules
+// Validate checks the field values on UpdateGoodsTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GoodsFilterRequest) Validate() error {
+func (m *UpdateGoodsTypeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GoodsFilterRequest with the rules
+// ValidateAll checks the field values on UpdateGoodsTypeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GoodsFilterRequestMultiError, or nil if none found.
-func (m *GoodsFilterRequest) ValidateAll() error {
+// UpdateGoodsTypeRequestMultiError, or nil if none found.
+func (m *UpdateGoodsTypeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GoodsFilterRequest) validate(all bool) error {
+func (m *UpdateGoodsTypeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Keywords
+	if m.GetId() <= 0 {
+		err := UpdateGoodsTypeRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for CategoryId
+	if m.Name != nil {
 
-	// no validation rules for BrandId
+		if utf8.RuneCountInString(m.GetName()) < 3 {
+			err := UpdateGoodsTypeRequestValidationError{
+				field:  "Name",
+				reason: "value length must be at least 3 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for MinPrice
+	}
 
-	// no validation rules for MaxPrice
+	if m.TypeCode != nil {
 
-	// no validation rules for IsHot
+		if utf8.RuneCountInString(m.GetTypeCode()) < 3 {
+			err := UpdateGoodsTypeRequestValidationError{
+				field:  "TypeCode",
+				reason: "value length must be at least 3 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for IsNew
+	}
 
-	// no validation rules for IsTab
+	if m.NameAlias != nil {
+		// no validation rules for NameAlias
+	}
 
-	// no validation rules for ClickNum
+	if m.IsVirtual != nil {
+		// no validation rules for IsVirtual
+	}
+
+	if m.Desc != nil {
+		// no validation rules for Desc
+	}
+
+	if m.Sort != nil {
+		// no validation rules for Sort
+	}
+
+	if m.BrandIds != nil {
+
+		if utf8.RuneCountInString(m.GetBrandIds()) < 1 {
+			err := UpdateGoodsTypeRequestValidationError{
+				field:  "BrandIds",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateGoodsTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateGoodsTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateGoodsTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateGoodsTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateGoodsTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateGoodsTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpdateGoodsTypeRequestValidationError is the validation error returned by
+// UpdateGoodsTypeRequest.Validate if the designated constraints aren't met.
+type UpdateGoodsTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateGoodsTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateGoodsTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateGoodsTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateGoodsTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateGoodsTypeRequestValidationError) ErrorName() string {
+	return "UpdateGoodsTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateGoodsTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateGoodsTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateGoodsTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateGoodsTypeRequestValidationError{}
+
+// Validate checks the field values on GoodsTypeInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeInfoResponseMultiError, or nil if none found.
+func (m *GoodsTypeInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for TypeCode
+
+	// no validation rules for NameAlias
+
+	// no validation rules for IsVirtual
+
+	// no validation rules for Desc
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return GoodsTypeInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeInfoResponseMultiError) AllErrors() []error { return m }
+
+// GoodsTypeInfoResponseValidationError is the validation error returned by
+// GoodsTypeInfoResponse.Validate if the designated constraints aren't met.
+type GoodsTypeInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeInfoResponseValidationError) ErrorName() string {
+	return "GoodsTypeInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeInfoResponseValidationError{}
+
+// Validate checks the field values on GoodsTypeListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeListRequestMultiError, or nil if none found.
+func (m *GoodsTypeListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Pages
+
+	// no validation rules for PagePerNums
+
+	if len(errors) > 0 {
+		return GoodsTypeListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeListRequestMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeListRequestMultiError) AllErrors() []error { return m }
+
+// GoodsTypeListRequestValidationError is the validation error returned by
+// GoodsTypeListRequest.Validate if the designated constraints aren't met.
+type GoodsTypeListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeListRequestValidationError) ErrorName() string {
+	return "GoodsTypeListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeListRequestValidationError{}
+
+// Validate checks the field values on GoodsTypeListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeListResponseMultiError, or nil if none found.
+func (m *GoodsTypeListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeListResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsTypeListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeListResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeListResponseMultiError) AllErrors() []error { return m }
+
+// GoodsTypeListResponseValidationError is the validation error returned by
+// GoodsTypeListResponse.Validate if the designated constraints aren't met.
+type GoodsTypeListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeListResponseValidationError) ErrorName() string {
+	return "GoodsTypeListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeListResponseValidationError{}
+
+// Validate checks the field values on DeleteGoodsTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteGoodsTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteGoodsTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteGoodsTypeRequestMultiError, or nil if none found.
+func (m *DeleteGoodsTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteGoodsTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := DeleteGoodsTypeRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteGoodsTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteGoodsTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteGoodsTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteGoodsTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteGoodsTypeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteGoodsTypeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteGoodsTypeRequestValidationError is the validation error returned by
+// DeleteGoodsTypeRequest.Validate if the designated constraints aren't met.
+type DeleteGoodsTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGoodsTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGoodsTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGoodsTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGoodsTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGoodsTypeRequestValidationError) ErrorName() string {
+	return "DeleteGoodsTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGoodsTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGoodsTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGoodsTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGoodsTypeRequestValidationError{}
+
+// Validate checks the field values on GoodsTypeTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeTemplateRequestMultiError, or nil if none found.
+func (m *GoodsTypeTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := GoodsTypeTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsTypeTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GoodsTypeTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeTemplateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GoodsTypeTemplateRequestValidationError is the validation error returned by
+// GoodsTypeTemplateRequest.Validate if the designated constraints aren't met.
+type GoodsTypeTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeTemplateRequestValidationError) ErrorName() string {
+	return "GoodsTypeTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeTemplateRequestValidationError{}
+
+// Validate checks the field values on SpecificationInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpecificationInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpecificationInfoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpecificationInfoResponseMultiError, or nil if none found.
+func (m *SpecificationInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpecificationInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TypeId
+
+	// no validation rules for Name
+
+	// no validation rules for Sort
+
+	// no validation rules for Status
+
+	// no validation rules for IsSku
+
+	// no validation rules for IsSelect
+
+	for idx, item := range m.GetSpecificationValue() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SpecificationInfoResponseValidationError{
+						field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SpecificationInfoResponseValidationError{
+						field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SpecificationInfoResponseValidationError{
+					field:  fmt.Sprintf("SpecificationValue[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SpecificationInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpecificationInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by SpecificationInfoResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SpecificationInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpecificationInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpecificationInfoResponseMultiError) AllErrors() []error { return m }
+
+// SpecificationInfoResponseValidationError is the validation error returned by
+// SpecificationInfoResponse.Validate if the designated constraints aren't met.
+type SpecificationInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpecificationInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpecificationInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpecificationInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpecificationInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpecificationInfoResponseValidationError) ErrorName() string {
+	return "SpecificationInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SpecificationInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpecificationInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpecificationInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpecificationInfoResponseValidationError{}
+
+// Validate checks the field values on AttrGroupInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttrGroupInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttrGroupInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttrGroupInfoResponseMultiError, or nil if none found.
+func (m *AttrGroupInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttrGroupInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TypeId
+
+	// no validation rules for Title
+
+	// no validation rules for Desc
+
+	// no validation rules for Status
+
+	// no validation rules for Sort
+
+	for idx, item := range m.GetAttr() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttrGroupInfoResponseValidationError{
+						field:  fmt.Sprintf("Attr[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttrGroupInfoResponseValidationError{
+						field:  fmt.Sprintf("Attr[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttrGroupInfoResponseValidationError{
+					field:  fmt.Sprintf("Attr[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttrGroupInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttrGroupInfoResponseMultiError is an error wrapping multiple validation
+// errors returned by AttrGroupInfoResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttrGroupInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttrGroupInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttrGroupInfoResponseMultiError) AllErrors() []error { return m }
+
+// AttrGroupInfoResponseValidationError is the validation error returned by
+// AttrGroupInfoResponse.Validate if the designated constraints aren't met.
+type AttrGroupInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttrGroupInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttrGroupInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttrGroupInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttrGroupInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttrGroupInfoResponseValidationError) ErrorName() string {
+	return "AttrGroupInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttrGroupInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttrGroupInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttrGroupInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttrGroupInfoResponseValidationError{}
+
+// Validate checks the field values on GoodsTypeTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsTypeTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsTypeTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsTypeTemplateResponseMultiError, or nil if none found.
+func (m *GoodsTypeTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsTypeTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GoodsTypeTemplateResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GoodsTypeTemplateResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GoodsTypeTemplateResponseValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBrands() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Brands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Brands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeTemplateResponseValidationError{
+					field:  fmt.Sprintf("Brands[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSpecifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Specifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("Specifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeTemplateResponseValidationError{
+					field:  fmt.Sprintf("Specifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAttrGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("AttrGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsTypeTemplateResponseValidationError{
+						field:  fmt.Sprintf("AttrGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsTypeTemplateResponseValidationError{
+					field:  fmt.Sprintf("AttrGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsTypeTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsTypeTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by GoodsTypeTemplateResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GoodsTypeTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsTypeTemplateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsTypeTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GoodsTypeTemplateResponseValidationError is the validation error returned by
+// GoodsTypeTemplateResponse.Validate if the designated constraints aren't met.
+type GoodsTypeTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsTypeTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsTypeTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsTypeTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsTypeTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsTypeTemplateResponseValidationError) ErrorName() string {
+	return "GoodsTypeTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsTypeTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsTypeTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsTypeTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsTypeTemplateResponseValidationError{}
+
+// Validate checks the field values on GoodsFilterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsFilterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsFilterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsFilterRequestMultiError, or nil if none found.
+func (m *GoodsFilterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsFilterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Keywords
+
+	// no validation rules for CategoryId
+
+	// no validation rules for BrandId
+
+	// no validation rules for MinPrice
+
+	// no validation rules for MaxPrice
+
+	// no validation rules for IsHot
+
+	// no validation rules for IsNew
+
+	// no validation rules for IsTab
+
+	// no validation rules for ClickNum
+
+	// no validation rules for SoldNum
+
+	// no validation rules for FavNum
+
+	// no validation rules for Pages
+
+	// no validation rules for PagePerNums
+
+	// no validation rules for Id
+
+	for idx, item := range m.GetAttrs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsFilterRequestValidationError{
+						field:  fmt.Sprintf("Attrs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsFilterRequestValidationError{
+						field:  fmt.Sprintf("Attrs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsFilterRequestValidationError{
+					field:  fmt.Sprintf("Attrs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsFilterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsFilterRequestMultiError is an error wrapping multiple validation errors
+// returned by GoodsFilterRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsFilterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsFilterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsFilterRequestMultiError) AllErrors() []error { return m }
+
+// GoodsFilterRequestValidationError is the validation error returned by
+// GoodsFilterRequest.Validate if the designated constraints aren't met.
+type GoodsFilterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsFilterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsFilterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsFilterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsFilterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsFilterRequestValidationError) ErrorName() string {
+	return "GoodsFilterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsFilterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsFilterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsFilterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsFilterRequestValidationError{}
+
+// Validate checks the field values on GoodsInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodsInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsInfoResponseMultiError, or nil if none found.
+func (m *GoodsInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CategoryId
+
+	// no validation rules for BrandId
+
+	// no validation rules for Name
+
+	// no validation rules for GoodsSn
+
+	// no validation rules for ClickNum
+
+	// no validation rules for SoldNum
+
+	// no validation rules for FavNum
+
+	// no validation rules for MarketPrice
+
+	// no validation rules for GoodsBrief
+
+	// no validation rules for GoodsDesc
+
+	// no validation rules for ShipFree
+
+	// no validation rules for Images
+
+	// no validation rules for IsNew
+
+	// no validation rules for IsHot
+
+	// no validation rules for OnSale
+
+	if len(errors) > 0 {
+		return GoodsInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by GoodsInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsInfoResponseMultiError) AllErrors() []error { return m }
+
+// GoodsInfoResponseValidationError is the validation error returned by
+// GoodsInfoResponse.Validate if the designated constraints aren't met.
+type GoodsInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsInfoResponseValidationError) ErrorName() string {
+	return "GoodsInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsInfoResponseValidationError{}
+
+// Validate checks the field values on GoodsListResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodsListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsListResponseMultiError, or nil if none found.
+func (m *GoodsListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GoodsListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GoodsListResponseValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GoodsListResponseValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GoodsListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsListResponseMultiError is an error wrapping multiple validation errors
+// returned by GoodsListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GoodsListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsListResponseMultiError) AllErrors() []error { return m }
+
+// GoodsListResponseValidationError is the validation error returned by
+// GoodsListResponse.Validate if the designated constraints aren't met.
+type GoodsListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsListResponseValidationError) ErrorName() string {
+	return "GoodsListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsListResponseValidationError{}
+
+// Validate checks the field values on GoodInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GoodInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodInfoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodInfoRequestMultiError, or nil if none found.
+func (m *GoodInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() < 1 {
+		err := GoodInfoRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by GoodInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GoodInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodInfoRequestMultiError) AllErrors() []error { return m }
+
+// GoodInfoRequestValidationError is the validation error returned by
+// GoodInfoRequest.Validate if the designated constraints aren't met.
+type GoodInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodInfoRequestValidationError) ErrorName() string { return "GoodInfoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GoodInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodInfoRequestValidationError{}
+
+// Validate checks the field values on BatchGoodsIdInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchGoodsIdInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGoodsIdInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGoodsIdInfoMultiError, or nil if none found.
+func (m *BatchGoodsIdInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGoodsIdInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetId()); l < 1 || l > 100 {
+		err := BatchGoodsIdInfoValidationError{
+			field:  "Id",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchGoodsIdInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGoodsIdInfoMultiError is an error wrapping multiple validation errors
+// returned by BatchGoodsIdInfo.ValidateAll() if the designated constraints
+// aren't met.
+type BatchGoodsIdInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGoodsIdInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGoodsIdInfoMultiError) AllErrors() []error { return m }
+
+// BatchGoodsIdInfoValidationError is the validation error returned by
+// BatchGoodsIdInfo.Validate if the designated constraints aren't met.
+type BatchGoodsIdInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGoodsIdInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGoodsIdInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGoodsIdInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGoodsIdInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGoodsIdInfoValidationError) ErrorName() string { return "BatchGoodsIdInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchGoodsIdInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGoodsIdInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGoodsIdInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGoodsIdInfoValidationError{}
+
+// Validate checks the field values on FavEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FavEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FavEventMultiError, or nil
+// if none found.
+func (m *FavEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetEventId()); l < 1 || l > 64 {
+		err := FavEventValidationError{
+			field:  "EventId",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGoodsId() <= 0 {
+		err := FavEventValidationError{
+			field:  "GoodsId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _FavEvent_Delta_InLookup[m.GetDelta()]; !ok {
+		err := FavEventValidationError{
+			field:  "Delta",
+			reason: "value must be in list [1 -1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FavEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavEventMultiError is an error wrapping multiple validation errors returned
+// by FavEvent.ValidateAll() if the designated constraints aren't met.
+type FavEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavEventMultiError) AllErrors() []error { return m }
+
+// FavEventValidationError is the validation error returned by
+// FavEvent.Validate if the designated constraints aren't met.
+type FavEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavEventValidationError) ErrorName() string { return "FavEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavEventValidationError{}
+
+var _FavEvent_Delta_InLookup = map[int32]struct{}{
+	1:  {},
+	-1: {},
+}
+
+// Validate checks the field values on FavEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FavEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FavEventsRequestMultiError, or nil if none found.
+func (m *FavEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetEvents()); l < 1 || l > 500 {
+		err := FavEventsRequestValidationError{
+			field:  "Events",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FavEventsRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FavEventsRequestValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FavEventsRequestValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FavEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by FavEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FavEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavEventsRequestMultiError) AllErrors() []error { return m }
+
+// FavEventsRequestValidationError is the validation error returned by
+// FavEventsRequest.Validate if the designated constraints aren't met.
+type FavEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavEventsRequestValidationError) ErrorName() string { return "FavEventsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavEventsRequestValidationError{}
+
+// Validate checks the field values on UploadMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadMediaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadMediaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadMediaRequestMultiError, or nil if none found.
+func (m *UploadMediaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadMediaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetContent()) < 1 {
+		err := UploadMediaRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadMediaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadMediaRequestMultiError is an error wrapping multiple validation errors
+// returned by UploadMediaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UploadMediaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadMediaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadMediaRequestMultiError) AllErrors() []error { return m }
+
+// UploadMediaRequestValidationError is the validation error returned by
+// UploadMediaRequest.Validate if the designated constraints aren't met.
+type UploadMediaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadMediaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadMediaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadMediaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadMediaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadMediaRequestValidationError) ErrorName() string {
+	return "UploadMediaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadMediaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadMediaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadMediaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadMediaRequestValidationError{}
+
+// Validate checks the field values on MediaResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaResponseMultiError, or
+// nil if none found.
+func (m *MediaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for ThumbUrl
+
+	// no validation rules for Hash
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if len(errors) > 0 {
+		return MediaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaResponseMultiError is an error wrapping multiple validation errors
+// returned by MediaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MediaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaResponseMultiError) AllErrors() []error { return m }
+
+// MediaResponseValidationError is the validation error returned by
+// MediaResponse.Validate if the designated constraints aren't met.
+type MediaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaResponseValidationError) ErrorName() string { return "MediaResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaResponseValidationError{}
+
+// Validate checks the field values on MediaGCRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MediaGCRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaGCRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MediaGCRequestMultiError,
+// or nil if none found.
+func (m *MediaGCRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaGCRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GraceSeconds
+
+	if len(errors) > 0 {
+		return MediaGCRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaGCRequestMultiError is an error wrapping multiple validation errors
+// returned by MediaGCRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MediaGCRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaGCRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaGCRequestMultiError) AllErrors() []error { return m }
+
+// MediaGCRequestValidationError is the validation error returned by
+// MediaGCRequest.Validate if the designated constraints aren't met.
+type MediaGCRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaGCRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaGCRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaGCRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaGCRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaGCRequestValidationError) ErrorName() string { return "MediaGCRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaGCRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaGCRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaGCRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaGCRequestValidationError{}
+
+// Validate checks the field values on MediaGCResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MediaGCResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MediaGCResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MediaGCResponseMultiError, or nil if none found.
+func (m *MediaGCResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MediaGCResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return MediaGCResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MediaGCResponseMultiError is an error wrapping multiple validation errors
+// returned by MediaGCResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MediaGCResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MediaGCResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MediaGCResponseMultiError) AllErrors() []error { return m }
+
+// MediaGCResponseValidationError is the validation error returned by
+// MediaGCResponse.Validate if the designated constraints aren't met.
+type MediaGCResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MediaGCResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MediaGCResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MediaGCResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MediaGCResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MediaGCResponseValidationError) ErrorName() string { return "MediaGCResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MediaGCResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMediaGCResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MediaGCResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MediaGCResponseValidationError{}
+
+// Validate checks the field values on ChangeSkuPriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeSkuPriceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeSkuPriceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeSkuPriceRequestMultiError, or nil if none found.
+func (m *ChangeSkuPriceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeSkuPriceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSkuId() < 1 {
+		err := ChangeSkuPriceRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() <= 0 {
+		err := ChangeSkuPriceRequestValidationError{
+			field:  "Price",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPromotionPrice() < 0 {
+		err := ChangeSkuPriceRequestValidationError{
+			field:  "PromotionPrice",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for EffectiveUntil
+
+	// no validation rules for Remark
+
+	if len(errors) > 0 {
+		return ChangeSkuPriceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeSkuPriceRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangeSkuPriceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeSkuPriceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeSkuPriceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeSkuPriceRequestMultiError) AllErrors() []error { return m }
+
+// ChangeSkuPriceRequestValidationError is the validation error returned by
+// ChangeSkuPriceRequest.Validate if the designated constraints aren't met.
+type ChangeSkuPriceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeSkuPriceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeSkuPriceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeSkuPriceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeSkuPriceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeSkuPriceRequestValidationError) ErrorName() string {
+	return "ChangeSkuPriceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeSkuPriceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeSkuPriceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeSkuPriceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeSkuPriceRequestValidationError{}
+
+// Validate checks the field values on SkuPriceResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SkuPriceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPriceResponseMultiError, or nil if none found.
+func (m *SkuPriceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SkuPriceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for SkuId
+
+	// no validation rules for Price
+
+	// no validation rules for PromotionPrice
+
+	// no validation rules for EffectiveFrom
+
+	// no validation rules for EffectiveUntil
+
+	// no validation rules for Status
+
+	// no validation rules for Remark
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return SkuPriceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SkuPriceResponseMultiError is an error wrapping multiple validation errors
+// returned by SkuPriceResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SkuPriceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SkuPriceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SkuPriceResponseMultiError) AllErrors() []error { return m }
+
+// SkuPriceResponseValidationError is the validation error returned by
+// SkuPriceResponse.Validate if the designated constraints aren't met.
+type SkuPriceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuPriceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuPriceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuPriceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuPriceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuPriceResponseValidationError) ErrorName() string { return "SkuPriceResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuPriceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuPriceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuPriceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuPriceResponseValidationError{}
+
+// Validate checks the field values on SkuPriceAtRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceAtRequest) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for SoldNum
+// ValidateAll checks the field values on SkuPriceAtRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SkuPriceAtRequestMultiError, or nil if none found.
+func (m *SkuPriceAtRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
-	// no validation rules for FavNum
+func (m *SkuPriceAtRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
 
-	// no validation rules for Pages
+	var errors []error
 
-	// no validation rules for PagePerNums
+	if m.GetSkuId() < 1 {
+		err := SkuPriceAtRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Id
+	if m.GetTimestamp() < 1 {
+		err := SkuPriceAtRequestValidationError{
+			field:  "Timestamp",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GoodsFilterRequestMultiError(errors)
+		return SkuPriceAtRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GoodsFilterRequestMultiError is an error wrapping multiple validation errors
-// returned by GoodsFilterRequest.ValidateAll() if the designated constraints
+// SkuPriceAtRequestMultiError is an error wrapping multiple validation errors
+// returned by SkuPriceAtRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GoodsFilterRequestMultiError []error
+type SkuPriceAtRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GoodsFilterRequestMultiError) Error() string {
+func (m SkuPriceAtRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3369,11 +6574,11 @@ func (m GoodsFilterRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GoodsFilterRequestMultiError) AllErrors() []error { return m }
+func (m SkuPriceAtRequestMultiError) AllErrors() []error { return m }
 
-// GoodsFilterRequestValidationError is the validation error returned by
-// GoodsFilterRequest.Validate if the designated constraints aren't met.
-type GoodsFilterRequestValidationError struct {
+// SkuPriceAtRequestValidationError is the validation error returned by
+// SkuPriceAtRequest.Validate if the designated constraints aren't met.
+type SkuPriceAtRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3381,24 +6586,24 @@ type GoodsFilterRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GoodsFilterRequestValidationError) Field() string { return e.field }
+func (e SkuPriceAtRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GoodsFilterRequestValidationError) Reason() string { return e.reason }
+func (e SkuPriceAtRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GoodsFilterRequestValidationError) Cause() error { return e.cause }
+func (e SkuPriceAtRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GoodsFilterRequestValidationError) Key() bool { return e.key }
+func (e SkuPriceAtRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GoodsFilterRequestValidationError) ErrorName() string {
-	return "GoodsFilterRequestValidationError"
+func (e SkuPriceAtRequestValidationError) ErrorName() string {
+	return "SkuPriceAtRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GoodsFilterRequestValidationError) Error() string {
+func (e SkuPriceAtRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3410,14 +6615,14 @@ func (e GoodsFilterRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGoodsFilterRequest.%s: %s%s",
+		"invalid %sSkuPriceAtRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GoodsFilterRequestValidationError{}
+var _ error = SkuPriceAtRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3425,76 +6630,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GoodsFilterRequestValidationError{}
+} = SkuPriceAtRequestValidationError{}
 
-// Validate checks the field values on GoodsInfoResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GoodsInfoResponse) Validate() error {
+// Validate checks the field values on SkuPriceHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceHistoryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GoodsInfoResponse with the rules
+// ValidateAll checks the field values on SkuPriceHistoryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GoodsInfoResponseMultiError, or nil if none found.
-func (m *GoodsInfoResponse) ValidateAll() error {
+// SkuPriceHistoryRequestMultiError, or nil if none found.
+func (m *SkuPriceHistoryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GoodsInfoResponse) validate(all bool) error {
+func (m *SkuPriceHistoryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for CategoryId
-
-	// no validation rules for BrandId
-
-	// no validation rules for Name
-
-	// no validation rules for GoodsSn
-
-	// no validation rules for ClickNum
-
-	// no validation rules for SoldNum
-
-	// no validation rules for FavNum
-
-	// no validation rules for MarketPrice
-
-	// no validation rules for GoodsBrief
-
-	// no validation rules for GoodsDesc
-
-	// no validation rules for ShipFree
-
-	// no validation rules for Images
-
-	// no validation rules for IsNew
-
-	// no validation rules for IsHot
-
-	// no validation rules for OnSale
+	if m.GetSkuId() < 1 {
+		err := SkuPriceHistoryRequestValidationError{
+			field:  "SkuId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return GoodsInfoResponseMultiError(errors)
+		return SkuPriceHistoryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GoodsInfoResponseMultiError is an error wrapping multiple validation errors
-// returned by GoodsInfoResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GoodsInfoResponseMultiError []error
+// SkuPriceHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by SkuPriceHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SkuPriceHistoryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GoodsInfoResponseMultiError) Error() string {
+func (m SkuPriceHistoryRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3503,11 +6687,11 @@ func (m GoodsInfoResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GoodsInfoResponseMultiError) AllErrors() []error { return m }
+func (m SkuPriceHistoryRequestMultiError) AllErrors() []error { return m }
 
-// GoodsInfoResponseValidationError is the validation error returned by
-// GoodsInfoResponse.Validate if the designated constraints aren't met.
-type GoodsInfoResponseValidationError struct {
+// SkuPriceHistoryRequestValidationError is the validation error returned by
+// SkuPriceHistoryRequest.Validate if the designated constraints aren't met.
+type SkuPriceHistoryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3515,24 +6699,24 @@ type GoodsInfoResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GoodsInfoResponseValidationError) Field() string { return e.field }
+func (e SkuPriceHistoryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GoodsInfoResponseValidationError) Reason() string { return e.reason }
+func (e SkuPriceHistoryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GoodsInfoResponseValidationError) Cause() error { return e.cause }
+func (e SkuPriceHistoryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GoodsInfoResponseValidationError) Key() bool { return e.key }
+func (e SkuPriceHistoryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GoodsInfoResponseValidationError) ErrorName() string {
-	return "GoodsInfoResponseValidationError"
+func (e SkuPriceHistoryRequestValidationError) ErrorName() string {
+	return "SkuPriceHistoryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GoodsInfoResponseValidationError) Error() string {
+func (e SkuPriceHistoryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3544,14 +6728,14 @@ func (e GoodsInfoResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGoodsInfoResponse.%s: %s%s",
+		"invalid %sSkuPriceHistoryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GoodsInfoResponseValidationError{}
+var _ error = SkuPriceHistoryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3559,32 +6743,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GoodsInfoResponseValidationError{}
+} = SkuPriceHistoryRequestValidationError{}
 
-// Validate checks the field values on GoodsListResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GoodsListResponse) Validate() error {
+// Validate checks the field values on SkuPriceHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SkuPriceHistoryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GoodsListResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SkuPriceHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GoodsListResponseMultiError, or nil if none found.
-func (m *GoodsListResponse) ValidateAll() error {
+// SkuPriceHistoryResponseMultiError, or nil if none found.
+func (m *SkuPriceHistoryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GoodsListResponse) validate(all bool) error {
+func (m *SkuPriceHistoryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Total
-
 	for idx, item := range m.GetList() {
 		_, _ = idx, item
 
@@ -3592,7 +6774,7 @@ func (m *GoodsListResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GoodsListResponseValidationError{
+					errors = append(errors, SkuPriceHistoryResponseValidationError{
 						field:  fmt.Sprintf("List[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3600,7 +6782,7 @@ func (m *GoodsListResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GoodsListResponseValidationError{
+					errors = append(errors, SkuPriceHistoryResponseValidationError{
 						field:  fmt.Sprintf("List[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -3609,7 +6791,7 @@ func (m *GoodsListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GoodsListResponseValidationError{
+				return SkuPriceHistoryResponseValidationError{
 					field:  fmt.Sprintf("List[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3620,19 +6802,19 @@ func (m *GoodsListResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GoodsListResponseMultiError(errors)
+		return SkuPriceHistoryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GoodsListResponseMultiError is an error wrapping multiple validation errors
-// returned by GoodsListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GoodsListResponseMultiError []error
+// SkuPriceHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by SkuPriceHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SkuPriceHistoryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GoodsListResponseMultiError) Error() string {
+func (m SkuPriceHistoryResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3641,11 +6823,11 @@ func (m GoodsListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GoodsListResponseMultiError) AllErrors() []error { return m }
+func (m SkuPriceHistoryResponseMultiError) AllErrors() []error { return m }
 
-// GoodsListResponseValidationError is the validation error returned by
-// GoodsListResponse.Validate if the designated constraints aren't met.
-type GoodsListResponseValidationError struct {
+// SkuPriceHistoryResponseValidationError is the validation error returned by
+// SkuPriceHistoryResponse.Validate if the designated constraints aren't met.
+type SkuPriceHistoryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3653,24 +6835,24 @@ type GoodsListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GoodsListResponseValidationError) Field() string { return e.field }
+func (e SkuPriceHistoryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GoodsListResponseValidationError) Reason() string { return e.reason }
+func (e SkuPriceHistoryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GoodsListResponseValidationError) Cause() error { return e.cause }
+func (e SkuPriceHistoryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GoodsListResponseValidationError) Key() bool { return e.key }
+func (e SkuPriceHistoryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GoodsListResponseValidationError) ErrorName() string {
-	return "GoodsListResponseValidationError"
+func (e SkuPriceHistoryResponseValidationError) ErrorName() string {
+	return "SkuPriceHistoryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GoodsListResponseValidationError) Error() string {
+func (e SkuPriceHistoryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3682,14 +6864,14 @@ func (e GoodsListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGoodsListResponse.%s: %s%s",
+		"invalid %sSkuPriceHistoryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GoodsListResponseValidationError{}
+var _ error = SkuPriceHistoryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3697,7 +6879,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GoodsListResponseValidationError{}
+} = SkuPriceHistoryResponseValidationError{}
 
 // Validate checks the field values on CreateGoodsRequestGoodsSku with the
 // rules defined in the proto definition for this message. If any rules are
@@ -3838,6 +7020,17 @@ func (m *CreateGoodsRequestGoodsSku) validate(all bool) error {
 
 	}
 
+	if m.GetPurchaseLimit() < 0 {
+		err := CreateGoodsRequestGoodsSkuValidationError{
+			field:  "PurchaseLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateGoodsRequestGoodsSkuMultiError(errors)
 	}
@@ -4338,3 +7531,128 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreateGoodsRequestGoodsSkuGroupAttrAttrValidationError{}
+
+// Validate checks the field values on GoodsFilterRequestAttrFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GoodsFilterRequestAttrFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GoodsFilterRequestAttrFilter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GoodsFilterRequestAttrFilterMultiError, or nil if none found.
+func (m *GoodsFilterRequestAttrFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GoodsFilterRequestAttrFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAttrId() < 1 {
+		err := GoodsFilterRequestAttrFilterValidationError{
+			field:  "AttrId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetValueIds()) < 1 {
+		err := GoodsFilterRequestAttrFilterValidationError{
+			field:  "ValueIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GoodsFilterRequestAttrFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// GoodsFilterRequestAttrFilterMultiError is an error wrapping multiple
+// validation errors returned by GoodsFilterRequestAttrFilter.ValidateAll() if
+// the designated constraints aren't met.
+type GoodsFilterRequestAttrFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GoodsFilterRequestAttrFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GoodsFilterRequestAttrFilterMultiError) AllErrors() []error { return m }
+
+// GoodsFilterRequestAttrFilterValidationError is the validation error returned
+// by GoodsFilterRequestAttrFilter.Validate if the designated constraints
+// aren't met.
+type GoodsFilterRequestAttrFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GoodsFilterRequestAttrFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GoodsFilterRequestAttrFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GoodsFilterRequestAttrFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GoodsFilterRequestAttrFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GoodsFilterRequestAttrFilterValidationError) ErrorName() string {
+	return "GoodsFilterRequestAttrFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GoodsFilterRequestAttrFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGoodsFilterRequestAttrFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GoodsFilterRequestAttrFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GoodsFilterRequestAttrFilterValidationError{}
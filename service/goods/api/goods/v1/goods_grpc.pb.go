@@ -31,12 +31,26 @@ const (
 	Goods_UpdateBrand_FullMethodName              = "/goods.v1.Goods/UpdateBrand"
 	Goods_CreateGoodsSpecification_FullMethodName = "/goods.v1.Goods/CreateGoodsSpecification"
 	Goods_CreateGoodsType_FullMethodName          = "/goods.v1.Goods/CreateGoodsType"
+	Goods_GoodsTypeList_FullMethodName            = "/goods.v1.Goods/GoodsTypeList"
+	Goods_UpdateGoodsType_FullMethodName          = "/goods.v1.Goods/UpdateGoodsType"
+	Goods_DeleteGoodsType_FullMethodName          = "/goods.v1.Goods/DeleteGoodsType"
+	Goods_GetGoodsTypeTemplate_FullMethodName     = "/goods.v1.Goods/GetGoodsTypeTemplate"
 	Goods_CreateAttrGroup_FullMethodName          = "/goods.v1.Goods/CreateAttrGroup"
 	Goods_CreateAttrValue_FullMethodName          = "/goods.v1.Goods/CreateAttrValue"
 	Goods_CreateGoods_FullMethodName              = "/goods.v1.Goods/CreateGoods"
 	Goods_UpdateGoods_FullMethodName              = "/goods.v1.Goods/UpdateGoods"
 	Goods_GoodsList_FullMethodName                = "/goods.v1.Goods/GoodsList"
+	Goods_GetGoodsDetail_FullMethodName           = "/goods.v1.Goods/GetGoodsDetail"
+	Goods_BatchGetGoods_FullMethodName            = "/goods.v1.Goods/BatchGetGoods"
+	Goods_ApplyFavEvents_FullMethodName           = "/goods.v1.Goods/ApplyFavEvents"
 	Goods_SkuList_FullMethodName                  = "/goods.v1.Goods/SkuList"
+	Goods_GoodsSkuList_FullMethodName             = "/goods.v1.Goods/GoodsSkuList"
+	Goods_UpdateSkuPurchaseLimit_FullMethodName   = "/goods.v1.Goods/UpdateSkuPurchaseLimit"
+	Goods_ChangeSkuPrice_FullMethodName           = "/goods.v1.Goods/ChangeSkuPrice"
+	Goods_SkuPriceAt_FullMethodName               = "/goods.v1.Goods/SkuPriceAt"
+	Goods_SkuPriceHistory_FullMethodName          = "/goods.v1.Goods/SkuPriceHistory"
+	Goods_UploadMedia_FullMethodName              = "/goods.v1.Goods/UploadMedia"
+	Goods_MediaGC_FullMethodName                  = "/goods.v1.Goods/MediaGC"
 )
 
 // GoodsClient is the client API for Goods service.
@@ -59,6 +73,10 @@ type GoodsClient interface {
 	// 商品类型 goods_property_names
 	// 商品类型不同于商品分类，指的是依据某一类商品的相同属性归纳成的属性集合 // 手机类型都有屏幕尺寸、网络制式等共同的属性
 	CreateGoodsType(ctx context.Context, in *GoodsTypeRequest, opts ...grpc.CallOption) (*GoodsTypeResponse, error)
+	GoodsTypeList(ctx context.Context, in *GoodsTypeListRequest, opts ...grpc.CallOption) (*GoodsTypeListResponse, error)
+	UpdateGoodsType(ctx context.Context, in *UpdateGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteGoodsType(ctx context.Context, in *DeleteGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetGoodsTypeTemplate(ctx context.Context, in *GoodsTypeTemplateRequest, opts ...grpc.CallOption) (*GoodsTypeTemplateResponse, error)
 	// 商品参数
 	CreateAttrGroup(ctx context.Context, in *AttrGroupRequest, opts ...grpc.CallOption) (*AttrGroupResponse, error)
 	CreateAttrValue(ctx context.Context, in *AttrRequest, opts ...grpc.CallOption) (*AttrResponse, error)
@@ -66,8 +84,20 @@ type GoodsClient interface {
 	CreateGoods(ctx context.Context, in *CreateGoodsRequest, opts ...grpc.CallOption) (*CreateGoodsResponse, error)
 	UpdateGoods(ctx context.Context, in *CreateGoodsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GoodsList(ctx context.Context, in *GoodsFilterRequest, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error)
+	BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error)
+	ApplyFavEvents(ctx context.Context, in *FavEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Sku
 	SkuList(ctx context.Context, in *SkuListRequest, opts ...grpc.CallOption) (*SkuListResponse, error)
+	GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error)
+	UpdateSkuPurchaseLimit(ctx context.Context, in *SkuPurchaseLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 商品价格
+	ChangeSkuPrice(ctx context.Context, in *ChangeSkuPriceRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error)
+	SkuPriceAt(ctx context.Context, in *SkuPriceAtRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error)
+	SkuPriceHistory(ctx context.Context, in *SkuPriceHistoryRequest, opts ...grpc.CallOption) (*SkuPriceHistoryResponse, error)
+	// 媒体文件
+	UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error)
+	MediaGC(ctx context.Context, in *MediaGCRequest, opts ...grpc.CallOption) (*MediaGCResponse, error)
 }
 
 type goodsClient struct {
@@ -188,6 +218,46 @@ func (c *goodsClient) CreateGoodsType(ctx context.Context, in *GoodsTypeRequest,
 	return out, nil
 }
 
+func (c *goodsClient) GoodsTypeList(ctx context.Context, in *GoodsTypeListRequest, opts ...grpc.CallOption) (*GoodsTypeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsTypeListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsTypeList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateGoodsType(ctx context.Context, in *UpdateGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateGoodsType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteGoodsType(ctx context.Context, in *DeleteGoodsTypeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteGoodsType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) GetGoodsTypeTemplate(ctx context.Context, in *GoodsTypeTemplateRequest, opts ...grpc.CallOption) (*GoodsTypeTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsTypeTemplateResponse)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsTypeTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) CreateAttrGroup(ctx context.Context, in *AttrGroupRequest, opts ...grpc.CallOption) (*AttrGroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AttrGroupResponse)
@@ -238,6 +308,36 @@ func (c *goodsClient) GoodsList(ctx context.Context, in *GoodsFilterRequest, opt
 	return out, nil
 }
 
+func (c *goodsClient) GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsInfoResponse)
+	err := c.cc.Invoke(ctx, Goods_GetGoodsDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BatchGetGoods(ctx context.Context, in *BatchGoodsIdInfo, opts ...grpc.CallOption) (*GoodsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsListResponse)
+	err := c.cc.Invoke(ctx, Goods_BatchGetGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ApplyFavEvents(ctx context.Context, in *FavEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_ApplyFavEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) SkuList(ctx context.Context, in *SkuListRequest, opts ...grpc.CallOption) (*SkuListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SkuListResponse)
@@ -248,6 +348,76 @@ func (c *goodsClient) SkuList(ctx context.Context, in *SkuListRequest, opts ...g
 	return out, nil
 }
 
+func (c *goodsClient) GoodsSkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_GoodsSkuList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateSkuPurchaseLimit(ctx context.Context, in *SkuPurchaseLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateSkuPurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ChangeSkuPrice(ctx context.Context, in *ChangeSkuPriceRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuPriceResponse)
+	err := c.cc.Invoke(ctx, Goods_ChangeSkuPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SkuPriceAt(ctx context.Context, in *SkuPriceAtRequest, opts ...grpc.CallOption) (*SkuPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuPriceResponse)
+	err := c.cc.Invoke(ctx, Goods_SkuPriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SkuPriceHistory(ctx context.Context, in *SkuPriceHistoryRequest, opts ...grpc.CallOption) (*SkuPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Goods_SkuPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UploadMedia(ctx context.Context, in *UploadMediaRequest, opts ...grpc.CallOption) (*MediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaResponse)
+	err := c.cc.Invoke(ctx, Goods_UploadMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) MediaGC(ctx context.Context, in *MediaGCRequest, opts ...grpc.CallOption) (*MediaGCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaGCResponse)
+	err := c.cc.Invoke(ctx, Goods_MediaGC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoodsServer is the server API for Goods service.
 // All implementations must embed UnimplementedGoodsServer
 // for forward compatibility.
@@ -268,6 +438,10 @@ type GoodsServer interface {
 	// 商品类型 goods_property_names
 	// 商品类型不同于商品分类，指的是依据某一类商品的相同属性归纳成的属性集合 // 手机类型都有屏幕尺寸、网络制式等共同的属性
 	CreateGoodsType(context.Context, *GoodsTypeRequest) (*GoodsTypeResponse, error)
+	GoodsTypeList(context.Context, *GoodsTypeListRequest) (*GoodsTypeListResponse, error)
+	UpdateGoodsType(context.Context, *UpdateGoodsTypeRequest) (*emptypb.Empty, error)
+	DeleteGoodsType(context.Context, *DeleteGoodsTypeRequest) (*emptypb.Empty, error)
+	GetGoodsTypeTemplate(context.Context, *GoodsTypeTemplateRequest) (*GoodsTypeTemplateResponse, error)
 	// 商品参数
 	CreateAttrGroup(context.Context, *AttrGroupRequest) (*AttrGroupResponse, error)
 	CreateAttrValue(context.Context, *AttrRequest) (*AttrResponse, error)
@@ -275,8 +449,20 @@ type GoodsServer interface {
 	CreateGoods(context.Context, *CreateGoodsRequest) (*CreateGoodsResponse, error)
 	UpdateGoods(context.Context, *CreateGoodsRequest) (*emptypb.Empty, error)
 	GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error)
+	GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error)
+	BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error)
+	ApplyFavEvents(context.Context, *FavEventsRequest) (*emptypb.Empty, error)
 	// Sku
 	SkuList(context.Context, *SkuListRequest) (*SkuListResponse, error)
+	GoodsSkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error)
+	UpdateSkuPurchaseLimit(context.Context, *SkuPurchaseLimitRequest) (*emptypb.Empty, error)
+	// 商品价格
+	ChangeSkuPrice(context.Context, *ChangeSkuPriceRequest) (*SkuPriceResponse, error)
+	SkuPriceAt(context.Context, *SkuPriceAtRequest) (*SkuPriceResponse, error)
+	SkuPriceHistory(context.Context, *SkuPriceHistoryRequest) (*SkuPriceHistoryResponse, error)
+	// 媒体文件
+	UploadMedia(context.Context, *UploadMediaRequest) (*MediaResponse, error)
+	MediaGC(context.Context, *MediaGCRequest) (*MediaGCResponse, error)
 	mustEmbedUnimplementedGoodsServer()
 }
 
@@ -320,6 +506,18 @@ func (UnimplementedGoodsServer) CreateGoodsSpecification(context.Context, *Speci
 func (UnimplementedGoodsServer) CreateGoodsType(context.Context, *GoodsTypeRequest) (*GoodsTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateGoodsType not implemented")
 }
+func (UnimplementedGoodsServer) GoodsTypeList(context.Context, *GoodsTypeListRequest) (*GoodsTypeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsTypeList not implemented")
+}
+func (UnimplementedGoodsServer) UpdateGoodsType(context.Context, *UpdateGoodsTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGoodsType not implemented")
+}
+func (UnimplementedGoodsServer) DeleteGoodsType(context.Context, *DeleteGoodsTypeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGoodsType not implemented")
+}
+func (UnimplementedGoodsServer) GetGoodsTypeTemplate(context.Context, *GoodsTypeTemplateRequest) (*GoodsTypeTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsTypeTemplate not implemented")
+}
 func (UnimplementedGoodsServer) CreateAttrGroup(context.Context, *AttrGroupRequest) (*AttrGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAttrGroup not implemented")
 }
@@ -335,9 +533,39 @@ func (UnimplementedGoodsServer) UpdateGoods(context.Context, *CreateGoodsRequest
 func (UnimplementedGoodsServer) GoodsList(context.Context, *GoodsFilterRequest) (*GoodsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GoodsList not implemented")
 }
+func (UnimplementedGoodsServer) GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsDetail not implemented")
+}
+func (UnimplementedGoodsServer) BatchGetGoods(context.Context, *BatchGoodsIdInfo) (*GoodsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGoods not implemented")
+}
+func (UnimplementedGoodsServer) ApplyFavEvents(context.Context, *FavEventsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyFavEvents not implemented")
+}
 func (UnimplementedGoodsServer) SkuList(context.Context, *SkuListRequest) (*SkuListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SkuList not implemented")
 }
+func (UnimplementedGoodsServer) GoodsSkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GoodsSkuList not implemented")
+}
+func (UnimplementedGoodsServer) UpdateSkuPurchaseLimit(context.Context, *SkuPurchaseLimitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSkuPurchaseLimit not implemented")
+}
+func (UnimplementedGoodsServer) ChangeSkuPrice(context.Context, *ChangeSkuPriceRequest) (*SkuPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSkuPrice not implemented")
+}
+func (UnimplementedGoodsServer) SkuPriceAt(context.Context, *SkuPriceAtRequest) (*SkuPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkuPriceAt not implemented")
+}
+func (UnimplementedGoodsServer) SkuPriceHistory(context.Context, *SkuPriceHistoryRequest) (*SkuPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkuPriceHistory not implemented")
+}
+func (UnimplementedGoodsServer) UploadMedia(context.Context, *UploadMediaRequest) (*MediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadMedia not implemented")
+}
+func (UnimplementedGoodsServer) MediaGC(context.Context, *MediaGCRequest) (*MediaGCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MediaGC not implemented")
+}
 func (UnimplementedGoodsServer) mustEmbedUnimplementedGoodsServer() {}
 func (UnimplementedGoodsServer) testEmbeddedByValue()               {}
 
@@ -557,6 +785,78 @@ func _Goods_CreateGoodsType_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_GoodsTypeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsTypeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsTypeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsTypeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsTypeList(ctx, req.(*GoodsTypeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateGoodsType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGoodsTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateGoodsType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateGoodsType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateGoodsType(ctx, req.(*UpdateGoodsTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteGoodsType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGoodsTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteGoodsType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteGoodsType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteGoodsType(ctx, req.(*DeleteGoodsTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_GetGoodsTypeTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsTypeTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsTypeTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsTypeTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsTypeTemplate(ctx, req.(*GoodsTypeTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_CreateAttrGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AttrGroupRequest)
 	if err := dec(in); err != nil {
@@ -647,6 +947,60 @@ func _Goods_GoodsList_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_GetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GetGoodsDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GetGoodsDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GetGoodsDetail(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BatchGetGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGoodsIdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchGetGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchGetGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchGetGoods(ctx, req.(*BatchGoodsIdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ApplyFavEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ApplyFavEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ApplyFavEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ApplyFavEvents(ctx, req.(*FavEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_SkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SkuListRequest)
 	if err := dec(in); err != nil {
@@ -665,6 +1019,132 @@ func _Goods_SkuList_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_GoodsSkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).GoodsSkuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_GoodsSkuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).GoodsSkuList(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateSkuPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuPurchaseLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateSkuPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateSkuPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateSkuPurchaseLimit(ctx, req.(*SkuPurchaseLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ChangeSkuPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSkuPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ChangeSkuPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ChangeSkuPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ChangeSkuPrice(ctx, req.(*ChangeSkuPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SkuPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SkuPriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SkuPriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SkuPriceAt(ctx, req.(*SkuPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SkuPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SkuPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SkuPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SkuPriceHistory(ctx, req.(*SkuPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UploadMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UploadMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UploadMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UploadMedia(ctx, req.(*UploadMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_MediaGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MediaGCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).MediaGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_MediaGC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).MediaGC(ctx, req.(*MediaGCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Goods_ServiceDesc is the grpc.ServiceDesc for Goods service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -716,6 +1196,22 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateGoodsType",
 			Handler:    _Goods_CreateGoodsType_Handler,
 		},
+		{
+			MethodName: "GoodsTypeList",
+			Handler:    _Goods_GoodsTypeList_Handler,
+		},
+		{
+			MethodName: "UpdateGoodsType",
+			Handler:    _Goods_UpdateGoodsType_Handler,
+		},
+		{
+			MethodName: "DeleteGoodsType",
+			Handler:    _Goods_DeleteGoodsType_Handler,
+		},
+		{
+			MethodName: "GetGoodsTypeTemplate",
+			Handler:    _Goods_GetGoodsTypeTemplate_Handler,
+		},
 		{
 			MethodName: "CreateAttrGroup",
 			Handler:    _Goods_CreateAttrGroup_Handler,
@@ -736,10 +1232,50 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GoodsList",
 			Handler:    _Goods_GoodsList_Handler,
 		},
+		{
+			MethodName: "GetGoodsDetail",
+			Handler:    _Goods_GetGoodsDetail_Handler,
+		},
+		{
+			MethodName: "BatchGetGoods",
+			Handler:    _Goods_BatchGetGoods_Handler,
+		},
+		{
+			MethodName: "ApplyFavEvents",
+			Handler:    _Goods_ApplyFavEvents_Handler,
+		},
 		{
 			MethodName: "SkuList",
 			Handler:    _Goods_SkuList_Handler,
 		},
+		{
+			MethodName: "GoodsSkuList",
+			Handler:    _Goods_GoodsSkuList_Handler,
+		},
+		{
+			MethodName: "UpdateSkuPurchaseLimit",
+			Handler:    _Goods_UpdateSkuPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "ChangeSkuPrice",
+			Handler:    _Goods_ChangeSkuPrice_Handler,
+		},
+		{
+			MethodName: "SkuPriceAt",
+			Handler:    _Goods_SkuPriceAt_Handler,
+		},
+		{
+			MethodName: "SkuPriceHistory",
+			Handler:    _Goods_SkuPriceHistory_Handler,
+		},
+		{
+			MethodName: "UploadMedia",
+			Handler:    _Goods_UploadMedia_Handler,
+		},
+		{
+			MethodName: "MediaGC",
+			Handler:    _Goods_MediaGC_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "goods/v1/goods.proto",
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"goods/internal/conf"
+	"goods/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -13,6 +14,7 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/go-kratos/kratos/v2/transport/http"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/jaeger"
@@ -39,7 +41,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, rr registry.Registrar) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ps *server.PriceScheduler, es *server.EsSyncScheduler, rr registry.Registrar) *kratos.App {
 	return kratos.New(
 		kratos.ID(id+"goods service"),
 		kratos.Name(Name),
@@ -48,6 +50,9 @@ func newApp(logger log.Logger, gs *grpc.Server, rr registry.Registrar) *kratos.A
 		kratos.Logger(logger),
 		kratos.Server(
 			gs,
+			hs, // 本地媒体文件访问
+			ps, // sku 价格定时生效
+			es, // es 同步失败重试
 		),
 		kratos.Registrar(rr), // consul 的引入 服务发现和注册
 	)
@@ -32,26 +32,36 @@ func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Regist
 		return nil, nil, err
 	}
 	brandRepo := data.NewBrandRepo(dataData, logger)
-	brandUsecase := biz.NewBrandUsecase(brandRepo, logger)
+	mediaRepo := data.NewMediaRepo(dataData, logger)
+	brandUsecase := biz.NewBrandUsecase(brandRepo, mediaRepo, logger)
 	categoryRepo := data.NewCategoryRepo(dataData, logger)
 	categoryUsecase := biz.NewCategoryUsecase(categoryRepo, logger)
 	goodsTypeRepo := data.NewGoodsTypeRepo(dataData, logger)
 	transaction := data.NewTransaction(dataData)
-	goodsTypeUsecase := biz.NewGoodsTypeUsecase(goodsTypeRepo, transaction, brandRepo, logger)
 	specificationRepo := data.NewSpecificationRepo(dataData, logger)
-	specificationUsecase := biz.NewSpecificationUsecase(specificationRepo, goodsTypeRepo, transaction, logger)
 	goodsAttrRepo := data.NewGoodsAttrRepo(dataData, logger)
+	goodsTypeUsecase := biz.NewGoodsTypeUsecase(goodsTypeRepo, transaction, brandRepo, specificationRepo, goodsAttrRepo, logger)
+	specificationUsecase := biz.NewSpecificationUsecase(specificationRepo, goodsTypeRepo, transaction, logger)
 	goodsAttrUsecase := biz.NewGoodsAttrUsecase(goodsAttrRepo, transaction, goodsTypeRepo, logger)
 	goodsRepo := data.NewGoodsRepo(dataData, logger)
+	skuPriceRepo := data.NewSkuPriceRepo(dataData, logger)
 	goodsSkuRepo := data.NewGoodsSkuRepoRepo(dataData, logger)
 	esGoodsRepo := data.NewEsGoodsRepo(dataData, logger)
 	inventoryRepo := data.NewInventoryRepo(dataData, logger)
-	goodsUsecase := biz.NewGoodsUsecase(goodsRepo, goodsSkuRepo, transaction, goodsTypeRepo, categoryRepo, brandRepo, specificationRepo, goodsAttrRepo, esGoodsRepo, inventoryRepo, logger)
+	esSyncRepo := data.NewEsSyncRepo(dataData, logger)
+	esSyncUsecase := biz.NewEsSyncUsecase(esSyncRepo, goodsRepo, goodsSkuRepo, esGoodsRepo, logger)
+	goodsUsecase := biz.NewGoodsUsecase(goodsRepo, goodsSkuRepo, transaction, goodsTypeRepo, categoryRepo, brandRepo, specificationRepo, goodsAttrRepo, esGoodsRepo, inventoryRepo, mediaRepo, skuPriceRepo, esSyncUsecase, logger)
 	esGoodsUsecase := biz.NewEsGoodsUsecase(goodsRepo, esGoodsRepo, categoryRepo, logger)
-	goodsService := service.NewGoodsService(brandUsecase, categoryUsecase, goodsTypeUsecase, specificationUsecase, goodsAttrUsecase, goodsUsecase, esGoodsUsecase, logger)
+	mediaStorage := data.NewMediaStorage(confData)
+	mediaUsecase := biz.NewMediaUsecase(mediaRepo, mediaStorage, confData, logger)
+	skuPriceUsecase := biz.NewSkuPriceUsecase(skuPriceRepo, goodsSkuRepo, esSyncUsecase, transaction, logger)
+	goodsService := service.NewGoodsService(brandUsecase, categoryUsecase, goodsTypeUsecase, specificationUsecase, goodsAttrUsecase, goodsUsecase, esGoodsUsecase, mediaUsecase, skuPriceUsecase, logger)
 	grpcServer := server.NewGRPCServer(confServer, goodsService, logger)
+	httpServer := server.NewHTTPServer(confServer, confData, logger)
 	registrar := server.NewRegistrar(registry)
-	app := newApp(logger, grpcServer, registrar)
+	priceScheduler := server.NewPriceScheduler(skuPriceUsecase, logger)
+	esSyncScheduler := server.NewEsSyncScheduler(esSyncUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, priceScheduler, esSyncScheduler, registrar)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -10,6 +10,7 @@ import (
 var ProviderSet = wire.NewSet(NewCategoryUsecase, NewGoodsTypeUsecase,
 	NewSpecificationUsecase, NewGoodsAttrUsecase, NewEsGoodsUsecase,
 	NewInventoryUsecase, NewGoodsSkuUsecase, NewGoodsUsecase, NewBrandUsecase,
+	NewMediaUsecase, NewSkuPriceUsecase, NewEsSyncUsecase,
 )
 
 // Transaction 新增事务接口方法
@@ -20,19 +20,28 @@ type BrandRepo interface {
 	IsBrandByID(context.Context, int32) (*domain.Brand, error)
 	IsBrand(context.Context, []int32) error
 	ListByIds(context.Context, ...int32) (domain.BrandList, error)
+	Delete(context.Context, int32) error
 }
 type BrandUsecase struct {
-	repo BrandRepo
-	log  *log.Helper
+	repo      BrandRepo
+	mediaRepo MediaRepo // 记录品牌 logo 的图片引用
+	log       *log.Helper
 }
 
-func NewBrandUsecase(repo BrandRepo, logger log.Logger) *BrandUsecase {
-	return &BrandUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewBrandUsecase(repo BrandRepo, mRepo MediaRepo, logger log.Logger) *BrandUsecase {
+	return &BrandUsecase{repo: repo, mediaRepo: mRepo, log: log.NewHelper(logger)}
 }
 func (uc *BrandUsecase) CreateBrand(ctx context.Context, b *domain.Brand) (*domain.Brand, error) {
 	_, err := uc.repo.GetBradByName(ctx, b.Name)
 	if err != nil {
-		return uc.repo.Create(ctx, b)
+		brand, err := uc.repo.Create(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.mediaRepo.BindRefs(ctx, domain.MediaRefBrand, int64(brand.ID), brand.Logo); err != nil {
+			return nil, err
+		}
+		return brand, nil
 	} else {
 		return nil, errors.New("当前品牌已经存在")
 	}
@@ -42,6 +51,9 @@ func (uc *BrandUsecase) UpdateBrand(ctx context.Context, b *domain.Brand) error
 	if err != nil {
 		return err
 	}
+	if b.Logo != "" {
+		return uc.mediaRepo.BindRefs(ctx, domain.MediaRefBrand, int64(b.ID), b.Logo)
+	}
 	return nil
 }
 
@@ -53,3 +65,11 @@ func (uc *BrandUsecase) BrandList(ctx context.Context, b *Pagination) ([]*domain
 	return list, total, nil
 
 }
+
+// DeleteBrand 删除品牌，还有商品使用的品牌不能删除，删除后释放 logo 的图片引用
+func (uc *BrandUsecase) DeleteBrand(ctx context.Context, id int32) error {
+	if err := uc.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return uc.mediaRepo.BindRefs(ctx, domain.MediaRefBrand, int64(id))
+}
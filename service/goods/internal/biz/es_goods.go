@@ -11,6 +11,8 @@ import (
 type EsGoodsRepo interface {
 	GoodsList(ctx context.Context, es *domain.EsSearch) ([]int64, int64, error)
 	InsertEsGoods(context.Context, *domain.ESGoods) error
+	UpdateSkuPrice(ctx context.Context, goodsID, skuID, price int64) error
+	UpdateFavNum(ctx context.Context, goodsID, favNum int64) error
 }
 
 type EsGoodsUsecase struct {
@@ -30,8 +32,9 @@ func NewEsGoodsUsecase(repo GoodsRepo, es EsGoodsRepo, cRepo CategoryRepo, logge
 }
 
 func (g EsGoodsUsecase) GoodsList(ctx context.Context, req *domain.ESGoodsFilter) (*domain.GoodsListResponse, error) {
-	// 组织 es 查询条件
+	// 组织 es 查询条件，只搜索上架的商品
 	var es domain.EsSearch
+	es.Filters = append(es.Filters, elastic.NewTermQuery("on_sale", true))
 	if req.Keywords != "" {
 		es.ShouldQuery = append(es.ShouldQuery, elastic.NewMultiMatchQuery(req.Keywords, "name", "goods_brief", "sku.sku_name"))
 	}
@@ -63,6 +66,18 @@ func (g EsGoodsUsecase) GoodsList(ctx context.Context, req *domain.ESGoodsFilter
 		}
 		es.ShouldQuery = append(es.ShouldQuery, elastic.NewTermsQuery("category_id", categoryIds...))
 	}
+	// 按属性值筛选，同一属性下的值为或关系，不同属性之间为且关系
+	for _, attr := range req.Attrs {
+		valueIds := make([]interface{}, 0, len(attr.ValueIDs))
+		for _, id := range attr.ValueIDs {
+			valueIds = append(valueIds, id)
+		}
+		query := elastic.NewBoolQuery().Filter(
+			elastic.NewTermQuery("attrs.attr_id", attr.AttrID),
+			elastic.NewTermsQuery("attrs.value_id", valueIds...),
+		)
+		es.Filters = append(es.Filters, elastic.NewNestedQuery("attrs", query))
+	}
 	// 分页处理
 	switch {
 	case req.PagePerNums > 100:
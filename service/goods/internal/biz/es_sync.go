@@ -0,0 +1,98 @@
+package biz
+
+import (
+	"context"
+	"goods/internal/domain"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type EsSyncRepo interface {
+	Create(context.Context, *domain.EsSyncTask) (*domain.EsSyncTask, error)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.EsSyncTask, error)
+	Delete(context.Context, int64) error
+	Fail(ctx context.Context, id int64, reason string, next time.Time) error
+}
+
+// EsSyncUsecase 数据库和 es 之间的同步，任务先随业务事务落库，同步失败时由定时任务重试
+type EsSyncUsecase struct {
+	repo      EsSyncRepo
+	goodsRepo GoodsRepo
+	skuRepo   GoodsSkuRepo
+	esRepo    EsGoodsRepo
+	log       *log.Helper
+}
+
+func NewEsSyncUsecase(repo EsSyncRepo, gRepo GoodsRepo, skuRepo GoodsSkuRepo, es EsGoodsRepo,
+	logger log.Logger) *EsSyncUsecase {
+	return &EsSyncUsecase{
+		repo:      repo,
+		goodsRepo: gRepo,
+		skuRepo:   skuRepo,
+		esRepo:    es,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// Add 新增同步任务，需要在修改数据的事务中调用
+func (s *EsSyncUsecase) Add(ctx context.Context, task *domain.EsSyncTask) (*domain.EsSyncTask, error) {
+	return s.repo.Create(ctx, task)
+}
+
+// Sync 按数据库中的最新数据同步到 es，成功后删除任务，失败时记录下一次重试时间
+func (s *EsSyncUsecase) Sync(ctx context.Context, task *domain.EsSyncTask) error {
+	err := s.push(ctx, task)
+	if err == nil {
+		return s.repo.Delete(ctx, task.ID)
+	}
+	if e := s.repo.Fail(ctx, task.ID, err.Error(), task.NextRetry(time.Now())); e != nil {
+		s.log.Errorf("record es sync task %d error: %v", task.ID, e)
+	}
+	return err
+}
+
+// RetryDue 重试到期的同步任务，返回同步成功的任务数量
+func (s *EsSyncUsecase) RetryDue(ctx context.Context) (int, error) {
+	list, err := s.repo.ListDue(ctx, time.Now(), 100)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, task := range list {
+		if err := s.Sync(ctx, task); err != nil {
+			s.log.Errorf("es sync task %d (%s goods %d) error: %v", task.ID, task.Kind, task.GoodsID, err)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// push 任务只记录变化的对象，同步时读取当前的值，重复执行和乱序执行的结果都一样
+func (s *EsSyncUsecase) push(ctx context.Context, task *domain.EsSyncTask) error {
+	switch task.Kind {
+	case domain.EsSyncSkuPrice:
+		sku, err := s.skuRepo.GetByID(ctx, task.SkuID)
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return s.esRepo.UpdateSkuPrice(ctx, sku.GoodsID, sku.ID, sku.Price)
+	case domain.EsSyncFavNum:
+		goods, err := s.goodsRepo.GetByID(ctx, task.GoodsID)
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return s.esRepo.UpdateFavNum(ctx, goods.ID, goods.FavNum)
+	}
+	// 未知的任务类型无法同步，直接丢弃
+	s.log.Warnf("unknown es sync task %d kind %s", task.ID, task.Kind)
+	return nil
+}
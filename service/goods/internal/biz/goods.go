@@ -2,10 +2,10 @@ package biz
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"goods/internal/domain"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -19,6 +19,9 @@ import (
 type GoodsRepo interface {
 	CreateGoods(ctx context.Context, goods *domain.Goods) (*domain.Goods, error)
 	GoodsListByIDs(context.Context, ...int64) ([]*domain.Goods, error)
+	GetByID(context.Context, int64) (*domain.Goods, error)
+	CreateFavEvent(context.Context, *domain.FavEvent) (bool, error)
+	IncrFavNum(ctx context.Context, goodsID int64, delta int32) (int64, error)
 }
 
 // GoodsUsecase is a Goods usecase.
@@ -33,6 +36,9 @@ type GoodsUsecase struct {
 	goodsAttrRepo     GoodsAttrRepo
 	inventoryRepo     InventoryRepo
 	esGoodsRepo       EsGoodsRepo
+	mediaRepo         MediaRepo
+	priceRepo         SkuPriceRepo
+	sync              *EsSyncUsecase
 	log               *log.Helper
 }
 
@@ -40,7 +46,7 @@ type GoodsUsecase struct {
 func NewGoodsUsecase(repo GoodsRepo, skuRepo GoodsSkuRepo, tx Transaction,
 	gRepo GoodsTypeRepo, cRepo CategoryRepo, bRepo BrandRepo,
 	sRepo SpecificationRepo, aRepo GoodsAttrRepo, es EsGoodsRepo,
-	iRepo InventoryRepo, logger log.Logger) *GoodsUsecase {
+	iRepo InventoryRepo, mRepo MediaRepo, pRepo SkuPriceRepo, sync *EsSyncUsecase, logger log.Logger) *GoodsUsecase {
 	return &GoodsUsecase{
 		repo:              repo,
 		log:               log.NewHelper(logger),
@@ -53,6 +59,9 @@ func NewGoodsUsecase(repo GoodsRepo, skuRepo GoodsSkuRepo, tx Transaction,
 		goodsAttrRepo:     aRepo,
 		esGoodsRepo:       es, // 新增的 es 的repo
 		inventoryRepo:     iRepo,
+		mediaRepo:         mRepo,
+		priceRepo:         pRepo,
+		sync:              sync,
 	}
 }
 
@@ -78,6 +87,7 @@ func (g GoodsUsecase) CreateGoods(ctx context.Context, r *domain.Goods) (*domain
 		return nil, errors.New("商品类型不存在")
 	}
 	// 判断商品规格和属性是否存在
+	esAttrs := make(map[int64]domain.EsAttr)
 	for _, sku := range r.Sku {
 		var sIDs []*int64
 		for _, info := range sku.Specification {
@@ -110,10 +120,31 @@ func (g GoodsUsecase) CreateGoods(ctx context.Context, r *domain.Goods) (*domain
 				if exist {
 					return nil, errors.New("商品属性不存在")
 				}
+				value := attrList.FindValue(id.AttrID, id.AttrValueID)
+				if value == nil {
+					return nil, errors.New("商品属性值不存在")
+				}
+				// es 中按属性值筛选商品，同一个属性值只记录一次
+				if _, ok := esAttrs[value.ID]; !ok {
+					esAttrs[value.ID] = domain.EsAttr{
+						AttrID:   id.AttrID,
+						AttrName: attrList.FindById(id.AttrID).Title,
+						ValueID:  value.ID,
+						Value:    value.Value,
+					}
+				}
 			}
 		}
 	}
+	esGoods = &domain.ESGoods{}
+	for _, attr := range esAttrs {
+		esGoods.Attrs = append(esGoods.Attrs, attr)
+	}
 	err = g.tr.ExecTx(ctx, func(ctx context.Context) error {
+		// 锁住商品类型，防止同时删除商品类型
+		if err := g.typeRepo.ShareLockByID(ctx, r.TypeID); err != nil {
+			return err
+		}
 		// 更新商品表
 		goods, err = g.repo.CreateGoods(ctx, &domain.Goods{
 			CategoryID:      r.CategoryID,
@@ -136,6 +167,11 @@ func (g GoodsUsecase) CreateGoods(ctx context.Context, r *domain.Goods) (*domain
 		if err != nil {
 			return err
 		}
+		// 记录商品封面图和介绍图的引用
+		err = g.mediaRepo.BindRefs(ctx, domain.MediaRefGoods, goods.ID, append([]string{goods.GoodsFrontImage}, goods.GoodsImages...)...)
+		if err != nil {
+			return err
+		}
 		// 更新商品 SKU 表
 		for _, v := range r.Sku {
 			res := &domain.GoodsSku{
@@ -152,15 +188,36 @@ func (g GoodsUsecase) CreateGoods(ctx context.Context, r *domain.Goods) (*domain
 				Pic:            v.Pic,
 				Inventory:      v.Inventory,
 				OnSale:         v.OnSale,
+				PurchaseLimit:  v.PurchaseLimit,
+			}
+			// 插入 sku 表
+			skuInfo, err := g.skuRepo.Create(ctx, res)
+			if err != nil {
+				return err
 			}
-			goodsAttr, err := json.Marshal(v.GroupAttr)
+
+			// 记录 sku 的初始价格
+			_, err = g.priceRepo.Create(ctx, &domain.SkuPrice{
+				SkuID:          skuInfo.ID,
+				Price:          skuInfo.Price,
+				PromotionPrice: skuInfo.PromotionPrice,
+				EffectiveFrom:  time.Now(),
+				Status:         domain.SkuPriceActive,
+				Remark:         "初始价格",
+			})
 			if err != nil {
 				return err
 			}
-			res.AttrInfo = string(goodsAttr)
 
-			// 插入 sku 表
-			skuInfo, err := g.skuRepo.Create(ctx, res)
+			// 记录 sku 图片的引用
+			err = g.mediaRepo.BindRefs(ctx, domain.MediaRefSku, skuInfo.ID, skuInfo.Pic)
+			if err != nil {
+				return err
+			}
+
+			// 插入商品属性值关联关系表
+			skuInfo.GroupAttr = v.GroupAttr
+			err = g.skuRepo.CreateAttrRelation(ctx, skuInfo.AttrRelation())
 			if err != nil {
 				return err
 			}
@@ -230,3 +287,114 @@ func (g GoodsUsecase) CreateGoods(ctx context.Context, r *domain.Goods) (*domain
 	}
 	return &domain.GoodsInfoResponse{GoodsID: goods.ID}, nil
 }
+
+// SkuList 批量查询 sku 信息，库存以库存表为准，商品下架时 sku 也视为下架
+func (g GoodsUsecase) SkuList(ctx context.Context, ids ...int64) ([]*domain.GoodsSku, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	skus, err := g.skuRepo.ListByIDs(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	if len(skus) == 0 {
+		return nil, nil
+	}
+
+	inventory, err := g.inventoryRepo.ListBySkuIDs(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+	stock := make(map[int64]int64, len(inventory))
+	for _, v := range inventory {
+		stock[v.SkuID] = v.Inventory
+	}
+
+	var goodsIds []int64
+	for _, sku := range skus {
+		goodsIds = append(goodsIds, sku.GoodsID)
+	}
+	goods, err := g.repo.GoodsListByIDs(ctx, goodsIds...)
+	if err != nil {
+		return nil, err
+	}
+	goodsMap := make(map[int64]*domain.Goods, len(goods))
+	for _, v := range goods {
+		goodsMap[v.ID] = v
+	}
+
+	for _, sku := range skus {
+		if v, ok := stock[sku.ID]; ok {
+			sku.Inventory = v
+		}
+		item, ok := goodsMap[sku.GoodsID]
+		sku.OnSale = sku.OnSale && ok && item.OnSale
+		if ok {
+			sku.ShipFree = item.ShipFree
+			sku.ShipID = item.ShipID
+		}
+	}
+	return skus, nil
+}
+
+// UpdateSkuPurchaseLimit 修改 sku 每个用户的限购数量，0 为不限购
+func (g GoodsUsecase) UpdateSkuPurchaseLimit(ctx context.Context, skuID int64, limit int32) error {
+	if limit < 0 {
+		return errors.New("限购数量不能小于0")
+	}
+	return g.skuRepo.UpdatePurchaseLimit(ctx, skuID, limit)
+}
+
+// BatchGetGoods 批量查询商品信息
+func (g GoodsUsecase) BatchGetGoods(ctx context.Context, ids ...int64) ([]*domain.Goods, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return g.repo.GoodsListByIDs(ctx, ids...)
+}
+
+// GoodsDetail 商品详情
+func (g GoodsUsecase) GoodsDetail(ctx context.Context, id int64) (*domain.Goods, error) {
+	return g.repo.GetByID(ctx, id)
+}
+
+// GoodsSkuList 商品下的所有 sku，库存和上架状态与 SkuList 一致
+func (g GoodsUsecase) GoodsSkuList(ctx context.Context, goodsID int64) ([]*domain.GoodsSku, error) {
+	if _, err := g.repo.GetByID(ctx, goodsID); err != nil {
+		return nil, err
+	}
+	ids, err := g.skuRepo.IDsByGoodsID(ctx, goodsID)
+	if err != nil {
+		return nil, err
+	}
+	return g.SkuList(ctx, ids...)
+}
+
+// ApplyFavEvents 处理收藏数变化的事件，已经处理过的事件跳过，收藏数变化后同步到 es
+func (g GoodsUsecase) ApplyFavEvents(ctx context.Context, events []*domain.FavEvent) error {
+	for _, e := range events {
+		var task *domain.EsSyncTask
+		err := g.tr.ExecTx(ctx, func(ctx context.Context) error {
+			applied, err := g.repo.CreateFavEvent(ctx, e)
+			if err != nil || !applied {
+				return err
+			}
+			if _, err = g.repo.IncrFavNum(ctx, e.GoodsID, e.Delta); err != nil {
+				return err
+			}
+			task, err = g.sync.Add(ctx, &domain.EsSyncTask{Kind: domain.EsSyncFavNum, GoodsID: e.GoodsID})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if task == nil {
+			continue
+		}
+		if err := g.sync.Sync(ctx, task); err != nil {
+			// 任务已经落库，由定时任务继续重试
+			g.log.Warnf("sync goods %d fav num to es error, will retry: %v", e.GoodsID, err)
+		}
+	}
+	return nil
+}
@@ -10,6 +10,12 @@ import (
 type GoodsSkuRepo interface {
 	Create(context.Context, *domain.GoodsSku) (*domain.GoodsSku, error)
 	CreateSkuRelation(context.Context, []*domain.GoodsSpecificationSku) error
+	CreateAttrRelation(context.Context, []*domain.GoodsAttrSku) error
+	GetByID(context.Context, int64) (*domain.GoodsSku, error)
+	ListByIDs(context.Context, ...int64) ([]*domain.GoodsSku, error)
+	IDsByGoodsID(context.Context, int64) ([]int64, error)
+	UpdatePrice(ctx context.Context, id, price, promotionPrice int64) error
+	UpdatePurchaseLimit(ctx context.Context, id int64, limit int32) error
 }
 
 type GoodsSkuUsecase struct {
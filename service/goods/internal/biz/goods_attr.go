@@ -15,6 +15,9 @@ type GoodsAttrRepo interface {
 	CreateGoodsAttrValue(context.Context, []*domain.GoodsAttrValue) ([]*domain.GoodsAttrValue, error)
 	GetAttrByIDs(ctx context.Context, id []*int64) error
 	ListByIds(ctx context.Context, id ...int64) (domain.GoodsAttrList, error)
+	ListGroupByTypeID(context.Context, int64) ([]*domain.AttrGroup, error)
+	ListByTypeID(context.Context, int64) (domain.GoodsAttrList, error)
+	DeleteByTypeID(context.Context, int64) error
 }
 
 type GoodsAttrUsecase struct {
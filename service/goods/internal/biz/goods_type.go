@@ -13,20 +13,34 @@ type GoodsTypeRepo interface {
 	CreateGoodsBrandType(context.Context, int64, string) error
 	GetGoodsTypeByID(context.Context, int64) (*domain.GoodsType, error)
 	IsExistsByID(context.Context, int64) (*domain.GoodsType, error)
+	UpdateGoodsType(context.Context, *domain.GoodsTypeUpdate) error
+	DeleteGoodsType(context.Context, int64) error
+	DeleteGoodsBrandType(context.Context, int64) error
+	List(context.Context, *Pagination) ([]*domain.GoodsType, int64, error)
+	ListBrandIdsByTypeID(context.Context, int64) ([]int32, error)
+	// IsUsedByGoods 需要在事务中调用，会锁住该类型的商品，事务结束前不能新增该类型的商品
+	IsUsedByGoods(context.Context, int64) (bool, error)
+	// ShareLockByID 在事务中给商品类型加共享锁，防止新增商品时商品类型被删除
+	ShareLockByID(context.Context, int64) error
 }
 
 type GoodsTypeUsecase struct {
 	repo  GoodsTypeRepo
 	bRepo BrandRepo
+	sRepo SpecificationRepo // 规格的 repo，用来加载商品类型模板
+	aRepo GoodsAttrRepo     // 属性的 repo，用来加载商品类型模板
 	tx    Transaction
 	log   *log.Helper
 }
 
-func NewGoodsTypeUsecase(repo GoodsTypeRepo, tx Transaction, BrandUc BrandRepo, logger log.Logger) *GoodsTypeUsecase {
+func NewGoodsTypeUsecase(repo GoodsTypeRepo, tx Transaction, BrandUc BrandRepo, sRepo SpecificationRepo,
+	aRepo GoodsAttrRepo, logger log.Logger) *GoodsTypeUsecase {
 	return &GoodsTypeUsecase{
 		repo:  repo,
 		tx:    tx,
 		bRepo: BrandUc,
+		sRepo: sRepo,
+		aRepo: aRepo,
 		log:   log.NewHelper(logger),
 	}
 }
@@ -69,3 +83,113 @@ func (gt *GoodsTypeUsecase) CreateGoodsType(ctx context.Context, r *domain.Goods
 	})
 	return id, err
 }
+
+// 商品类型列表
+func (gt *GoodsTypeUsecase) GoodsTypeList(ctx context.Context, p *Pagination) ([]*domain.GoodsType, int64, error) {
+	return gt.repo.List(ctx, p)
+}
+
+// 修改商品类型，传入品牌时重新绑定品牌
+func (gt *GoodsTypeUsecase) UpdateGoodsType(ctx context.Context, r *domain.GoodsTypeUpdate) error {
+	if _, err := gt.repo.IsExistsByID(ctx, r.ID); err != nil {
+		return err
+	}
+
+	var ids []int32
+	if r.BrandIds != nil {
+		i, err := (&domain.GoodsType{BrandIds: *r.BrandIds}).FormatBrandIds()
+		if err != nil {
+			return errors.BadRequest("BRAND_IDS_INVALID", "品牌ID格式错误")
+		}
+		if len(i) == 0 {
+			return errors.BadRequest("TYPE_IS_EMPTY", "请选择品牌进行绑定")
+		}
+		brand, err := gt.bRepo.ListByIds(ctx, i...)
+		if err != nil {
+			return err
+		}
+		if !brand.CheckLength(len(i)) {
+			return errors.BadRequest("BRAND_IS_EMPTY", "品牌不存在")
+		}
+		ids = i
+	}
+
+	return gt.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := gt.repo.UpdateGoodsType(ctx, r); err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		// 重新绑定商品类型和品牌的关联关系
+		if err := gt.repo.DeleteGoodsBrandType(ctx, r.ID); err != nil {
+			return err
+		}
+		return gt.repo.CreateGoodsBrandType(ctx, r.ID, *r.BrandIds)
+	})
+}
+
+// 删除商品类型，同时删除绑定的品牌、规格以及属性。是否被商品使用在删除的事务中检查，
+// 检查时锁住该类型的商品，避免检查之后新增的商品引用已经删除的类型
+func (gt *GoodsTypeUsecase) DeleteGoodsType(ctx context.Context, id int64) error {
+	if _, err := gt.repo.IsExistsByID(ctx, id); err != nil {
+		return err
+	}
+
+	return gt.tx.ExecTx(ctx, func(ctx context.Context) error {
+		used, err := gt.repo.IsUsedByGoods(ctx, id)
+		if err != nil {
+			return err
+		}
+		if used {
+			return errors.BadRequest("TYPE_IS_USED", "商品类型已被商品使用，无法删除")
+		}
+		if err := gt.repo.DeleteGoodsBrandType(ctx, id); err != nil {
+			return err
+		}
+		if err := gt.sRepo.DeleteByTypeID(ctx, id); err != nil {
+			return err
+		}
+		if err := gt.aRepo.DeleteByTypeID(ctx, id); err != nil {
+			return err
+		}
+		return gt.repo.DeleteGoodsType(ctx, id)
+	})
+}
+
+// 获取商品类型模板
+func (gt *GoodsTypeUsecase) GetGoodsTypeTemplate(ctx context.Context, id int64) (*domain.GoodsTypeTemplate, error) {
+	goodsType, err := gt.repo.IsExistsByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &domain.GoodsTypeTemplate{GoodsType: goodsType}
+
+	brandIds, err := gt.repo.ListBrandIdsByTypeID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(brandIds) > 0 {
+		res.Brands, err = gt.bRepo.ListByIds(ctx, brandIds...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res.Specifications, err = gt.sRepo.ListByTypeID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := gt.aRepo.ListGroupByTypeID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := gt.aRepo.ListByTypeID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res.AttrGroups = attrs.GroupBy(groups)
+	return res, nil
+}
@@ -9,6 +9,7 @@ import (
 
 type InventoryRepo interface {
 	Create(context.Context, *domain.Inventory) (*domain.Inventory, error)
+	ListBySkuIDs(context.Context, ...int64) ([]*domain.Inventory, error)
 }
 
 type InventoryUsecase struct {
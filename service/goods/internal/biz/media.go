@@ -0,0 +1,202 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"goods/internal/conf"
+	"goods/internal/domain"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MediaStorage 媒体文件的存储，可以替换为本地、oss 等不同的实现
+type MediaStorage interface {
+	Put(ctx context.Context, path string, content []byte) error
+	Delete(ctx context.Context, path string) error
+	URL(path string) string
+}
+
+type MediaRepo interface {
+	Create(context.Context, *domain.Media) (*domain.Media, error)
+	GetByHash(context.Context, string) (*domain.Media, error)
+	BindRefs(ctx context.Context, refType string, refID int64, urls ...string) error
+	ListOrphans(ctx context.Context, before time.Time, limit int) ([]*domain.Media, error)
+	// DeleteOrphan 图片仍然没有被引用时删除记录，返回是否删除
+	DeleteOrphan(context.Context, int64) (bool, error)
+}
+
+// 上传图片默认的最大宽高，解码后的图片按每像素 4 字节占用内存
+const (
+	defaultMediaMaxWidth  = 4096
+	defaultMediaMaxHeight = 4096
+)
+
+type MediaUsecase struct {
+	repo      MediaRepo
+	storage   MediaStorage
+	maxWidth  int
+	maxHeight int
+	log       *log.Helper
+}
+
+func NewMediaUsecase(repo MediaRepo, storage MediaStorage, c *conf.Data, logger log.Logger) *MediaUsecase {
+	m := &MediaUsecase{
+		repo:      repo,
+		storage:   storage,
+		maxWidth:  int(c.GetMedia().GetMaxWidth()),
+		maxHeight: int(c.GetMedia().GetMaxHeight()),
+		log:       log.NewHelper(logger),
+	}
+	if m.maxWidth <= 0 {
+		m.maxWidth = defaultMediaMaxWidth
+	}
+	if m.maxHeight <= 0 {
+		m.maxHeight = defaultMediaMaxHeight
+	}
+	return m
+}
+
+// Upload 上传图片，校验类型和大小，根据内容 hash 去重并生成缩略图
+func (m *MediaUsecase) Upload(ctx context.Context, content []byte) (*domain.Media, error) {
+	if len(content) == 0 {
+		return nil, errors.BadRequest("MEDIA_IS_EMPTY", "请选择上传的图片")
+	}
+	if len(content) > domain.MaxMediaSize {
+		return nil, errors.BadRequest("MEDIA_TOO_LARGE", "图片大小不能超过3M")
+	}
+	contentType, ext := domain.DetectMediaType(content)
+	if contentType == "" {
+		return nil, errors.BadRequest("MEDIA_TYPE_NOT_ALLOWED", "只支持上传 jpg、png、gif 格式的图片")
+	}
+	// 先只读取图片头中的宽高，避免解码声明了超大尺寸的图片时占满内存
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, errors.BadRequest("MEDIA_DECODE_ERROR", "图片解析失败")
+	}
+	if cfg.Width > m.maxWidth || cfg.Height > m.maxHeight {
+		return nil, errors.BadRequest("MEDIA_DIMENSION_TOO_LARGE",
+			fmt.Sprintf("图片尺寸不能超过 %dx%d", m.maxWidth, m.maxHeight))
+	}
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, errors.BadRequest("MEDIA_DECODE_ERROR", "图片解析失败")
+	}
+
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+
+	// 相同内容的图片直接返回已经上传的记录
+	media, err := m.repo.GetByHash(ctx, hash)
+	if err == nil {
+		return media, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	media = &domain.Media{
+		Hash:        hash,
+		Path:        domain.MediaPath(hash, ext),
+		ThumbPath:   domain.ThumbPath(hash),
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		Width:       int32(img.Bounds().Dx()),
+		Height:      int32(img.Bounds().Dy()),
+	}
+	media.URL = m.storage.URL(media.Path)
+	media.ThumbURL = m.storage.URL(media.ThumbPath)
+
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, thumbnail(img, domain.ThumbnailWidth), &jpeg.Options{Quality: 85}); err != nil {
+		return nil, errors.InternalServer("MEDIA_THUMB_ERROR", err.Error())
+	}
+	if err := m.storage.Put(ctx, media.Path, content); err != nil {
+		return nil, err
+	}
+	if err := m.storage.Put(ctx, media.ThumbPath, thumb.Bytes()); err != nil {
+		m.removeFiles(ctx, media.Path)
+		return nil, err
+	}
+
+	res, err := m.repo.Create(ctx, media)
+	if err != nil {
+		// 并发上传相同图片时唯一索引冲突，返回先写入的记录，文件路径相同不能删除
+		if exist, e := m.repo.GetByHash(ctx, hash); e == nil {
+			return exist, nil
+		}
+		m.removeFiles(ctx, media.Path, media.ThumbPath)
+		return nil, err
+	}
+	return res, nil
+}
+
+// removeFiles 清理上传失败时已经写入的文件，删除失败只记录日志
+func (m *MediaUsecase) removeFiles(ctx context.Context, paths ...string) {
+	for _, path := range paths {
+		if err := m.storage.Delete(ctx, path); err != nil {
+			m.log.Errorf("delete media file %s error: %v", path, err)
+		}
+	}
+}
+
+// GC 清理没有被引用的图片，grace 时间内上传的图片不清理，避免删除刚上传还没保存商品的图片。
+// 先删除记录再删除文件，删除记录时再次确认没有被引用，删除之后重新上传了相同内容的图片时保留文件
+func (m *MediaUsecase) GC(ctx context.Context, grace time.Duration) (int64, error) {
+	if grace <= 0 {
+		grace = 24 * time.Hour
+	}
+	list, err := m.repo.ListOrphans(ctx, time.Now().Add(-grace), 500)
+	if err != nil {
+		return 0, err
+	}
+
+	var removed int64
+	for _, media := range list {
+		deleted, err := m.repo.DeleteOrphan(ctx, media.ID)
+		if err != nil {
+			return removed, err
+		}
+		if !deleted {
+			continue
+		}
+		removed++
+		if _, err := m.repo.GetByHash(ctx, media.Hash); err == nil {
+			continue
+		}
+		m.removeFiles(ctx, media.Path, media.ThumbPath)
+	}
+	return removed, nil
+}
+
+// thumbnail 按宽度等比缩放图片，图片宽度小于缩略图宽度时保持原图大小
+func thumbnail(src image.Image, width int) image.Image {
+	b := src.Bounds()
+	if b.Dx() <= width {
+		dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Src)
+		return dst
+	}
+	height := b.Dy() * width / b.Dx()
+	if height == 0 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		sy := b.Min.Y + y*b.Dy()/height
+		for x := 0; x < width; x++ {
+			sx := b.Min.X + x*b.Dx()/width
+			dst.Set(x, y, src.At(sx, sy))
+		}
+	}
+	return dst
+}
@@ -0,0 +1,134 @@
+package biz
+
+import (
+	"context"
+	"goods/internal/domain"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type SkuPriceRepo interface {
+	Create(context.Context, *domain.SkuPrice) (*domain.SkuPrice, error)
+	PriceAt(ctx context.Context, skuID int64, t time.Time) (*domain.SkuPrice, error)
+	ListBySkuID(context.Context, int64) ([]*domain.SkuPrice, error)
+	ListDueSkuIDs(ctx context.Context, now time.Time) ([]int64, error)
+	MarkDue(ctx context.Context, skuID int64, now time.Time) error
+}
+
+type SkuPriceUsecase struct {
+	repo    SkuPriceRepo
+	skuRepo GoodsSkuRepo
+	sync    *EsSyncUsecase
+	tx      Transaction
+	log     *log.Helper
+}
+
+func NewSkuPriceUsecase(repo SkuPriceRepo, skuRepo GoodsSkuRepo, sync *EsSyncUsecase, tx Transaction,
+	logger log.Logger) *SkuPriceUsecase {
+	return &SkuPriceUsecase{
+		repo:    repo,
+		skuRepo: skuRepo,
+		sync:    sync,
+		tx:      tx,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// ChangePrice 新增价格变更，没有开始时间或者开始时间已经过去的从当前时间立即生效，
+// 不改写已经过去的时间点的价格，否则等待定时任务生效
+func (s *SkuPriceUsecase) ChangePrice(ctx context.Context, r *domain.SkuPrice) (*domain.SkuPrice, error) {
+	now := time.Now()
+	if r.EffectiveFrom.Before(now) {
+		r.EffectiveFrom = now
+	}
+	if r.IsPriceInvalid() {
+		return nil, errors.BadRequest("SKU_PRICE_INVALID", "售价必须大于0且促销价不能高于售价")
+	}
+	if r.IsWindowInvalid() {
+		return nil, errors.BadRequest("SKU_PRICE_WINDOW_INVALID", "结束时间必须晚于开始时间")
+	}
+	if r.EffectiveUntil != nil && !r.EffectiveUntil.After(now) {
+		return nil, errors.BadRequest("SKU_PRICE_WINDOW_INVALID", "结束时间必须晚于当前时间")
+	}
+	if _, err := s.skuRepo.GetByID(ctx, r.SkuID); err != nil {
+		return nil, err
+	}
+
+	r.Status = domain.SkuPricePending
+	price, err := s.repo.Create(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if !price.EffectiveFrom.After(now) {
+		if err := s.apply(ctx, r.SkuID, now); err != nil {
+			return nil, err
+		}
+		price.Status = domain.SkuPriceActive
+	}
+	return price, nil
+}
+
+// ApplyDue 应用所有到期的价格变更，返回价格发生变化的 sku 数量
+func (s *SkuPriceUsecase) ApplyDue(ctx context.Context) (int, error) {
+	now := time.Now()
+	ids, err := s.repo.ListDueSkuIDs(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, id := range ids {
+		if err := s.apply(ctx, id, now); err != nil {
+			s.log.Errorf("apply sku %d price error: %v", id, err)
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// PriceAt 查询某个时间点 sku 有效的价格
+func (s *SkuPriceUsecase) PriceAt(ctx context.Context, skuID int64, t time.Time) (*domain.SkuPrice, error) {
+	return s.repo.PriceAt(ctx, skuID, t)
+}
+
+// History sku 的价格变更记录
+func (s *SkuPriceUsecase) History(ctx context.Context, skuID int64) ([]*domain.SkuPrice, error) {
+	return s.repo.ListBySkuID(ctx, skuID)
+}
+
+// apply 更新到期记录的状态，并将 sku 价格修改为当前有效的价格，同时写入 es 同步任务
+func (s *SkuPriceUsecase) apply(ctx context.Context, skuID int64, now time.Time) error {
+	var task *domain.EsSyncTask
+	err := s.tx.ExecTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.MarkDue(ctx, skuID, now); err != nil {
+			return err
+		}
+		price, err := s.repo.PriceAt(ctx, skuID, now)
+		if errors.IsNotFound(err) {
+			// 没有有效的价格记录时保留 sku 当前价格
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		sku, err := s.skuRepo.GetByID(ctx, skuID)
+		if err != nil {
+			return err
+		}
+		if err = s.skuRepo.UpdatePrice(ctx, skuID, price.Price, price.PromotionPrice); err != nil {
+			return err
+		}
+		task, err = s.sync.Add(ctx, &domain.EsSyncTask{Kind: domain.EsSyncSkuPrice, GoodsID: sku.GoodsID, SkuID: skuID})
+		return err
+	})
+	if err != nil || task == nil {
+		return err
+	}
+	if err := s.sync.Sync(ctx, task); err != nil {
+		// 任务已经落库，由定时任务继续重试
+		s.log.Warnf("sync sku %d price to es error, will retry: %v", skuID, err)
+	}
+	return nil
+}
@@ -12,6 +12,8 @@ type SpecificationRepo interface {
 	CreateSpecification(context.Context, *domain.Specification) (int64, error)
 	CreateSpecificationValue(context.Context, int64, []*domain.SpecificationValue) error
 	ListByIds(ctx context.Context, id ...*int64) (domain.SpecificationList, error)
+	ListByTypeID(context.Context, int64) (domain.SpecificationList, error)
+	DeleteByTypeID(context.Context, int64) error
 }
 type SpecificationUsecase struct {
 	repo  SpecificationRepo
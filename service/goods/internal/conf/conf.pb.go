@@ -155,6 +155,7 @@ type Data struct {
 	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
 	Elastic       *Data_Elastic          `protobuf:"bytes,3,opt,name=elastic,proto3" json:"elastic,omitempty"`
+	Media         *Data_Media            `protobuf:"bytes,4,opt,name=media,proto3" json:"media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +211,13 @@ func (x *Data) GetElastic() *Data_Elastic {
 	return nil
 }
 
+func (x *Data) GetMedia() *Data_Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
 type Service struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *Service_User          `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -702,6 +710,75 @@ func (x *Data_Elastic) GetAddr() string {
 	return ""
 }
 
+// 媒体文件存储，root 为本地存储目录，base_url 为对外访问地址
+type Data_Media struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	BaseUrl       string                 `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	MaxWidth      int32                  `protobuf:"varint,3,opt,name=max_width,json=maxWidth,proto3" json:"max_width,omitempty"`    // 上传图片的最大宽度，默认 4096
+	MaxHeight     int32                  `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"` // 上传图片的最大高度，默认 4096
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Media) Reset() {
+	*x = Data_Media{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Media) ProtoMessage() {}
+
+func (x *Data_Media) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Media.ProtoReflect.Descriptor instead.
+func (*Data_Media) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Data_Media) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *Data_Media) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+func (x *Data_Media) GetMaxWidth() int32 {
+	if x != nil {
+		return x.MaxWidth
+	}
+	return 0
+}
+
+func (x *Data_Media) GetMaxHeight() int32 {
+	if x != nil {
+		return x.MaxHeight
+	}
+	return 0
+}
+
 type Service_User struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
@@ -711,7 +788,7 @@ type Service_User struct {
 
 func (x *Service_User) Reset() {
 	*x = Service_User{}
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -723,7 +800,7 @@ func (x *Service_User) String() string {
 func (*Service_User) ProtoMessage() {}
 
 func (x *Service_User) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[12]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +832,7 @@ type Service_Goods struct {
 
 func (x *Service_Goods) Reset() {
 	*x = Service_Goods{}
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -767,7 +844,7 @@ func (x *Service_Goods) String() string {
 func (*Service_Goods) ProtoMessage() {}
 
 func (x *Service_Goods) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[13]
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +877,7 @@ type Registry_Consul struct {
 
 func (x *Registry_Consul) Reset() {
 	*x = Registry_Consul{}
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -812,7 +889,7 @@ func (x *Registry_Consul) String() string {
 func (*Registry_Consul) ProtoMessage() {}
 
 func (x *Registry_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[14]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,11 +941,12 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x04GRPC\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\x9a\x04\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\"\xbc\x05\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
 	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x122\n" +
-	"\aelastic\x18\x03 \x01(\v2\x18.kratos.api.Data.ElasticR\aelastic\x1a:\n" +
+	"\aelastic\x18\x03 \x01(\v2\x18.kratos.api.Data.ElasticR\aelastic\x12,\n" +
+	"\x05media\x18\x04 \x01(\v2\x16.kratos.api.Data.MediaR\x05media\x1a:\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
 	"\x06source\x18\x02 \x01(\tR\x06source\x1a\x9d\x02\n" +
@@ -881,7 +959,13 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\fread_timeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
 	"\rwrite_timeout\x18\a \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\x1d\n" +
 	"\aElastic\x12\x12\n" +
-	"\x04addr\x18\x01 \x01(\tR\x04addr\"\xb1\x01\n" +
+	"\x04addr\x18\x01 \x01(\tR\x04addr\x1ar\n" +
+	"\x05Media\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\x12\x1b\n" +
+	"\tmax_width\x18\x03 \x01(\x05R\bmaxWidth\x12\x1d\n" +
+	"\n" +
+	"max_height\x18\x04 \x01(\x05R\tmaxHeight\"\xb1\x01\n" +
 	"\aService\x12,\n" +
 	"\x04user\x18\x01 \x01(\v2\x18.kratos.api.Service.UserR\x04user\x12/\n" +
 	"\x05goods\x18\x02 \x01(\v2\x19.kratos.api.Service.GoodsR\x05goods\x1a\"\n" +
@@ -911,7 +995,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -925,10 +1009,11 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Data_Database)(nil),       // 9: kratos.api.Data.Database
 	(*Data_Redis)(nil),          // 10: kratos.api.Data.Redis
 	(*Data_Elastic)(nil),        // 11: kratos.api.Data.Elastic
-	(*Service_User)(nil),        // 12: kratos.api.Service.User
-	(*Service_Goods)(nil),       // 13: kratos.api.Service.Goods
-	(*Registry_Consul)(nil),     // 14: kratos.api.Registry.Consul
-	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
+	(*Data_Media)(nil),          // 12: kratos.api.Data.Media
+	(*Service_User)(nil),        // 13: kratos.api.Service.User
+	(*Service_Goods)(nil),       // 14: kratos.api.Service.Goods
+	(*Registry_Consul)(nil),     // 15: kratos.api.Registry.Consul
+	(*durationpb.Duration)(nil), // 16: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -941,19 +1026,20 @@ var file_conf_conf_proto_depIdxs = []int32{
 	9,  // 7: kratos.api.Data.database:type_name -> kratos.api.Data.Database
 	10, // 8: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
 	11, // 9: kratos.api.Data.elastic:type_name -> kratos.api.Data.Elastic
-	12, // 10: kratos.api.Service.user:type_name -> kratos.api.Service.User
-	13, // 11: kratos.api.Service.goods:type_name -> kratos.api.Service.Goods
-	14, // 12: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
-	15, // 13: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	15, // 14: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	15, // 15: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	15, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	15, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	12, // 10: kratos.api.Data.media:type_name -> kratos.api.Data.Media
+	13, // 11: kratos.api.Service.user:type_name -> kratos.api.Service.User
+	14, // 12: kratos.api.Service.goods:type_name -> kratos.api.Service.Goods
+	15, // 13: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
+	16, // 14: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	16, // 15: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	16, // 16: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	16, // 17: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	16, // 18: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -967,7 +1053,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
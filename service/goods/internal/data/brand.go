@@ -137,6 +137,7 @@ func (r *BrandRepo) List(ctx context.Context, b *biz.Pagination) ([]*domain.Bran
 			ID:    v.ID,
 			Name:  v.Name,
 			Logo:  v.Logo,
+			Desc:  v.Desc,
 			IsTab: v.IsTab,
 			Sort:  v.Sort,
 		}
@@ -185,3 +186,21 @@ func (r *BrandRepo) ListByIds(ctx context.Context, ids ...int32) (domain.BrandLi
 	}
 	return res, nil
 }
+
+func (r *BrandRepo) Delete(ctx context.Context, id int32) error {
+	var count int64
+	if err := r.data.DB(ctx).Model(&Goods{}).Where("brands_id = ?", id).Count(&count).Error; err != nil {
+		return errors.InternalServer("DELETE_BRAND_ERROR", err.Error())
+	}
+	if count > 0 {
+		return errors.BadRequest("BRAND_IN_USE", "品牌下还有商品，不能删除")
+	}
+	res := r.data.DB(ctx).Delete(&Brand{}, id)
+	if res.Error != nil {
+		return errors.InternalServer("DELETE_BRAND_ERROR", res.Error.Error())
+	}
+	if res.RowsAffected == 0 {
+		return errors.NotFound("BRAND_NOT_FOUND", "brand not found")
+	}
+	return nil
+}
@@ -30,6 +30,10 @@ var ProviderSet = wire.NewSet(NewData, NewDB, NewTransaction, NewRedis, NewElast
 	NewEsGoodsRepo,
 	NewGoodsSkuRepoRepo,
 	NewInventoryRepo,
+	NewMediaRepo,
+	NewMediaStorage,
+	NewSkuPriceRepo,
+	NewEsSyncRepo,
 )
 
 // Data .
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"goods/internal/data"
 	"log"
 	"os"
 	"time"
 
+	"github.com/olivere/elastic/v7"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -14,6 +16,7 @@ import (
 
 // 链接数据库
 func main() {
+	esAddr := "http://127.0.0.1:9200"
 	dsn := "root:123456@tcp(127.0.0.1:3306)/lushop_goods?charset=utf8mb4&parseTime=True&loc=Local"
 	newLogger := logger.New(
 		log.New(os.Stdout, "\r\n", log.LstdFlags), // io writer
@@ -49,6 +52,37 @@ func main() {
 		&data.GoodsSku{},
 		// &data.GoodsImages{},
 		&data.GoodsSpecificationSku{},
+		&data.GoodsAttrSku{},
 		&data.GoodsInventory{},
+		&data.Media{},
+		&data.MediaRef{},
+		&data.GoodsSkuPrice{},
+		&data.GoodsFavEvent{},
+		&data.GoodsEsSync{},
 	)
+
+	// 将 sku 表中的属性 JSON 迁移到商品属性关联表
+	count, err := data.BackfillGoodsAttrSku(db)
+	if err != nil {
+		panic(err)
+	}
+	log.Printf("backfill goods_attr_sku: %d sku migrated", count)
+
+	// 为已有的 sku 补充初始价格记录
+	priceCount, err := data.BackfillGoodsSkuPrice(db)
+	if err != nil {
+		panic(err)
+	}
+	log.Printf("backfill goods_sku_price: %d sku migrated", priceCount)
+
+	// 为已有的商品索引补充 attrs mapping 和属性数据
+	es, err := elastic.NewClient(elastic.SetURL(esAddr), elastic.SetSniff(false))
+	if err != nil {
+		panic(err)
+	}
+	attrsCount, err := data.BackfillEsGoodsAttrs(context.Background(), db, es)
+	if err != nil {
+		panic(err)
+	}
+	log.Printf("backfill es goods attrs: %d goods reindexed", attrsCount)
 }
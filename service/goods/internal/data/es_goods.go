@@ -3,14 +3,44 @@ package data
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"goods/internal/biz"
 	"goods/internal/domain"
 	"strconv"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/olivere/elastic/v7"
+	"gorm.io/gorm"
 )
 
+// goodsIndexName 商品索引名
+const goodsIndexName = "goods"
+
+// attrsMapping 商品属性筛选用的 nested 字段，已存在的索引通过 PutMapping 追加
+const attrsMapping = `
+{
+    "properties": {
+        "attrs": {
+            "type": "nested",
+            "properties": {
+                "attr_id": {
+                    "type": "integer"
+                },
+                "attr_name": {
+                    "type": "keyword",
+                    "index": false
+                },
+                "value_id": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "keyword"
+                }
+            }
+        }
+    }
+}`
+
 type esGoodsRepo struct {
 	data *Data
 	log  *log.Helper
@@ -26,7 +56,7 @@ func NewEsGoodsRepo(data *Data, logger log.Logger) biz.EsGoodsRepo {
 
 // GetIndexName 设计商品的索引 goods
 func (esGoodsRepo) GetIndexName() string {
-	return "goods"
+	return goodsIndexName
 }
 
 // GetMapping 设计商品的 mapping 结构
@@ -109,6 +139,24 @@ func (esGoodsRepo) GetMapping() string {
 				"sku_price": {
 					"type": "integer",
 				},
+			},
+			"attrs": {
+				"type": "nested",
+				"properties": {
+					"attr_id": {
+						"type": "integer"
+					},
+					"attr_name": {
+						"type": "keyword",
+						"index": false
+					},
+					"value_id": {
+						"type": "integer"
+					},
+					"value": {
+						"type": "keyword"
+					}
+				}
 			}
         }
     }
@@ -160,6 +208,7 @@ func (p esGoodsRepo) InsertEsGoods(ctx context.Context, esModel *domain.ESGoods)
 			return err
 		}
 	}
+	// 已经存在的旧索引由 BackfillEsGoodsAttrs 补充 attrs 字段的 mapping
 	// 新增索引
 	_, err = p.data.esClient.Index().Index(p.GetIndexName()).BodyJson(esModel).Id(strconv.Itoa(int(esModel.ID))).Do(ctx)
 	if err != nil {
@@ -172,3 +221,101 @@ func (p esGoodsRepo) InsertEsGoods(ctx context.Context, esModel *domain.ESGoods)
 	// }
 	return nil
 }
+
+// UpdateSkuPrice 更新商品索引中 sku 的价格
+func (p esGoodsRepo) UpdateSkuPrice(ctx context.Context, goodsID, skuID, price int64) error {
+	script := elastic.NewScript(`for (s in ctx._source.sku) { if (s.sku_id == params.sku_id) { s.sku_price = params.price } }`).
+		Params(map[string]interface{}{"sku_id": skuID, "price": price})
+	_, err := p.data.esClient.Update().Index(p.GetIndexName()).Id(strconv.Itoa(int(goodsID))).Script(script).Do(ctx)
+	if err != nil && !elastic.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// UpdateFavNum 更新商品索引中的收藏数
+func (p esGoodsRepo) UpdateFavNum(ctx context.Context, goodsID, favNum int64) error {
+	_, err := p.data.esClient.Update().Index(p.GetIndexName()).Id(strconv.Itoa(int(goodsID))).
+		Doc(map[string]interface{}{"fav_num": favNum}).Do(ctx)
+	if err != nil && !elastic.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// BackfillEsGoodsAttrs 为已存在的商品索引追加 attrs mapping，
+// 并按 goods_attr_sku 关联关系补写已有商品文档的 attrs 字段，可以重复执行
+func BackfillEsGoodsAttrs(ctx context.Context, db *gorm.DB, es *elastic.Client) (int, error) {
+	exists, err := es.IndexExists(goodsIndexName).Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		// 索引不存在时由 InsertEsGoods 按完整 mapping 创建
+		return 0, nil
+	}
+	if _, err = es.PutMapping().Index(goodsIndexName).BodyString(attrsMapping).Do(ctx); err != nil {
+		return 0, err
+	}
+
+	var rows []struct {
+		GoodsID  int64
+		AttrID   int64
+		AttrName string
+		ValueID  int64
+		Value    string
+	}
+	err = db.Table("goods_attr_sku AS s").
+		Select("DISTINCT s.goods_id, s.attr_id, a.title AS attr_name, s.value_id, v.value").
+		Joins("JOIN goods_attr AS a ON a.id = s.attr_id").
+		Joins("JOIN goods_attr_value AS v ON v.id = s.value_id").
+		Where("s.deleted_at IS NULL").
+		Order("s.goods_id, s.value_id").
+		Scan(&rows).Error
+	if err != nil {
+		return 0, err
+	}
+
+	// 同一个属性值只记录一次，与 CreateGoods 写入索引时保持一致
+	attrs := make(map[int64][]domain.EsAttr)
+	var goodsIDs []int64
+	for _, r := range rows {
+		if _, ok := attrs[r.GoodsID]; !ok {
+			goodsIDs = append(goodsIDs, r.GoodsID)
+		}
+		attrs[r.GoodsID] = append(attrs[r.GoodsID], domain.EsAttr{
+			AttrID:   r.AttrID,
+			AttrName: r.AttrName,
+			ValueID:  r.ValueID,
+			Value:    r.Value,
+		})
+	}
+
+	var total int
+	for start := 0; start < len(goodsIDs); start += 100 {
+		end := start + 100
+		if end > len(goodsIDs) {
+			end = len(goodsIDs)
+		}
+		bulk := es.Bulk().Index(goodsIndexName)
+		for _, id := range goodsIDs[start:end] {
+			bulk.Add(elastic.NewBulkUpdateRequest().Id(strconv.Itoa(int(id))).
+				Doc(map[string]interface{}{"attrs": attrs[id]}))
+		}
+		res, err := bulk.Do(ctx)
+		if err != nil {
+			return total, err
+		}
+		for _, item := range res.Updated() {
+			switch {
+			case item.Error == nil:
+				total++
+			case item.Status == 404:
+				// 商品还没有写入索引，跳过
+			default:
+				return total, fmt.Errorf("goods %s update attrs error: %s", item.Id, item.Error.Reason)
+			}
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"goods/internal/biz"
+	"goods/internal/domain"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// GoodsEsSync 等待同步到 es 的任务表
+type GoodsEsSync struct {
+	ID          int64     `gorm:"primarykey;type:int"`
+	Kind        string    `gorm:"type:varchar(20);comment:同步类型;not null"`
+	GoodsID     int64     `gorm:"type:int;index:goods_id;comment:商品ID;not null"`
+	SkuID       int64     `gorm:"type:int;default:0;comment:商品SKU_ID;not null"`
+	Attempts    int32     `gorm:"type:int;default:0;comment:失败次数;not null"`
+	LastError   string    `gorm:"type:varchar(500);comment:最后一次失败原因;not null"`
+	NextRetryAt time.Time `gorm:"index:next_retry_at;comment:下一次重试时间;not null"`
+	CreatedAt   time.Time `gorm:"column:add_time"`
+}
+
+func (p *GoodsEsSync) ToDomain() *domain.EsSyncTask {
+	return &domain.EsSyncTask{
+		ID:       p.ID,
+		Kind:     p.Kind,
+		GoodsID:  p.GoodsID,
+		SkuID:    p.SkuID,
+		Attempts: p.Attempts,
+	}
+}
+
+type esSyncRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewEsSyncRepo .
+func NewEsSyncRepo(data *Data, logger log.Logger) biz.EsSyncRepo {
+	return &esSyncRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *esSyncRepo) Create(ctx context.Context, req *domain.EsSyncTask) (*domain.EsSyncTask, error) {
+	task := &GoodsEsSync{
+		Kind:        req.Kind,
+		GoodsID:     req.GoodsID,
+		SkuID:       req.SkuID,
+		NextRetryAt: time.Now(),
+	}
+	if err := r.data.DB(ctx).Create(task).Error; err != nil {
+		return nil, errors.InternalServer("ES_SYNC_SAVE_ERROR", err.Error())
+	}
+	return task.ToDomain(), nil
+}
+
+// ListDue 查询到达重试时间的任务
+func (r *esSyncRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.EsSyncTask, error) {
+	var l []*GoodsEsSync
+	if err := r.data.DB(ctx).Where("next_retry_at <= ?", now).Order("id").Limit(limit).Find(&l).Error; err != nil {
+		return nil, errors.InternalServer("ES_SYNC_LIST_ERROR", err.Error())
+	}
+	var res []*domain.EsSyncTask
+	for _, item := range l {
+		res = append(res, item.ToDomain())
+	}
+	return res, nil
+}
+
+func (r *esSyncRepo) Delete(ctx context.Context, id int64) error {
+	if err := r.data.DB(ctx).Delete(&GoodsEsSync{}, id).Error; err != nil {
+		return errors.InternalServer("ES_SYNC_DELETE_ERROR", err.Error())
+	}
+	return nil
+}
+
+// Fail 记录同步失败的原因和下一次重试时间
+func (r *esSyncRepo) Fail(ctx context.Context, id int64, reason string, next time.Time) error {
+	if len(reason) > 500 {
+		reason = reason[:500]
+	}
+	err := r.data.DB(ctx).Model(&GoodsEsSync{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":      gorm.Expr("attempts + 1"),
+			"last_error":    reason,
+			"next_retry_at": next,
+		}).Error
+	if err != nil {
+		return errors.InternalServer("ES_SYNC_UPDATE_ERROR", err.Error())
+	}
+	return nil
+}
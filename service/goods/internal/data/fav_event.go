@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+	"goods/internal/domain"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GoodsFavEvent 已经处理的收藏事件，用于事件去重
+type GoodsFavEvent struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	EventID   string    `gorm:"type:varchar(64);uniqueIndex:event_id;comment:事件ID;not null"`
+	GoodsID   int64     `gorm:"type:int;index:goods_id;comment:商品ID;not null"`
+	Delta     int32     `gorm:"type:int;comment:收藏数变化;not null"`
+	CreatedAt time.Time `gorm:"column:add_time"`
+}
+
+// CreateFavEvent 记录事件，事件已经处理过时返回 false
+func (g GoodsRepo) CreateFavEvent(ctx context.Context, e *domain.FavEvent) (bool, error) {
+	res := g.data.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&GoodsFavEvent{
+		EventID: e.EventID,
+		GoodsID: e.GoodsID,
+		Delta:   e.Delta,
+	})
+	if res.Error != nil {
+		return false, errors.InternalServer("FAV_EVENT_SAVE_ERROR", res.Error.Error())
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// IncrFavNum 修改商品收藏数，收藏数不会小于0，返回修改后的收藏数
+func (g GoodsRepo) IncrFavNum(ctx context.Context, goodsID int64, delta int32) (int64, error) {
+	res := g.data.DB(ctx).Model(&Goods{}).Where("id = ?", goodsID).
+		Update("fav_num", gorm.Expr("GREATEST(fav_num + ?, 0)", delta))
+	if res.Error != nil {
+		return 0, errors.InternalServer("GOODS_UPDATE_ERROR", res.Error.Error())
+	}
+	var favNum int64
+	if err := g.data.DB(ctx).Model(&Goods{}).Where("id = ?", goodsID).Pluck("fav_num", &favNum).Error; err != nil {
+		return 0, errors.InternalServer("GOODS_GET_ERROR", err.Error())
+	}
+	return favNum, nil
+}
@@ -115,3 +115,13 @@ func (g GoodsRepo) GoodsListByIDs(c context.Context, ids ...int64) ([]*domain.Go
 	}
 	return res, nil
 }
+
+func (g GoodsRepo) GetByID(c context.Context, id int64) (*domain.Goods, error) {
+	var goods Goods
+	if res := g.data.DB(c).Where("id = ?", id).Limit(1).Find(&goods); res.Error != nil {
+		return nil, errors.InternalServer("GOODS_GET_ERROR", res.Error.Error())
+	} else if res.RowsAffected == 0 {
+		return nil, errors.NotFound("GOODS_NOT_FOUND", "商品不存在")
+	}
+	return goods.ToDomain(), nil
+}
@@ -176,15 +176,82 @@ func (g *goodsAttrRepo) GetAttrByIDs(ctx context.Context, ids []*int64) error {
 	return nil
 }
 
+// ListByIds 根据ID获取属性及属性值
 func (g *goodsAttrRepo) ListByIds(ctx context.Context, ids ...int64) (domain.GoodsAttrList, error) {
 	var l []*GoodsAttr
 	if err := g.data.DB(ctx).Where("id IN (?)", ids).Find(&l).Error; err != nil {
 		return nil, errors.NotFound("ATTR_NOT_FOUND", "商品属性不存在")
 	}
+	return g.withValues(ctx, l)
+}
 
-	var res domain.GoodsAttrList
+// ListGroupByTypeID 获取商品类型下的属性分组
+func (g *goodsAttrRepo) ListGroupByTypeID(ctx context.Context, typeID int64) ([]*domain.AttrGroup, error) {
+	var l []*GoodsAttrGroup
+	if err := g.data.DB(ctx).Where("goods_type_id = ?", typeID).Order("sort").Find(&l).Error; err != nil {
+		return nil, errors.InternalServer("ATTR_GROUP_LIST_ERROR", err.Error())
+	}
+
+	var res []*domain.AttrGroup
 	for _, item := range l {
 		res = append(res, item.ToDomain())
 	}
 	return res, nil
 }
+
+// ListByTypeID 获取商品类型下的属性及属性值
+func (g *goodsAttrRepo) ListByTypeID(ctx context.Context, typeID int64) (domain.GoodsAttrList, error) {
+	var l []*GoodsAttr
+	if err := g.data.DB(ctx).Where("goods_type_id = ?", typeID).Order("sort").Find(&l).Error; err != nil {
+		return nil, errors.InternalServer("ATTR_LIST_ERROR", err.Error())
+	}
+	return g.withValues(ctx, l)
+}
+
+// withValues 加载属性下的属性值
+func (g *goodsAttrRepo) withValues(ctx context.Context, l []*GoodsAttr) (domain.GoodsAttrList, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(l))
+	for _, item := range l {
+		ids = append(ids, item.ID)
+	}
+	var values []*GoodsAttrValue
+	if err := g.data.DB(ctx).Where("attr_id IN (?)", ids).Find(&values).Error; err != nil {
+		return nil, errors.InternalServer("ATTR_VALUE_LIST_ERROR", err.Error())
+	}
+
+	var res domain.GoodsAttrList
+	for _, item := range l {
+		attr := item.ToDomain()
+		for _, v := range values {
+			if v.AttrId == item.ID {
+				attr.GoodsAttrValue = append(attr.GoodsAttrValue, v.ToDomain())
+			}
+		}
+		res = append(res, attr)
+	}
+	return res, nil
+}
+
+// DeleteByTypeID 删除商品类型下的属性分组、属性及属性值
+func (g *goodsAttrRepo) DeleteByTypeID(ctx context.Context, typeID int64) error {
+	var ids []int64
+	if err := g.data.DB(ctx).Model(&GoodsAttr{}).Where("goods_type_id = ?", typeID).Pluck("id", &ids).Error; err != nil {
+		return errors.InternalServer("ATTR_DELETE_ERROR", err.Error())
+	}
+	if len(ids) > 0 {
+		if err := g.data.DB(ctx).Where("attr_id IN (?)", ids).Delete(&GoodsAttrValue{}).Error; err != nil {
+			return errors.InternalServer("ATTR_VALUE_DELETE_ERROR", err.Error())
+		}
+		if err := g.data.DB(ctx).Where("id IN (?)", ids).Delete(&GoodsAttr{}).Error; err != nil {
+			return errors.InternalServer("ATTR_DELETE_ERROR", err.Error())
+		}
+	}
+	if err := g.data.DB(ctx).Where("goods_type_id = ?", typeID).Delete(&GoodsAttrGroup{}).Error; err != nil {
+		return errors.InternalServer("ATTR_GROUP_DELETE_ERROR", err.Error())
+	}
+	return nil
+}
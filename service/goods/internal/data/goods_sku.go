@@ -2,11 +2,13 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"goods/internal/biz"
 	"goods/internal/domain"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
 )
 
 // GoodsSku 商品SKU 表
@@ -24,8 +26,9 @@ type GoodsSku struct {
 	RemarksInfo    string `gorm:"type:varchar(100);comment:备注信息;not null"`
 	Pic            string `gorm:"type:varchar(500);not null;comment:规格参数对应的图片" json:"pic"`
 	OnSale         bool   `gorm:"comment:是否上架;default:false;not null"`
-	AttrInfo       string `gorm:"type:varchar(2000);comment:商品属性信息JSON;not null"`
+	AttrInfo       string `gorm:"type:varchar(2000);comment:商品属性信息JSON(已废弃，改用goods_attr_sku);not null"`
 	Inventory      int64  `gorm:"type:int;comment:商品SKU库存冗余字段;not null"`
+	PurchaseLimit  int32  `gorm:"type:int;default:0;comment:每个用户限购数量，0为不限购;not null"`
 }
 
 // GoodsSpecificationSku 商品规格和商品Sku关联表
@@ -37,6 +40,17 @@ type GoodsSpecificationSku struct {
 	ValueId         int64  `gorm:"index:value_id;type:int;comment:商品规格值表ID;not null"`
 }
 
+// GoodsAttrSku 商品属性值和商品Sku关联表
+type GoodsAttrSku struct {
+	BaseFields
+	GoodsID int64  `gorm:"index:goods_id;type:int;comment:商品ID;not null"`
+	SkuID   int64  `gorm:"index:sku_id;type:int;comment:商品SKU_ID;not null"`
+	SkuCode string `gorm:"type:varchar(100);comment:商品SKU_Code;not null"`
+	GroupID int64  `gorm:"type:int;comment:属性分组ID;not null"`
+	AttrID  int64  `gorm:"index:attr_id;type:int;comment:属性ID;not null"`
+	ValueID int64  `gorm:"index:value_id;type:int;comment:属性值ID;not null"`
+}
+
 type goodsSkuRepo struct {
 	data *Data
 	log  *log.Helper
@@ -67,6 +81,7 @@ func (p *GoodsSku) ToDomain() *domain.GoodsSku {
 		Inventory:      p.Inventory,
 		OnSale:         p.OnSale,
 		AttrInfo:       p.AttrInfo,
+		PurchaseLimit:  p.PurchaseLimit,
 	}
 }
 
@@ -86,6 +101,7 @@ func (g *goodsSkuRepo) Create(ctx context.Context, req *domain.GoodsSku) (*domai
 		OnSale:         req.OnSale,
 		AttrInfo:       req.AttrInfo,
 		Inventory:      req.Inventory,
+		PurchaseLimit:  req.PurchaseLimit,
 	}
 
 	if err := g.data.DB(ctx).Save(sku).Error; err != nil {
@@ -110,3 +126,124 @@ func (g *goodsSkuRepo) CreateSkuRelation(ctx context.Context, req []*domain.Good
 	}
 	return nil
 }
+
+func (g *goodsSkuRepo) GetByID(ctx context.Context, id int64) (*domain.GoodsSku, error) {
+	var sku GoodsSku
+	if res := g.data.DB(ctx).Where("id = ?", id).Limit(1).Find(&sku); res.Error != nil {
+		return nil, errors.InternalServer("SKU_GET_ERROR", res.Error.Error())
+	} else if res.RowsAffected == 0 {
+		return nil, errors.NotFound("SKU_NOT_FOUND", "商品sku不存在")
+	}
+	return sku.ToDomain(), nil
+}
+
+func (g *goodsSkuRepo) ListByIDs(ctx context.Context, ids ...int64) ([]*domain.GoodsSku, error) {
+	var l []*GoodsSku
+	if err := g.data.DB(ctx).Where("id IN (?)", ids).Find(&l).Error; err != nil {
+		return nil, errors.InternalServer("SKU_LIST_ERROR", err.Error())
+	}
+	var res []*domain.GoodsSku
+	for _, item := range l {
+		res = append(res, item.ToDomain())
+	}
+	return res, nil
+}
+
+func (g *goodsSkuRepo) IDsByGoodsID(ctx context.Context, goodsID int64) ([]int64, error) {
+	var ids []int64
+	if err := g.data.DB(ctx).Model(&GoodsSku{}).Where("goods_id = ?", goodsID).Order("id").Pluck("id", &ids).Error; err != nil {
+		return nil, errors.InternalServer("SKU_LIST_ERROR", err.Error())
+	}
+	return ids, nil
+}
+
+// UpdatePrice 修改 sku 当前的售价和促销价
+func (g *goodsSkuRepo) UpdatePrice(ctx context.Context, id, price, promotionPrice int64) error {
+	err := g.data.DB(ctx).Model(&GoodsSku{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"price": price, "promotion_price": promotionPrice}).Error
+	if err != nil {
+		return errors.InternalServer("SKU_PRICE_UPDATE_ERROR", err.Error())
+	}
+	return nil
+}
+
+func (g *goodsSkuRepo) UpdatePurchaseLimit(ctx context.Context, id int64, limit int32) error {
+	res := g.data.DB(ctx).Model(&GoodsSku{}).Where("id = ?", id).Update("purchase_limit", limit)
+	if res.Error != nil {
+		return errors.InternalServer("SKU_UPDATE_ERROR", res.Error.Error())
+	}
+	if res.RowsAffected == 0 {
+		if _, err := g.GetByID(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateAttrRelation 插入商品属性值和 sku 关联关系
+func (g *goodsSkuRepo) CreateAttrRelation(ctx context.Context, req []*domain.GoodsAttrSku) error {
+	if len(req) == 0 {
+		return nil
+	}
+	var info []*GoodsAttrSku
+	for _, v := range req {
+		info = append(info, &GoodsAttrSku{
+			GoodsID: v.GoodsID,
+			SkuID:   v.SkuID,
+			SkuCode: v.SkuCode,
+			GroupID: v.GroupID,
+			AttrID:  v.AttrID,
+			ValueID: v.ValueID,
+		})
+	}
+	if err := g.data.DB(ctx).Create(&info).Error; err != nil {
+		return errors.InternalServer("SKU_ATTR_RELATION_SAVE_ERROR", err.Error())
+	}
+	return nil
+}
+
+// BackfillGoodsAttrSku 将 GoodsSku.AttrInfo 中的 JSON 属性信息迁移到 goods_attr_sku 表，
+// 已经存在关联关系的 sku 会被跳过，可以重复执行
+func BackfillGoodsAttrSku(db *gorm.DB) (int, error) {
+	var total int
+	var skus []*GoodsSku
+	err := db.Where("attr_info <> ''").FindInBatches(&skus, 100, func(tx *gorm.DB, batch int) error {
+		for _, sku := range skus {
+			var count int64
+			if err := db.Model(&GoodsAttrSku{}).Where("sku_id = ?", sku.ID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				continue
+			}
+
+			info := sku.ToDomain()
+			if err := json.Unmarshal([]byte(sku.AttrInfo), &info.GroupAttr); err != nil {
+				// JSON 被截断或格式错误时跳过，不中断迁移
+				log.Warnf("sku %d attr_info unmarshal error: %v", sku.ID, err)
+				continue
+			}
+
+			var relation []*GoodsAttrSku
+			for _, v := range info.AttrRelation() {
+				relation = append(relation, &GoodsAttrSku{
+					GoodsID: v.GoodsID,
+					SkuID:   v.SkuID,
+					SkuCode: v.SkuCode,
+					GroupID: v.GroupID,
+					AttrID:  v.AttrID,
+					ValueID: v.ValueID,
+				})
+			}
+			if len(relation) == 0 {
+				continue
+			}
+			if err := db.Create(&relation).Error; err != nil {
+				return err
+			}
+			total++
+		}
+		return nil
+	}).Error
+	return total, err
+}
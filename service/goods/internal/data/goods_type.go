@@ -11,6 +11,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GoodsType 商品类型表
@@ -112,3 +113,107 @@ func (g *GoodsTypeRepo) IsExistsByID(ctx context.Context, typeID int64) (*domain
 	}
 	return goodsType.ToDomain(), nil
 }
+
+// 修改商品类型
+func (g *GoodsTypeRepo) UpdateGoodsType(ctx context.Context, req *domain.GoodsTypeUpdate) error {
+	var goodsType GoodsType
+	if res := g.data.DB(ctx).First(&goodsType, req.ID); res.RowsAffected == 0 {
+		return errors.NotFound("GOODS_TYPE_NOT_FOUND", "商品类型不存在")
+	}
+
+	if req.Name != nil {
+		goodsType.Name = *req.Name
+	}
+	if req.TypeCode != nil {
+		goodsType.TypeCode = *req.TypeCode
+	}
+	if req.NameAlias != nil {
+		goodsType.NameAlias = *req.NameAlias
+	}
+	if req.Desc != nil {
+		goodsType.Desc = *req.Desc
+	}
+	if req.Sort != nil {
+		goodsType.Sort = *req.Sort
+	}
+	if req.IsVirtual != nil {
+		goodsType.IsVirtual = *req.IsVirtual
+	}
+
+	if err := g.data.DB(ctx).Save(&goodsType).Error; err != nil {
+		return errors.InternalServer("GOODS_TYPE_UPDATE_ERROR", err.Error())
+	}
+	return nil
+}
+
+// 删除商品类型
+func (g *GoodsTypeRepo) DeleteGoodsType(ctx context.Context, typeID int64) error {
+	res := g.data.DB(ctx).Delete(&GoodsType{}, typeID)
+	if res.Error != nil {
+		return errors.InternalServer("GOODS_TYPE_DELETE_ERROR", res.Error.Error())
+	}
+	if res.RowsAffected == 0 {
+		return errors.NotFound("GOODS_TYPE_NOT_FOUND", "商品类型不存在")
+	}
+	return nil
+}
+
+// 删除商品类型和品牌的关联关系
+func (g *GoodsTypeRepo) DeleteGoodsBrandType(ctx context.Context, typeID int64) error {
+	if err := g.data.DB(ctx).Where("type_id = ?", typeID).Delete(&GoodsTypeBrand{}).Error; err != nil {
+		return errors.InternalServer("GOODS_TYPE_BRAND_DELETE_ERROR", err.Error())
+	}
+	return nil
+}
+
+// 商品类型列表
+func (g *GoodsTypeRepo) List(ctx context.Context, p *biz.Pagination) ([]*domain.GoodsType, int64, error) {
+	var total int64
+	if err := g.data.DB(ctx).Model(&GoodsType{}).Count(&total).Error; err != nil {
+		return nil, 0, errors.InternalServer("GOODS_TYPE_LIST_ERROR", err.Error())
+	}
+
+	var types []*GoodsType
+	if err := g.data.DB(ctx).Order("sort").Scopes(Paginate(p.PageNum, p.PageSize)).Find(&types).Error; err != nil {
+		return nil, 0, errors.InternalServer("GOODS_TYPE_LIST_ERROR", err.Error())
+	}
+
+	var res []*domain.GoodsType
+	for _, v := range types {
+		res = append(res, v.ToDomain())
+	}
+	return res, total, nil
+}
+
+// 获取商品类型绑定的品牌ID
+func (g *GoodsTypeRepo) ListBrandIdsByTypeID(ctx context.Context, typeID int64) ([]int32, error) {
+	var ids []int32
+	if err := g.data.DB(ctx).Model(&GoodsTypeBrand{}).Where("type_id = ?", typeID).Pluck("brand_id", &ids).Error; err != nil {
+		return nil, errors.InternalServer("GOODS_TYPE_BRAND_LIST_ERROR", err.Error())
+	}
+	return ids, nil
+}
+
+// 判断商品类型是否已经被商品使用
+func (g *GoodsTypeRepo) IsUsedByGoods(ctx context.Context, typeID int64) (bool, error) {
+	var count int64
+	err := g.data.DB(ctx).Model(&Goods{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("type_id = ?", typeID).Count(&count).Error
+	if err != nil {
+		return false, errors.InternalServer("GOODS_COUNT_ERROR", err.Error())
+	}
+	return count > 0, nil
+}
+
+// ShareLockByID 共享锁和删除时的 IsUsedByGoods 互斥，类型已经删除时返回不存在
+func (g *GoodsTypeRepo) ShareLockByID(ctx context.Context, typeID int64) error {
+	var goodsType GoodsType
+	res := g.data.DB(ctx).Clauses(clause.Locking{Strength: "SHARE"}).Select("id").Where("id = ?", typeID).Limit(1).Find(&goodsType)
+	if res.Error != nil {
+		return errors.InternalServer("GOODS_TYPE_GET_ERROR", res.Error.Error())
+	}
+	if res.RowsAffected == 0 {
+		return errors.NotFound("GOODS_TYPE_NOT_FOUND", "商品类型不存在")
+	}
+	return nil
+}
@@ -46,3 +46,15 @@ func (i inventoryRepo) Create(ctx context.Context, inventory *domain.Inventory)
 	}
 	return info.ToDomain(), nil
 }
+
+func (i inventoryRepo) ListBySkuIDs(ctx context.Context, ids ...int64) ([]*domain.Inventory, error) {
+	var l []*GoodsInventory
+	if err := i.data.DB(ctx).Where("sku_id IN (?)", ids).Find(&l).Error; err != nil {
+		return nil, errors.InternalServer("INVENTORY_LIST_ERROR", err.Error())
+	}
+	var res []*domain.Inventory
+	for _, item := range l {
+		res = append(res, item.ToDomain())
+	}
+	return res, nil
+}
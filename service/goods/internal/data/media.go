@@ -0,0 +1,210 @@
+package data
+
+import (
+	"context"
+	"goods/internal/biz"
+	"goods/internal/conf"
+	"goods/internal/domain"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+// Media 媒体文件表
+type Media struct {
+	BaseFields
+	Hash        string `gorm:"type:char(64);uniqueIndex:hash;comment:文件内容sha256;not null"`
+	Path        string `gorm:"type:varchar(200);comment:存储路径;not null"`
+	ThumbPath   string `gorm:"type:varchar(200);comment:缩略图存储路径;not null"`
+	URL         string `gorm:"type:varchar(500);index:url,length:191;comment:访问地址;not null"`
+	ThumbURL    string `gorm:"type:varchar(500);index:thumb_url,length:191;comment:缩略图访问地址;not null"`
+	ContentType string `gorm:"type:varchar(50);comment:文件类型;not null"`
+	Size        int64  `gorm:"type:int;comment:文件大小;not null"`
+	Width       int32  `gorm:"type:int;comment:图片宽度;not null"`
+	Height      int32  `gorm:"type:int;comment:图片高度;not null"`
+}
+
+// MediaRef 媒体文件引用关系表，没有引用的文件会被清理
+type MediaRef struct {
+	ID        int64     `gorm:"primarykey;type:int"`
+	MediaID   int64     `gorm:"index:media_id;type:int;comment:媒体文件ID;not null"`
+	RefType   string    `gorm:"type:varchar(20);index:ref,priority:1;comment:引用类型 goods/sku/brand;not null"`
+	RefID     int64     `gorm:"type:int;index:ref,priority:2;comment:引用ID;not null"`
+	CreatedAt time.Time `gorm:"column:add_time"`
+}
+
+func (p *Media) ToDomain() *domain.Media {
+	return &domain.Media{
+		ID:          p.ID,
+		Hash:        p.Hash,
+		Path:        p.Path,
+		ThumbPath:   p.ThumbPath,
+		URL:         p.URL,
+		ThumbURL:    p.ThumbURL,
+		ContentType: p.ContentType,
+		Size:        p.Size,
+		Width:       p.Width,
+		Height:      p.Height,
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+type mediaRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewMediaRepo .
+func NewMediaRepo(data *Data, logger log.Logger) biz.MediaRepo {
+	return &mediaRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (m *mediaRepo) Create(ctx context.Context, req *domain.Media) (*domain.Media, error) {
+	media := &Media{
+		Hash:        req.Hash,
+		Path:        req.Path,
+		ThumbPath:   req.ThumbPath,
+		URL:         req.URL,
+		ThumbURL:    req.ThumbURL,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		Width:       req.Width,
+		Height:      req.Height,
+	}
+	if err := m.data.DB(ctx).Create(media).Error; err != nil {
+		return nil, errors.InternalServer("MEDIA_SAVE_ERROR", err.Error())
+	}
+	return media.ToDomain(), nil
+}
+
+func (m *mediaRepo) GetByHash(ctx context.Context, hash string) (*domain.Media, error) {
+	var media Media
+	if res := m.data.DB(ctx).Where("hash = ?", hash).Limit(1).Find(&media); res.Error != nil {
+		return nil, errors.InternalServer("MEDIA_GET_ERROR", res.Error.Error())
+	} else if res.RowsAffected == 0 {
+		return nil, errors.NotFound("MEDIA_NOT_FOUND", "图片不存在")
+	}
+	return media.ToDomain(), nil
+}
+
+// BindRefs 重新绑定引用关系，不是本服务上传的图片地址会被忽略
+func (m *mediaRepo) BindRefs(ctx context.Context, refType string, refID int64, urls ...string) error {
+	if err := m.data.DB(ctx).Where("ref_type = ? AND ref_id = ?", refType, refID).Delete(&MediaRef{}).Error; err != nil {
+		return errors.InternalServer("MEDIA_REF_DELETE_ERROR", err.Error())
+	}
+
+	var list []string
+	for _, url := range urls {
+		if url != "" {
+			list = append(list, url)
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+
+	var ids []int64
+	// 共享锁和 GC 删除记录互斥，绑定的图片不会在事务提交前被删除
+	if err := m.data.DB(ctx).Model(&Media{}).Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("url IN (?) OR thumb_url IN (?)", list, list).Pluck("id", &ids).Error; err != nil {
+		return errors.InternalServer("MEDIA_LIST_ERROR", err.Error())
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var refs []*MediaRef
+	for _, id := range ids {
+		refs = append(refs, &MediaRef{MediaID: id, RefType: refType, RefID: refID})
+	}
+	if err := m.data.DB(ctx).Create(&refs).Error; err != nil {
+		return errors.InternalServer("MEDIA_REF_SAVE_ERROR", err.Error())
+	}
+	return nil
+}
+
+// ListOrphans 查询 before 之前上传且没有被引用的图片
+func (m *mediaRepo) ListOrphans(ctx context.Context, before time.Time, limit int) ([]*domain.Media, error) {
+	var l []*Media
+	err := m.data.DB(ctx).
+		Where("add_time < ?", before).
+		Where("NOT EXISTS (SELECT 1 FROM media_ref WHERE media_ref.media_id = media.id)").
+		Limit(limit).Find(&l).Error
+	if err != nil {
+		return nil, errors.InternalServer("MEDIA_LIST_ERROR", err.Error())
+	}
+
+	var res []*domain.Media
+	for _, item := range l {
+		res = append(res, item.ToDomain())
+	}
+	return res, nil
+}
+
+// DeleteOrphan 物理删除，相同内容的图片可以再次上传。删除条件中再次检查引用，
+// 查询孤儿图片之后被绑定的图片不会删除
+func (m *mediaRepo) DeleteOrphan(ctx context.Context, id int64) (bool, error) {
+	res := m.data.DB(ctx).Unscoped().
+		Where("NOT EXISTS (SELECT 1 FROM media_ref WHERE media_ref.media_id = media.id)").
+		Delete(&Media{}, id)
+	if res.Error != nil {
+		return false, errors.InternalServer("MEDIA_DELETE_ERROR", res.Error.Error())
+	}
+	return res.RowsAffected > 0, nil
+}
+
+// localStorage 本地文件系统存储
+type localStorage struct {
+	root    string
+	baseURL string
+}
+
+// NewMediaStorage .
+func NewMediaStorage(c *conf.Data) biz.MediaStorage {
+	s := &localStorage{root: "./static/media", baseURL: "/media"}
+	if c.Media != nil {
+		if c.Media.Root != "" {
+			s.root = c.Media.Root
+		}
+		if c.Media.BaseUrl != "" {
+			s.baseURL = c.Media.BaseUrl
+		}
+	}
+	return s
+}
+
+func (s *localStorage) Put(ctx context.Context, path string, content []byte) error {
+	file := filepath.Join(s.root, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return errors.InternalServer("MEDIA_STORAGE_ERROR", err.Error())
+	}
+	// 先写临时文件再重命名，避免读到写了一半的文件
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o644); err != nil {
+		return errors.InternalServer("MEDIA_STORAGE_ERROR", err.Error())
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		return errors.InternalServer("MEDIA_STORAGE_ERROR", err.Error())
+	}
+	return nil
+}
+
+func (s *localStorage) Delete(ctx context.Context, path string) error {
+	err := os.Remove(filepath.Join(s.root, filepath.FromSlash(path)))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.InternalServer("MEDIA_STORAGE_ERROR", err.Error())
+	}
+	return nil
+}
+
+func (s *localStorage) URL(path string) string {
+	return strings.TrimRight(s.baseURL, "/") + "/" + path
+}
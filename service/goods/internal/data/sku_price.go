@@ -0,0 +1,137 @@
+package data
+
+import (
+	"context"
+	"goods/internal/biz"
+	"goods/internal/domain"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// GoodsSkuPrice 商品 sku 价格台账表
+type GoodsSkuPrice struct {
+	BaseFields
+	SkuID          int64      `gorm:"index:sku_id;type:int;comment:商品SKU_ID;not null"`
+	Price          int64      `gorm:"type:int;comment:商品售价;not null"`
+	PromotionPrice int64      `gorm:"type:int;comment:商品促销售价;not null"`
+	EffectiveFrom  time.Time  `gorm:"index:effective_from;comment:生效开始时间;not null"`
+	EffectiveUntil *time.Time `gorm:"index:effective_until;comment:生效结束时间，为空表示一直有效"`
+	Status         int32      `gorm:"index:status;type:tinyint;default:0;comment:状态 0等待生效 1已生效 2已结束;not null"`
+	Remark         string     `gorm:"type:varchar(200);comment:变更原因;not null"`
+}
+
+func (p *GoodsSkuPrice) ToDomain() *domain.SkuPrice {
+	return &domain.SkuPrice{
+		ID:             p.ID,
+		SkuID:          p.SkuID,
+		Price:          p.Price,
+		PromotionPrice: p.PromotionPrice,
+		EffectiveFrom:  p.EffectiveFrom,
+		EffectiveUntil: p.EffectiveUntil,
+		Status:         p.Status,
+		Remark:         p.Remark,
+		CreatedAt:      p.CreatedAt,
+	}
+}
+
+type skuPriceRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewSkuPriceRepo .
+func NewSkuPriceRepo(data *Data, logger log.Logger) biz.SkuPriceRepo {
+	return &skuPriceRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *skuPriceRepo) Create(ctx context.Context, req *domain.SkuPrice) (*domain.SkuPrice, error) {
+	price := &GoodsSkuPrice{
+		SkuID:          req.SkuID,
+		Price:          req.Price,
+		PromotionPrice: req.PromotionPrice,
+		EffectiveFrom:  req.EffectiveFrom,
+		EffectiveUntil: req.EffectiveUntil,
+		Status:         req.Status,
+		Remark:         req.Remark,
+	}
+	if err := r.data.DB(ctx).Create(price).Error; err != nil {
+		return nil, errors.InternalServer("SKU_PRICE_SAVE_ERROR", err.Error())
+	}
+	return price.ToDomain(), nil
+}
+
+// PriceAt 查询某个时间点有效的价格，多条记录同时有效时以最晚开始生效的为准
+func (r *skuPriceRepo) PriceAt(ctx context.Context, skuID int64, t time.Time) (*domain.SkuPrice, error) {
+	var price GoodsSkuPrice
+	res := r.data.DB(ctx).
+		Where("sku_id = ? AND effective_from <= ?", skuID, t).
+		Where("effective_until IS NULL OR effective_until > ?", t).
+		Order("effective_from DESC, id DESC").
+		Limit(1).Find(&price)
+	if res.Error != nil {
+		return nil, errors.InternalServer("SKU_PRICE_GET_ERROR", res.Error.Error())
+	}
+	if res.RowsAffected == 0 {
+		return nil, errors.NotFound("SKU_PRICE_NOT_FOUND", "该时间点没有有效的价格记录")
+	}
+	return price.ToDomain(), nil
+}
+
+func (r *skuPriceRepo) ListBySkuID(ctx context.Context, skuID int64) ([]*domain.SkuPrice, error) {
+	var l []*GoodsSkuPrice
+	if err := r.data.DB(ctx).Where("sku_id = ?", skuID).Order("effective_from DESC, id DESC").Find(&l).Error; err != nil {
+		return nil, errors.InternalServer("SKU_PRICE_LIST_ERROR", err.Error())
+	}
+	var res []*domain.SkuPrice
+	for _, item := range l {
+		res = append(res, item.ToDomain())
+	}
+	return res, nil
+}
+
+// ListDueSkuIDs 查询有价格需要生效或者结束的 sku
+func (r *skuPriceRepo) ListDueSkuIDs(ctx context.Context, now time.Time) ([]int64, error) {
+	var ids []int64
+	err := r.data.DB(ctx).Model(&GoodsSkuPrice{}).
+		Where("status = ? AND effective_from <= ?", domain.SkuPricePending, now).
+		Or("status = ? AND effective_until <= ?", domain.SkuPriceActive, now).
+		Distinct().Pluck("sku_id", &ids).Error
+	if err != nil {
+		return nil, errors.InternalServer("SKU_PRICE_LIST_ERROR", err.Error())
+	}
+	return ids, nil
+}
+
+// MarkDue 更新 sku 到期价格记录的状态
+func (r *skuPriceRepo) MarkDue(ctx context.Context, skuID int64, now time.Time) error {
+	err := r.data.DB(ctx).Model(&GoodsSkuPrice{}).
+		Where("sku_id = ? AND status = ? AND effective_until <= ?", skuID, domain.SkuPriceActive, now).
+		Update("status", domain.SkuPriceFinished).Error
+	if err != nil {
+		return errors.InternalServer("SKU_PRICE_UPDATE_ERROR", err.Error())
+	}
+	// 开始时间已到的记录，如果结束时间也已经过了直接标记为结束
+	err = r.data.DB(ctx).Model(&GoodsSkuPrice{}).
+		Where("sku_id = ? AND status = ? AND effective_from <= ?", skuID, domain.SkuPricePending, now).
+		Update("status", gorm.Expr("CASE WHEN effective_until IS NOT NULL AND effective_until <= ? THEN ? ELSE ? END",
+			now, domain.SkuPriceFinished, domain.SkuPriceActive)).Error
+	if err != nil {
+		return errors.InternalServer("SKU_PRICE_UPDATE_ERROR", err.Error())
+	}
+	return nil
+}
+
+// BackfillGoodsSkuPrice 为没有价格记录的 sku 补充初始价格，生效时间为 sku 的创建时间，可以重复执行
+func BackfillGoodsSkuPrice(db *gorm.DB) (int64, error) {
+	res := db.Exec(`INSERT INTO goods_sku_price (sku_id, price, promotion_price, effective_from, status, remark, add_time, update_time)
+		SELECT s.id, s.price, s.promotion_price, s.add_time, ?, '初始价格', NOW(), NOW() FROM goods_sku s
+		WHERE s.deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM goods_sku_price p WHERE p.sku_id = s.id)`,
+		domain.SkuPriceActive)
+	return res.RowsAffected, res.Error
+}
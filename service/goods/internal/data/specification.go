@@ -105,3 +105,62 @@ func (g *specificationRepo) ListByIds(ctx context.Context, id ...*int64) (domain
 	}
 	return res, nil
 }
+
+func (p *SpecificationsAttrValue) ToDomain() *domain.SpecificationValue {
+	return &domain.SpecificationValue{
+		ID:     p.ID,
+		AttrId: p.AttrId,
+		Value:  p.Value,
+		Sort:   p.Sort,
+	}
+}
+
+// ListByTypeID 获取商品类型下的规格及规格的参数值
+func (g *specificationRepo) ListByTypeID(ctx context.Context, typeID int64) (domain.SpecificationList, error) {
+	var l []*SpecificationsAttr
+	if err := g.data.DB(ctx).Where("type_id = ?", typeID).Order("sort").Find(&l).Error; err != nil {
+		return nil, errors.InternalServer("SPECIFICATION_LIST_ERROR", err.Error())
+	}
+	if len(l) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(l))
+	for _, item := range l {
+		ids = append(ids, item.ID)
+	}
+	var values []*SpecificationsAttrValue
+	if err := g.data.DB(ctx).Where("attr_id IN (?)", ids).Order("sort").Find(&values).Error; err != nil {
+		return nil, errors.InternalServer("SPECIFICATION_VALUE_LIST_ERROR", err.Error())
+	}
+
+	var res domain.SpecificationList
+	for _, item := range l {
+		s := item.ToDomain()
+		for _, v := range values {
+			if v.AttrId == item.ID {
+				s.SpecificationValue = append(s.SpecificationValue, v.ToDomain())
+			}
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// DeleteByTypeID 删除商品类型下的规格及规格的参数值
+func (g *specificationRepo) DeleteByTypeID(ctx context.Context, typeID int64) error {
+	var ids []int64
+	if err := g.data.DB(ctx).Model(&SpecificationsAttr{}).Where("type_id = ?", typeID).Pluck("id", &ids).Error; err != nil {
+		return errors.InternalServer("SPECIFICATION_DELETE_ERROR", err.Error())
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := g.data.DB(ctx).Where("attr_id IN (?)", ids).Delete(&SpecificationsAttrValue{}).Error; err != nil {
+		return errors.InternalServer("SPECIFICATION_VALUE_DELETE_ERROR", err.Error())
+	}
+	if err := g.data.DB(ctx).Where("id IN (?)", ids).Delete(&SpecificationsAttr{}).Error; err != nil {
+		return errors.InternalServer("SPECIFICATION_DELETE_ERROR", err.Error())
+	}
+	return nil
+}
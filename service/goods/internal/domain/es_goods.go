@@ -19,31 +19,39 @@ type ESGoodsFilter struct {
 	MinPrice    int64
 	Pages       int64
 	PagePerNums int64
+	Attrs       []*ESAttrFilter
+}
+
+// ESAttrFilter 属性筛选条件，ValueIDs 之间为或关系
+type ESAttrFilter struct {
+	AttrID   int64
+	ValueIDs []int64
 }
 
 // 构建插入es的时候所需的结构，json存入到es中显示的字段名
 type ESGoods struct {
-	ID           int64   `json:"id"`
-	CategoryID   int32   `json:"category_id"`
-	CategoryName string  `json:"category_name"`
-	BrandsID     int32   `json:"brands_id"`
-	BrandName    string  `json:"brand_name"`
-	TypeID       int64   `json:"type_id"`
-	TypeName     string  `json:"type_name"`
-	OnSale       bool    `json:"on_sale"`
-	ShipFree     bool    `json:"ship_free"`
-	IsNew        bool    `json:"is_new"`
-	IsHot        bool    `json:"is_hot"`
-	Name         string  `json:"name"`
-	GoodsTags    string  `json:"goods_tags"`
-	ClickNum     int64   `json:"click_num"`
-	SoldNum      int64   `json:"sold_num"`
-	FavNum       int64   `json:"fav_num"`
-	MarketPrice  int64   `json:"market_price"`
-	GoodsBrief   string  `json:"goods_brief"`
-	Pages        int64   `json:"pages"`
-	PagePerNums  int64   `json:"page_pre_num"`
-	Sku          []EsSku `json:"sku"`
+	ID           int64    `json:"id"`
+	CategoryID   int32    `json:"category_id"`
+	CategoryName string   `json:"category_name"`
+	BrandsID     int32    `json:"brands_id"`
+	BrandName    string   `json:"brand_name"`
+	TypeID       int64    `json:"type_id"`
+	TypeName     string   `json:"type_name"`
+	OnSale       bool     `json:"on_sale"`
+	ShipFree     bool     `json:"ship_free"`
+	IsNew        bool     `json:"is_new"`
+	IsHot        bool     `json:"is_hot"`
+	Name         string   `json:"name"`
+	GoodsTags    string   `json:"goods_tags"`
+	ClickNum     int64    `json:"click_num"`
+	SoldNum      int64    `json:"sold_num"`
+	FavNum       int64    `json:"fav_num"`
+	MarketPrice  int64    `json:"market_price"`
+	GoodsBrief   string   `json:"goods_brief"`
+	Pages        int64    `json:"pages"`
+	PagePerNums  int64    `json:"page_pre_num"`
+	Sku          []EsSku  `json:"sku"`
+	Attrs        []EsAttr `json:"attrs"`
 }
 type EsSku struct {
 	SkuID    int64  `json:"sku_id"`
@@ -51,6 +59,14 @@ type EsSku struct {
 	SkuPrice int64  `json:"sku_price"`
 }
 
+// EsAttr 商品的属性值，用于按属性筛选商品
+type EsAttr struct {
+	AttrID   int64  `json:"attr_id"`
+	AttrName string `json:"attr_name"`
+	ValueID  int64  `json:"value_id"`
+	Value    string `json:"value"`
+}
+
 // es 公共的查询语法，用过来不同条件拼接查询sql
 type EsSearch struct {
 	MustQuery    []elastic.Query
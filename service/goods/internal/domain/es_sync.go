@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// es 同步任务的类型
+const (
+	EsSyncSkuPrice = "sku_price" // sku 售价
+	EsSyncFavNum   = "fav_num"   // 商品收藏数
+)
+
+// esSyncMaxDelay 同步失败后重试的最长间隔
+const esSyncMaxDelay = time.Hour
+
+// EsSyncTask 需要同步到 es 的数据变更，和数据库修改在同一个事务中写入，同步成功后删除
+type EsSyncTask struct {
+	ID       int64
+	Kind     string
+	GoodsID  int64
+	SkuID    int64
+	Attempts int32
+}
+
+// NextRetry 按失败次数指数退避计算下一次重试的时间
+func (t *EsSyncTask) NextRetry(now time.Time) time.Time {
+	delay := esSyncMaxDelay
+	if t.Attempts < 12 {
+		if d := time.Duration(1<<uint(t.Attempts)) * time.Second; d < delay {
+			delay = d
+		}
+	}
+	return now.Add(delay)
+}
@@ -0,0 +1,8 @@
+package domain
+
+// FavEvent 商品收藏数变化的事件，Delta 为 1 或 -1
+type FavEvent struct {
+	EventID string
+	GoodsID int64
+	Delta   int32
+}
@@ -1,12 +1,13 @@
 package domain
 
 type AttrGroup struct {
-	ID     int64
-	TypeID int64
-	Title  string
-	Desc   string
-	Status bool
-	Sort   int32
+	ID        int64
+	TypeID    int64
+	Title     string
+	Desc      string
+	Status    bool
+	Sort      int32
+	GoodsAttr []*GoodsAttr
 }
 
 func (p AttrGroup) IsTypeIDEmpty() bool {
@@ -50,6 +51,18 @@ func (p GoodsAttrList) FindById(id int64) *GoodsAttr {
 	return nil
 }
 
+// GroupBy 将属性按照属性分组归类
+func (p GoodsAttrList) GroupBy(groups []*AttrGroup) []*AttrGroup {
+	for _, group := range groups {
+		for _, item := range p {
+			if item.GroupID == group.ID {
+				group.GoodsAttr = append(group.GoodsAttr, item)
+			}
+		}
+	}
+	return groups
+}
+
 func (p GoodsAttrList) IsNotExist(groupId, attrId int64) bool {
 	for _, item := range p {
 		if item.GroupID != groupId && item.ID != attrId {
@@ -58,3 +71,17 @@ func (p GoodsAttrList) IsNotExist(groupId, attrId int64) bool {
 	}
 	return false
 }
+
+// FindValue 查找属性下的属性值，不属于该属性时返回 nil
+func (p GoodsAttrList) FindValue(attrId, valueId int64) *GoodsAttrValue {
+	attr := p.FindById(attrId)
+	if attr == nil {
+		return nil
+	}
+	for _, v := range attr.GoodsAttrValue {
+		if v.ID == valueId {
+			return v
+		}
+	}
+	return nil
+}
@@ -15,7 +15,10 @@ type GoodsSku struct {
 	Pic            string
 	Inventory      int64
 	OnSale         bool
-	AttrInfo       string
+	ShipFree       bool   // 商品是否免运费，查询 sku 列表时从商品获取
+	ShipID         int32  // 商品的运费模版
+	PurchaseLimit  int32  // 每个用户限购数量，0 为不限购
+	AttrInfo       string // 已废弃，属性信息改为写入商品属性关联表
 	Specification  []*SpecificationInfo
 	GroupAttr      []*GroupAttr
 }
@@ -45,3 +48,32 @@ type GoodsSpecificationSku struct {
 	SpecificationId int64
 	ValueId         int64
 }
+
+// GoodsAttrSku 商品属性值和商品Sku关联关系
+type GoodsAttrSku struct {
+	ID      int64
+	GoodsID int64
+	SkuID   int64
+	SkuCode string
+	GroupID int64
+	AttrID  int64
+	ValueID int64
+}
+
+// AttrRelation 根据 sku 选择的属性组生成属性关联关系
+func (p *GoodsSku) AttrRelation() []*GoodsAttrSku {
+	var res []*GoodsAttrSku
+	for _, group := range p.GroupAttr {
+		for _, attr := range group.Attr {
+			res = append(res, &GoodsAttrSku{
+				GoodsID: p.GoodsID,
+				SkuID:   p.ID,
+				SkuCode: p.SkuCode,
+				GroupID: group.GroupId,
+				AttrID:  attr.AttrID,
+				ValueID: attr.AttrValueID,
+			})
+		}
+	}
+	return res
+}
@@ -16,6 +16,26 @@ type GoodsType struct {
 	BrandIds  string
 }
 
+// GoodsTypeUpdate 修改商品类型，为 nil 的字段保持不变
+type GoodsTypeUpdate struct {
+	ID        int64
+	Name      *string
+	TypeCode  *string
+	NameAlias *string
+	IsVirtual *bool
+	Desc      *string
+	Sort      *int32
+	BrandIds  *string // 不为 nil 时重新绑定品牌
+}
+
+// GoodsTypeTemplate 商品类型模板，包含绑定的品牌、规格以及属性分组
+type GoodsTypeTemplate struct {
+	GoodsType      *GoodsType
+	Brands         BrandList
+	Specifications SpecificationList
+	AttrGroups     []*AttrGroup
+}
+
 func (b *GoodsType) IsEmpty() bool {
 	return b.BrandIds == ""
 }
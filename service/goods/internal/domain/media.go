@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"net/http"
+	"path"
+	"time"
+)
+
+const (
+	MaxMediaSize   = 3 << 20 // 单个文件最大 3M，不超过 grpc 默认的消息大小
+	ThumbnailWidth = 200     // 缩略图宽度
+)
+
+// 媒体文件的引用类型
+const (
+	MediaRefGoods = "goods" // 商品封面图和介绍图
+	MediaRefSku   = "sku"   // sku 规格图片
+	MediaRefBrand = "brand" // 品牌 logo
+)
+
+// 允许上传的文件类型及对应的扩展名
+var mediaTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type Media struct {
+	ID          int64
+	Hash        string
+	Path        string
+	ThumbPath   string
+	URL         string
+	ThumbURL    string
+	ContentType string
+	Size        int64
+	Width       int32
+	Height      int32
+	CreatedAt   time.Time
+}
+
+// MediaRef 媒体文件被商品、sku、品牌引用的关系
+type MediaRef struct {
+	MediaID int64
+	RefType string
+	RefID   int64
+}
+
+// DetectMediaType 根据文件内容判断文件类型，不支持的类型返回空
+func DetectMediaType(content []byte) (string, string) {
+	contentType := http.DetectContentType(content)
+	ext, ok := mediaTypes[contentType]
+	if !ok {
+		return "", ""
+	}
+	return contentType, ext
+}
+
+// MediaPath 根据文件 hash 生成存储路径，使用 hash 前缀分目录避免单个目录文件过多
+func MediaPath(hash, ext string) string {
+	return path.Join(hash[:2], hash[2:4], hash+ext)
+}
+
+// ThumbPath 缩略图的存储路径
+func ThumbPath(hash string) string {
+	return path.Join("thumb", hash[:2], hash[2:4], hash+".jpg")
+}
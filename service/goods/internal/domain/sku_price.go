@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// sku 价格变更记录的状态
+const (
+	SkuPricePending  int32 = iota // 等待生效
+	SkuPriceActive                // 已生效
+	SkuPriceFinished              // 生效时间已结束
+)
+
+// SkuPrice sku 价格台账，记录每一次价格变更及其生效时间段
+type SkuPrice struct {
+	ID             int64
+	SkuID          int64
+	Price          int64
+	PromotionPrice int64
+	EffectiveFrom  time.Time
+	EffectiveUntil *time.Time // 为空表示一直有效，直到被新的价格覆盖
+	Status         int32
+	Remark         string
+	CreatedAt      time.Time
+}
+
+// IsWindowInvalid 结束时间必须晚于开始时间
+func (p *SkuPrice) IsWindowInvalid() bool {
+	return p.EffectiveUntil != nil && !p.EffectiveUntil.After(p.EffectiveFrom)
+}
+
+// IsPriceInvalid 售价必须大于0，促销价不能高于售价
+func (p *SkuPrice) IsPriceInvalid() bool {
+	return p.Price <= 0 || p.PromotionPrice < 0 || p.PromotionPrice > p.Price
+}
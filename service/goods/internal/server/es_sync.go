@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"goods/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// esSyncInterval 重试 es 同步任务的时间间隔
+const esSyncInterval = 30 * time.Second
+
+// EsSyncScheduler 定时重试同步失败的 es 任务，作为 kratos 的 server 随服务启动和停止
+type EsSyncScheduler struct {
+	uc   *biz.EsSyncUsecase
+	stop chan struct{}
+	log  *log.Helper
+}
+
+// NewEsSyncScheduler .
+func NewEsSyncScheduler(uc *biz.EsSyncUsecase, logger log.Logger) *EsSyncScheduler {
+	return &EsSyncScheduler{
+		uc:   uc,
+		stop: make(chan struct{}),
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (s *EsSyncScheduler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(esSyncInterval)
+	defer ticker.Stop()
+	for {
+		s.run(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *EsSyncScheduler) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
+
+func (s *EsSyncScheduler) run(ctx context.Context) {
+	count, err := s.uc.RetryDue(ctx)
+	if err != nil {
+		s.log.Errorf("retry es sync error: %v", err)
+		return
+	}
+	if count > 0 {
+		s.log.Infof("synced %d es tasks", count)
+	}
+}
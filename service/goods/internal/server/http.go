@@ -0,0 +1,54 @@
+package server
+
+import (
+	nethttp "net/http"
+
+	"goods/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// mediaPrefix 本地存储的媒体文件对外访问路径，需要和 data.media.base_url 的路径保持一致
+const mediaPrefix = "/media/"
+
+// NewHTTPServer 提供本地存储媒体文件的静态访问
+func NewHTTPServer(c *conf.Server, d *conf.Data, logger log.Logger) *http.Server {
+	var opts = []http.ServerOption{
+		http.Middleware(
+			recovery.Recovery(),
+		),
+	}
+	if c.Http != nil {
+		if c.Http.Network != "" {
+			opts = append(opts, http.Network(c.Http.Network))
+		}
+		if c.Http.Addr != "" {
+			opts = append(opts, http.Address(c.Http.Addr))
+		}
+		if c.Http.Timeout != nil {
+			opts = append(opts, http.Timeout(c.Http.Timeout.AsDuration()))
+		}
+	}
+	srv := http.NewServer(opts...)
+
+	root := "./static/media"
+	if d.Media != nil && d.Media.Root != "" {
+		root = d.Media.Root
+	}
+	srv.HandlePrefix(mediaPrefix, nethttp.StripPrefix(mediaPrefix, mediaHandler(nethttp.Dir(root))))
+	return srv
+}
+
+// mediaHandler 只提供文件下载，不列出目录内容
+func mediaHandler(fs nethttp.FileSystem) nethttp.Handler {
+	files := nethttp.FileServer(fs)
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.URL.Path == "" || r.URL.Path[len(r.URL.Path)-1] == '/' {
+			nethttp.NotFound(w, r)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"goods/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// priceInterval 检查到期价格变更的时间间隔
+const priceInterval = time.Minute
+
+// PriceScheduler 定时应用到期的 sku 价格变更，作为 kratos 的 server 随服务启动和停止
+type PriceScheduler struct {
+	uc   *biz.SkuPriceUsecase
+	stop chan struct{}
+	log  *log.Helper
+}
+
+// NewPriceScheduler .
+func NewPriceScheduler(uc *biz.SkuPriceUsecase, logger log.Logger) *PriceScheduler {
+	return &PriceScheduler{
+		uc:   uc,
+		stop: make(chan struct{}),
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (s *PriceScheduler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(priceInterval)
+	defer ticker.Stop()
+	for {
+		s.run(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PriceScheduler) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
+
+func (s *PriceScheduler) run(ctx context.Context) {
+	count, err := s.uc.ApplyDue(ctx)
+	if err != nil {
+		s.log.Errorf("apply sku price error: %v", err)
+		return
+	}
+	if count > 0 {
+		s.log.Infof("applied %d sku price changes", count)
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	v1 "goods/api/goods/v1"
+	"goods/internal/biz"
+	"goods/internal/domain"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (g *GoodsService) BrandList(ctx context.Context, r *v1.BrandListRequest) (*v1.BrandListResponse, error) {
+	list, total, err := g.bc.BrandList(ctx, &biz.Pagination{
+		PageNum:  int(r.Pages),
+		PageSize: int(r.PagePerNums),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.BrandListResponse{Total: int32(total)}
+	for _, b := range list {
+		rsp.Data = append(rsp.Data, brandInfoResponse(b))
+	}
+	return rsp, nil
+}
+
+func (g *GoodsService) CreateBrand(ctx context.Context, r *v1.BrandRequest) (*v1.BrandInfoResponse, error) {
+	brand, err := g.bc.CreateBrand(ctx, &domain.Brand{
+		Name:  r.Name,
+		Logo:  r.Logo,
+		Desc:  r.Desc,
+		IsTab: r.IsTab,
+		Sort:  r.Sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return brandInfoResponse(brand), nil
+}
+
+func (g *GoodsService) UpdateBrand(ctx context.Context, r *v1.BrandRequest) (*emptypb.Empty, error) {
+	err := g.bc.UpdateBrand(ctx, &domain.Brand{
+		ID:    r.Id,
+		Name:  r.Name,
+		Logo:  r.Logo,
+		Desc:  r.Desc,
+		IsTab: r.IsTab,
+		Sort:  r.Sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *GoodsService) DeleteBrand(ctx context.Context, r *v1.BrandRequest) (*emptypb.Empty, error) {
+	if err := g.bc.DeleteBrand(ctx, r.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func brandInfoResponse(b *domain.Brand) *v1.BrandInfoResponse {
+	return &v1.BrandInfoResponse{
+		Id:    b.ID,
+		Name:  b.Name,
+		Logo:  b.Logo,
+		Desc:  b.Desc,
+		IsTab: b.IsTab,
+		Sort:  b.Sort,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	v1 "goods/api/goods/v1"
 	"goods/internal/domain"
+
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // CreateGoods 创建商品
@@ -22,6 +24,7 @@ func (g *GoodsService) CreateGoods(ctx context.Context, r *v1.CreateGoodsRequest
 			Pic:            sku.Image,
 			Inventory:      sku.Inventory,
 			OnSale:         r.OnSale,
+			PurchaseLimit:  sku.PurchaseLimit,
 		}
 
 		for _, specification := range sku.SpecificationInfo {
@@ -94,6 +97,12 @@ func (g *GoodsService) GoodsList(ctx context.Context, r *v1.GoodsFilterRequest)
 		Pages:       r.Pages,
 		PagePerNums: r.PagePerNums,
 	}
+	for _, attr := range r.Attrs {
+		goodsFilter.Attrs = append(goodsFilter.Attrs, &domain.ESAttrFilter{
+			AttrID:   attr.AttrId,
+			ValueIDs: attr.ValueIds,
+		})
+	}
 
 	result, err := g.esGoods.GoodsList(ctx, goodsFilter)
 	if err != nil {
@@ -103,26 +112,116 @@ func (g *GoodsService) GoodsList(ctx context.Context, r *v1.GoodsFilterRequest)
 		Total: result.Total,
 	}
 	for _, goods := range result.List {
-		res := v1.GoodsInfoResponse{
-			Id:          goods.ID,
-			CategoryId:  goods.CategoryID,
-			BrandId:     goods.BrandsID,
-			Name:        goods.Name,
-			GoodsSn:     goods.GoodsSn,
-			ClickNum:    goods.ClickNum,
-			SoldNum:     goods.SoldNum,
-			FavNum:      goods.FavNum,
-			MarketPrice: goods.MarketPrice,
-			GoodsBrief:  goods.GoodsBrief,
-			GoodsDesc:   goods.GoodsBrief,
-			ShipFree:    goods.ShipFree,
-			Images:      goods.GoodsFrontImage,
-			GoodsImages: goods.GoodsImages,
-			IsNew:       goods.IsNew,
-			IsHot:       goods.IsHot,
-			OnSale:      goods.OnSale,
-		}
-		response.List = append(response.List, &res)
+		response.List = append(response.List, goodsInfoResponse(goods))
 	}
 	return &response, nil
 }
+
+// SkuList 批量查询 sku 信息
+func (g *GoodsService) SkuList(ctx context.Context, r *v1.SkuListRequest) (*v1.SkuListResponse, error) {
+	skus, err := g.g.SkuList(ctx, r.Id...)
+	if err != nil {
+		return nil, err
+	}
+	return skuListResponse(skus), nil
+}
+
+func skuListResponse(skus []*domain.GoodsSku) *v1.SkuListResponse {
+	response := &v1.SkuListResponse{}
+	for _, sku := range skus {
+		response.List = append(response.List, &v1.SkuInfoResponse{
+			Id:             sku.ID,
+			GoodsId:        sku.GoodsID,
+			GoodsSn:        sku.GoodsSn,
+			GoodsName:      sku.GoodsName,
+			SkuName:        sku.SkuName,
+			SkuCode:        sku.SkuCode,
+			Price:          sku.Price,
+			PromotionPrice: sku.PromotionPrice,
+			Points:         sku.Points,
+			Pic:            sku.Pic,
+			Inventory:      sku.Inventory,
+			OnSale:         sku.OnSale,
+			ShipFree:       sku.ShipFree,
+			ShipId:         sku.ShipID,
+			PurchaseLimit:  sku.PurchaseLimit,
+		})
+	}
+	return response
+}
+
+func (g *GoodsService) UpdateSkuPurchaseLimit(ctx context.Context, r *v1.SkuPurchaseLimitRequest) (*emptypb.Empty, error) {
+	if err := g.g.UpdateSkuPurchaseLimit(ctx, r.SkuId, r.PurchaseLimit); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// BatchGetGoods 批量查询商品信息
+func (g *GoodsService) BatchGetGoods(ctx context.Context, r *v1.BatchGoodsIdInfo) (*v1.GoodsListResponse, error) {
+	list, err := g.g.BatchGetGoods(ctx, r.Id...)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1.GoodsListResponse{Total: int64(len(list))}
+	for _, goods := range list {
+		response.List = append(response.List, goodsInfoResponse(goods))
+	}
+	return response, nil
+}
+
+// GetGoodsDetail 商品详情
+func (g *GoodsService) GetGoodsDetail(ctx context.Context, r *v1.GoodInfoRequest) (*v1.GoodsInfoResponse, error) {
+	goods, err := g.g.GoodsDetail(ctx, r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return goodsInfoResponse(goods), nil
+}
+
+// GoodsSkuList 商品下的所有 sku
+func (g *GoodsService) GoodsSkuList(ctx context.Context, r *v1.GoodInfoRequest) (*v1.SkuListResponse, error) {
+	skus, err := g.g.GoodsSkuList(ctx, r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return skuListResponse(skus), nil
+}
+
+// ApplyFavEvents 处理收藏数变化的事件
+func (g *GoodsService) ApplyFavEvents(ctx context.Context, r *v1.FavEventsRequest) (*emptypb.Empty, error) {
+	var events []*domain.FavEvent
+	for _, e := range r.Events {
+		events = append(events, &domain.FavEvent{
+			EventID: e.EventId,
+			GoodsID: e.GoodsId,
+			Delta:   e.Delta,
+		})
+	}
+	if err := g.g.ApplyFavEvents(ctx, events); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func goodsInfoResponse(goods *domain.Goods) *v1.GoodsInfoResponse {
+	return &v1.GoodsInfoResponse{
+		Id:          goods.ID,
+		CategoryId:  goods.CategoryID,
+		BrandId:     goods.BrandsID,
+		Name:        goods.Name,
+		GoodsSn:     goods.GoodsSn,
+		ClickNum:    goods.ClickNum,
+		SoldNum:     goods.SoldNum,
+		FavNum:      goods.FavNum,
+		MarketPrice: goods.MarketPrice,
+		GoodsBrief:  goods.GoodsBrief,
+		GoodsDesc:   goods.GoodsBrief,
+		ShipFree:    goods.ShipFree,
+		Images:      goods.GoodsFrontImage,
+		GoodsImages: goods.GoodsImages,
+		IsNew:       goods.IsNew,
+		IsHot:       goods.IsHot,
+		OnSale:      goods.OnSale,
+	}
+}
@@ -3,7 +3,10 @@ package service
 import (
 	"context"
 	v1 "goods/api/goods/v1"
+	"goods/internal/biz"
 	"goods/internal/domain"
+
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // 创建商品类型
@@ -24,3 +27,134 @@ func (g *GoodsService) CreateGoodsType(ctx context.Context, r *v1.GoodsTypeReque
 		Id: id,
 	}, nil
 }
+
+// 商品类型列表
+func (g *GoodsService) GoodsTypeList(ctx context.Context, r *v1.GoodsTypeListRequest) (*v1.GoodsTypeListResponse, error) {
+	list, total, err := g.gt.GoodsTypeList(ctx, &biz.Pagination{
+		PageNum:  int(r.Pages),
+		PageSize: int(r.PagePerNums),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1.GoodsTypeListResponse{Total: total}
+	for _, item := range list {
+		response.List = append(response.List, goodsTypeInfoResponse(item))
+	}
+	return response, nil
+}
+
+// 修改商品类型
+func (g *GoodsService) UpdateGoodsType(ctx context.Context, r *v1.UpdateGoodsTypeRequest) (*emptypb.Empty, error) {
+	err := g.gt.UpdateGoodsType(ctx, &domain.GoodsTypeUpdate{
+		ID:        r.Id,
+		Name:      r.Name,
+		TypeCode:  r.TypeCode,
+		NameAlias: r.NameAlias,
+		IsVirtual: r.IsVirtual,
+		Desc:      r.Desc,
+		Sort:      r.Sort,
+		BrandIds:  r.BrandIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// 删除商品类型
+func (g *GoodsService) DeleteGoodsType(ctx context.Context, r *v1.DeleteGoodsTypeRequest) (*emptypb.Empty, error) {
+	if err := g.gt.DeleteGoodsType(ctx, r.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetGoodsTypeTemplate 获取商品类型模板
+func (g *GoodsService) GetGoodsTypeTemplate(ctx context.Context, r *v1.GoodsTypeTemplateRequest) (*v1.GoodsTypeTemplateResponse, error) {
+	template, err := g.gt.GetGoodsTypeTemplate(ctx, r.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1.GoodsTypeTemplateResponse{
+		Info: goodsTypeInfoResponse(template.GoodsType),
+	}
+	for _, b := range template.Brands {
+		response.Brands = append(response.Brands, &v1.BrandInfoResponse{
+			Id:    b.ID,
+			Name:  b.Name,
+			Logo:  b.Logo,
+			Desc:  b.Desc,
+			IsTab: b.IsTab,
+			Sort:  b.Sort,
+		})
+	}
+
+	for _, s := range template.Specifications {
+		spec := &v1.SpecificationInfoResponse{
+			Id:       s.ID,
+			TypeId:   s.TypeID,
+			Name:     s.Name,
+			Sort:     s.Sort,
+			Status:   s.Status,
+			IsSku:    s.IsSKU,
+			IsSelect: s.IsSelect,
+		}
+		for _, v := range s.SpecificationValue {
+			spec.SpecificationValue = append(spec.SpecificationValue, &v1.SpecificationValueResponse{
+				Id:     v.ID,
+				AttrId: v.AttrId,
+				Value:  v.Value,
+				Sort:   v.Sort,
+			})
+		}
+		response.Specifications = append(response.Specifications, spec)
+	}
+
+	for _, group := range template.AttrGroups {
+		groupRes := &v1.AttrGroupInfoResponse{
+			Id:     group.ID,
+			TypeId: group.TypeID,
+			Title:  group.Title,
+			Desc:   group.Desc,
+			Status: group.Status,
+			Sort:   group.Sort,
+		}
+		for _, attr := range group.GoodsAttr {
+			attrRes := &v1.AttrResponse{
+				Id:      attr.ID,
+				TypeId:  attr.TypeID,
+				GroupId: attr.GroupID,
+				Title:   attr.Title,
+				Desc:    attr.Desc,
+				Status:  attr.Status,
+				Sort:    attr.Sort,
+			}
+			for _, v := range attr.GoodsAttrValue {
+				attrRes.AttrValue = append(attrRes.AttrValue, &v1.AttrValueResponse{
+					Id:      v.ID,
+					AttrId:  v.AttrId,
+					GroupId: v.GroupID,
+					Value:   v.Value,
+				})
+			}
+			groupRes.Attr = append(groupRes.Attr, attrRes)
+		}
+		response.AttrGroups = append(response.AttrGroups, groupRes)
+	}
+	return response, nil
+}
+
+func goodsTypeInfoResponse(t *domain.GoodsType) *v1.GoodsTypeInfoResponse {
+	return &v1.GoodsTypeInfoResponse{
+		Id:        t.ID,
+		Name:      t.Name,
+		TypeCode:  t.TypeCode,
+		NameAlias: t.NameAlias,
+		IsVirtual: t.IsVirtual,
+		Desc:      t.Desc,
+		Sort:      t.Sort,
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	v1 "goods/api/goods/v1"
+	"time"
+)
+
+// UploadMedia 上传图片
+func (g *GoodsService) UploadMedia(ctx context.Context, r *v1.UploadMediaRequest) (*v1.MediaResponse, error) {
+	media, err := g.m.Upload(ctx, r.Content)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MediaResponse{
+		Id:          media.ID,
+		Url:         media.URL,
+		ThumbUrl:    media.ThumbURL,
+		Hash:        media.Hash,
+		ContentType: media.ContentType,
+		Size:        media.Size,
+		Width:       media.Width,
+		Height:      media.Height,
+	}, nil
+}
+
+// MediaGC 清理没有被引用的图片
+func (g *GoodsService) MediaGC(ctx context.Context, r *v1.MediaGCRequest) (*v1.MediaGCResponse, error) {
+	removed, err := g.m.GC(ctx, time.Duration(r.GraceSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MediaGCResponse{Removed: removed}, nil
+}
@@ -21,20 +21,25 @@ type GoodsService struct {
 	ga      *biz.GoodsAttrUsecase
 	g       *biz.GoodsUsecase
 	esGoods *biz.EsGoodsUsecase
+	m       *biz.MediaUsecase
+	sp      *biz.SkuPriceUsecase
 	log     *log.Helper
 }
 
 // NewGoodsService new a goods service.
 func NewGoodsService(bc *biz.BrandUsecase, cac *biz.CategoryUsecase, gt *biz.GoodsTypeUsecase, s *biz.SpecificationUsecase,
-	ga *biz.GoodsAttrUsecase, gc *biz.GoodsUsecase, esGoods *biz.EsGoodsUsecase, logger log.Logger) *GoodsService {
+	ga *biz.GoodsAttrUsecase, gc *biz.GoodsUsecase, esGoods *biz.EsGoodsUsecase, m *biz.MediaUsecase,
+	sp *biz.SkuPriceUsecase, logger log.Logger) *GoodsService {
 	return &GoodsService{
-		bc:  bc,
-		cac: cac,
-		gt:  gt,
-		s:   s,
-		ga:  ga,
-		g:   gc,
-		// esGoods: esGoods,
-		log: log.NewHelper(logger),
+		bc:      bc,
+		cac:     cac,
+		gt:      gt,
+		s:       s,
+		ga:      ga,
+		g:       gc,
+		esGoods: esGoods,
+		m:       m,
+		sp:      sp,
+		log:     log.NewHelper(logger),
 	}
 }
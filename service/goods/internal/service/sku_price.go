@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	v1 "goods/api/goods/v1"
+	"goods/internal/domain"
+	"time"
+)
+
+// ChangeSkuPrice 修改 sku 价格
+func (g *GoodsService) ChangeSkuPrice(ctx context.Context, r *v1.ChangeSkuPriceRequest) (*v1.SkuPriceResponse, error) {
+	req := &domain.SkuPrice{
+		SkuID:          r.SkuId,
+		Price:          r.Price,
+		PromotionPrice: r.PromotionPrice,
+		Remark:         r.Remark,
+	}
+	if r.EffectiveFrom > 0 {
+		req.EffectiveFrom = time.Unix(r.EffectiveFrom, 0)
+	}
+	if r.EffectiveUntil > 0 {
+		until := time.Unix(r.EffectiveUntil, 0)
+		req.EffectiveUntil = &until
+	}
+
+	price, err := g.sp.ChangePrice(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return skuPriceResponse(price), nil
+}
+
+// SkuPriceAt 查询某个时间点 sku 有效的价格
+func (g *GoodsService) SkuPriceAt(ctx context.Context, r *v1.SkuPriceAtRequest) (*v1.SkuPriceResponse, error) {
+	price, err := g.sp.PriceAt(ctx, r.SkuId, time.Unix(r.Timestamp, 0))
+	if err != nil {
+		return nil, err
+	}
+	return skuPriceResponse(price), nil
+}
+
+// SkuPriceHistory sku 价格变更记录
+func (g *GoodsService) SkuPriceHistory(ctx context.Context, r *v1.SkuPriceHistoryRequest) (*v1.SkuPriceHistoryResponse, error) {
+	list, err := g.sp.History(ctx, r.SkuId)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1.SkuPriceHistoryResponse{}
+	for _, item := range list {
+		response.List = append(response.List, skuPriceResponse(item))
+	}
+	return response, nil
+}
+
+func skuPriceResponse(p *domain.SkuPrice) *v1.SkuPriceResponse {
+	res := &v1.SkuPriceResponse{
+		Id:             p.ID,
+		SkuId:          p.SkuID,
+		Price:          p.Price,
+		PromotionPrice: p.PromotionPrice,
+		EffectiveFrom:  p.EffectiveFrom.Unix(),
+		Status:         p.Status,
+		Remark:         p.Remark,
+		CreatedAt:      p.CreatedAt.Unix(),
+	}
+	if p.EffectiveUntil != nil {
+		res.EffectiveUntil = p.EffectiveUntil.Unix()
+	}
+	return res
+}
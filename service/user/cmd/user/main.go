@@ -108,7 +108,7 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	app, cleanup, err := wireApp(bc.Server, bc.Data, &rc, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, &rc, logger)
 	if err != nil {
 		panic(err)
 	}
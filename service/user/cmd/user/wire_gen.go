@@ -19,16 +19,27 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewDB(confData)
 	client := data.NewRedis(confData)
 	dataData, cleanup, err := data.NewData(confData, logger, db, client)
 	if err != nil {
 		return nil, nil, err
 	}
-	userRepo := data.NewUserRepo(dataData, logger)
-	userUsecase := biz.NewUserUsecase(userRepo, logger)
-	userService := service.NewUserService(userUsecase, logger)
+	passwordHasher := data.NewPasswordHasher(auth)
+	userRepo := data.NewUserRepo(dataData, passwordHasher, logger)
+	passwordPolicy := biz.NewPasswordPolicy(auth)
+	resetTicket, err := biz.NewResetTicket(auth)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userUsecase := biz.NewUserUsecase(userRepo, passwordPolicy, resetTicket, logger)
+	lockEventRepo := data.NewLockEventRepo(dataData, logger)
+	lockEventUsecase := biz.NewLockEventUsecase(lockEventRepo, logger)
+	addressRepo := data.NewAddressRepo(dataData, logger)
+	addressUsecase := biz.NewAddressUsecase(addressRepo, logger)
+	userService := service.NewUserService(userUsecase, lockEventUsecase, addressUsecase, logger)
 	grpcServer := server.NewGRPCServer(confServer, userService, logger)
 	registrar := server.NewRegistrar(registry)
 	app := newApp(logger, grpcServer, registrar)
@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MaxAddressCount 每个用户最多保存的收货地址数量
+const MaxAddressCount = 20
+
+var (
+	ErrAddressInvalid  = errors.BadRequest("ADDRESS_INVALID", "收货地址信息不完整")
+	ErrAddressLimit    = errors.BadRequest("ADDRESS_LIMIT", "收货地址数量已达上限")
+	ErrAddressNotFound = errors.NotFound("ADDRESS_NOT_FOUND", "收货地址不存在")
+)
+
+var (
+	regionCodeRegexp = regexp.MustCompile(`^\d{6}$`)
+	mobileRegexp     = regexp.MustCompile(`^1[3-9]\d{9}$`)
+)
+
+// Address 收货地址
+type Address struct {
+	ID           int64
+	UserID       int64
+	ProvinceCode string
+	CityCode     string
+	DistrictCode string
+	Recipient    string
+	Mobile       string
+	Detail       string
+	IsDefault    bool
+}
+
+// AddressRepo 每个用户最多一个默认地址，由实现在事务中保证
+type AddressRepo interface {
+	// CreateAddress 用户的第一个地址自动设为默认
+	CreateAddress(ctx context.Context, a *Address) (*Address, error)
+	UpdateAddress(ctx context.Context, a *Address) error
+	// DeleteAddress 删除默认地址时把最近添加的地址设为默认
+	DeleteAddress(ctx context.Context, userId, id int64) error
+	ListAddress(ctx context.Context, userId int64) ([]*Address, error)
+	SetDefaultAddress(ctx context.Context, userId, id int64) error
+}
+
+type AddressUsecase struct {
+	repo AddressRepo
+	log  *log.Helper
+}
+
+func NewAddressUsecase(repo AddressRepo, logger log.Logger) *AddressUsecase {
+	return &AddressUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func (uc *AddressUsecase) Create(ctx context.Context, a *Address) (*Address, error) {
+	if err := checkAddress(a); err != nil {
+		return nil, err
+	}
+	return uc.repo.CreateAddress(ctx, a)
+}
+
+func (uc *AddressUsecase) Update(ctx context.Context, a *Address) error {
+	if a.ID <= 0 {
+		return ErrAddressNotFound
+	}
+	if err := checkAddress(a); err != nil {
+		return err
+	}
+	return uc.repo.UpdateAddress(ctx, a)
+}
+
+func (uc *AddressUsecase) Delete(ctx context.Context, userId, id int64) error {
+	return uc.repo.DeleteAddress(ctx, userId, id)
+}
+
+func (uc *AddressUsecase) List(ctx context.Context, userId int64) ([]*Address, error) {
+	return uc.repo.ListAddress(ctx, userId)
+}
+
+func (uc *AddressUsecase) SetDefault(ctx context.Context, userId, id int64) error {
+	return uc.repo.SetDefaultAddress(ctx, userId, id)
+}
+
+// checkAddress 校验必填字段和格式，顺便去掉首尾空白
+func checkAddress(a *Address) error {
+	a.Recipient = strings.TrimSpace(a.Recipient)
+	a.Detail = strings.TrimSpace(a.Detail)
+	if a.UserID <= 0 {
+		return ErrAddressInvalid
+	}
+	for _, code := range []string{a.ProvinceCode, a.CityCode, a.DistrictCode} {
+		if !regionCodeRegexp.MatchString(code) {
+			return errors.BadRequest("ADDRESS_INVALID", "地区代码错误")
+		}
+	}
+	if n := utf8.RuneCountInString(a.Recipient); n == 0 || n > 25 {
+		return errors.BadRequest("ADDRESS_INVALID", "收货人不能为空且不能超过 25 个字")
+	}
+	if !mobileRegexp.MatchString(a.Mobile) {
+		return errors.BadRequest("ADDRESS_INVALID", "收货人手机号格式错误")
+	}
+	if n := utf8.RuneCountInString(a.Detail); n == 0 || n > 200 {
+		return errors.BadRequest("ADDRESS_INVALID", "详细地址不能为空且不能超过 200 个字")
+	}
+	return nil
+}
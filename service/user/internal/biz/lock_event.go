@@ -0,0 +1,73 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 登录锁定事件的动作和范围
+const (
+	LockActionLock   = "lock"
+	LockActionUnlock = "unlock"
+
+	LockScopeMobile = "mobile"
+	LockScopeIP     = "ip"
+)
+
+var ErrLockEventInvalid = errors.BadRequest("LOCK_EVENT_INVALID", "锁定事件参数错误")
+
+// LockEvent 网关登录防暴力破解产生的锁定、解锁记录
+type LockEvent struct {
+	ID        int64
+	Mobile    string
+	IP        string
+	Action    string
+	Scope     string
+	Reason    string
+	Failures  int64
+	Until     *time.Time
+	Operator  int64
+	CreatedAt time.Time
+}
+
+// LockEventFilter 锁定事件查询条件
+type LockEventFilter struct {
+	Mobile   string
+	IP       string
+	PageNum  int
+	PageSize int
+}
+
+type LockEventRepo interface {
+	CreateLockEvent(ctx context.Context, e *LockEvent) error
+	ListLockEvent(ctx context.Context, f *LockEventFilter) ([]*LockEvent, int, error)
+}
+
+type LockEventUsecase struct {
+	repo LockEventRepo
+	log  *log.Helper
+}
+
+func NewLockEventUsecase(repo LockEventRepo, logger log.Logger) *LockEventUsecase {
+	return &LockEventUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+func (uc *LockEventUsecase) Create(ctx context.Context, e *LockEvent) error {
+	if e.Action != LockActionLock && e.Action != LockActionUnlock {
+		return ErrLockEventInvalid
+	}
+	if e.Scope != LockScopeMobile && e.Scope != LockScopeIP {
+		return ErrLockEventInvalid
+	}
+	if (e.Scope == LockScopeMobile && e.Mobile == "") || (e.Scope == LockScopeIP && e.IP == "") {
+		return ErrLockEventInvalid
+	}
+	return uc.repo.CreateLockEvent(ctx, e)
+}
+
+func (uc *LockEventUsecase) List(ctx context.Context, f *LockEventFilter) ([]*LockEvent, int, error) {
+	return uc.repo.ListLockEvent(ctx, f)
+}
@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"user/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 密码强度默认规则
+const (
+	defaultPasswordMinLength  = 8
+	defaultPasswordMaxLength  = 64
+	defaultPasswordMinClasses = 2
+
+	// bcryptMaxBytes bcrypt 只使用密码的前 72 字节
+	bcryptMaxBytes = 72
+)
+
+// PasswordPolicy 密码强度规则，创建用户、修改和重置密码时检查
+type PasswordPolicy struct {
+	minLength  int
+	maxLength  int
+	minClasses int
+	maxBytes   int // 按字节的长度上限，0 为不限制
+}
+
+func NewPasswordPolicy(c *conf.Auth) *PasswordPolicy {
+	p := c.GetPassword()
+	policy := &PasswordPolicy{
+		minLength:  int(p.GetMinLength()),
+		maxLength:  int(p.GetMaxLength()),
+		minClasses: int(p.GetMinClasses()),
+	}
+	if policy.minLength <= 0 {
+		policy.minLength = defaultPasswordMinLength
+	}
+	if policy.maxLength <= 0 {
+		policy.maxLength = defaultPasswordMaxLength
+	}
+	if policy.minClasses <= 0 {
+		policy.minClasses = defaultPasswordMinClasses
+	}
+	// 默认算法是 bcrypt，超过 72 字节的部分不参与哈希
+	if algorithm := p.GetAlgorithm(); algorithm == "" || algorithm == "bcrypt" {
+		policy.maxBytes = bcryptMaxBytes
+	}
+	return policy
+}
+
+// Check 检查长度和包含的字符种类
+func (p *PasswordPolicy) Check(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n < p.minLength || n > p.maxLength {
+		return errors.BadRequest("PASSWORD_TOO_WEAK", fmt.Sprintf("密码长度需要在 %d 到 %d 位之间", p.minLength, p.maxLength))
+	}
+	if p.maxBytes > 0 && len(password) > p.maxBytes {
+		return errors.BadRequest("PASSWORD_TOO_WEAK", fmt.Sprintf("密码不能超过 %d 字节", p.maxBytes))
+	}
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		case unicode.IsSpace(r):
+			return errors.BadRequest("PASSWORD_TOO_WEAK", "密码不能包含空白字符")
+		default:
+			symbol = 1
+		}
+	}
+	if lower+upper+digit+symbol < p.minClasses {
+		return errors.BadRequest("PASSWORD_TOO_WEAK",
+			fmt.Sprintf("密码需要包含小写字母、大写字母、数字、符号中的至少 %d 种", p.minClasses))
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package biz
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"user/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var ErrResetTicketInvalid = errors.BadRequest("RESET_TICKET_INVALID", "重置密码凭证无效或已过期")
+
+// ResetTicket 校验网关签发的重置密码凭证。网关验证手机验证码后签发凭证，
+// 格式为 base64(手机号:随机串:过期时间戳).base64(HMAC-SHA256)，随机串用于保证只能使用一次
+type ResetTicket struct {
+	key []byte
+}
+
+func NewResetTicket(c *conf.Auth) (*ResetTicket, error) {
+	key := c.GetResetTicketKey()
+	if key == "" {
+		return nil, errors.InternalServer("RESET_TICKET_KEY_EMPTY", "auth.reset_ticket_key 没有配置")
+	}
+	return &ResetTicket{key: []byte(key)}, nil
+}
+
+// Verify 校验签名、手机号和有效期，返回凭证的随机串和过期时间
+func (t *ResetTicket) Verify(ticket, mobile string, now time.Time) (string, time.Time, error) {
+	parts := strings.Split(ticket, ".")
+	if len(parts) != 2 {
+		return "", time.Time{}, ErrResetTicketInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return "", time.Time{}, ErrResetTicketInvalid
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, t.sign(payload)) {
+		return "", time.Time{}, ErrResetTicketInvalid
+	}
+	fields := strings.Split(string(payload), ":")
+	if len(fields) != 3 || fields[0] != mobile || fields[1] == "" {
+		return "", time.Time{}, ErrResetTicketInvalid
+	}
+	exp, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil || now.Unix() >= exp {
+		return "", time.Time{}, ErrResetTicketInvalid
+	}
+	return fields[1], time.Unix(exp, 0), nil
+}
+
+func (t *ResetTicket) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, t.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
@@ -2,25 +2,30 @@ package biz
 
 import (
 	"context"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 )
 
 // 定义返回数据结构体
 type User struct {
-	ID          int64
-	Mobile      string
-	Password    string
-	NickName    string
-	Birthday    *time.Time
-	Gender      string
-	Role        int
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt
-	IsDeletedAt bool
+	ID           int64
+	Mobile       string
+	Password     string
+	NickName     string
+	Birthday     *time.Time
+	Gender       string
+	Role         int
+	TokenVersion int64 // 修改密码后加一，网关据此拒绝之前签发的 token
+	Disabled     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt
+	IsDeletedAt  bool
 }
 
 // var (
@@ -28,28 +33,90 @@ type User struct {
 // 	ErrUserNotFound = errors.NotFound(v1.ErrorReason_USER_NOT_FOUND.String(), "user not found")
 // )
 
+// ErrInvalidCredentials 手机号不存在和密码错误返回同一个错误，避免被用来探测手机号是否注册
+var ErrInvalidCredentials = errors.Unauthorized("INVALID_CREDENTIALS", "手机号或密码错误")
+
+var ErrOldPasswordInvalid = errors.BadRequest("OLD_PASSWORD_INVALID", "原密码错误")
+
+var (
+	ErrUserDisabled      = errors.Forbidden("USER_DISABLED", "账号已被禁用")
+	ErrRoleInvalid       = errors.BadRequest("ROLE_INVALID", "用户角色错误")
+	ErrGenderInvalid     = errors.BadRequest("GENDER_INVALID", "性别只能是 male 或 female")
+	ErrNickNameInvalid   = errors.BadRequest("NICKNAME_INVALID", "昵称不能为空且不能超过 25 个字")
+	ErrBirthdayInvalid   = errors.BadRequest("BIRTHDAY_INVALID", "生日不能晚于今天")
+	ErrUpdateMaskInvalid = errors.BadRequest("UPDATE_MASK_INVALID", "不支持更新的字段")
+)
+
+// UpdateUser 可以更新的字段，和 UpdateUserInfo 中的字段名一致
+const (
+	UserFieldNickName = "nickName"
+	UserFieldGender   = "gender"
+	UserFieldBirthday = "birthday"
+)
+
+// 性别
+const (
+	GenderMale   = "male"
+	GenderFemale = "female"
+)
+
+// 用户角色
+const (
+	RoleUser  = 1
+	RoleAdmin = 2
+)
+
+// UserFilter 后台搜索用户的条件，零值表示不过滤
+type UserFilter struct {
+	Mobile    string // 手机号前缀
+	NickName  string // 昵称包含
+	Role      int
+	Gender    string
+	StartTime *time.Time // 注册时间范围，包含开始时间，不包含结束时间
+	EndTime   *time.Time
+	PageNum   int
+	PageSize  int
+}
+
 // 注意这一行新增的 mock 数据的命令
 //
 //go:generate mockgen -destination=../mocks/mrepo/user.go -package=mrepo . UserRepo
 type UserRepo interface {
 	CreateUser(context.Context, *User) (*User, error)
 	UserByMobile(context.Context, string) (*User, error)
-	ListUser(ctx context.Context, pageNum, pageSize int) ([]*User, int, error)
+	ListUser(ctx context.Context, f *UserFilter) ([]*User, int, error)
 	GetUserById(ctx context.Context, id int64) (*User, error)
-	UpdateUser(context.Context, *User) (bool, error)
+	// UpdateUser 只更新 fields 中的字段，Birthday 为 nil 时清空生日
+	UpdateUser(ctx context.Context, user *User, fields []string) (bool, error)
 	CheckPassword(ctx context.Context, password, encryptedPassword string) (bool, error)
+	// UpdatePassword 更新密码并将 token 版本加一，返回新的 token 版本
+	UpdatePassword(ctx context.Context, id int64, password string) (int64, error)
+	// RehashPassword 哈希算法或参数变化时用明文密码重新哈希
+	RehashPassword(ctx context.Context, id int64, password, encryptedPassword string) error
+	// UpdateDisabled 和 UpdateRole 同时将 token 版本加一，返回新的 token 版本
+	UpdateDisabled(ctx context.Context, id int64, disabled bool) (int64, error)
+	UpdateRole(ctx context.Context, id int64, role int) (int64, error)
+	// UseResetTicket 记录已经使用的重置密码凭证，凭证已经使用过时返回 false
+	UseResetTicket(ctx context.Context, nonce string, expiredAt time.Time) (bool, error)
+	// DeleteUser 匿名化并软删除用户，保留用户ID供订单等数据引用，返回新的 token 版本
+	DeleteUser(ctx context.Context, id int64) (int64, error)
 }
 
 type UserUsecase struct {
-	repo UserRepo
-	log  *log.Helper
+	repo   UserRepo
+	policy *PasswordPolicy
+	ticket *ResetTicket
+	log    *log.Helper
 }
 
-func NewUserUsecase(repo UserRepo, logger log.Logger) *UserUsecase {
-	return &UserUsecase{repo: repo, log: log.NewHelper(logger)}
+func NewUserUsecase(repo UserRepo, policy *PasswordPolicy, ticket *ResetTicket, logger log.Logger) *UserUsecase {
+	return &UserUsecase{repo: repo, policy: policy, ticket: ticket, log: log.NewHelper(logger)}
 }
 
 func (uc *UserUsecase) Create(ctx context.Context, u *User) (*User, error) {
+	if err := uc.policy.Check(u.Password); err != nil {
+		return nil, err
+	}
 	return uc.repo.CreateUser(ctx, u)
 }
 
@@ -57,16 +124,140 @@ func (uc *UserUsecase) UserByMobile(ctx context.Context, mobile string) (*User,
 	return uc.repo.UserByMobile(ctx, mobile)
 }
 
-func (uc *UserUsecase) List(ctx context.Context, pageNum, pageSize int) ([]*User, int, error) {
-	return uc.repo.ListUser(ctx, pageNum, pageSize)
+func (uc *UserUsecase) List(ctx context.Context, f *UserFilter) ([]*User, int, error) {
+	return uc.repo.ListUser(ctx, f)
+}
+
+// SetDisabled 禁用或启用账号，已经签发的 token 全部失效
+func (uc *UserUsecase) SetDisabled(ctx context.Context, id int64, disabled bool) (*User, error) {
+	version, err := uc.repo.UpdateDisabled(ctx, id, disabled)
+	if err != nil {
+		return nil, err
+	}
+	return &User{ID: id, Disabled: disabled, TokenVersion: version}, nil
+}
+
+// ChangeRole 修改角色，token 中带有角色，之前签发的 token 全部失效
+func (uc *UserUsecase) ChangeRole(ctx context.Context, id int64, role int) (*User, error) {
+	if role != RoleUser && role != RoleAdmin {
+		return nil, ErrRoleInvalid
+	}
+	version, err := uc.repo.UpdateRole(ctx, id, role)
+	if err != nil {
+		return nil, err
+	}
+	return &User{ID: id, Role: role, TokenVersion: version}, nil
+}
+
+// UpdateUser 只校验和更新 fields 中的字段，fields 为空时更新全部字段
+func (uc *UserUsecase) UpdateUser(ctx context.Context, user *User, fields []string) (bool, error) {
+	if len(fields) == 0 {
+		fields = []string{UserFieldNickName, UserFieldGender, UserFieldBirthday}
+	}
+	for _, field := range fields {
+		switch field {
+		case UserFieldNickName:
+			user.NickName = strings.TrimSpace(user.NickName)
+			if n := utf8.RuneCountInString(user.NickName); n == 0 || n > 25 {
+				return false, ErrNickNameInvalid
+			}
+		case UserFieldGender:
+			if user.Gender != GenderMale && user.Gender != GenderFemale {
+				return false, ErrGenderInvalid
+			}
+		case UserFieldBirthday:
+			if user.Birthday != nil && user.Birthday.After(time.Now()) {
+				return false, ErrBirthdayInvalid
+			}
+		default:
+			return false, ErrUpdateMaskInvalid
+		}
+	}
+	return uc.repo.UpdateUser(ctx, user, fields)
+}
+
+// dummyPasswordHash 用户不存在时比对的固定哈希，和默认的 bcrypt 参数一致
+const dummyPasswordHash = "$2a$12$7GHc86l3dnsxXPuM47fx8uQSaENz5OLJU/t2xkJNVUF3eSIJLOKNe"
+
+// VerifyCredentials 在服务内部完成查询和密码比对，密码摘要不离开用户服务
+func (uc *UserUsecase) VerifyCredentials(ctx context.Context, mobile, password string) (*User, error) {
+	user, err := uc.repo.UserByMobile(ctx, mobile)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// 用户不存在时也比对一次密码，响应时间不会暴露手机号是否注册
+			_, _ = uc.repo.CheckPassword(ctx, password, dummyPasswordHash)
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	if ok, err := uc.repo.CheckPassword(ctx, password, user.Password); err != nil || !ok {
+		return nil, ErrInvalidCredentials
+	}
+	// 密码正确后才提示账号已禁用，避免被用来探测账号状态
+	if user.Disabled {
+		return nil, ErrUserDisabled
+	}
+	// 只有登录时才能拿到明文密码，在这里升级旧的哈希，失败不影响登录
+	if err := uc.repo.RehashPassword(ctx, user.ID, password, user.Password); err != nil {
+		uc.log.Errorf("rehash password error: %v", err)
+	}
+	return user, nil
+}
+
+// ChangePassword 校验旧密码后修改密码，返回的用户带有新的 token 版本
+func (uc *UserUsecase) ChangePassword(ctx context.Context, id int64, oldPassword, newPassword string) (*User, error) {
+	user, err := uc.repo.GetUserById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := uc.repo.CheckPassword(ctx, oldPassword, user.Password); err != nil || !ok {
+		return nil, ErrOldPasswordInvalid
+	}
+	return uc.updatePassword(ctx, user, newPassword)
+}
+
+// ResetPassword 忘记密码时重置，需要网关验证手机验证码后签发的一次性凭证
+func (uc *UserUsecase) ResetPassword(ctx context.Context, mobile, ticket, newPassword string) (*User, error) {
+	nonce, expiredAt, err := uc.ticket.Verify(ticket, mobile, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.policy.Check(newPassword); err != nil {
+		return nil, err
+	}
+	user, err := uc.repo.UserByMobile(ctx, mobile)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := uc.repo.UseResetTicket(ctx, nonce, expiredAt)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrResetTicketInvalid
+	}
+	return uc.updatePassword(ctx, user, newPassword)
 }
 
-func (uc *UserUsecase) UpdateUser(ctx context.Context, user *User) (bool, error) {
-	return uc.repo.UpdateUser(ctx, user)
+func (uc *UserUsecase) updatePassword(ctx context.Context, user *User, password string) (*User, error) {
+	if err := uc.policy.Check(password); err != nil {
+		return nil, err
+	}
+	version, err := uc.repo.UpdatePassword(ctx, user.ID, password)
+	if err != nil {
+		return nil, err
+	}
+	user.TokenVersion = version
+	return user, nil
 }
 
-func (uc *UserUsecase) CheckPassword(ctx context.Context, password, encryptedPassword string) (bool, error) {
-	return uc.repo.CheckPassword(ctx, password, encryptedPassword)
+// DeleteUser 注销账号，之前签发的 token 全部失效，手机号可以重新注册
+func (uc *UserUsecase) DeleteUser(ctx context.Context, id int64) (*User, error) {
+	version, err := uc.repo.DeleteUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &User{ID: id, TokenVersion: version}, nil
 }
 
 func (uc *UserUsecase) UserById(ctx context.Context, id int64) (*User, error) {
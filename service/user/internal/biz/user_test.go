@@ -1,10 +1,17 @@
 package biz_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
 	"time"
 	"user/internal/biz"
+	"user/internal/conf"
 	"user/internal/mocks/mrepo"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -16,14 +23,16 @@ var _ = Describe("UserUseCase", func() {
 	BeforeEach(func() {
 		// 隔离了数据层，只测试业务逻辑
 		mUserRepo = mrepo.NewMockUserRepo(ctl)
-		userCase = biz.NewUserUsecase(mUserRepo, nil)
+		ticket, err := biz.NewResetTicket(&conf.Auth{ResetTicketKey: resetTicketKey})
+		Ω(err).ShouldNot(HaveOccurred())
+		userCase = biz.NewUserUsecase(mUserRepo, biz.NewPasswordPolicy(nil), ticket, nil)
 	})
 	It("Create", func() {
 		birthDay := time.Unix(int64(693646426), 0)
 		info := &biz.User{
 			ID:       1,
 			Mobile:   "13803881388",
-			Password: "123456",
+			Password: "lucien123",
 			NickName: "lucien",
 			Role:     1,
 			Birthday: &birthDay,
@@ -35,4 +44,161 @@ var _ = Describe("UserUseCase", func() {
 		Ω(l.ID).To(Equal(int64(1)))
 		Ω(l.Mobile).To(Equal("13803881388"))
 	})
+	It("VerifyCredentials", func() {
+		info := &biz.User{ID: 1, Mobile: "13803881388", Password: "hashed"}
+		mUserRepo.EXPECT().UserByMobile(ctx, "13803881388").Return(info, nil)
+		mUserRepo.EXPECT().CheckPassword(ctx, "123456", "hashed").Return(true, nil)
+		mUserRepo.EXPECT().RehashPassword(ctx, int64(1), "123456", "hashed").Return(nil)
+		u, err := userCase.VerifyCredentials(ctx, "13803881388", "123456")
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(u.ID).To(Equal(int64(1)))
+	})
+	It("VerifyCredentialsInvalid", func() {
+		// 手机号不存在和密码错误返回同一个错误
+		mUserRepo.EXPECT().UserByMobile(ctx, "13800000000").Return(nil, errors.NotFound("USER_NOT_FOUND", "user not found"))
+		mUserRepo.EXPECT().CheckPassword(ctx, "123456", gomock.Any()).Return(false, nil)
+		_, err := userCase.VerifyCredentials(ctx, "13800000000", "123456")
+		Ω(err).Should(Equal(biz.ErrInvalidCredentials))
+
+		info := &biz.User{ID: 1, Mobile: "13803881388", Password: "hashed"}
+		mUserRepo.EXPECT().UserByMobile(ctx, "13803881388").Return(info, nil)
+		mUserRepo.EXPECT().CheckPassword(ctx, "wrong", "hashed").Return(false, errors.InternalServer("USER_PWD_ERROR", "user check pwd error"))
+		_, err = userCase.VerifyCredentials(ctx, "13803881388", "wrong")
+		Ω(err).Should(Equal(biz.ErrInvalidCredentials))
+	})
+	It("VerifyCredentialsDisabled", func() {
+		// 密码正确但账号已禁用
+		info := &biz.User{ID: 1, Mobile: "13803881388", Password: "hashed", Disabled: true}
+		mUserRepo.EXPECT().UserByMobile(ctx, "13803881388").Return(info, nil)
+		mUserRepo.EXPECT().CheckPassword(ctx, "123456", "hashed").Return(true, nil)
+		_, err := userCase.VerifyCredentials(ctx, "13803881388", "123456")
+		Ω(err).Should(Equal(biz.ErrUserDisabled))
+	})
+	It("ResetPassword", func() {
+		info := &biz.User{ID: 1, Mobile: "13803881388"}
+		ticket := signResetTicket("13803881388", "n1", time.Now().Add(time.Minute))
+		mUserRepo.EXPECT().UserByMobile(ctx, "13803881388").Return(info, nil)
+		mUserRepo.EXPECT().UseResetTicket(ctx, "n1", gomock.Any()).Return(true, nil)
+		mUserRepo.EXPECT().UpdatePassword(ctx, int64(1), "lucien123").Return(int64(2), nil)
+		u, err := userCase.ResetPassword(ctx, "13803881388", ticket, "lucien123")
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(u.TokenVersion).To(Equal(int64(2)))
+
+		// 同一个凭证只能使用一次
+		mUserRepo.EXPECT().UserByMobile(ctx, "13803881388").Return(info, nil)
+		mUserRepo.EXPECT().UseResetTicket(ctx, "n1", gomock.Any()).Return(false, nil)
+		_, err = userCase.ResetPassword(ctx, "13803881388", ticket, "lucien123")
+		Ω(err).Should(Equal(biz.ErrResetTicketInvalid))
+	})
+	It("ResetPasswordTicketInvalid", func() {
+		// 没有凭证、手机号不一致、过期或者签名错误时不访问数据层
+		for _, ticket := range []string{
+			"",
+			signResetTicket("13800000000", "n1", time.Now().Add(time.Minute)),
+			signResetTicket("13803881388", "n1", time.Now().Add(-time.Second)),
+			signResetTicket("13803881388", "n1", time.Now().Add(time.Minute)) + "x",
+		} {
+			_, err := userCase.ResetPassword(ctx, "13803881388", ticket, "lucien123")
+			Ω(err).Should(Equal(biz.ErrResetTicketInvalid))
+		}
+	})
+	It("SetDisabled", func() {
+		mUserRepo.EXPECT().UpdateDisabled(ctx, int64(1), true).Return(int64(3), nil)
+		u, err := userCase.SetDisabled(ctx, 1, true)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(u.Disabled).To(BeTrue())
+		Ω(u.TokenVersion).To(Equal(int64(3)))
+	})
+	It("ChangeRole", func() {
+		mUserRepo.EXPECT().UpdateRole(ctx, int64(1), biz.RoleAdmin).Return(int64(2), nil)
+		u, err := userCase.ChangeRole(ctx, 1, biz.RoleAdmin)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(u.TokenVersion).To(Equal(int64(2)))
+
+		// 不存在的角色不会访问数据层
+		_, err = userCase.ChangeRole(ctx, 1, 9)
+		Ω(err).Should(Equal(biz.ErrRoleInvalid))
+	})
+	It("DeleteUser", func() {
+		mUserRepo.EXPECT().DeleteUser(ctx, int64(1)).Return(int64(4), nil)
+		u, err := userCase.DeleteUser(ctx, 1)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(u.TokenVersion).To(Equal(int64(4)))
+	})
+	It("ChangePassword", func() {
+		info := &biz.User{ID: 1, Mobile: "13803881388", Password: "hashed", TokenVersion: 1}
+		mUserRepo.EXPECT().GetUserById(ctx, int64(1)).Return(info, nil)
+		mUserRepo.EXPECT().CheckPassword(ctx, "123456", "hashed").Return(true, nil)
+		mUserRepo.EXPECT().UpdatePassword(ctx, int64(1), "newpass123").Return(int64(2), nil)
+		u, err := userCase.ChangePassword(ctx, 1, "123456", "newpass123")
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(u.TokenVersion).To(Equal(int64(2)))
+	})
+	It("ChangePasswordWrongOld", func() {
+		info := &biz.User{ID: 1, Mobile: "13803881388", Password: "hashed"}
+		mUserRepo.EXPECT().GetUserById(ctx, int64(1)).Return(info, nil)
+		mUserRepo.EXPECT().CheckPassword(ctx, "wrong", "hashed").Return(false, errors.InternalServer("USER_PWD_ERROR", "user check pwd error"))
+		_, err := userCase.ChangePassword(ctx, 1, "wrong", "newpass123")
+		Ω(err).Should(Equal(biz.ErrOldPasswordInvalid))
+	})
+	It("UpdateUser", func() {
+		// 只更新昵称时不校验其他字段
+		info := &biz.User{ID: 1, NickName: " lucien "}
+		fields := []string{biz.UserFieldNickName}
+		mUserRepo.EXPECT().UpdateUser(ctx, info, fields).Return(true, nil)
+		ok, err := userCase.UpdateUser(ctx, info, fields)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(ok).To(BeTrue())
+		Ω(info.NickName).To(Equal("lucien"))
+
+		// 没有指定字段时全部更新，生日为空表示清空
+		info = &biz.User{ID: 1, NickName: "lucien", Gender: "female"}
+		all := []string{biz.UserFieldNickName, biz.UserFieldGender, biz.UserFieldBirthday}
+		mUserRepo.EXPECT().UpdateUser(ctx, info, all).Return(true, nil)
+		_, err = userCase.UpdateUser(ctx, info, nil)
+		Ω(err).ShouldNot(HaveOccurred())
+
+		// 1970 年之前的生日
+		old := time.Date(1965, 3, 1, 0, 0, 0, 0, time.Local)
+		info = &biz.User{ID: 1, Birthday: &old}
+		fields = []string{biz.UserFieldBirthday}
+		mUserRepo.EXPECT().UpdateUser(ctx, info, fields).Return(true, nil)
+		_, err = userCase.UpdateUser(ctx, info, fields)
+		Ω(err).ShouldNot(HaveOccurred())
+	})
+	It("UpdateUserInvalid", func() {
+		_, err := userCase.UpdateUser(ctx, &biz.User{ID: 1, Gender: "unknown"}, []string{biz.UserFieldGender})
+		Ω(err).Should(Equal(biz.ErrGenderInvalid))
+
+		future := time.Now().Add(24 * time.Hour)
+		_, err = userCase.UpdateUser(ctx, &biz.User{ID: 1, Birthday: &future}, []string{biz.UserFieldBirthday})
+		Ω(err).Should(Equal(biz.ErrBirthdayInvalid))
+
+		_, err = userCase.UpdateUser(ctx, &biz.User{ID: 1}, []string{"role"})
+		Ω(err).Should(Equal(biz.ErrUpdateMaskInvalid))
+	})
+	It("WeakPassword", func() {
+		// 长度不够或者字符种类太少都不能创建，也不会访问数据层
+		_, err := userCase.Create(ctx, &biz.User{Mobile: "13803881388", Password: "abc12", NickName: "lucien"})
+		Ω(errors.Reason(err)).To(Equal("PASSWORD_TOO_WEAK"))
+		_, err = userCase.Create(ctx, &biz.User{Mobile: "13803881388", Password: "12345678", NickName: "lucien"})
+		Ω(errors.Reason(err)).To(Equal("PASSWORD_TOO_WEAK"))
+	})
+	It("PasswordBcryptBytes", func() {
+		// 默认的 bcrypt 只使用前 72 字节，字符数没有超过上限但字节数超过时也不能使用
+		password := strings.Repeat("密码", 13) + "a1"
+		Ω(biz.NewPasswordPolicy(nil).Check(password)).Should(HaveOccurred())
+		argon2 := &conf.Auth{Password: &conf.Auth_Password{Algorithm: "argon2id"}}
+		Ω(biz.NewPasswordPolicy(argon2).Check(password)).ShouldNot(HaveOccurred())
+	})
 })
+
+const resetTicketKey = "reset-ticket-test"
+
+// signResetTicket 按网关的格式签发重置密码凭证
+func signResetTicket(mobile, nonce string, expiredAt time.Time) string {
+	payload := fmt.Sprintf("%s:%s:%d", mobile, nonce, expiredAt.Unix())
+	mac := hmac.New(sha256.New, []byte(resetTicketKey))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -343,10 +343,12 @@ func (x *Registry) GetConsul() *Registry_Consul {
 }
 
 type Auth struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	JwtKey        string                 `protobuf:"bytes,1,opt,name=jwt_key,json=jwtKey,proto3" json:"jwt_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JwtKey         string                 `protobuf:"bytes,1,opt,name=jwt_key,json=jwtKey,proto3" json:"jwt_key,omitempty"`
+	Password       *Auth_Password         `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ResetTicketKey string                 `protobuf:"bytes,3,opt,name=reset_ticket_key,json=resetTicketKey,proto3" json:"reset_ticket_key,omitempty"` // 重置密码凭证的签名密钥，必须配置，和网关的 auth.reset_ticket_key 一致
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Auth) Reset() {
@@ -386,6 +388,20 @@ func (x *Auth) GetJwtKey() string {
 	return ""
 }
 
+func (x *Auth) GetPassword() *Auth_Password {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *Auth) GetResetTicketKey() string {
+	if x != nil {
+		return x.ResetTicketKey
+	}
+	return ""
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -790,6 +806,106 @@ func (x *Registry_Consul) GetScheme() string {
 	return ""
 }
 
+type Auth_Password struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Algorithm     string                 `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`                               // bcrypt 或 argon2id，默认 bcrypt，参数变化后用户下次登录时自动重新哈希
+	BcryptCost    int32                  `protobuf:"varint,2,opt,name=bcrypt_cost,json=bcryptCost,proto3" json:"bcrypt_cost,omitempty"`          // 默认 12
+	Argon2Time    uint32                 `protobuf:"varint,3,opt,name=argon2_time,json=argon2Time,proto3" json:"argon2_time,omitempty"`          // 迭代次数，默认 3
+	Argon2Memory  uint32                 `protobuf:"varint,4,opt,name=argon2_memory,json=argon2Memory,proto3" json:"argon2_memory,omitempty"`    // 内存，单位 KiB，默认 65536
+	Argon2Threads uint32                 `protobuf:"varint,5,opt,name=argon2_threads,json=argon2Threads,proto3" json:"argon2_threads,omitempty"` // 并行度，默认 2
+	MinLength     int32                  `protobuf:"varint,6,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`             // 最小长度，默认 8
+	MaxLength     int32                  `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`             // 最大长度，默认 64，bcrypt 只使用前 72 字节
+	MinClasses    int32                  `protobuf:"varint,8,opt,name=min_classes,json=minClasses,proto3" json:"min_classes,omitempty"`          // 至少包含几类字符（小写字母、大写字母、数字、符号），默认 2
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Password) Reset() {
+	*x = Auth_Password{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Password) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Password) ProtoMessage() {}
+
+func (x *Auth_Password) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Password.ProtoReflect.Descriptor instead.
+func (*Auth_Password) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *Auth_Password) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Auth_Password) GetBcryptCost() int32 {
+	if x != nil {
+		return x.BcryptCost
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetArgon2Time() uint32 {
+	if x != nil {
+		return x.Argon2Time
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetArgon2Memory() uint32 {
+	if x != nil {
+		return x.Argon2Memory
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetArgon2Threads() uint32 {
+	if x != nil {
+		return x.Argon2Threads
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetMinClasses() int32 {
+	if x != nil {
+		return x.MinClasses
+	}
+	return 0
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
@@ -840,9 +956,25 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x06consul\x18\x01 \x01(\v2\x1b.kratos.api.Registry.ConsulR\x06consul\x1a:\n" +
 	"\x06Consul\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
-	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"\x1f\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\"\x98\x03\n" +
 	"\x04Auth\x12\x17\n" +
-	"\ajwt_key\x18\x01 \x01(\tR\x06jwtKeyB\x19Z\x17user/internal/conf;confb\x06proto3"
+	"\ajwt_key\x18\x01 \x01(\tR\x06jwtKey\x125\n" +
+	"\bpassword\x18\x02 \x01(\v2\x19.kratos.api.Auth.PasswordR\bpassword\x12(\n" +
+	"\x10reset_ticket_key\x18\x03 \x01(\tR\x0eresetTicketKey\x1a\x95\x02\n" +
+	"\bPassword\x12\x1c\n" +
+	"\talgorithm\x18\x01 \x01(\tR\talgorithm\x12\x1f\n" +
+	"\vbcrypt_cost\x18\x02 \x01(\x05R\n" +
+	"bcryptCost\x12\x1f\n" +
+	"\vargon2_time\x18\x03 \x01(\rR\n" +
+	"argon2Time\x12#\n" +
+	"\rargon2_memory\x18\x04 \x01(\rR\fargon2Memory\x12%\n" +
+	"\x0eargon2_threads\x18\x05 \x01(\rR\rargon2Threads\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x06 \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\a \x01(\x05R\tmaxLength\x12\x1f\n" +
+	"\vmin_classes\x18\b \x01(\x05R\n" +
+	"minClassesB\x19Z\x17user/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -856,7 +988,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -872,7 +1004,8 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Service_User)(nil),        // 11: kratos.api.Service.User
 	(*Service_Goods)(nil),       // 12: kratos.api.Service.Goods
 	(*Registry_Consul)(nil),     // 13: kratos.api.Registry.Consul
-	(*durationpb.Duration)(nil), // 14: google.protobuf.Duration
+	(*Auth_Password)(nil),       // 14: kratos.api.Auth.Password
+	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -887,16 +1020,17 @@ var file_conf_conf_proto_depIdxs = []int32{
 	11, // 9: kratos.api.Service.user:type_name -> kratos.api.Service.User
 	12, // 10: kratos.api.Service.goods:type_name -> kratos.api.Service.Goods
 	13, // 11: kratos.api.Registry.consul:type_name -> kratos.api.Registry.Consul
-	14, // 12: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	14, // 13: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	14, // 14: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	14, // 15: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	14, // 16: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	14, // 12: kratos.api.Auth.password:type_name -> kratos.api.Auth.Password
+	15, // 13: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	15, // 14: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	15, // 15: kratos.api.Data.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	15, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	15, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -910,7 +1044,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,213 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Address 收货地址表
+type Address struct {
+	ID           int64     `gorm:"primarykey"`
+	UserID       int64     `gorm:"index:idx_user_id;type:bigint comment '用户ID';not null"`
+	ProvinceCode string    `gorm:"type:char(6) comment '省级行政区划代码';not null"`
+	CityCode     string    `gorm:"type:char(6) comment '市级行政区划代码';not null"`
+	DistrictCode string    `gorm:"type:char(6) comment '区县行政区划代码';not null"`
+	Recipient    string    `gorm:"type:varchar(25) comment '收货人';not null"`
+	Mobile       string    `gorm:"type:varchar(11) comment '收货人手机号';not null"`
+	Detail       string    `gorm:"type:varchar(200) comment '详细地址';not null"`
+	IsDefault    bool      `gorm:"default:false;comment:是否默认地址;not null"`
+	CreatedAt    time.Time `gorm:"column:add_time"`
+	UpdatedAt    time.Time `gorm:"column:update_time"`
+	DeletedAt    gorm.DeletedAt
+}
+
+func (Address) TableName() string {
+	return "user_address"
+}
+
+func (a *Address) toBiz() *biz.Address {
+	return &biz.Address{
+		ID:           a.ID,
+		UserID:       a.UserID,
+		ProvinceCode: a.ProvinceCode,
+		CityCode:     a.CityCode,
+		DistrictCode: a.DistrictCode,
+		Recipient:    a.Recipient,
+		Mobile:       a.Mobile,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+	}
+}
+
+type addressRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewAddressRepo .
+func NewAddressRepo(data *Data, logger log.Logger) biz.AddressRepo {
+	return &addressRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// transaction 锁住用户行后执行，同一个用户的地址修改串行执行，保证最多一个默认地址
+func (r *addressRepo) transaction(ctx context.Context, userId int64, fn func(tx *gorm.DB) error) error {
+	return r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user User
+		res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", userId).Limit(1).Find(&user)
+		if res.Error != nil {
+			return errors.New(500, "FIND_USER_ERROR", "find user error")
+		}
+		if res.RowsAffected == 0 {
+			return errors.NotFound("USER_NOT_FOUND", "user not found")
+		}
+		return fn(tx)
+	})
+}
+
+// CreateAddress .
+func (r *addressRepo) CreateAddress(ctx context.Context, a *biz.Address) (*biz.Address, error) {
+	address := Address{
+		UserID:       a.UserID,
+		ProvinceCode: a.ProvinceCode,
+		CityCode:     a.CityCode,
+		DistrictCode: a.DistrictCode,
+		Recipient:    a.Recipient,
+		Mobile:       a.Mobile,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+	}
+	err := r.transaction(ctx, a.UserID, func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&Address{}).Where("user_id = ?", a.UserID).Count(&count).Error; err != nil {
+			return errors.InternalServer("ADDRESS_CREATE_ERROR", "address count error")
+		}
+		if count >= biz.MaxAddressCount {
+			return biz.ErrAddressLimit
+		}
+		if count == 0 {
+			address.IsDefault = true
+		}
+		if address.IsDefault {
+			if err := clearDefault(tx, a.UserID); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(&address).Error; err != nil {
+			return errors.InternalServer("ADDRESS_CREATE_ERROR", "address save error")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return address.toBiz(), nil
+}
+
+// UpdateAddress 取消默认地址不会自动选择新的默认地址
+func (r *addressRepo) UpdateAddress(ctx context.Context, a *biz.Address) error {
+	return r.transaction(ctx, a.UserID, func(tx *gorm.DB) error {
+		if a.IsDefault {
+			if err := clearDefault(tx, a.UserID); err != nil {
+				return err
+			}
+		}
+		res := tx.Model(&Address{}).Where("id = ? AND user_id = ?", a.ID, a.UserID).Updates(map[string]interface{}{
+			"province_code": a.ProvinceCode,
+			"city_code":     a.CityCode,
+			"district_code": a.DistrictCode,
+			"recipient":     a.Recipient,
+			"mobile":        a.Mobile,
+			"detail":        a.Detail,
+			"is_default":    a.IsDefault,
+		})
+		if res.Error != nil {
+			return errors.InternalServer("ADDRESS_UPDATE_ERROR", "address save error")
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrAddressNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteAddress .
+func (r *addressRepo) DeleteAddress(ctx context.Context, userId, id int64) error {
+	return r.transaction(ctx, userId, func(tx *gorm.DB) error {
+		var address Address
+		res := tx.Where("id = ? AND user_id = ?", id, userId).Limit(1).Find(&address)
+		if res.Error != nil {
+			return errors.InternalServer("ADDRESS_DELETE_ERROR", "find address error")
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrAddressNotFound
+		}
+		if err := tx.Delete(&address).Error; err != nil {
+			return errors.InternalServer("ADDRESS_DELETE_ERROR", "address delete error")
+		}
+		if !address.IsDefault {
+			return nil
+		}
+		var latest Address
+		res = tx.Where("user_id = ?", userId).Order("id DESC").Limit(1).Find(&latest)
+		if res.Error != nil {
+			return errors.InternalServer("ADDRESS_DELETE_ERROR", "find address error")
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		if err := tx.Model(&latest).Update("is_default", true).Error; err != nil {
+			return errors.InternalServer("ADDRESS_DELETE_ERROR", "address save error")
+		}
+		return nil
+	})
+}
+
+// ListAddress 默认地址排在最前面，其余按添加时间倒序
+func (r *addressRepo) ListAddress(ctx context.Context, userId int64) ([]*biz.Address, error) {
+	var list []Address
+	err := r.data.db.WithContext(ctx).Where("user_id = ?", userId).
+		Order("is_default DESC").Order("id DESC").Find(&list).Error
+	if err != nil {
+		return nil, errors.InternalServer("ADDRESS_LIST_ERROR", "find address error")
+	}
+	rv := make([]*biz.Address, 0, len(list))
+	for i := range list {
+		rv = append(rv, list[i].toBiz())
+	}
+	return rv, nil
+}
+
+// SetDefaultAddress .
+func (r *addressRepo) SetDefaultAddress(ctx context.Context, userId, id int64) error {
+	return r.transaction(ctx, userId, func(tx *gorm.DB) error {
+		if err := clearDefault(tx, userId); err != nil {
+			return err
+		}
+		res := tx.Model(&Address{}).Where("id = ? AND user_id = ?", id, userId).Update("is_default", true)
+		if res.Error != nil {
+			return errors.InternalServer("ADDRESS_UPDATE_ERROR", "address save error")
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrAddressNotFound
+		}
+		return nil
+	})
+}
+
+func clearDefault(tx *gorm.DB, userId int64) error {
+	err := tx.Model(&Address{}).Where("user_id = ? AND is_default = ?", userId, true).Update("is_default", false).Error
+	if err != nil {
+		return errors.InternalServer("ADDRESS_UPDATE_ERROR", "address save error")
+	}
+	return nil
+}
@@ -61,7 +61,7 @@ func initialize(db *gorm.DB) error {
 		return errors.WithStack(err)
 	}
 	// 自动创建表结构
-	err := db.AutoMigrate(&data.User{})
+	err := db.AutoMigrate(&data.User{}, &data.LockEvent{}, &data.Address{}, &data.ResetTicket{})
 	return errors.WithStack(err)
 }
 
@@ -34,5 +34,5 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	_ = db.AutoMigrate(&data.User{})
+	_ = db.AutoMigrate(&data.User{}, &data.LockEvent{}, &data.Address{}, &data.ResetTicket{})
 }
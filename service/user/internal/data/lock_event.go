@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"user/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LockEvent 登录锁定事件表，只追加不修改
+type LockEvent struct {
+	ID        int64      `gorm:"primarykey"`
+	Mobile    string     `gorm:"index:idx_mobile;type:varchar(11) comment '手机号码'"`
+	IP        string     `gorm:"index:idx_ip;type:varchar(64) comment '客户端IP'"`
+	Action    string     `gorm:"type:varchar(16) comment 'lock:锁定,unlock:解锁';not null"`
+	Scope     string     `gorm:"type:varchar(16) comment 'mobile:按手机号,ip:按IP';not null"`
+	Reason    string     `gorm:"type:varchar(100) comment '原因'"`
+	Failures  int64      `gorm:"type:int comment '窗口内失败次数'"`
+	Until     *time.Time `gorm:"type:datetime comment '锁定到期时间'"`
+	Operator  int64      `gorm:"type:bigint comment '手动解锁的管理员ID'"`
+	CreatedAt time.Time  `gorm:"column:add_time"`
+}
+
+func (LockEvent) TableName() string {
+	return "user_lock_event"
+}
+
+type lockEventRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+// NewLockEventRepo .
+func NewLockEventRepo(data *Data, logger log.Logger) biz.LockEventRepo {
+	return &lockEventRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// CreateLockEvent .
+func (r *lockEventRepo) CreateLockEvent(ctx context.Context, e *biz.LockEvent) error {
+	event := LockEvent{
+		Mobile:   e.Mobile,
+		IP:       e.IP,
+		Action:   e.Action,
+		Scope:    e.Scope,
+		Reason:   e.Reason,
+		Failures: e.Failures,
+		Until:    e.Until,
+		Operator: e.Operator,
+	}
+	if res := r.data.db.WithContext(ctx).Create(&event); res.Error != nil {
+		return errors.InternalServer("LOCK_EVENT_CREATE_ERROR", "lock event save error")
+	}
+	return nil
+}
+
+// ListLockEvent 按时间倒序查询
+func (r *lockEventRepo) ListLockEvent(ctx context.Context, f *biz.LockEventFilter) ([]*biz.LockEvent, int, error) {
+	db := r.data.db.WithContext(ctx).Model(&LockEvent{})
+	if f.Mobile != "" {
+		db = db.Where("mobile = ?", f.Mobile)
+	}
+	if f.IP != "" {
+		db = db.Where("ip = ?", f.IP)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, errors.New(500, "FIND_LOCK_EVENT_ERROR", "find lock event error")
+	}
+	var events []LockEvent
+	if err := db.Scopes(paginate(f.PageNum, f.PageSize)).Order("id DESC").Find(&events).Error; err != nil {
+		return nil, 0, errors.New(500, "FIND_LOCK_EVENT_ERROR", "find lock event error")
+	}
+	rv := make([]*biz.LockEvent, 0, len(events))
+	for _, e := range events {
+		rv = append(rv, &biz.LockEvent{
+			ID:        e.ID,
+			Mobile:    e.Mobile,
+			IP:        e.IP,
+			Action:    e.Action,
+			Scope:     e.Scope,
+			Reason:    e.Reason,
+			Failures:  e.Failures,
+			Until:     e.Until,
+			Operator:  e.Operator,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return rv, int(total), nil
+}
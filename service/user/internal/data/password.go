@@ -0,0 +1,168 @@
+package data
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"user/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 密码哈希算法
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+// 密码哈希默认参数
+const (
+	PasswordCost = 12 //密码加密难度
+
+	defaultArgon2Time    = 3
+	defaultArgon2Memory  = 64 * 1024
+	defaultArgon2Threads = 2
+	argon2SaltLen        = 16
+	argon2KeyLen         = 32
+)
+
+var ErrPasswordHashFormat = errors.InternalServer("PASSWORD_HASH_FORMAT", "unsupported password hash")
+
+// PasswordHasher 密码哈希，Verify 根据哈希的格式选择算法，所以切换算法后旧密码仍然可以登录
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	Verify(password, encoded string) (bool, error)
+	// NeedsRehash 哈希的算法或参数和当前配置不一致
+	NeedsRehash(encoded string) bool
+}
+
+type passwordHasher struct {
+	algorithm     string
+	bcryptCost    int
+	argon2Time    uint32
+	argon2Memory  uint32
+	argon2Threads uint8
+}
+
+// NewPasswordHasher .
+func NewPasswordHasher(c *conf.Auth) PasswordHasher {
+	p := c.GetPassword()
+	h := &passwordHasher{
+		algorithm:     p.GetAlgorithm(),
+		bcryptCost:    int(p.GetBcryptCost()),
+		argon2Time:    p.GetArgon2Time(),
+		argon2Memory:  p.GetArgon2Memory(),
+		argon2Threads: uint8(p.GetArgon2Threads()),
+	}
+	if h.algorithm == "" {
+		h.algorithm = AlgorithmBcrypt
+	}
+	if h.algorithm != AlgorithmBcrypt && h.algorithm != AlgorithmArgon2id {
+		panic(fmt.Sprintf("unsupported password algorithm %q", h.algorithm))
+	}
+	if h.bcryptCost == 0 {
+		h.bcryptCost = PasswordCost
+	}
+	if h.bcryptCost < bcrypt.MinCost || h.bcryptCost > bcrypt.MaxCost {
+		panic(fmt.Sprintf("invalid bcrypt cost %d", h.bcryptCost))
+	}
+	if h.argon2Time == 0 {
+		h.argon2Time = defaultArgon2Time
+	}
+	if h.argon2Memory == 0 {
+		h.argon2Memory = defaultArgon2Memory
+	}
+	if h.argon2Threads == 0 {
+		h.argon2Threads = defaultArgon2Threads
+	}
+	return h
+}
+
+func (h *passwordHasher) Hash(password string) (string, error) {
+	if h.algorithm == AlgorithmArgon2id {
+		salt := make([]byte, argon2SaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		key := argon2.IDKey([]byte(password), salt, h.argon2Time, h.argon2Memory, h.argon2Threads, argon2KeyLen)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.argon2Memory, h.argon2Time,
+			h.argon2Threads, base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	}
+	b, err := bcrypt.GenerateFromPassword([]byte(password), h.bcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (h *passwordHasher) Verify(password, encoded string) (bool, error) {
+	if strings.HasPrefix(encoded, "$argon2id$") {
+		p, err := parseArgon2id(encoded)
+		if err != nil {
+			return false, err
+		}
+		key := argon2.IDKey([]byte(password), p.salt, p.time, p.memory, p.threads, uint32(len(p.key)))
+		return subtle.ConstantTimeCompare(key, p.key) == 1, nil
+	}
+	if _, err := bcrypt.Cost([]byte(encoded)); err != nil {
+		return false, ErrPasswordHashFormat
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *passwordHasher) NeedsRehash(encoded string) bool {
+	if h.algorithm == AlgorithmArgon2id {
+		p, err := parseArgon2id(encoded)
+		if err != nil {
+			return true
+		}
+		return p.time != h.argon2Time || p.memory != h.argon2Memory || p.threads != h.argon2Threads ||
+			len(p.key) != argon2KeyLen
+	}
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost != h.bcryptCost
+}
+
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// parseArgon2id 解析 $argon2id$v=19$m=65536,t=3,p=2$salt$key 格式的哈希
+func parseArgon2id(encoded string) (*argon2Params, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return nil, ErrPasswordHashFormat
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, ErrPasswordHashFormat
+	}
+	p := &argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return nil, ErrPasswordHashFormat
+	}
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrPasswordHashFormat
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(p.key) == 0 {
+		return nil, ErrPasswordHashFormat
+	}
+	return p, nil
+}
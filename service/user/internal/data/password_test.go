@@ -0,0 +1,34 @@
+package data_test
+
+import (
+	"user/internal/conf"
+	"user/internal/data"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PasswordHasher", func() {
+	bcryptHasher := data.NewPasswordHasher(nil)
+	argon2Hasher := data.NewPasswordHasher(&conf.Auth{Password: &conf.Auth_Password{Algorithm: data.AlgorithmArgon2id}})
+	It("Argon2id", func() {
+		encoded, err := argon2Hasher.Hash("lucien123")
+		Ω(err).ShouldNot(HaveOccurred())
+		ok, err := argon2Hasher.Verify("lucien123", encoded)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(ok).Should(BeTrue())
+		ok, err = argon2Hasher.Verify("lucien124", encoded)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(ok).Should(BeFalse())
+	})
+	It("NeedsRehash", func() {
+		// 切换算法后旧哈希仍然可以校验，但需要重新哈希
+		encoded, err := bcryptHasher.Hash("lucien123")
+		Ω(err).ShouldNot(HaveOccurred())
+		ok, err := argon2Hasher.Verify("lucien123", encoded)
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(ok).Should(BeTrue())
+		Ω(argon2Hasher.NeedsRehash(encoded)).Should(BeTrue())
+		Ω(bcryptHasher.NeedsRehash(encoded)).Should(BeFalse())
+	})
+})
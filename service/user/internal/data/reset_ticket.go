@@ -0,0 +1,34 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"gorm.io/gorm/clause"
+)
+
+// ResetTicket 已经使用的重置密码凭证，过期后可以删除
+type ResetTicket struct {
+	ID        int64     `gorm:"primarykey"`
+	Nonce     string    `gorm:"uniqueIndex:idx_nonce;type:varchar(64) comment '凭证随机串';not null"`
+	ExpiredAt time.Time `gorm:"index:idx_expired_at;type:datetime comment '凭证过期时间';not null"`
+	CreatedAt time.Time `gorm:"column:add_time"`
+}
+
+func (ResetTicket) TableName() string {
+	return "user_reset_ticket"
+}
+
+// UseResetTicket 插入凭证随机串，唯一索引冲突说明凭证已经使用过，同时清理已经过期的记录
+func (r *userRepo) UseResetTicket(ctx context.Context, nonce string, expiredAt time.Time) (bool, error) {
+	db := r.data.db.WithContext(ctx)
+	if err := db.Where("expired_at < ?", time.Now()).Delete(&ResetTicket{}).Error; err != nil {
+		r.log.Warnf("clean expired reset ticket error: %v", err)
+	}
+	res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&ResetTicket{Nonce: nonce, ExpiredAt: expiredAt})
+	if res.Error != nil {
+		return false, errors.InternalServer("RESET_TICKET_SAVE_ERROR", "reset ticket save error")
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -2,6 +2,8 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
@@ -9,23 +11,25 @@ import (
 	"user/internal/biz"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // 定义数据表结构体
 type User struct {
-	ID          int64      `gorm:"primarykey"`
-	Mobile      string     `gorm:"index:idx_mobile;unique;type:varchar(11) comment '手机号码，用户唯一标识';not null"`
-	Password    string     `gorm:"type:varchar(100);not null "` // 用户密码的保存需要注意是否加密
-	NickName    string     `gorm:"type:varchar(25) comment '用户昵称'"`
-	Birthday    *time.Time `gorm:"type:datetime comment '出生日期'"`
-	Gender      string     `gorm:"column:gender;default:male;type:varchar(16) comment 'female:女,male:男'"`
-	Role        int        `gorm:"column:role;default:1;type:int comment '1:普通用户, 2:管理员'"`
-	CreatedAt   time.Time  `gorm:"column:add_time"`
-	UpdatedAt   time.Time  `gorm:"column:update_time"`
-	DeletedAt   gorm.DeletedAt
-	IsDeletedAt bool
+	ID           int64      `gorm:"primarykey"`
+	Mobile       string     `gorm:"index:idx_mobile;unique;type:varchar(11) comment '手机号码，用户唯一标识';not null"`
+	Password     string     `gorm:"type:varchar(100);not null "` // 用户密码的保存需要注意是否加密
+	NickName     string     `gorm:"type:varchar(25) comment '用户昵称'"`
+	Birthday     *time.Time `gorm:"type:datetime comment '出生日期'"`
+	Gender       string     `gorm:"column:gender;default:male;type:varchar(16) comment 'female:女,male:男'"`
+	Role         int        `gorm:"column:role;default:1;type:int comment '1:普通用户, 2:管理员'"`
+	TokenVersion int64      `gorm:"column:token_version;default:0;not null;type:bigint comment '修改密码后加一'"`
+	Disabled     bool       `gorm:"column:disabled;default:false;not null;type:boolean comment '账号是否禁用'"`
+	CreatedAt    time.Time  `gorm:"column:add_time"`
+	UpdatedAt    time.Time  `gorm:"column:update_time"`
+	DeletedAt    gorm.DeletedAt
+	IsDeletedAt  bool // 用户主动注销
 }
 
 func (User) TableName() string {
@@ -33,29 +37,33 @@ func (User) TableName() string {
 }
 
 type userRepo struct {
-	data *Data
-	log  *log.Helper
+	data   *Data
+	hasher PasswordHasher
+	log    *log.Helper
 }
 
 // NewUserRepo .
-func NewUserRepo(data *Data, logger log.Logger) biz.UserRepo {
+func NewUserRepo(data *Data, hasher PasswordHasher, logger log.Logger) biz.UserRepo {
 	return &userRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:   data,
+		hasher: hasher,
+		log:    log.NewHelper(logger),
 	}
 }
 
 // ModelToResponse 转换 user 表中所有字段的值
 func modelToResponse(user User) biz.User {
 	userInfoRsp := biz.User{
-		ID:        user.ID,
-		Mobile:    user.Mobile,
-		Password:  user.Password,
-		NickName:  user.NickName,
-		Gender:    user.Gender,
-		Role:      user.Role,
-		Birthday:  user.Birthday,
-		CreatedAt: user.CreatedAt,
+		ID:           user.ID,
+		Mobile:       user.Mobile,
+		Password:     user.Password,
+		NickName:     user.NickName,
+		Gender:       user.Gender,
+		Role:         user.Role,
+		Birthday:     user.Birthday,
+		CreatedAt:    user.CreatedAt,
+		TokenVersion: user.TokenVersion,
+		Disabled:     user.Disabled,
 	}
 	return userInfoRsp
 }
@@ -68,9 +76,13 @@ func (r *userRepo) CreateUser(ctx context.Context, u *biz.User) (*biz.User, erro
 	if result.RowsAffected == 1 {
 		return nil, errors.New(500, "USER_EXIST", "用户已存在"+u.Mobile)
 	}
+	password, err := r.hasher.Hash(u.Password)
+	if err != nil {
+		return nil, errors.InternalServer("PASSWORD_HASH_ERROR", "password hash error").WithCause(err)
+	}
 	user.Mobile = u.Mobile
 	user.NickName = u.NickName
-	user.Password = setPassword(u.Password)
+	user.Password = password
 	res := r.data.db.Create(&user)
 	if res.Error != nil {
 		return nil, errors.New(500, "CREAT_USER_ERROR", "用户创建失败")
@@ -85,21 +97,6 @@ func (r *userRepo) CreateUser(ctx context.Context, u *biz.User) (*biz.User, erro
 	}, nil
 }
 
-const (
-	PasswordCost = 12 //密码加密难度
-)
-
-// SetPassword 设置密码
-func setPassword(password string) string {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), PasswordCost)
-	if err != nil {
-		return " "
-	}
-	// 将原密码进行加密
-	encodePwd := string(bytes)
-	return encodePwd
-}
-
 // paginate 分页
 func paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
@@ -119,31 +116,46 @@ func paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
-// ListUser .
-func (r *userRepo) ListUser(ctx context.Context, pageNum, pageSize int) ([]*biz.User, int, error) {
-	var users []User
-	result := r.data.db.Find(&users)
-	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return nil, 0, errors.NotFound("USER_NOT_FOUND", "user not found")
+// ListUser 按条件搜索用户，总数使用 COUNT 查询
+func (r *userRepo) ListUser(ctx context.Context, f *biz.UserFilter) ([]*biz.User, int, error) {
+	db := r.data.db.WithContext(ctx).Model(&User{})
+	if f.Mobile != "" {
+		db = db.Where("mobile LIKE ?", escapeLike(f.Mobile)+"%")
 	}
-	if result.Error != nil {
+	if f.NickName != "" {
+		db = db.Where("nick_name LIKE ?", "%"+escapeLike(f.NickName)+"%")
+	}
+	if f.Role > 0 {
+		db = db.Where("role = ?", f.Role)
+	}
+	if f.Gender != "" {
+		db = db.Where("gender = ?", f.Gender)
+	}
+	if f.StartTime != nil {
+		db = db.Where("add_time >= ?", *f.StartTime)
+	}
+	if f.EndTime != nil {
+		db = db.Where("add_time < ?", *f.EndTime)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
 		return nil, 0, errors.New(500, "FIND_USER_ERROR", "find user error")
 	}
-	total := int(result.RowsAffected)
-	r.data.db.Scopes(paginate(pageNum, pageSize)).Find(&users)
-	rv := make([]*biz.User, 0)
+	var users []User
+	if err := db.Scopes(paginate(f.PageNum, f.PageSize)).Order("id").Find(&users).Error; err != nil {
+		return nil, 0, errors.New(500, "FIND_USER_ERROR", "find user error")
+	}
+	rv := make([]*biz.User, 0, len(users))
 	for _, u := range users {
-		rv = append(rv, &biz.User{
-			ID:       u.ID,
-			Mobile:   u.Mobile,
-			Password: u.Password,
-			NickName: u.NickName,
-			Gender:   u.Gender,
-			Role:     u.Role,
-			Birthday: u.Birthday,
-		})
+		user := modelToResponse(u)
+		rv = append(rv, &user)
 	}
-	return rv, total, nil
+	return rv, int(total), nil
+}
+
+// escapeLike 转义 LIKE 中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
 // GetUser .
@@ -163,26 +175,36 @@ func (r *userRepo) UserByMobile(ctx context.Context, mobile string) (*biz.User,
 	return &res, nil
 }
 
-// UpdateUser .
-func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) (bool, error) {
-	var userInfo User
-	result := r.data.db.Where(&User{ID: user.ID}).First(&userInfo)
-	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-		return false, errors.NotFound("USER_NOT_FOUND", "user not found")
-	}
-	if result.Error != nil {
-		return false, errors.New(500, "FIND_USER_ERROR", "find user error")
+// UpdateUser 只更新指定的字段
+func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User, fields []string) (bool, error) {
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		switch field {
+		case biz.UserFieldNickName:
+			values["nick_name"] = user.NickName
+		case biz.UserFieldGender:
+			values["gender"] = user.Gender
+		case biz.UserFieldBirthday:
+			values["birthday"] = user.Birthday
+		}
 	}
-	if result.RowsAffected == 0 {
-		return false, errors.NotFound("USER_NOT_FOUND", "user not found")
+	if len(values) == 0 {
+		return false, errors.BadRequest("UPDATE_MASK_INVALID", "no field to update")
 	}
-	userInfo.NickName = user.NickName
-	userInfo.Birthday = user.Birthday
-	userInfo.Gender = user.Gender
-	res := r.data.db.Save(&userInfo)
+	res := r.data.db.Model(&User{}).Where("id = ?", user.ID).Updates(values)
 	if res.Error != nil {
 		return false, errors.InternalServer("USER_UPDATE_ERROR", "user save error")
 	}
+	if res.RowsAffected == 0 {
+		// 值没有变化时 RowsAffected 也是 0，需要确认用户是否存在
+		var count int64
+		if err := r.data.db.Model(&User{}).Where("id = ?", user.ID).Count(&count).Error; err != nil {
+			return false, errors.New(500, "FIND_USER_ERROR", "find user error")
+		}
+		if count == 0 {
+			return false, errors.NotFound("USER_NOT_FOUND", "user not found")
+		}
+	}
 	return true, nil
 }
 
@@ -199,11 +221,106 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *biz.User) (bool, error)
 
 // CheckPassword .
 func (r *userRepo) CheckPassword(ctx context.Context, psd, encryptedPsd string) (bool, error) {
-	err := bcrypt.CompareHashAndPassword([]byte(encryptedPsd), []byte(psd))
+	ok, err := r.hasher.Verify(psd, encryptedPsd)
 	if err != nil {
-		return false, errors.InternalServer("USER_PWD_ERROR", "user check pwd error")
+		return false, errors.InternalServer("USER_PWD_ERROR", "user check pwd error").WithCause(err)
 	}
-	return true, nil
+	return ok, nil
+}
+
+// RehashPassword 哈希算法或参数变化后用新的配置重新哈希，token 版本不变。
+// 条件中带上旧的哈希，期间修改过密码时不会覆盖
+func (r *userRepo) RehashPassword(ctx context.Context, id int64, psd, encryptedPsd string) error {
+	if !r.hasher.NeedsRehash(encryptedPsd) {
+		return nil
+	}
+	password, err := r.hasher.Hash(psd)
+	if err != nil {
+		return errors.InternalServer("PASSWORD_HASH_ERROR", "password hash error").WithCause(err)
+	}
+	res := r.data.db.Model(&User{}).Where("id = ? AND password = ?", id, encryptedPsd).Update("password", password)
+	if res.Error != nil {
+		return errors.InternalServer("USER_UPDATE_ERROR", "user password save error")
+	}
+	return nil
+}
+
+// UpdatePassword 密码和 token 版本在同一条语句中更新
+func (r *userRepo) UpdatePassword(ctx context.Context, id int64, password string) (int64, error) {
+	hashed, err := r.hasher.Hash(password)
+	if err != nil {
+		return 0, errors.InternalServer("PASSWORD_HASH_ERROR", "password hash error").WithCause(err)
+	}
+	return r.updateWithVersion(ctx, id, map[string]interface{}{"password": hashed})
+}
+
+// UpdateDisabled .
+func (r *userRepo) UpdateDisabled(ctx context.Context, id int64, disabled bool) (int64, error) {
+	return r.updateWithVersion(ctx, id, map[string]interface{}{"disabled": disabled})
+}
+
+// UpdateRole .
+func (r *userRepo) UpdateRole(ctx context.Context, id int64, role int) (int64, error) {
+	return r.updateWithVersion(ctx, id, map[string]interface{}{"role": role})
+}
+
+// DeletedNickName 注销后的用户昵称
+const DeletedNickName = "已注销用户"
+
+// DeleteUser 在一个事务中匿名化用户、删除收货地址，然后软删除用户。
+// 手机号改成不可能是手机号的唯一值，原手机号可以重新注册
+func (r *userRepo) DeleteUser(ctx context.Context, id int64) (int64, error) {
+	var version int64
+	err := r.data.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user User
+		res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Limit(1).Find(&user)
+		if res.Error != nil {
+			return errors.New(500, "FIND_USER_ERROR", "find user error")
+		}
+		if res.RowsAffected == 0 {
+			return errors.NotFound("USER_NOT_FOUND", "user not found")
+		}
+		err := tx.Model(&user).Updates(map[string]interface{}{
+			"mobile":        fmt.Sprintf("d%010d", id),
+			"nick_name":     DeletedNickName,
+			"password":      "",
+			"birthday":      nil,
+			"is_deleted_at": true,
+			"token_version": gorm.Expr("token_version + 1"),
+		}).Error
+		if err != nil {
+			return errors.InternalServer("USER_DELETE_ERROR", "user anonymize error")
+		}
+		if err := tx.Where("user_id = ?", id).Delete(&Address{}).Error; err != nil {
+			return errors.InternalServer("USER_DELETE_ERROR", "address delete error")
+		}
+		if err := tx.Delete(&User{}, id).Error; err != nil {
+			return errors.InternalServer("USER_DELETE_ERROR", "user delete error")
+		}
+		version = user.TokenVersion + 1
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// updateWithVersion 更新字段的同时将 token 版本加一，返回新的 token 版本
+func (r *userRepo) updateWithVersion(ctx context.Context, id int64, values map[string]interface{}) (int64, error) {
+	values["token_version"] = gorm.Expr("token_version + 1")
+	res := r.data.db.Model(&User{}).Where("id = ?", id).Updates(values)
+	if res.Error != nil {
+		return 0, errors.InternalServer("USER_UPDATE_ERROR", "user save error")
+	}
+	if res.RowsAffected == 0 {
+		return 0, errors.NotFound("USER_NOT_FOUND", "user not found")
+	}
+	var user User
+	if err := r.data.db.Select("token_version").Where("id = ?", id).First(&user).Error; err != nil {
+		return 0, errors.New(500, "FIND_USER_ERROR", "find user error")
+	}
+	return user.TokenVersion, nil
 }
 
 // GetUserById .
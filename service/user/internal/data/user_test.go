@@ -16,7 +16,7 @@ var _ = Describe("User", func() {
 	var uD *biz.User
 	BeforeEach(func() {
 		//  Db 是 data_suite_test.go 文件里面定义的
-		ro = data.NewUserRepo(Db, nil)
+		ro = data.NewUserRepo(Db, data.NewPasswordHasher(nil), nil)
 		uD = testdata.User()
 	})
 	// 设置It 块来添加单个规格
@@ -27,19 +27,28 @@ var _ = Describe("User", func() {
 		Ω(u.Mobile).Should(Equal("13803881388"))
 	})
 	It("ListUser", func() {
-		user, total, err := ro.ListUser(ctx, 1, 10)
+		user, total, err := ro.ListUser(ctx, &biz.UserFilter{PageNum: 1, PageSize: 10})
 		Ω(err).ShouldNot(HaveOccurred()) // 获取列表不应该出现错误
 		Ω(user).ShouldNot(BeEmpty())     // 结果不应该为空
 		Ω(total).Should(Equal(1))        // 总数应该为 1，因为上面只创建了一条
 		Ω(len(user)).Should(Equal(1))
 		Ω(user[0].Mobile).Should(Equal("13803881388"))
+
+		// 按手机号前缀过滤，总数是过滤后的数量
+		user, total, err = ro.ListUser(ctx, &biz.UserFilter{Mobile: "1380388", PageNum: 1, PageSize: 10})
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(total).Should(Equal(1))
+		user, total, err = ro.ListUser(ctx, &biz.UserFilter{Mobile: "139", PageNum: 1, PageSize: 10})
+		Ω(err).ShouldNot(HaveOccurred())
+		Ω(total).Should(Equal(0))
+		Ω(user).Should(BeEmpty())
 	})
 	It("UpdateUser", func() {
 		birthDay := time.Unix(int64(693646426), 0)
 		uD.NickName = "lucien"
 		uD.Birthday = &birthDay
 		uD.Gender = "male"
-		user, err := ro.UpdateUser(ctx, uD)
+		user, err := ro.UpdateUser(ctx, uD, []string{biz.UserFieldNickName, biz.UserFieldBirthday, biz.UserFieldGender})
 		Ω(err).ShouldNot(HaveOccurred()) // 更新不应该出现错误
 		Ω(user).Should(BeTrue())         // 结果应该为 true
 	})
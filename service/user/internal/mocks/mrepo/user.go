@@ -7,6 +7,7 @@ package mrepo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	biz "user/internal/biz"
 
 	gomock "github.com/golang/mock/gomock"
@@ -65,6 +66,21 @@ func (mr *MockUserRepoMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepo)(nil).CreateUser), arg0, arg1)
 }
 
+// DeleteUser mocks base method.
+func (m *MockUserRepo) DeleteUser(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockUserRepoMockRecorder) DeleteUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserRepo)(nil).DeleteUser), arg0, arg1)
+}
+
 // GetUserById mocks base method.
 func (m *MockUserRepo) GetUserById(arg0 context.Context, arg1 int64) (*biz.User, error) {
 	m.ctrl.T.Helper()
@@ -81,9 +97,9 @@ func (mr *MockUserRepoMockRecorder) GetUserById(arg0, arg1 interface{}) *gomock.
 }
 
 // ListUser mocks base method.
-func (m *MockUserRepo) ListUser(arg0 context.Context, arg1, arg2 int) ([]*biz.User, int, error) {
+func (m *MockUserRepo) ListUser(arg0 context.Context, arg1 *biz.UserFilter) ([]*biz.User, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUser", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListUser", arg0, arg1)
 	ret0, _ := ret[0].([]*biz.User)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
@@ -91,24 +107,98 @@ func (m *MockUserRepo) ListUser(arg0 context.Context, arg1, arg2 int) ([]*biz.Us
 }
 
 // ListUser indicates an expected call of ListUser.
-func (mr *MockUserRepoMockRecorder) ListUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) ListUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUser", reflect.TypeOf((*MockUserRepo)(nil).ListUser), arg0, arg1)
+}
+
+// RehashPassword mocks base method.
+func (m *MockUserRepo) RehashPassword(arg0 context.Context, arg1 int64, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RehashPassword", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RehashPassword indicates an expected call of RehashPassword.
+func (mr *MockUserRepoMockRecorder) RehashPassword(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RehashPassword", reflect.TypeOf((*MockUserRepo)(nil).RehashPassword), arg0, arg1, arg2, arg3)
+}
+
+// UpdateDisabled mocks base method.
+func (m *MockUserRepo) UpdateDisabled(arg0 context.Context, arg1 int64, arg2 bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDisabled", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDisabled indicates an expected call of UpdateDisabled.
+func (mr *MockUserRepoMockRecorder) UpdateDisabled(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUser", reflect.TypeOf((*MockUserRepo)(nil).ListUser), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDisabled", reflect.TypeOf((*MockUserRepo)(nil).UpdateDisabled), arg0, arg1, arg2)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUserRepo) UpdatePassword(arg0 context.Context, arg1 int64, arg2 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserRepoMockRecorder) UpdatePassword(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepo)(nil).UpdatePassword), arg0, arg1, arg2)
+}
+
+// UpdateRole mocks base method.
+func (m *MockUserRepo) UpdateRole(arg0 context.Context, arg1 int64, arg2 int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockUserRepoMockRecorder) UpdateRole(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockUserRepo)(nil).UpdateRole), arg0, arg1, arg2)
 }
 
 // UpdateUser mocks base method.
-func (m *MockUserRepo) UpdateUser(arg0 context.Context, arg1 *biz.User) (bool, error) {
+func (m *MockUserRepo) UpdateUser(arg0 context.Context, arg1 *biz.User, arg2 []string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockUserRepoMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockUserRepoMockRecorder) UpdateUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepo)(nil).UpdateUser), arg0, arg1, arg2)
+}
+
+// UseResetTicket mocks base method.
+func (m *MockUserRepo) UseResetTicket(arg0 context.Context, arg1 string, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseResetTicket", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseResetTicket indicates an expected call of UseResetTicket.
+func (mr *MockUserRepoMockRecorder) UseResetTicket(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepo)(nil).UpdateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseResetTicket", reflect.TypeOf((*MockUserRepo)(nil).UseResetTicket), arg0, arg1, arg2)
 }
 
 // UserByMobile mocks base method.
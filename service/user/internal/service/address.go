@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateAddress .
+func (u *UserService) CreateAddress(ctx context.Context, req *v1.AddressInfo) (*v1.AddressInfo, error) {
+	a, err := u.ad.Create(ctx, addressFromInfo(req))
+	if err != nil {
+		return nil, err
+	}
+	return addressInfo(a), nil
+}
+
+// UpdateAddress .
+func (u *UserService) UpdateAddress(ctx context.Context, req *v1.AddressInfo) (*emptypb.Empty, error) {
+	if err := u.ad.Update(ctx, addressFromInfo(req)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteAddress .
+func (u *UserService) DeleteAddress(ctx context.Context, req *v1.AddressRequest) (*emptypb.Empty, error) {
+	if err := u.ad.Delete(ctx, req.UserId, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListAddresses .
+func (u *UserService) ListAddresses(ctx context.Context, req *v1.UserIdRequest) (*v1.AddressListResponse, error) {
+	list, err := u.ad.List(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.AddressListResponse{}
+	for _, a := range list {
+		rsp.Data = append(rsp.Data, addressInfo(a))
+	}
+	return rsp, nil
+}
+
+// SetDefaultAddress .
+func (u *UserService) SetDefaultAddress(ctx context.Context, req *v1.AddressRequest) (*emptypb.Empty, error) {
+	if err := u.ad.SetDefault(ctx, req.UserId, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func addressFromInfo(req *v1.AddressInfo) *biz.Address {
+	return &biz.Address{
+		ID:           req.Id,
+		UserID:       req.UserId,
+		ProvinceCode: req.ProvinceCode,
+		CityCode:     req.CityCode,
+		DistrictCode: req.DistrictCode,
+		Recipient:    req.Recipient,
+		Mobile:       req.Mobile,
+		Detail:       req.Detail,
+		IsDefault:    req.IsDefault,
+	}
+}
+
+func addressInfo(a *biz.Address) *v1.AddressInfo {
+	return &v1.AddressInfo{
+		Id:           a.ID,
+		UserId:       a.UserID,
+		ProvinceCode: a.ProvinceCode,
+		CityCode:     a.CityCode,
+		DistrictCode: a.DistrictCode,
+		Recipient:    a.Recipient,
+		Mobile:       a.Mobile,
+		Detail:       a.Detail,
+		IsDefault:    a.IsDefault,
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "user/api/user/v1"
+	"user/internal/biz"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateLockEvent .
+func (u *UserService) CreateLockEvent(ctx context.Context, req *v1.LockEventInfo) (*emptypb.Empty, error) {
+	e := &biz.LockEvent{
+		Mobile:   req.Mobile,
+		IP:       req.Ip,
+		Action:   req.Action,
+		Scope:    req.Scope,
+		Reason:   req.Reason,
+		Failures: req.Failures,
+		Operator: req.Operator,
+	}
+	if req.Until > 0 {
+		until := time.Unix(int64(req.Until), 0)
+		e.Until = &until
+	}
+	if err := u.le.Create(ctx, e); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetLockEventList .
+func (u *UserService) GetLockEventList(ctx context.Context, req *v1.LockEventListRequest) (*v1.LockEventListResponse, error) {
+	list, total, err := u.le.List(ctx, &biz.LockEventFilter{
+		Mobile:   req.Mobile,
+		IP:       req.Ip,
+		PageNum:  int(req.Pn),
+		PageSize: int(req.PSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.LockEventListResponse{Total: int32(total)}
+	for _, e := range list {
+		item := &v1.LockEventInfo{
+			Id:        e.ID,
+			Mobile:    e.Mobile,
+			Ip:        e.IP,
+			Action:    e.Action,
+			Scope:     e.Scope,
+			Reason:    e.Reason,
+			Failures:  e.Failures,
+			Operator:  e.Operator,
+			CreatedAt: uint64(e.CreatedAt.Unix()),
+		}
+		if e.Until != nil {
+			item.Until = uint64(e.Until.Unix())
+		}
+		rsp.Data = append(rsp.Data, item)
+	}
+	return rsp, nil
+}
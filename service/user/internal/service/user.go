@@ -14,25 +14,32 @@ import (
 type UserService struct {
 	v1.UnimplementedUserServer
 	uc  *biz.UserUsecase
+	le  *biz.LockEventUsecase
+	ad  *biz.AddressUsecase
 	log *log.Helper
 }
 
-func NewUserService(uc *biz.UserUsecase, logger log.Logger) *UserService {
-	return &UserService{uc: uc, log: log.NewHelper(logger)}
+func NewUserService(uc *biz.UserUsecase, le *biz.LockEventUsecase, ad *biz.AddressUsecase, logger log.Logger) *UserService {
+	return &UserService{uc: uc, le: le, ad: ad, log: log.NewHelper(logger)}
 }
 
 // 将biz.User数据结构体转化为protoc格式
 func UserResponse(user *biz.User) *v1.UserInfoResponse {
 	userInfoRsp := v1.UserInfoResponse{
-		Id:       user.ID,
-		Mobile:   user.Mobile,
-		Password: user.Password,
-		NickName: user.NickName,
-		Gender:   user.Gender,
-		Role:     int32(user.Role),
+		Id:           user.ID,
+		Mobile:       user.Mobile,
+		NickName:     user.NickName,
+		Gender:       user.Gender,
+		Role:         int32(user.Role),
+		TokenVersion: user.TokenVersion,
+		Disabled:     user.Disabled,
 	}
 	if user.Birthday != nil {
-		userInfoRsp.Birthday = uint64(user.Birthday.Unix())
+		birthday := user.Birthday.Unix()
+		userInfoRsp.Birthday = &birthday
+	}
+	if !user.CreatedAt.IsZero() {
+		userInfoRsp.CreatedAt = uint64(user.CreatedAt.Unix())
 	}
 	return &userInfoRsp
 }
@@ -63,7 +70,7 @@ func (u *UserService) GetUserByMobile(ctx context.Context, req *v1.MobileRequest
 
 // GetUserList .
 func (u *UserService) GetUserList(ctx context.Context, req *v1.PageInfo) (*v1.UserListResponse, error) {
-	list, total, err := u.uc.List(ctx, int(req.Pn), int(req.PSize))
+	list, total, err := u.uc.List(ctx, &biz.UserFilter{PageNum: int(req.Pn), PageSize: int(req.PSize)})
 	if err != nil {
 		return nil, err
 	}
@@ -76,31 +83,104 @@ func (u *UserService) GetUserList(ctx context.Context, req *v1.PageInfo) (*v1.Us
 	return rsp, nil
 }
 
-// UpdateUser .
+// SearchUser .
+func (u *UserService) SearchUser(ctx context.Context, req *v1.UserSearchRequest) (*v1.UserListResponse, error) {
+	f := &biz.UserFilter{
+		Mobile:   req.Mobile,
+		NickName: req.NickName,
+		Role:     int(req.Role),
+		Gender:   req.Gender,
+		PageNum:  int(req.Pn),
+		PageSize: int(req.PSize),
+	}
+	if req.StartTime > 0 {
+		start := time.Unix(int64(req.StartTime), 0)
+		f.StartTime = &start
+	}
+	if req.EndTime > 0 {
+		end := time.Unix(int64(req.EndTime), 0)
+		f.EndTime = &end
+	}
+	list, total, err := u.uc.List(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &v1.UserListResponse{Total: int32(total)}
+	for _, user := range list {
+		rsp.Data = append(rsp.Data, UserResponse(user))
+	}
+	return rsp, nil
+}
+
+// UpdateUser 只更新 updateMask 中的字段，birthday 没有设置时清空生日
 func (u *UserService) UpdateUser(ctx context.Context, req *v1.UpdateUserInfo) (*emptypb.Empty, error) {
-	birthDay := time.Unix(int64(req.Birthday), 0)
-	user, err := u.uc.UpdateUser(ctx, &biz.User{
+	user := &biz.User{
 		ID:       req.Id,
 		Gender:   req.Gender,
-		Birthday: &birthDay,
 		NickName: req.NickName,
-	})
+	}
+	if req.Birthday != nil {
+		birthday := time.Unix(req.GetBirthday(), 0)
+		user.Birthday = &birthday
+	}
+	if _, err := u.uc.UpdateUser(ctx, user, req.GetUpdateMask().GetPaths()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// VerifyCredentials .
+func (u *UserService) VerifyCredentials(ctx context.Context, req *v1.VerifyCredentialsRequest) (*v1.UserInfoResponse, error) {
+	user, err := u.uc.VerifyCredentials(ctx, req.Mobile, req.Password)
 	if err != nil {
 		return nil, err
 	}
-	if !user {
+	return UserResponse(user), nil
+}
+
+// ChangePassword .
+func (u *UserService) ChangePassword(ctx context.Context, req *v1.ChangePasswordRequest) (*v1.TokenVersionResponse, error) {
+	user, err := u.uc.ChangePassword(ctx, req.Id, req.OldPassword, req.NewPassword)
+	if err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	return &v1.TokenVersionResponse{Id: user.ID, TokenVersion: user.TokenVersion}, nil
+}
+
+// ResetPassword .
+func (u *UserService) ResetPassword(ctx context.Context, req *v1.ResetPasswordRequest) (*v1.TokenVersionResponse, error) {
+	user, err := u.uc.ResetPassword(ctx, req.Mobile, req.Ticket, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TokenVersionResponse{Id: user.ID, TokenVersion: user.TokenVersion}, nil
+}
+
+// SetUserDisabled .
+func (u *UserService) SetUserDisabled(ctx context.Context, req *v1.UserDisabledRequest) (*v1.TokenVersionResponse, error) {
+	user, err := u.uc.SetDisabled(ctx, req.Id, req.Disabled)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TokenVersionResponse{Id: user.ID, TokenVersion: user.TokenVersion}, nil
+}
+
+// ChangeRole .
+func (u *UserService) ChangeRole(ctx context.Context, req *v1.ChangeRoleRequest) (*v1.TokenVersionResponse, error) {
+	user, err := u.uc.ChangeRole(ctx, req.Id, int(req.Role))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TokenVersionResponse{Id: user.ID, TokenVersion: user.TokenVersion}, nil
 }
 
-// CheckPassword .
-func (u *UserService) CheckPassword(ctx context.Context, req *v1.PasswordCheckInfo) (*v1.CheckResponse, error) {
-	check, err := u.uc.CheckPassword(ctx, req.Password, req.EncryptedPassword)
+// DeleteUser .
+func (u *UserService) DeleteUser(ctx context.Context, req *v1.IdRequest) (*v1.TokenVersionResponse, error) {
+	user, err := u.uc.DeleteUser(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.CheckResponse{Success: check}, nil
+	return &v1.TokenVersionResponse{Id: user.ID, TokenVersion: user.TokenVersion}, nil
 }
 
 // GetUserById .